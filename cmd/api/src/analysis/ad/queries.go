@@ -24,6 +24,7 @@ import (
 
 	"github.com/gofrs/uuid"
 	"github.com/specterops/bloodhound/cmd/api/src/database/types/nan"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
 	"github.com/specterops/bloodhound/cmd/api/src/model"
 	"github.com/specterops/bloodhound/cmd/api/src/queries"
 	"github.com/specterops/bloodhound/packages/go/analysis"
@@ -104,6 +105,10 @@ func GraphStats(ctx context.Context, db graph.Database) (model.ADDataQualityStat
 						mutex = &sync.Mutex{}
 					)
 
+					if lastSeen, err := domain.Properties.Get(common.LastSeen.String()).Time(); err == nil {
+						stat.LastCollected = null.TimeFrom(lastSeen)
+					}
+
 					for _, kind := range kinds {
 						innerKind := kind
 
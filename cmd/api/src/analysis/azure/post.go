@@ -39,18 +39,38 @@ func Post(ctx context.Context, db graph.Database) (*analysis.AtomicPostProcessin
 		return &aggregateStats, err
 	} else if executeCommandStats, err := azureAnalysis.ExecuteCommand(ctx, db); err != nil {
 		return &aggregateStats, err
+	} else if intuneExecuteCommandStats, err := azureAnalysis.IntuneScriptExecution(ctx, db); err != nil {
+		return &aggregateStats, err
 	} else if appRoleAssignmentStats, err := azureAnalysis.AppRoleAssignments(ctx, db); err != nil {
 		return &aggregateStats, err
+	} else if storageAccessStats, err := azureAnalysis.StorageAccess(ctx, db); err != nil {
+		return &aggregateStats, err
 	} else if hybridStats, err := hybrid.PostHybrid(ctx, db); err != nil {
 		return &aggregateStats, err
+	} else if hybridGroupStats, err := hybrid.PostHybridGroups(ctx, db); err != nil {
+		return &aggregateStats, err
+	} else if hybridDeviceStats, err := hybrid.PostHybridDevices(ctx, db); err != nil {
+		return &aggregateStats, err
+	} else if entraConnectStats, err := hybrid.PostEntraConnectSyncAccounts(ctx, db); err != nil {
+		return &aggregateStats, err
+	} else if hybridExecuteCommandStats, err := hybrid.PostHybridExecuteCommand(ctx, db); err != nil {
+		return &aggregateStats, err
 	} else if pimRolesStats, err := azureAnalysis.CreateAZRoleApproverEdge(ctx, db); err != nil {
 		return &aggregateStats, err
+	} else if err := azureAnalysis.ApplyConditionalAccessPolicies(ctx, db); err != nil {
+		return &aggregateStats, err
 	} else {
 		aggregateStats.Merge(stats)
 		aggregateStats.Merge(userRoleStats)
 		aggregateStats.Merge(executeCommandStats)
+		aggregateStats.Merge(intuneExecuteCommandStats)
 		aggregateStats.Merge(appRoleAssignmentStats)
+		aggregateStats.Merge(storageAccessStats)
 		aggregateStats.Merge(hybridStats)
+		aggregateStats.Merge(hybridGroupStats)
+		aggregateStats.Merge(hybridDeviceStats)
+		aggregateStats.Merge(entraConnectStats)
+		aggregateStats.Merge(hybridExecuteCommandStats)
 		aggregateStats.Merge(pimRolesStats)
 		return &aggregateStats, nil
 	}
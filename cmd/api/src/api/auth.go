@@ -50,8 +50,14 @@ var (
 	ErrInvalidAuth                  = errors.New("invalid authentication")
 	ErrNoUserSecret                 = errors.New("user does not have a secret auth provider registered")
 	ErrUserDisabled                 = errors.New("user disabled")
+	ErrUserLocked                   = errors.New("user locked due to too many failed login attempts")
+	ErrNoWebAuthnCredentials        = errors.New("user does not have any webauthn credentials registered")
+	ErrWebAuthnRequired             = errors.New("webauthn authentication required")
+	ErrPhishingResistantMFARequired = errors.New("a webauthn credential is required to sign in as an administrator")
 	ErrUserNotAuthorizedForProvider = errors.New("user not authorized for this provider")
 	ErrInvalidAuthProvider          = errors.New("invalid auth provider")
+	ErrAuthTokenExpired             = errors.New("auth token expired")
+	ErrAuthTokenAddressNotAllowed   = errors.New("auth token not allowed from this address")
 )
 
 func parseRequestDate(rawDate string) (time.Time, error) {
@@ -68,12 +74,14 @@ func parseRequestDate(rawDate string) (time.Time, error) {
 
 type Authenticator interface {
 	LoginWithSecret(ctx context.Context, loginRequest LoginRequest) (LoginDetails, error)
+	BeginWebAuthnLogin(ctx context.Context, loginRequest LoginRequest) (auth.WebAuthnRequestOptions, error)
 	Logout(ctx context.Context, userSession model.UserSession)
 	ValidateSecret(ctx context.Context, secret string, authSecret model.AuthSecret) error
 	ValidateRequestSignature(tokenID uuid.UUID, request *http.Request, serverTime time.Time) (auth.Context, int, error)
 	CreateSession(ctx context.Context, user model.User, authProvider any) (string, error)
 	CreateSSOSession(request *http.Request, response http.ResponseWriter, principalNameOrEmail string, ssoProvider model.SSOProvider)
 	ValidateSession(ctx context.Context, jwtTokenString string) (auth.Context, error)
+	ValidateSCIMToken(ctx context.Context, token string) (auth.Context, error)
 }
 
 type authenticator struct {
@@ -117,18 +125,40 @@ func (s authenticator) auditLogin(requestContext context.Context, commitID uuid.
 	}
 }
 
-func (s authenticator) validateSecretLogin(ctx context.Context, loginRequest LoginRequest) (model.User, string, error) {
+// validateFirstFactor looks up the user of a local login and validates their secret. The secret is validated before the
+// lockout is checked so that a locked account is indistinguishable from a wrong secret to anyone that does not know it.
+// Failed attempts against a locked account are not recorded so they cannot extend the lockout.
+func (s authenticator) validateFirstFactor(ctx context.Context, loginRequest LoginRequest) (model.User, error) {
 	if user, err := s.db.LookupUser(ctx, loginRequest.Username); err != nil {
 		if errors.Is(err, database.ErrNotFound) {
-			return model.User{}, "", ErrInvalidAuth
+			return model.User{}, ErrInvalidAuth
 		}
 
-		return model.User{}, "", FormatDatabaseError(err)
+		return model.User{}, FormatDatabaseError(err)
 	} else if user.AuthSecret == nil {
-		return user, "", ErrNoUserSecret
+		return user, ErrNoUserSecret
 	} else if err := s.ValidateSecret(ctx, loginRequest.Secret, *user.AuthSecret); err != nil {
+		if errors.Is(err, ErrInvalidAuth) && !user.IsLocked(time.Now()) {
+			s.recordFailedLogin(ctx, user)
+		}
+
+		return user, err
+	} else if user.IsLocked(time.Now()) {
+		return user, ErrUserLocked
+	} else {
+		return user, nil
+	}
+}
+
+func (s authenticator) validateSecretLogin(ctx context.Context, loginRequest LoginRequest) (model.User, string, error) {
+	if user, err := s.validateFirstFactor(ctx, loginRequest); err != nil {
 		return user, "", err
-	} else if err = auth.ValidateTOTPSecret(loginRequest.OTP, *user.AuthSecret); err != nil {
+	} else if err := s.validateSecondFactor(ctx, user, loginRequest); err != nil {
+		// A login without a second factor is the first step of an MFA login and is not counted as a failed attempt
+		if (errors.Is(err, auth.ErrInvalidOTP) && loginRequest.OTP != "") || errors.Is(err, auth.ErrInvalidWebAuthn) || errors.Is(err, auth.ErrWebAuthnSignCount) {
+			s.recordFailedLogin(ctx, user)
+		}
+
 		return user, "", err
 	} else if sessionToken, err := s.CreateSession(ctx, user, *user.AuthSecret); err != nil {
 		return user, "", err
@@ -137,6 +167,120 @@ func (s authenticator) validateSecretLogin(ctx context.Context, loginRequest Log
 	}
 }
 
+// validateSecondFactor validates the second factor of a local login. A WebAuthn assertion satisfies any MFA requirement
+// while TOTP codes are only accepted from users that are not required to use a phishing-resistant factor by the MFA
+// policy.
+func (s authenticator) validateSecondFactor(ctx context.Context, user model.User, loginRequest LoginRequest) error {
+	credentials, err := s.db.GetWebAuthnCredentials(ctx, user.ID)
+	if err != nil {
+		return FormatDatabaseError(err)
+	}
+
+	if loginRequest.WebAuthn != nil {
+		return s.validateWebAuthnLogin(ctx, user, credentials, *loginRequest.WebAuthn)
+	} else if user.Roles.Has(model.Role{Name: auth.RoleAdministrator}) && appcfg.GetMFAPolicy(ctx, s.db).RequirePhishingResistantMFAForAdministrators {
+		if len(credentials) == 0 {
+			return ErrPhishingResistantMFARequired
+		}
+
+		return ErrWebAuthnRequired
+	} else if len(credentials) > 0 && !user.AuthSecret.TOTPActivated {
+		return ErrWebAuthnRequired
+	}
+
+	return auth.ValidateTOTPSecret(loginRequest.OTP, *user.AuthSecret)
+}
+
+func (s authenticator) validateWebAuthnLogin(ctx context.Context, user model.User, credentials model.WebAuthnCredentials, authentication auth.WebAuthnAuthenticationResponse) error {
+	relyingParty := auth.NewWebAuthnRelyingParty(s.cfg.RootURL.AsURL())
+
+	if challenge, err := auth.WebAuthnClientDataChallenge(authentication.Response.ClientDataJSON); err != nil {
+		return err
+	} else if err := s.db.ConsumeWebAuthnChallenge(ctx, user.ID, auth.WebAuthnCeremonyAuthentication, challenge); err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return fmt.Errorf("%w: unknown or expired challenge", auth.ErrInvalidWebAuthn)
+		}
+
+		return FormatDatabaseError(err)
+	} else {
+		for _, credential := range credentials {
+			if !bytes.Equal(credential.CredentialID, authentication.RawID) {
+				continue
+			}
+
+			if signCount, err := relyingParty.VerifyAuthentication(challenge, credential.PublicKey, uint32(credential.SignCount), authentication); err != nil {
+				return err
+			} else if err := s.db.UpdateWebAuthnCredentialUsage(ctx, credential, int64(signCount)); err != nil {
+				return FormatDatabaseError(err)
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("%w: unknown credential", auth.ErrInvalidWebAuthn)
+	}
+}
+
+// BeginWebAuthnLogin validates the username and secret of a local login and issues a challenge for the user to sign with
+// one of their registered WebAuthn credentials. The signed challenge completes the login through LoginWithSecret.
+func (s authenticator) BeginWebAuthnLogin(ctx context.Context, loginRequest LoginRequest) (auth.WebAuthnRequestOptions, error) {
+	auditLogFields := types.JSONUntypedObject{"username": loginRequest.Username, "auth_type": auth.ProviderTypeSecret, "webauthn_challenge": true}
+
+	if commitID, err := uuid.NewV4(); err != nil {
+		slog.ErrorContext(ctx, fmt.Sprintf("Error generating commit ID for login: %s", err))
+		return auth.WebAuthnRequestOptions{}, err
+	} else {
+		s.auditLogin(ctx, commitID, model.AuditLogStatusIntent, model.User{}, auditLogFields)
+
+		if user, requestOptions, err := s.issueWebAuthnLoginChallenge(ctx, loginRequest); err != nil {
+			auditLogFields["error"] = err
+			s.auditLogin(ctx, commitID, model.AuditLogStatusFailure, user, auditLogFields)
+			return auth.WebAuthnRequestOptions{}, err
+		} else {
+			s.auditLogin(ctx, commitID, model.AuditLogStatusSuccess, user, auditLogFields)
+			return requestOptions, nil
+		}
+	}
+}
+
+func (s authenticator) issueWebAuthnLoginChallenge(ctx context.Context, loginRequest LoginRequest) (model.User, auth.WebAuthnRequestOptions, error) {
+	if user, err := s.validateFirstFactor(ctx, loginRequest); err != nil {
+		return user, auth.WebAuthnRequestOptions{}, err
+	} else if credentials, err := s.db.GetWebAuthnCredentials(ctx, user.ID); err != nil {
+		return user, auth.WebAuthnRequestOptions{}, FormatDatabaseError(err)
+	} else if len(credentials) == 0 {
+		return user, auth.WebAuthnRequestOptions{}, ErrNoWebAuthnCredentials
+	} else if challenge, err := auth.GenerateWebAuthnChallenge(); err != nil {
+		return user, auth.WebAuthnRequestOptions{}, err
+	} else if err := s.db.CreateWebAuthnChallenge(ctx, model.WebAuthnChallenge{
+		Challenge: challenge,
+		UserID:    user.ID,
+		Ceremony:  auth.WebAuthnCeremonyAuthentication,
+		ExpiresAt: time.Now().UTC().Add(auth.WebAuthnChallengeTimeout),
+	}); err != nil {
+		return user, auth.WebAuthnRequestOptions{}, FormatDatabaseError(err)
+	} else {
+		relyingParty := auth.NewWebAuthnRelyingParty(s.cfg.RootURL.AsURL())
+		return user, relyingParty.RequestOptions(challenge, auth.WebAuthnCredentialDescriptors(credentials)), nil
+	}
+}
+
+// recordFailedLogin counts a failed local login against the user and locks the account once the password policy's
+// lockout threshold is reached. Failures are logged rather than returned so that they never mask the login error.
+func (s authenticator) recordFailedLogin(ctx context.Context, user model.User) {
+	if passwordPolicy := appcfg.GetPasswordPolicy(ctx, s.db); !passwordPolicy.LockoutEnabled() {
+		return
+	} else if attempts, err := s.db.RecordFailedLogin(ctx, user); err != nil {
+		slog.ErrorContext(ctx, fmt.Sprintf("Failed to record failed login for user %s: %v", user.ID, err))
+	} else if attempts >= passwordPolicy.LockoutThreshold {
+		if err := s.db.LockUser(ctx, user, time.Now().Add(passwordPolicy.LockoutDuration())); err != nil {
+			slog.ErrorContext(ctx, fmt.Sprintf("Failed to lock user %s: %v", user.ID, err))
+		} else {
+			slog.WarnContext(ctx, fmt.Sprintf("Locked user %s(%s) after %d failed login attempts", user.ID, user.PrincipalName, attempts))
+		}
+	}
+}
+
 func (s authenticator) LoginWithSecret(ctx context.Context, loginRequest LoginRequest) (LoginDetails, error) {
 	auditLogFields := types.JSONUntypedObject{"username": loginRequest.Username, "auth_type": auth.ProviderTypeSecret}
 
@@ -237,6 +381,10 @@ func (s authenticator) ValidateRequestSignature(tokenID uuid.UUID, request *http
 		return auth.Context{}, http.StatusBadRequest, fmt.Errorf("malformed signature header: %w", err)
 	} else if authToken, err := s.db.GetAuthToken(request.Context(), tokenID); err != nil {
 		return handleAuthDBError(err)
+	} else if authToken.IsExpired(serverTime) {
+		return auth.Context{}, http.StatusUnauthorized, ErrAuthTokenExpired
+	} else if len(authToken.AllowedCIDRs) > 0 && !authToken.AllowsAddress(ClientIP(request, appcfg.GetTrustedProxiesParameters(request.Context(), s.db))) {
+		return auth.Context{}, http.StatusForbidden, ErrAuthTokenAddressNotAllowed
 	} else if authContext, err := s.ctxInitializer.InitContextFromToken(request.Context(), authToken); err != nil {
 		return handleAuthDBError(err)
 	} else if user, isUser := auth.GetUserFromAuthCtx(authContext); isUser && user.IsDisabled {
@@ -355,6 +503,12 @@ func (s authenticator) CreateSSOSession(request *http.Request, response http.Res
 			auditLogFields["oidc_provider_id"] = ssoProvider.OIDCProvider.ID
 			authProvider = *ssoProvider.OIDCProvider
 		}
+	case model.SessionAuthProviderLDAP:
+		auditLogFields["auth_type"] = auth.ProviderTypeLDAP
+		if ssoProvider.LDAPProvider != nil {
+			auditLogFields["ldap_provider_id"] = ssoProvider.LDAPProvider.ID
+			authProvider = *ssoProvider.LDAPProvider
+		}
 	}
 
 	// Generate commit ID for audit logging
@@ -411,18 +565,23 @@ func (s authenticator) CreateSSOSession(request *http.Request, response http.Res
 	}
 }
 
-func (s authenticator) CreateSession(ctx context.Context, user model.User, authProvider any) (string, error) {
+func (s authenticator) CreateSession(requestContext context.Context, user model.User, authProvider any) (string, error) {
 	if user.IsDisabled {
 		return "", ErrUserDisabled
 	}
 
-	slog.InfoContext(ctx, fmt.Sprintf("Creating session for user: %s(%s)", user.ID, user.PrincipalName))
+	slog.InfoContext(requestContext, fmt.Sprintf("Creating session for user: %s(%s)", user.ID, user.PrincipalName))
 
-	userSession := model.UserSession{
-		User:      user,
-		UserID:    user.ID,
-		ExpiresAt: time.Now().UTC().Add(appcfg.GetSessionTTLHours(ctx, s.db)),
-	}
+	var (
+		bhCtx       = ctx.Get(requestContext)
+		userSession = model.UserSession{
+			User:            user,
+			UserID:          user.ID,
+			ExpiresAt:       time.Now().UTC().Add(appcfg.GetSessionTTLHours(requestContext, s.db)),
+			SourceIPAddress: bhCtx.RequestIP,
+			UserAgent:       bhCtx.UserAgent,
+		}
+	)
 
 	switch typedAuthProvider := authProvider.(type) {
 	case model.AuthSecret:
@@ -434,11 +593,14 @@ func (s authenticator) CreateSession(ctx context.Context, user model.User, authP
 	case model.OIDCProvider:
 		userSession.AuthProviderType = model.SessionAuthProviderOIDC
 		userSession.AuthProviderID = typedAuthProvider.ID
+	case model.LDAPProvider:
+		userSession.AuthProviderType = model.SessionAuthProviderLDAP
+		userSession.AuthProviderID = typedAuthProvider.ID
 	default:
 		return "", ErrInvalidAuthProvider
 	}
 
-	if newSession, err := s.db.CreateUserSession(ctx, userSession); err != nil {
+	if newSession, err := s.db.CreateUserSession(requestContext, userSession); err != nil {
 		return "", FormatDatabaseError(err)
 	} else if signingKeyBytes, err := s.cfg.Crypto.JWT.SigningKeyBytes(); err != nil {
 		return "", err
@@ -524,11 +686,34 @@ func (s authenticator) ValidateSession(ctx context.Context, jwtTokenString strin
 	}
 }
 
+// ValidateSCIMToken authenticates a SCIM provisioning request. The resulting auth context is owned by the SSO provider
+// that was issued the token and carries no user permissions.
+func (s authenticator) ValidateSCIMToken(ctx context.Context, token string) (auth.Context, error) {
+	if provider, err := s.db.GetSSOProviderBySCIMTokenDigest(ctx, auth.SCIMTokenDigest(token)); err != nil {
+		if !errors.Is(err, database.ErrNotFound) {
+			slog.ErrorContext(ctx, fmt.Sprintf("Unable to look up SCIM token: %v", err))
+		}
+
+		return auth.Context{}, ErrInvalidAuth
+	} else {
+		return auth.Context{
+			Owner: provider,
+			PermissionOverrides: auth.PermissionOverrides{
+				Enabled:     true,
+				Permissions: model.Permissions{},
+			},
+		}, nil
+	}
+}
+
 type LoginRequest struct {
 	LoginMethod string `json:"login_method"`
 	Username    string `json:"username"`
 	Secret      string `json:"secret,omitempty"`
 	OTP         string `json:"otp,omitempty"`
+
+	// WebAuthn is the response to a challenge issued by BeginWebAuthnLogin and may be given in place of an OTP
+	WebAuthn *auth.WebAuthnAuthenticationResponse `json:"webauthn,omitempty"`
 }
 
 type LoginDetails struct {
@@ -30,13 +30,17 @@ import (
 	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/pquerna/otp/totp"
 	"github.com/specterops/bloodhound/cmd/api/src/auth"
 	"github.com/specterops/bloodhound/cmd/api/src/config"
 	"github.com/specterops/bloodhound/cmd/api/src/ctx"
+	"github.com/specterops/bloodhound/cmd/api/src/database"
 	dbMocks "github.com/specterops/bloodhound/cmd/api/src/database/mocks"
 	"github.com/specterops/bloodhound/cmd/api/src/database/types"
 	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
 	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
+	"github.com/specterops/bloodhound/cmd/api/src/serde"
 	cryptoMocks "github.com/specterops/bloodhound/packages/go/crypto/mocks"
 	"github.com/specterops/bloodhound/packages/go/headers"
 	"github.com/specterops/bloodhound/packages/go/slicesext"
@@ -225,6 +229,50 @@ func TestValidateRequestSignature(t *testing.T) {
 		require.Equal(t, http.StatusInternalServerError, status)
 	})
 
+	t.Run("should return 401 error on expired auth token", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		authenticator := NewTestAuthenticator(ctrl)
+
+		req, err := http.NewRequest(http.MethodGet, "http://teapotsrus.dev", nil)
+		require.NoError(t, err)
+
+		req.Header.Add(headers.RequestDate.String(), time.Now().Format(time.RFC3339))
+		signature, err := NewRequestSignature(context.Background(), sha256.New, "token", time.Now().Format(time.RFC3339), req.Method, req.RequestURI, nil)
+		require.NoError(t, err)
+		req.Header.Add(headers.Signature.String(), base64.StdEncoding.EncodeToString(signature))
+
+		db := authenticator.db.(*dbMocks.MockDatabase)
+		db.EXPECT().GetAuthToken(gomock.Any(), gomock.Any()).Return(model.AuthToken{ExpiresAt: null.TimeFrom(time.Now().Add(-time.Minute))}, nil)
+
+		_, status, err := authenticator.ValidateRequestSignature(uuid.UUID{}, req, time.Now())
+		require.ErrorIs(t, err, ErrAuthTokenExpired)
+		require.Equal(t, http.StatusUnauthorized, status)
+	})
+
+	t.Run("should return 403 error when the client address is outside the token's allowed CIDRs", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		authenticator := NewTestAuthenticator(ctrl)
+
+		req, err := http.NewRequest(http.MethodGet, "http://teapotsrus.dev", nil)
+		require.NoError(t, err)
+
+		req.RemoteAddr = "10.1.1.1:54321"
+		req.Header.Add(headers.RequestDate.String(), time.Now().Format(time.RFC3339))
+		signature, err := NewRequestSignature(context.Background(), sha256.New, "token", time.Now().Format(time.RFC3339), req.Method, req.RequestURI, nil)
+		require.NoError(t, err)
+		req.Header.Add(headers.Signature.String(), base64.StdEncoding.EncodeToString(signature))
+
+		db := authenticator.db.(*dbMocks.MockDatabase)
+		db.EXPECT().GetAuthToken(gomock.Any(), gomock.Any()).Return(model.AuthToken{AllowedCIDRs: []string{"192.168.0.0/16"}}, nil)
+		db.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.TrustedProxiesConfig).Return(appcfg.Parameter{}, fmt.Errorf("not configured"))
+
+		_, status, err := authenticator.ValidateRequestSignature(uuid.UUID{}, req, time.Now())
+		require.ErrorIs(t, err, ErrAuthTokenAddressNotAllowed)
+		require.Equal(t, http.StatusForbidden, status)
+	})
+
 	t.Run("should return 500 error on failure to initialize user auth context", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -437,3 +485,224 @@ func TestValidateRequestSignature(t *testing.T) {
 		require.Equal(t, http.StatusOK, status)
 	})
 }
+
+func TestValidateSecretLogin_Lockout(t *testing.T) {
+	var (
+		lockoutPolicy = appcfg.Parameter{
+			Key: appcfg.PasswordPolicy,
+			Value: types.JSONBObject{Object: appcfg.PasswordPolicyParameters{
+				MinLength:              12,
+				MinLowercase:           1,
+				MinUppercase:           1,
+				MinSpecial:             1,
+				MinNumeric:             1,
+				LockoutThreshold:       3,
+				LockoutDurationMinutes: 15,
+			}},
+		}
+		lockoutUser = model.User{
+			PrincipalName: "locky",
+			AuthSecret:    &model.AuthSecret{Digest: "digest", DigestMethod: "argon2"},
+			Unique:        model.Unique{ID: testyUserId},
+		}
+	)
+
+	newTestAuthenticator := func(ctrl *gomock.Controller, validSecret bool) (authenticator, *dbMocks.MockDatabase) {
+		var (
+			mockDB         = dbMocks.NewMockDatabase(ctrl)
+			secretDigester = cryptoMocks.NewMockSecretDigester(ctrl)
+			secretDigest   = cryptoMocks.NewMockSecretDigest(ctrl)
+		)
+
+		secretDigester.EXPECT().Method().Return("argon2").AnyTimes()
+		secretDigester.EXPECT().ParseDigest("digest").Return(secretDigest, nil).AnyTimes()
+		secretDigest.EXPECT().Validate(gomock.Any()).Return(validSecret).AnyTimes()
+
+		return authenticator{
+			db:              mockDB,
+			secretDigester:  secretDigester,
+			concurrencyLock: make(chan struct{}, 1),
+		}, mockDB
+	}
+
+	t.Run("locked user with a valid secret is rejected as locked", func(t *testing.T) {
+		var (
+			ctrl       = gomock.NewController(t)
+			a, mockDB  = newTestAuthenticator(ctrl, true)
+			lockedUser = lockoutUser
+		)
+		defer ctrl.Finish()
+
+		lockedUser.LockedUntil = null.TimeFrom(time.Now().Add(time.Minute))
+		mockDB.EXPECT().LookupUser(gomock.Any(), lockedUser.PrincipalName).Return(lockedUser, nil)
+
+		_, _, err := a.validateSecretLogin(context.Background(), LoginRequest{Username: lockedUser.PrincipalName, Secret: "secret"})
+		require.ErrorIs(t, err, ErrUserLocked)
+	})
+
+	t.Run("locked user with an invalid secret is rejected as invalid auth", func(t *testing.T) {
+		var (
+			ctrl       = gomock.NewController(t)
+			a, mockDB  = newTestAuthenticator(ctrl, false)
+			lockedUser = lockoutUser
+		)
+		defer ctrl.Finish()
+
+		lockedUser.LockedUntil = null.TimeFrom(time.Now().Add(time.Minute))
+		mockDB.EXPECT().LookupUser(gomock.Any(), lockedUser.PrincipalName).Return(lockedUser, nil)
+
+		_, _, err := a.validateSecretLogin(context.Background(), LoginRequest{Username: lockedUser.PrincipalName, Secret: "wrong"})
+		require.ErrorIs(t, err, ErrInvalidAuth)
+		require.NotErrorIs(t, err, ErrUserLocked)
+	})
+
+	t.Run("failed login below the threshold is recorded", func(t *testing.T) {
+		var (
+			ctrl      = gomock.NewController(t)
+			a, mockDB = newTestAuthenticator(ctrl, false)
+		)
+		defer ctrl.Finish()
+
+		mockDB.EXPECT().LookupUser(gomock.Any(), lockoutUser.PrincipalName).Return(lockoutUser, nil)
+		mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.PasswordPolicy).Return(lockoutPolicy, nil)
+		mockDB.EXPECT().RecordFailedLogin(gomock.Any(), lockoutUser).Return(2, nil)
+
+		_, _, err := a.validateSecretLogin(context.Background(), LoginRequest{Username: lockoutUser.PrincipalName, Secret: "wrong"})
+		require.ErrorIs(t, err, ErrInvalidAuth)
+	})
+
+	t.Run("failed login reaching the threshold locks the user", func(t *testing.T) {
+		var (
+			ctrl      = gomock.NewController(t)
+			a, mockDB = newTestAuthenticator(ctrl, false)
+			before    = time.Now()
+		)
+		defer ctrl.Finish()
+
+		mockDB.EXPECT().LookupUser(gomock.Any(), lockoutUser.PrincipalName).Return(lockoutUser, nil)
+		mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.PasswordPolicy).Return(lockoutPolicy, nil)
+		mockDB.EXPECT().RecordFailedLogin(gomock.Any(), lockoutUser).Return(3, nil)
+		mockDB.EXPECT().LockUser(gomock.Any(), lockoutUser, gomock.Any()).DoAndReturn(func(_ context.Context, _ model.User, lockedUntil time.Time) error {
+			assert.WithinDuration(t, before.Add(15*time.Minute), lockedUntil, time.Minute)
+			return nil
+		})
+
+		_, _, err := a.validateSecretLogin(context.Background(), LoginRequest{Username: lockoutUser.PrincipalName, Secret: "wrong"})
+		require.ErrorIs(t, err, ErrInvalidAuth)
+	})
+
+	t.Run("missing OTP is not counted as a failed login", func(t *testing.T) {
+		var (
+			ctrl      = gomock.NewController(t)
+			a, mockDB = newTestAuthenticator(ctrl, true)
+			mfaUser   = lockoutUser
+		)
+		defer ctrl.Finish()
+
+		mfaUser.AuthSecret = &model.AuthSecret{Digest: "digest", DigestMethod: "argon2", TOTPSecret: "JBSWY3DPEHPK3PXP", TOTPActivated: true}
+		mockDB.EXPECT().LookupUser(gomock.Any(), mfaUser.PrincipalName).Return(mfaUser, nil)
+		mockDB.EXPECT().GetWebAuthnCredentials(gomock.Any(), mfaUser.ID).Return(model.WebAuthnCredentials{}, nil)
+
+		_, _, err := a.validateSecretLogin(context.Background(), LoginRequest{Username: mfaUser.PrincipalName, Secret: "secret"})
+		require.ErrorIs(t, err, auth.ErrInvalidOTP)
+	})
+}
+
+func TestValidateSecondFactor(t *testing.T) {
+	var (
+		adminRole = model.Role{Name: auth.RoleAdministrator}
+		mfaPolicy = appcfg.Parameter{
+			Key:   appcfg.MFAPolicy,
+			Value: types.JSONBObject{Object: appcfg.MFAPolicyParameters{RequirePhishingResistantMFAForAdministrators: true}},
+		}
+		totpUser = model.User{
+			AuthSecret: &model.AuthSecret{TOTPSecret: "JBSWY3DPEHPK3PXP", TOTPActivated: true},
+			Unique:     model.Unique{ID: testyUserId},
+		}
+		credentials = model.WebAuthnCredentials{{UserID: testyUserId, Name: "key", CredentialID: []byte("credential")}}
+	)
+
+	validOTP, err := totp.GenerateCode(totpUser.AuthSecret.TOTPSecret, time.Now())
+	require.Nil(t, err)
+
+	newTestAuthenticator := func(ctrl *gomock.Controller) (authenticator, *dbMocks.MockDatabase) {
+		mockDB := dbMocks.NewMockDatabase(ctrl)
+		return authenticator{cfg: config.Configuration{RootURL: serde.MustParseURL("https://bloodhound.example.com")}, db: mockDB}, mockDB
+	}
+
+	t.Run("TOTP is accepted when the MFA policy does not apply", func(t *testing.T) {
+		var (
+			ctrl      = gomock.NewController(t)
+			a, mockDB = newTestAuthenticator(ctrl)
+		)
+		defer ctrl.Finish()
+
+		mockDB.EXPECT().GetWebAuthnCredentials(gomock.Any(), totpUser.ID).Return(credentials, nil)
+
+		require.Nil(t, a.validateSecondFactor(context.Background(), totpUser, LoginRequest{OTP: validOTP}))
+	})
+
+	t.Run("WebAuthn is required from users without TOTP", func(t *testing.T) {
+		var (
+			ctrl         = gomock.NewController(t)
+			a, mockDB    = newTestAuthenticator(ctrl)
+			webAuthnUser = totpUser
+		)
+		defer ctrl.Finish()
+
+		webAuthnUser.AuthSecret = &model.AuthSecret{}
+		mockDB.EXPECT().GetWebAuthnCredentials(gomock.Any(), webAuthnUser.ID).Return(credentials, nil)
+
+		require.ErrorIs(t, a.validateSecondFactor(context.Background(), webAuthnUser, LoginRequest{}), ErrWebAuthnRequired)
+	})
+
+	t.Run("TOTP is rejected for administrators when the MFA policy requires WebAuthn", func(t *testing.T) {
+		var (
+			ctrl      = gomock.NewController(t)
+			a, mockDB = newTestAuthenticator(ctrl)
+			adminUser = totpUser
+		)
+		defer ctrl.Finish()
+
+		adminUser.Roles = model.Roles{adminRole}
+		mockDB.EXPECT().GetWebAuthnCredentials(gomock.Any(), adminUser.ID).Return(credentials, nil)
+		mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.MFAPolicy).Return(mfaPolicy, nil)
+
+		require.ErrorIs(t, a.validateSecondFactor(context.Background(), adminUser, LoginRequest{OTP: validOTP}), ErrWebAuthnRequired)
+	})
+
+	t.Run("administrators without WebAuthn credentials are rejected when the MFA policy requires WebAuthn", func(t *testing.T) {
+		var (
+			ctrl      = gomock.NewController(t)
+			a, mockDB = newTestAuthenticator(ctrl)
+			adminUser = totpUser
+		)
+		defer ctrl.Finish()
+
+		adminUser.Roles = model.Roles{adminRole}
+		mockDB.EXPECT().GetWebAuthnCredentials(gomock.Any(), adminUser.ID).Return(model.WebAuthnCredentials{}, nil)
+		mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.MFAPolicy).Return(mfaPolicy, nil)
+
+		require.ErrorIs(t, a.validateSecondFactor(context.Background(), adminUser, LoginRequest{OTP: validOTP}), ErrPhishingResistantMFARequired)
+	})
+
+	t.Run("WebAuthn responses to unknown challenges are rejected", func(t *testing.T) {
+		var (
+			ctrl      = gomock.NewController(t)
+			a, mockDB = newTestAuthenticator(ctrl)
+		)
+		defer ctrl.Finish()
+
+		mockDB.EXPECT().GetWebAuthnCredentials(gomock.Any(), totpUser.ID).Return(credentials, nil)
+		mockDB.EXPECT().ConsumeWebAuthnChallenge(gomock.Any(), totpUser.ID, auth.WebAuthnCeremonyAuthentication, "challenge").Return(database.ErrNotFound)
+
+		err := a.validateSecondFactor(context.Background(), totpUser, LoginRequest{
+			WebAuthn: &auth.WebAuthnAuthenticationResponse{
+				RawID:    []byte("credential"),
+				Type:     auth.WebAuthnCredentialType,
+				Response: auth.WebAuthnAssertionResponse{ClientDataJSON: []byte(`{"type":"webauthn.get","challenge":"challenge","origin":"https://bloodhound.example.com"}`)},
+			},
+		})
+		require.ErrorIs(t, err, auth.ErrInvalidWebAuthn)
+	})
+}
@@ -150,6 +150,18 @@ func (s *BloodHoundGraphNode) SetIcon(nType string) {
 		s.FontIcon = &BloodHoundGraphFontIcon{
 			Text: "fa-users",
 		}
+	case "AZConditionalAccessPolicy":
+		s.FontIcon = &BloodHoundGraphFontIcon{
+			Text: "fa-user-shield",
+		}
+	case "AZIntuneRoleAssignment":
+		s.FontIcon = &BloodHoundGraphFontIcon{
+			Text: "fa-id-badge",
+		}
+	case "AZIntuneScript":
+		s.FontIcon = &BloodHoundGraphFontIcon{
+			Text: "fa-scroll",
+		}
 	case "AZKeyVault":
 		s.FontIcon = &BloodHoundGraphFontIcon{
 			Text: "fa-lock",
@@ -170,6 +182,18 @@ func (s *BloodHoundGraphNode) SetIcon(nType string) {
 		s.FontIcon = &BloodHoundGraphFontIcon{
 			Text: "fa-robot",
 		}
+	case "AZStorageAccount":
+		s.FontIcon = &BloodHoundGraphFontIcon{
+			Text: "fa-box-archive",
+		}
+	case "AZStorageContainer":
+		s.FontIcon = &BloodHoundGraphFontIcon{
+			Text: "fa-box-open",
+		}
+	case "AZStorageFileShare":
+		s.FontIcon = &BloodHoundGraphFontIcon{
+			Text: "fa-folder-open",
+		}
 	case "AZSubscription":
 		s.FontIcon = &BloodHoundGraphFontIcon{
 			Text: "fa-key",
@@ -287,6 +311,12 @@ func (s *BloodHoundGraphNode) SetBackground(nType string) {
 		s.Color = "#F4BA44"
 	case "AZGroup":
 		s.Color = "#F57C9B"
+	case "AZConditionalAccessPolicy":
+		s.Color = "#6A8CAF"
+	case "AZIntuneRoleAssignment":
+		s.Color = "#7FA6D9"
+	case "AZIntuneScript":
+		s.Color = "#9EC3B0"
 	case "AZKeyVault":
 		s.Color = "#ED658C"
 	case "AZManagementGroup":
@@ -297,6 +327,12 @@ func (s *BloodHoundGraphNode) SetBackground(nType string) {
 		s.Color = "#ED8537"
 	case "AZServicePrincipal":
 		s.Color = "#C1D6D6"
+	case "AZStorageAccount":
+		s.Color = "#6CA0DC"
+	case "AZStorageContainer":
+		s.Color = "#A4C8E1"
+	case "AZStorageFileShare":
+		s.Color = "#B8D4E8"
 	case "AZSubscription":
 		s.Color = "#D2CCA1"
 	case "AZTenant":
@@ -30,13 +30,18 @@ const (
 	UserLoginPath     = "/ui/login"
 	UserDisabledPath  = "/ui/user-disabled"
 
+	// SCIMPathPrefix is the root of the SCIM 2.0 provisioning API. SCIM bearer tokens are only accepted beneath it.
+	SCIMPathPrefix = "/scim/v2"
+
 	// Authorization schemes
 	AuthorizationSchemeBHESignature = "bhesignature"
 	AuthorizationSchemeBearer       = "bearer"
 
 	// Form parameters
-	FormParameterState = "state"
-	FormParameterCode  = "code"
+	FormParameterState    = "state"
+	FormParameterCode     = "code"
+	FormParameterUsername = "username"
+	FormParameterSecret   = "secret"
 
 	// Query parameters
 	QueryParameterSortBy          = "sort_by"
@@ -60,6 +65,7 @@ const (
 	URIPathVariableDomainID                          = "domain_id"
 	URIPathVariableEventID                           = "event_id"
 	URIPathVariableFeatureID                         = "feature_id"
+	URIPathVariableFindingID                         = "finding_id"
 	URIPathVariableJobID                             = "job_id"
 	URIPathVariableObjectID                          = "object_id"
 	URIPathVariablePermissionID                      = "permission_id"
@@ -71,6 +77,9 @@ const (
 	URIPathVariableTokenID                           = "token_id"
 	URIPathVariableUserID                            = "user_id"
 	URIPathVariableSavedQueryID                      = "saved_query_id"
+	URIPathVariableSessionID                         = "session_id"
+	URIPathVariableWebAuthnCredentialID              = "webauthn_credential_id"
 	URIPathVariableSSOProviderID                     = "sso_provider_id"
 	URIPathVariableSSOProviderSlug                   = "sso_provider_slug"
+	URIPathVariableSCIMResourceID                    = "scim_resource_id"
 )
@@ -52,10 +52,12 @@ const (
 	ErrorResponseDetailsNotSortable                 = "column format does not support sorting"
 	ErrorResponseEmptySortParameter                 = "empty sort_by parameter supplied"
 	ErrorResponseDetailsOTPInvalid                  = "one time password is invalid"
+	ErrorResponseDetailsWebAuthnInvalid             = "webauthn credential is invalid"
 	ErrorResponseDetailsResourceNotFound            = "resource not found"
 	ErrorResponseDetailsToBeforeFrom                = "to time cannot be before from time"
 	ErrorResponseDetailsTimeRangeInvalid            = "time range provided is invalid"
 	ErrorResponseDetailsToMalformed                 = "to parameter should be formatted as RFC3339 i.e 2021-04-21T07:20:50.52Z"
+	ErrorResponseDetailsTooManyRequests             = "too many requests, please try again later"
 	ErrorResponseMultipleCollectionScopesProvided   = "may only scope collection by exactly one of OU, Domain, or All Trusted Domains"
 	ErrorResponsePayloadUnmarshalError              = "error unmarshalling JSON payload"
 	ErrorResponseRequestTimeout                     = "request timed out"
@@ -67,7 +67,18 @@ func AuthMiddleware(authenticator api.Authenticator) mux.MiddlewareFunc {
 			} else {
 				switch authScheme {
 				case api.AuthorizationSchemeBearer:
-					if userAuth, err := authenticator.ValidateSession(request.Context(), schemeParameter); err != nil {
+					if auth.IsSCIMToken(schemeParameter) {
+						if !strings.HasPrefix(request.URL.Path, api.SCIMPathPrefix+"/") {
+							api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusUnauthorized, api.ErrorResponseDetailsAuthenticationInvalid, request), response)
+							return
+						} else if scimAuth, err := authenticator.ValidateSCIMToken(request.Context(), schemeParameter); err != nil {
+							api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusUnauthorized, api.ErrorResponseDetailsAuthenticationInvalid, request), response)
+							return
+						} else {
+							bhCtx := ctx.Get(request.Context())
+							bhCtx.AuthCtx = scimAuth
+						}
+					} else if userAuth, err := authenticator.ValidateSession(request.Context(), schemeParameter); err != nil {
 						api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusUnauthorized, api.ErrorResponseDetailsAuthenticationInvalid, request), response)
 						return
 					} else {
@@ -133,6 +144,22 @@ func PermissionsCheckAtLeastOne(authorizer auth.Authorizer, permissions ...model
 	}
 }
 
+// RequireSCIMProvider is a middleware func generator that returns a http.Handler which only admits requests that were
+// authenticated with an SSO provider's SCIM token.
+func RequireSCIMProvider() mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+			if bhCtx := ctx.FromRequest(request); !bhCtx.AuthCtx.Authenticated() {
+				api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusUnauthorized, "not authenticated", request), response)
+			} else if _, isSCIM := auth.GetSCIMProviderFromAuthCtx(bhCtx.AuthCtx); !isSCIM {
+				api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusForbidden, "not authorized", request), response)
+			} else {
+				next.ServeHTTP(response, request)
+			}
+		})
+	}
+}
+
 // Helper function to pull the userID from the path variable.
 func getUserId(request *http.Request) (string, bool) {
 	if mux.Vars(request)[api.URIPathVariableUserID] != "" {
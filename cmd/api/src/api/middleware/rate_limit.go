@@ -19,100 +19,145 @@ package middleware
 import (
 	"fmt"
 	"log/slog"
-	"net"
 	"net/http"
-	"strings"
+	"strconv"
 	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	"github.com/specterops/bloodhound/cmd/api/src/auth"
+	"github.com/specterops/bloodhound/cmd/api/src/config"
+	"github.com/specterops/bloodhound/cmd/api/src/ctx"
 	"github.com/specterops/bloodhound/cmd/api/src/database"
 	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
+	"github.com/specterops/bloodhound/packages/go/headers"
 	"github.com/ulule/limiter/v3"
-	"github.com/ulule/limiter/v3/drivers/middleware/stdlib"
 	"github.com/ulule/limiter/v3/drivers/store/memory"
 )
 
 // DefaultRateLimit is the default number of allowed requests per second
 const DefaultRateLimit = 55
 
-func rateLimitMiddleware(db database.Database, limiter *limiter.Limiter) mux.MiddlewareFunc {
-	ipGetter := stdlib.WithKeyGetter(
-		func(r *http.Request) string {
-			var remoteIP string
-			trustedProxies := appcfg.GetTrustedProxiesParameters(r.Context(), db)
-
-			if host, _, err := net.SplitHostPort(r.RemoteAddr); err != nil {
-				slog.WarnContext(r.Context(), fmt.Sprintf("Error parsing remoteAddress '%s': %s", r.RemoteAddr, err))
-				remoteIP = r.RemoteAddr
-			} else {
-				remoteIP = host
-			}
+// RateLimit response headers as described by the IETF RateLimit header fields draft
+const (
+	HeaderRateLimitLimit     = "RateLimit-Limit"
+	HeaderRateLimitRemaining = "RateLimit-Remaining"
+	HeaderRateLimitReset     = "RateLimit-Reset"
+)
 
-			if trustedProxies <= 0 {
-				slog.DebugContext(r.Context(), "Using direct remote IP Address for rate limiting", "IP Address", remoteIP)
-				return remoteIP
-			} else if xff := r.Header.Get("X-Forwarded-For"); xff == "" {
-				slog.DebugContext(r.Context(), "Expected X-Forwarded-For header for rate limiting but none found. Defaulted to remote IP Address", "IP Address", remoteIP)
-				return remoteIP
-			} else {
-				ips := strings.Split(xff, ",")
+// RateLimitGroup names a set of routes that share a configured rate limit
+type RateLimitGroup string
 
-				idxIP := len(ips) - trustedProxies
-				if idxIP < 0 {
-					slog.WarnContext(r.Context(), "Not enough IPs in X-Forwarded-For, defaulting to first IP", "X-Forwarded-For", xff)
-					idxIP = 0
-				}
+const (
+	RateLimitGroupDefault RateLimitGroup = "default"
+	RateLimitGroupLogin   RateLimitGroup = "login"
+	RateLimitGroupCypher  RateLimitGroup = "cypher"
+)
 
-				finalIP := strings.TrimSpace(ips[idxIP])
+// RateLimiter creates rate limiting middleware for route groups. All middleware created by a RateLimiter count
+// requests in the same store, so a single RateLimiter should be shared by every route registration.
+type RateLimiter struct {
+	db     database.Database
+	store  limiter.Store
+	limits config.RateLimitConfiguration
+}
 
-				slog.DebugContext(r.Context(), "Found client IP Address for rate limiting in XFF", "IP Address", finalIP, "X-Forwarded-For", xff)
-				return finalIP
-			}
-		},
-	)
+// NewRateLimiter creates a RateLimiter from the given configuration. The postgres store shares counters between API
+// replicas, otherwise counters are kept in memory.
+func NewRateLimiter(cfg config.RateLimitConfiguration, db database.Database) RateLimiter {
+	var store limiter.Store
 
-	middleware := stdlib.NewMiddleware(limiter, ipGetter)
+	switch cfg.Store {
+	case config.RateLimitStorePostgres:
+		store = NewPostgresRateLimitStore(db)
+	default:
+		store = memory.NewStore()
+	}
 
-	return func(next http.Handler) http.Handler {
-		return middleware.Handler(removeRateLimitHeadersMiddleware(next))
+	return RateLimiter{
+		db:     db,
+		store:  store,
+		limits: cfg,
 	}
 }
 
-// RemoveRateLimitHeadersMiddleware removes rate limit headers that we do not want appearing in our responses
-func removeRateLimitHeadersMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
-		response.Header().Del("X-RateLimit-Limit")
-		response.Header().Del("X-RateLimit-Remaining")
-		response.Header().Del("X-RateLimit-Reset")
-		next.ServeHTTP(response, request)
-	})
-}
+// Limit returns the number of allowed requests per second for the route group
+func (s RateLimiter) Limit(group RateLimitGroup) int64 {
+	var limit int64
+
+	switch group {
+	case RateLimitGroupLogin:
+		limit = s.limits.Login
+	case RateLimitGroupCypher:
+		limit = s.limits.Cypher
+	default:
+		limit = s.limits.Default
+	}
 
-// DefaultRateLimitMiddleware is a convenience function for creating the default rate limiting middleware
-// for a router/route
-//
-// Usage:
-//
-//	router.Use(DefaultRateLimitMiddleware(db))
-func DefaultRateLimitMiddleware(db database.Database) mux.MiddlewareFunc {
-	return RateLimitMiddleware(db, DefaultRateLimit)
+	if limit <= 0 {
+		return DefaultRateLimit
+	}
+
+	return limit
 }
 
-// RateLimitMiddleware is a function for creating rate limiting middleware
-// with a particular limit for a router/route
+// Middleware creates rate limiting middleware for a route in the given group
 //
 // Usage:
 //
-//	router.Use(RateLimitMiddleware(db, 1))
-func RateLimitMiddleware(db database.Database, limit int64) mux.MiddlewareFunc {
+//	router.Use(rateLimiter.Middleware(RateLimitGroupCypher))
+func (s RateLimiter) Middleware(group RateLimitGroup) mux.MiddlewareFunc {
 	rate := limiter.Rate{
 		Period: 1 * time.Second,
-		Limit:  limit,
+		Limit:  s.Limit(group),
+	}
+
+	return rateLimitMiddleware(s.db, string(group), limiter.New(s.store, rate))
+}
+
+func rateLimitMiddleware(db database.Database, group string, limiter *limiter.Limiter) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+			if limitCtx, err := limiter.Get(request.Context(), rateLimitKey(request, db, group)); err != nil {
+				// Failing open keeps the API available when a shared store is unreachable
+				slog.WarnContext(request.Context(), fmt.Sprintf("Rate limit store error, allowing request: %v", err))
+				next.ServeHTTP(response, request)
+			} else {
+				resetSeconds := max(limitCtx.Reset-time.Now().Unix(), 0)
+
+				response.Header().Set(HeaderRateLimitLimit, strconv.FormatInt(limitCtx.Limit, 10))
+				response.Header().Set(HeaderRateLimitRemaining, strconv.FormatInt(limitCtx.Remaining, 10))
+				response.Header().Set(HeaderRateLimitReset, strconv.FormatInt(resetSeconds, 10))
+
+				if limitCtx.Reached {
+					response.Header().Set(headers.RetryAfter.String(), strconv.FormatInt(max(resetSeconds, 1), 10))
+					api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusTooManyRequests, api.ErrorResponseDetailsTooManyRequests, request), response)
+				} else {
+					next.ServeHTTP(response, request)
+				}
+			}
+		})
 	}
+}
 
-	store := memory.NewStore()
+// rateLimitKey identifies who a request is counted against within its route group: the API token for signed requests,
+// the SSO provider for SCIM requests, the user for sessions and otherwise the client IP. All routes of a group share
+// the identity's budget.
+func rateLimitKey(request *http.Request, db database.Database, group string) string {
+	var (
+		authCtx  = ctx.Get(request.Context()).AuthCtx
+		identity string
+	)
 
-	instance := limiter.New(store, rate)
+	if authScheme, tokenID, err := parseAuthorizationHeader(request); authCtx.Authenticated() && err == nil && authScheme == api.AuthorizationSchemeBHESignature {
+		identity = "token:" + tokenID
+	} else if ssoProvider, isSCIM := auth.GetSCIMProviderFromAuthCtx(authCtx); isSCIM {
+		identity = fmt.Sprintf("sso_provider:%d", ssoProvider.ID)
+	} else if user, isUser := auth.GetUserFromAuthCtx(authCtx); isUser {
+		identity = "user:" + user.ID.String()
+	} else {
+		identity = "ip:" + api.ClientIP(request, appcfg.GetTrustedProxiesParameters(request.Context(), db))
+	}
 
-	return rateLimitMiddleware(db, instance)
+	return fmt.Sprintf("%s:%s", group, identity)
 }
@@ -0,0 +1,75 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/database"
+	"github.com/ulule/limiter/v3"
+	"github.com/ulule/limiter/v3/drivers/store/common"
+)
+
+// PostgresRateLimitStore is a limiter.Store that keeps rate limit counters in PostgreSQL so that they are shared by
+// every API replica connected to the same database
+type PostgresRateLimitStore struct {
+	db database.RateLimitData
+}
+
+func NewPostgresRateLimitStore(db database.RateLimitData) PostgresRateLimitStore {
+	return PostgresRateLimitStore{db: db}
+}
+
+// Get increments the counter for key and returns the resulting limit state
+func (s PostgresRateLimitStore) Get(ctx context.Context, key string, rate limiter.Rate) (limiter.Context, error) {
+	return s.Increment(ctx, key, 1, rate)
+}
+
+// Peek returns the limit state for key without incrementing the counter
+func (s PostgresRateLimitStore) Peek(ctx context.Context, key string, rate limiter.Rate) (limiter.Context, error) {
+	now := time.Now()
+
+	if counter, err := s.db.GetRateLimitCounter(ctx, key); errors.Is(err, database.ErrNotFound) {
+		return common.GetContextFromState(now, rate, now.Add(rate.Period), 0), nil
+	} else if err != nil {
+		return limiter.Context{}, err
+	} else {
+		return common.GetContextFromState(now, rate, counter.ExpiresAt, counter.Count), nil
+	}
+}
+
+// Reset removes the counter for key
+func (s PostgresRateLimitStore) Reset(ctx context.Context, key string, rate limiter.Rate) (limiter.Context, error) {
+	now := time.Now()
+
+	if err := s.db.DeleteRateLimitCounter(ctx, key); err != nil {
+		return limiter.Context{}, err
+	}
+
+	return common.GetContextFromState(now, rate, now.Add(rate.Period), 0), nil
+}
+
+// Increment adds count to the counter for key and returns the resulting limit state
+func (s PostgresRateLimitStore) Increment(ctx context.Context, key string, count int64, rate limiter.Rate) (limiter.Context, error) {
+	if counter, err := s.db.IncrementRateLimitCounter(ctx, key, count, rate.Period); err != nil {
+		return limiter.Context{}, err
+	} else {
+		return common.GetContextFromState(time.Now(), rate, counter.ExpiresAt, counter.Count), nil
+	}
+}
@@ -17,14 +17,25 @@
 package middleware_test
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/gofrs/uuid"
 	"github.com/gorilla/mux"
 	"github.com/specterops/bloodhound/cmd/api/src/api/middleware"
+	"github.com/specterops/bloodhound/cmd/api/src/auth"
+	"github.com/specterops/bloodhound/cmd/api/src/config"
+	"github.com/specterops/bloodhound/cmd/api/src/ctx"
+	"github.com/specterops/bloodhound/cmd/api/src/database"
 	"github.com/specterops/bloodhound/cmd/api/src/database/mocks"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
 	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
+	"github.com/specterops/bloodhound/packages/go/headers"
+	"github.com/stretchr/testify/require"
+	"github.com/ulule/limiter/v3"
 	"go.uber.org/mock/gomock"
 )
 
@@ -36,8 +47,9 @@ func TestRateLimitMiddleware(t *testing.T) {
 	mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.TrustedProxiesConfig).Return(appcfg.Parameter{}, nil).AnyTimes()
 
 	testHandler := &CountingHandler{}
+	rateLimiter := middleware.NewRateLimiter(config.RateLimitConfiguration{Store: config.RateLimitStoreMemory, Default: int64(allowedReqsPerSecond)}, mockDB)
 	router := mux.NewRouter()
-	router.Use(middleware.RateLimitMiddleware(mockDB, int64(allowedReqsPerSecond)))
+	router.Use(rateLimiter.Middleware(middleware.RateLimitGroupDefault))
 	router.Handle("/teapot", testHandler)
 
 	if req, err := http.NewRequest("GET", "/teapot", nil); err != nil {
@@ -62,8 +74,9 @@ func TestDefaultRateLimitMiddleware(t *testing.T) {
 	mockDB := mocks.NewMockDatabase(mockCtl)
 	mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.TrustedProxiesConfig).Return(appcfg.Parameter{}, nil).AnyTimes()
 
+	rateLimiter := middleware.NewRateLimiter(config.RateLimitConfiguration{Store: config.RateLimitStoreMemory}, mockDB)
 	router := mux.NewRouter()
-	router.Use(middleware.DefaultRateLimitMiddleware(mockDB))
+	router.Use(rateLimiter.Middleware(middleware.RateLimitGroupDefault))
 	router.Handle("/teapot", testHandler)
 
 	if req, err := http.NewRequest("GET", "/teapot", nil); err != nil {
@@ -81,6 +94,113 @@ func TestDefaultRateLimitMiddleware(t *testing.T) {
 	}
 }
 
+func requestAs(t *testing.T, owner any) *http.Request {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodGet, "/teapot", nil)
+	require.Nil(t, err)
+
+	return req.WithContext(ctx.Set(req.Context(), &ctx.Context{AuthCtx: auth.Context{Owner: owner}}))
+}
+
+func TestRateLimiter_Middleware_KeysByUser(t *testing.T) {
+	var (
+		mockCtl     = gomock.NewController(t)
+		mockDB      = mocks.NewMockDatabase(mockCtl)
+		testHandler = &CountingHandler{}
+		router      = mux.NewRouter()
+		rateLimiter = middleware.NewRateLimiter(config.RateLimitConfiguration{Store: config.RateLimitStoreMemory, Default: 2}, mockDB)
+		alice       = model.User{Unique: model.Unique{ID: uuid.Must(uuid.NewV4())}}
+		bob         = model.User{Unique: model.Unique{ID: uuid.Must(uuid.NewV4())}}
+	)
+
+	router.Use(rateLimiter.Middleware(middleware.RateLimitGroupDefault))
+	router.Handle("/teapot", testHandler)
+
+	// Exhaust alice's bucket; bob shares the same client IP but must not be limited
+	for i := 0; i < 3; i++ {
+		router.ServeHTTP(httptest.NewRecorder(), requestAs(t, alice))
+	}
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, requestAs(t, alice))
+	require.Equal(t, http.StatusTooManyRequests, rr.Code)
+	require.Equal(t, "2", rr.Header().Get(middleware.HeaderRateLimitLimit))
+	require.Equal(t, "0", rr.Header().Get(middleware.HeaderRateLimitRemaining))
+	require.NotEmpty(t, rr.Header().Get(headers.RetryAfter.String()))
+
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, requestAs(t, bob))
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Equal(t, "1", rr.Header().Get(middleware.HeaderRateLimitRemaining))
+
+	require.Equal(t, 3, testHandler.Count)
+}
+
+func TestRateLimiter_Middleware_SharesGroupBudgetAcrossRoutes(t *testing.T) {
+	var (
+		mockCtl     = gomock.NewController(t)
+		mockDB      = mocks.NewMockDatabase(mockCtl)
+		testHandler = &CountingHandler{}
+		router      = mux.NewRouter()
+		rateLimiter = middleware.NewRateLimiter(config.RateLimitConfiguration{Store: config.RateLimitStoreMemory, Default: 2}, mockDB)
+		alice       = model.User{Unique: model.Unique{ID: uuid.Must(uuid.NewV4())}}
+	)
+
+	router.Use(rateLimiter.Middleware(middleware.RateLimitGroupDefault))
+	router.Handle("/teapot", testHandler)
+	router.Handle("/kettle", testHandler)
+
+	for i := 0; i < 2; i++ {
+		router.ServeHTTP(httptest.NewRecorder(), requestAs(t, alice))
+	}
+
+	req, err := http.NewRequest(http.MethodPost, "/kettle", nil)
+	require.Nil(t, err)
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req.WithContext(ctx.Set(req.Context(), &ctx.Context{AuthCtx: auth.Context{Owner: alice}})))
+	require.Equal(t, http.StatusTooManyRequests, rr.Code)
+	require.Equal(t, 2, testHandler.Count)
+}
+
+func TestRateLimiter_Limit(t *testing.T) {
+	rateLimiter := middleware.NewRateLimiter(config.RateLimitConfiguration{Default: 30, Cypher: 5}, nil)
+
+	require.Equal(t, int64(30), rateLimiter.Limit(middleware.RateLimitGroupDefault))
+	require.Equal(t, int64(5), rateLimiter.Limit(middleware.RateLimitGroupCypher))
+	require.Equal(t, int64(middleware.DefaultRateLimit), rateLimiter.Limit(middleware.RateLimitGroupLogin))
+}
+
+func TestPostgresRateLimitStore(t *testing.T) {
+	var (
+		mockCtl   = gomock.NewController(t)
+		mockDB    = mocks.NewMockDatabase(mockCtl)
+		store     = middleware.NewPostgresRateLimitStore(mockDB)
+		rate      = limiter.Rate{Period: time.Second, Limit: 2}
+		expiresAt = time.Now().Add(time.Second)
+	)
+
+	t.Run("Get increments the shared counter", func(t *testing.T) {
+		mockDB.EXPECT().IncrementRateLimitCounter(gomock.Any(), "key", int64(1), time.Second).Return(model.RateLimitCounter{Key: "key", Count: 3, ExpiresAt: expiresAt}, nil)
+
+		limitCtx, err := store.Get(context.Background(), "key", rate)
+		require.Nil(t, err)
+		require.True(t, limitCtx.Reached)
+		require.Equal(t, int64(0), limitCtx.Remaining)
+		require.Equal(t, expiresAt.Unix(), limitCtx.Reset)
+	})
+
+	t.Run("Peek of an unknown key is not limited", func(t *testing.T) {
+		mockDB.EXPECT().GetRateLimitCounter(gomock.Any(), "other").Return(model.RateLimitCounter{}, database.ErrNotFound)
+
+		limitCtx, err := store.Peek(context.Background(), "other", rate)
+		require.Nil(t, err)
+		require.False(t, limitCtx.Reached)
+		require.Equal(t, int64(2), limitCtx.Remaining)
+	})
+}
+
 type CountingHandler struct {
 	Count int
 }
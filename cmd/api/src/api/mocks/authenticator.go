@@ -62,6 +62,21 @@ func (m *MockAuthenticator) EXPECT() *MockAuthenticatorMockRecorder {
 	return m.recorder
 }
 
+// BeginWebAuthnLogin mocks base method.
+func (m *MockAuthenticator) BeginWebAuthnLogin(ctx context.Context, loginRequest api.LoginRequest) (auth.WebAuthnRequestOptions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginWebAuthnLogin", ctx, loginRequest)
+	ret0, _ := ret[0].(auth.WebAuthnRequestOptions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BeginWebAuthnLogin indicates an expected call of BeginWebAuthnLogin.
+func (mr *MockAuthenticatorMockRecorder) BeginWebAuthnLogin(ctx, loginRequest any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginWebAuthnLogin", reflect.TypeOf((*MockAuthenticator)(nil).BeginWebAuthnLogin), ctx, loginRequest)
+}
+
 // CreateSSOSession mocks base method.
 func (m *MockAuthenticator) CreateSSOSession(request *http.Request, response http.ResponseWriter, principalNameOrEmail string, ssoProvider model.SSOProvider) {
 	m.ctrl.T.Helper()
@@ -132,6 +147,21 @@ func (mr *MockAuthenticatorMockRecorder) ValidateRequestSignature(tokenID, reque
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateRequestSignature", reflect.TypeOf((*MockAuthenticator)(nil).ValidateRequestSignature), tokenID, request, serverTime)
 }
 
+// ValidateSCIMToken mocks base method.
+func (m *MockAuthenticator) ValidateSCIMToken(ctx context.Context, token string) (auth.Context, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateSCIMToken", ctx, token)
+	ret0, _ := ret[0].(auth.Context)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateSCIMToken indicates an expected call of ValidateSCIMToken.
+func (mr *MockAuthenticatorMockRecorder) ValidateSCIMToken(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateSCIMToken", reflect.TypeOf((*MockAuthenticator)(nil).ValidateSCIMToken), ctx, token)
+}
+
 // ValidateSecret mocks base method.
 func (m *MockAuthenticator) ValidateSecret(ctx context.Context, secret string, authSecret model.AuthSecret) error {
 	m.ctrl.T.Helper()
@@ -63,8 +63,10 @@ func RegisterFossRoutes(
 	authorizer auth.Authorizer,
 	ingestSchema upload.IngestSchema,
 ) {
+	rateLimiter := middleware.NewRateLimiter(cfg.RateLimit, rdms)
+
 	router.With(func() mux.MiddlewareFunc {
-		return middleware.DefaultRateLimitMiddleware(rdms)
+		return rateLimiter.Middleware(middleware.RateLimitGroupDefault)
 	},
 		// Health Endpoint
 		routerInst.GET("/health", func(response http.ResponseWriter, _ *http.Request) {
@@ -81,5 +83,5 @@ func RegisterFossRoutes(
 	)
 
 	var resources = v2.NewResources(rdms, graphDB, cfg, apiCache, graphQuery, collectorManifests, authorizer, authenticator, ingestSchema)
-	NewV2API(resources, routerInst)
+	NewV2API(resources, routerInst, rateLimiter)
 }
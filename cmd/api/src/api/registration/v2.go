@@ -33,31 +33,55 @@ import (
 	"github.com/specterops/bloodhound/packages/go/params"
 )
 
-func registerV2Auth(resources v2.Resources, routerInst *router.Router, permissions auth.PermissionSet) {
+func registerV2Auth(resources v2.Resources, routerInst *router.Router, permissions auth.PermissionSet, rateLimiter middleware.RateLimiter) {
 	var (
 		loginResource      = authapi.NewLoginResource(resources.Config, resources.Authenticator, resources.DB)
 		managementResource = authapi.NewManagementResource(resources.Config, resources.DB, resources.Authorizer, resources.Authenticator)
 	)
 
 	router.With(func() mux.MiddlewareFunc {
-		return middleware.RateLimitMiddleware(resources.DB, 1)
+		return rateLimiter.Middleware(middleware.RateLimitGroupLogin)
 	},
 		// Login resource
 		routerInst.POST("/api/v2/login", func(response http.ResponseWriter, request *http.Request) {
 			middleware.LoginTimer()(http.HandlerFunc(loginResource.Login)).ServeHTTP(response, request)
 		}),
+		routerInst.POST("/api/v2/login/webauthn-options", func(response http.ResponseWriter, request *http.Request) {
+			middleware.LoginTimer()(http.HandlerFunc(loginResource.BeginWebAuthnLogin)).ServeHTTP(response, request)
+		}),
+		// Username and password login for SSO providers that bind against a directory such as LDAP
+		routerInst.POST(fmt.Sprintf("/api/v2/sso/{%s}/login", api.URIPathVariableSSOProviderSlug), func(response http.ResponseWriter, request *http.Request) {
+			middleware.LoginTimer()(http.HandlerFunc(managementResource.SSOLoginHandler)).ServeHTTP(response, request)
+		}),
 	)
 
 	router.With(func() mux.MiddlewareFunc {
-		return middleware.DefaultRateLimitMiddleware(resources.DB)
+		return rateLimiter.Middleware(middleware.RateLimitGroupDefault)
 	},
 		// Login resources
 		routerInst.GET("/api/v2/self", managementResource.GetSelf),
+		routerInst.GET("/api/v2/self/sessions", managementResource.ListSelfSessions).RequireAuth(),
+		routerInst.DELETE("/api/v2/self/sessions", managementResource.RevokeSelfSessions).RequireAuth(),
+		routerInst.DELETE(fmt.Sprintf("/api/v2/self/sessions/{%s}", api.URIPathVariableSessionID), managementResource.RevokeSelfSession).RequireAuth(),
 		routerInst.POST("/api/v2/logout", loginResource.Logout),
 
 		// Login path prefix matcher for SAML providers, order matters here due to PathPrefix
 		routerInst.POST(fmt.Sprintf("/api/{version}/login/saml/{%s}/acs", api.URIPathVariableSSOProviderSlug), managementResource.SAMLCallbackRedirect),
 		routerInst.GET(fmt.Sprintf("/api/{version}/login/saml/{%s}/metadata", api.URIPathVariableSSOProviderSlug), managementResource.ServeMetadata),
+
+		// SCIM 2.0 provisioning, authenticated with an SSO provider's SCIM bearer token
+		routerInst.GET(api.SCIMPathPrefix+"/Users", managementResource.ListSCIMUsers).RequireSCIMAuth(),
+		routerInst.POST(api.SCIMPathPrefix+"/Users", managementResource.CreateSCIMUser).RequireSCIMAuth(),
+		routerInst.GET(fmt.Sprintf("%s/Users/{%s}", api.SCIMPathPrefix, api.URIPathVariableSCIMResourceID), managementResource.GetSCIMUser).RequireSCIMAuth(),
+		routerInst.PUT(fmt.Sprintf("%s/Users/{%s}", api.SCIMPathPrefix, api.URIPathVariableSCIMResourceID), managementResource.ReplaceSCIMUser).RequireSCIMAuth(),
+		routerInst.PATCH(fmt.Sprintf("%s/Users/{%s}", api.SCIMPathPrefix, api.URIPathVariableSCIMResourceID), managementResource.PatchSCIMUser).RequireSCIMAuth(),
+		routerInst.DELETE(fmt.Sprintf("%s/Users/{%s}", api.SCIMPathPrefix, api.URIPathVariableSCIMResourceID), managementResource.DeleteSCIMUser).RequireSCIMAuth(),
+		routerInst.GET(api.SCIMPathPrefix+"/Groups", managementResource.ListSCIMGroups).RequireSCIMAuth(),
+		routerInst.POST(api.SCIMPathPrefix+"/Groups", managementResource.CreateSCIMGroup).RequireSCIMAuth(),
+		routerInst.GET(fmt.Sprintf("%s/Groups/{%s}", api.SCIMPathPrefix, api.URIPathVariableSCIMResourceID), managementResource.GetSCIMGroup).RequireSCIMAuth(),
+		routerInst.PUT(fmt.Sprintf("%s/Groups/{%s}", api.SCIMPathPrefix, api.URIPathVariableSCIMResourceID), managementResource.ReplaceSCIMGroup).RequireSCIMAuth(),
+		routerInst.PATCH(fmt.Sprintf("%s/Groups/{%s}", api.SCIMPathPrefix, api.URIPathVariableSCIMResourceID), managementResource.PatchSCIMGroup).RequireSCIMAuth(),
+		routerInst.DELETE(fmt.Sprintf("%s/Groups/{%s}", api.SCIMPathPrefix, api.URIPathVariableSCIMResourceID), managementResource.DeleteSCIMGroup).RequireSCIMAuth(),
 		routerInst.PathPrefix(fmt.Sprintf("/api/{version}/login/saml/{%s}", api.URIPathVariableSSOProviderSlug), http.HandlerFunc(managementResource.SAMLLoginRedirect)),
 
 		// SAML resources
@@ -72,9 +96,12 @@ func registerV2Auth(resources v2.Resources, routerInst *router.Router, permissio
 		routerInst.GET("/api/v2/sso-providers", managementResource.ListAuthProviders),
 		routerInst.POST("/api/v2/sso-providers/saml", managementResource.CreateSAMLProviderMultipart).RequirePermissions(permissions.AuthManageProviders),
 		routerInst.POST("/api/v2/sso-providers/oidc", managementResource.CreateOIDCProvider).CheckFeatureFlag(resources.DB, appcfg.FeatureOIDCSupport).RequirePermissions(permissions.AuthManageProviders),
+		routerInst.POST("/api/v2/sso-providers/ldap", managementResource.CreateLDAPProvider).RequirePermissions(permissions.AuthManageProviders),
 		routerInst.DELETE(fmt.Sprintf("/api/v2/sso-providers/{%s}", api.URIPathVariableSSOProviderID), managementResource.DeleteSSOProvider).RequirePermissions(permissions.AuthManageProviders),
 		routerInst.PATCH(fmt.Sprintf("/api/v2/sso-providers/{%s}", api.URIPathVariableSSOProviderID), managementResource.UpdateSSOProvider).RequirePermissions(permissions.AuthManageProviders),
 		routerInst.GET(fmt.Sprintf("/api/v2/sso-providers/{%s}/signing-certificate", api.URIPathVariableSSOProviderID), managementResource.ServeSigningCertificate).RequirePermissions(permissions.AuthManageProviders),
+		routerInst.PUT(fmt.Sprintf("/api/v2/sso-providers/{%s}/scim-token", api.URIPathVariableSSOProviderID), managementResource.IssueSCIMToken).RequirePermissions(permissions.AuthManageProviders),
+		routerInst.DELETE(fmt.Sprintf("/api/v2/sso-providers/{%s}/scim-token", api.URIPathVariableSSOProviderID), managementResource.RevokeSCIMToken).RequirePermissions(permissions.AuthManageProviders),
 
 		routerInst.GET(fmt.Sprintf("/api/v2/sso/{%s}/login", api.URIPathVariableSSOProviderSlug), managementResource.SSOLoginHandler),
 		routerInst.PathPrefix(fmt.Sprintf("/api/v2/sso/{%s}/callback", api.URIPathVariableSSOProviderSlug), http.HandlerFunc(managementResource.SSOCallbackHandler)),
@@ -87,6 +114,9 @@ func registerV2Auth(resources v2.Resources, routerInst *router.Router, permissio
 		// Roles
 		routerInst.GET("/api/v2/roles", managementResource.ListRoles).RequirePermissions(permissions.AuthManageSelf),
 		routerInst.GET(fmt.Sprintf("/api/v2/roles/{%s}", api.URIPathVariableRoleID), managementResource.GetRole).RequirePermissions(permissions.AuthManageSelf),
+		routerInst.POST("/api/v2/roles", managementResource.CreateRole).RequirePermissions(permissions.AuthManageUsers),
+		routerInst.PATCH(fmt.Sprintf("/api/v2/roles/{%s}", api.URIPathVariableRoleID), managementResource.UpdateRole).RequirePermissions(permissions.AuthManageUsers),
+		routerInst.DELETE(fmt.Sprintf("/api/v2/roles/{%s}", api.URIPathVariableRoleID), managementResource.DeleteRole).RequirePermissions(permissions.AuthManageUsers),
 
 		// User management for all BloodHound users
 		routerInst.GET("/api/v2/bloodhound-users", managementResource.ListUsers).RequirePermissions(permissions.AuthManageUsers),
@@ -95,6 +125,9 @@ func registerV2Auth(resources v2.Resources, routerInst *router.Router, permissio
 		routerInst.GET(fmt.Sprintf("/api/v2/bloodhound-users/{%s}", api.URIPathVariableUserID), managementResource.GetUser).RequirePermissions(permissions.AuthManageUsers),
 		routerInst.PATCH(fmt.Sprintf("/api/v2/bloodhound-users/{%s}", api.URIPathVariableUserID), managementResource.UpdateUser).RequirePermissions(permissions.AuthManageUsers),
 		routerInst.DELETE(fmt.Sprintf("/api/v2/bloodhound-users/{%s}", api.URIPathVariableUserID), managementResource.DeleteUser).RequirePermissions(permissions.AuthManageUsers),
+		routerInst.GET(fmt.Sprintf("/api/v2/bloodhound-users/{%s}/sessions", api.URIPathVariableUserID), managementResource.ListUserSessions).RequirePermissions(permissions.AuthManageUsers),
+		routerInst.DELETE(fmt.Sprintf("/api/v2/bloodhound-users/{%s}/sessions", api.URIPathVariableUserID), managementResource.RevokeUserSessions).RequirePermissions(permissions.AuthManageUsers),
+		routerInst.DELETE(fmt.Sprintf("/api/v2/bloodhound-users/{%s}/sessions/{%s}", api.URIPathVariableUserID, api.URIPathVariableSessionID), managementResource.RevokeUserSession).RequirePermissions(permissions.AuthManageUsers),
 
 		routerInst.PUT(fmt.Sprintf("/api/v2/bloodhound-users/{%s}/secret", api.URIPathVariableUserID), managementResource.PutUserAuthSecret).AuthorizeUserManagementAccess().RequireUserId(),
 		routerInst.DELETE(fmt.Sprintf("/api/v2/bloodhound-users/{%s}/secret", api.URIPathVariableUserID), managementResource.ExpireUserAuthSecret).AuthorizeUserManagementAccess().RequireUserId(),
@@ -103,6 +136,11 @@ func registerV2Auth(resources v2.Resources, routerInst *router.Router, permissio
 		routerInst.DELETE(fmt.Sprintf("/api/v2/bloodhound-users/{%s}/mfa", api.URIPathVariableUserID), managementResource.DisenrollMFA).AuthorizeUserManagementAccess().RequireUserId(),
 		routerInst.GET(fmt.Sprintf("/api/v2/bloodhound-users/{%s}/mfa-activation", api.URIPathVariableUserID), managementResource.GetMFAActivationStatus).AuthorizeUserManagementAccess().RequireUserId(),
 		routerInst.POST(fmt.Sprintf("/api/v2/bloodhound-users/{%s}/mfa-activation", api.URIPathVariableUserID), managementResource.ActivateMFA).AuthorizeUserManagementAccess().RequireUserId(),
+		routerInst.GET(fmt.Sprintf("/api/v2/bloodhound-users/{%s}/webauthn-credentials", api.URIPathVariableUserID), managementResource.ListWebAuthnCredentials).AuthorizeUserManagementAccess().RequireUserId(),
+		routerInst.POST(fmt.Sprintf("/api/v2/bloodhound-users/{%s}/webauthn-credentials", api.URIPathVariableUserID), managementResource.FinishWebAuthnRegistration).AuthorizeUserManagementAccess().RequireUserId(),
+		routerInst.POST(fmt.Sprintf("/api/v2/bloodhound-users/{%s}/webauthn-credentials/registration-options", api.URIPathVariableUserID), managementResource.BeginWebAuthnRegistration).AuthorizeUserManagementAccess().RequireUserId(),
+		routerInst.PATCH(fmt.Sprintf("/api/v2/bloodhound-users/{%s}/webauthn-credentials/{%s}", api.URIPathVariableUserID, api.URIPathVariableWebAuthnCredentialID), managementResource.UpdateWebAuthnCredential).AuthorizeUserManagementAccess().RequireUserId(),
+		routerInst.DELETE(fmt.Sprintf("/api/v2/bloodhound-users/{%s}/webauthn-credentials/{%s}", api.URIPathVariableUserID, api.URIPathVariableWebAuthnCredentialID), managementResource.DeleteWebAuthnCredential).AuthorizeUserManagementAccess().RequireUserId(),
 
 		routerInst.POST("/api/v2/tokens", managementResource.CreateAuthToken).RequirePermissions(permissions.AuthCreateToken).AuthorizeUserManagementAccess(),
 		routerInst.GET("/api/v2/tokens", managementResource.ListAuthTokens).RequirePermissions(permissions.AuthCreateToken).AuthorizeUserManagementAccess(),
@@ -111,11 +149,11 @@ func registerV2Auth(resources v2.Resources, routerInst *router.Router, permissio
 }
 
 // NewV2API sets up dependencies, authorization and a router, and then defines the BloodHound V2 API endpoints on said router
-func NewV2API(resources v2.Resources, routerInst *router.Router) {
-	var permissions = auth.Permissions()
+func NewV2API(resources v2.Resources, routerInst *router.Router, rateLimiter middleware.RateLimiter) {
+	permissions := auth.Permissions()
 
 	// Register the auth API endpoints
-	registerV2Auth(resources, routerInst, permissions)
+	registerV2Auth(resources, routerInst, permissions, rateLimiter)
 
 	// Collector APIs
 	routerInst.GET(fmt.Sprintf("/api/v2/collectors/{%s}", v2.CollectorTypePathParameterName), resources.GetCollectorManifest).RequireAuth()
@@ -130,7 +168,7 @@ func NewV2API(resources v2.Resources, routerInst *router.Router) {
 	routerInst.POST(fmt.Sprintf("/api/v2/file-upload/{%s}/end", v2.FileUploadJobIdPathParameterName), resources.EndIngestJob).RequirePermissions(permissions.GraphDBIngest)
 
 	router.With(func() mux.MiddlewareFunc {
-		return middleware.DefaultRateLimitMiddleware(resources.DB)
+		return rateLimiter.Middleware(middleware.RateLimitGroupDefault)
 	},
 		// Version API
 		routerInst.GET("/api/version", v2.GetVersion).RequireAuth(),
@@ -144,8 +182,9 @@ func NewV2API(resources v2.Resources, routerInst *router.Router) {
 		routerInst.GET("/api/v2/available-domains", resources.GetAvailableDomains).RequirePermissions(permissions.GraphDBRead),
 
 		// Audit API
-		// TODO: This might actually need its own permission that's assigned to the Administrator user by default
-		routerInst.GET("/api/v2/audit", resources.ListAuditLogs).RequirePermissions(permissions.AuthManageUsers),
+		routerInst.GET("/api/v2/audit", resources.ListAuditLogs).RequireAtLeastOnePermission(permissions.AuthManageUsers, permissions.AuditLogRead),
+		routerInst.GET("/api/v2/audit/export", resources.ExportAuditLogs).RequireAtLeastOnePermission(permissions.AuthManageUsers, permissions.AuditLogRead),
+		routerInst.GET("/api/v2/audit/verify", resources.VerifyAuditLogs).RequireAtLeastOnePermission(permissions.AuthManageUsers, permissions.AuditLogRead),
 
 		// App Config API
 		routerInst.GET("/api/v2/config", resources.GetApplicationConfigurations).RequirePermissions(permissions.AppReadApplicationConfiguration),
@@ -174,22 +213,40 @@ func NewV2API(resources v2.Resources, routerInst *router.Router) {
 		// Asset group management API
 		// tags
 		routerInst.GET("/api/v2/asset-group-tags", resources.GetAssetGroupTags).CheckFeatureFlag(resources.DB, appcfg.FeatureTierManagement).RequirePermissions(permissions.GraphDBRead),
+		routerInst.POST("/api/v2/asset-group-tags", resources.CreateAssetGroupTag).CheckFeatureFlag(resources.DB, appcfg.FeatureTierManagement).RequirePermissions(permissions.GraphDBWrite),
 		routerInst.POST("/api/v2/asset-group-tags/search", resources.SearchAssetGroupTags).CheckFeatureFlag(resources.DB, appcfg.FeatureTierManagement).RequirePermissions(permissions.GraphDBRead),
+		routerInst.POST("/api/v2/asset-group-tags/import-owned", resources.ImportOwnedPrincipals).CheckFeatureFlag(resources.DB, appcfg.FeatureTierManagement).RequirePermissions(permissions.GraphDBWrite),
 		routerInst.GET("/api/v2/asset-group-tags/history", resources.GetAssetGroupTagHistory).CheckFeatureFlag(resources.DB, appcfg.FeatureTierManagement).RequirePermissions(permissions.GraphDBRead),
 		routerInst.GET(fmt.Sprintf("/api/v2/asset-group-tags/{%s}", api.URIPathVariableAssetGroupTagID), resources.GetAssetGroupTag).CheckFeatureFlag(resources.DB, appcfg.FeatureTierManagement).RequirePermissions(permissions.GraphDBRead),
+		routerInst.PATCH(fmt.Sprintf("/api/v2/asset-group-tags/{%s}", api.URIPathVariableAssetGroupTagID), resources.UpdateAssetGroupTag).CheckFeatureFlag(resources.DB, appcfg.FeatureTierManagement).RequirePermissions(permissions.GraphDBWrite),
+		routerInst.DELETE(fmt.Sprintf("/api/v2/asset-group-tags/{%s}", api.URIPathVariableAssetGroupTagID), resources.DeleteAssetGroupTag).CheckFeatureFlag(resources.DB, appcfg.FeatureTierManagement).RequirePermissions(permissions.GraphDBWrite),
 		routerInst.GET(fmt.Sprintf("/api/v2/asset-group-tags/{%s}/members", api.URIPathVariableAssetGroupTagID), resources.GetAssetGroupMembersByTag).CheckFeatureFlag(resources.DB, appcfg.FeatureTierManagement).RequirePermissions(permissions.GraphDBRead),
 		routerInst.GET(fmt.Sprintf("/api/v2/asset-group-tags/{%s}/members/counts", api.URIPathVariableAssetGroupTagID), resources.GetAssetGroupTagMemberCountsByKind).CheckFeatureFlag(resources.DB, appcfg.FeatureTierManagement).RequirePermissions(permissions.GraphDBRead),
+		routerInst.GET(fmt.Sprintf("/api/v2/asset-group-tags/{%s}/members/history", api.URIPathVariableAssetGroupTagID), resources.GetAssetGroupTagMembershipHistory).CheckFeatureFlag(resources.DB, appcfg.FeatureTierManagement).RequirePermissions(permissions.GraphDBRead),
+		routerInst.GET(fmt.Sprintf("/api/v2/asset-group-tags/{%s}/certifications", api.URIPathVariableAssetGroupTagID), resources.GetAssetGroupTagCertifications).CheckFeatureFlag(resources.DB, appcfg.FeatureTierManagement).RequirePermissions(permissions.GraphDBRead),
+		routerInst.POST(fmt.Sprintf("/api/v2/asset-group-tags/{%s}/certifications", api.URIPathVariableAssetGroupTagID), resources.UpdateAssetGroupTagCertifications).CheckFeatureFlag(resources.DB, appcfg.FeatureTierManagement).RequirePermissions(permissions.GraphDBWrite),
+		routerInst.GET(fmt.Sprintf("/api/v2/asset-group-tags/{%s}/violations", api.URIPathVariableAssetGroupTagID), resources.GetAssetGroupTagViolations).CheckFeatureFlag(resources.DB, appcfg.FeatureTierManagement).RequirePermissions(permissions.GraphDBRead),
+		routerInst.GET(fmt.Sprintf("/api/v2/asset-group-tags/{%s}/violations/export", api.URIPathVariableAssetGroupTagID), resources.ExportAssetGroupTagViolations).CheckFeatureFlag(resources.DB, appcfg.FeatureTierManagement).RequirePermissions(permissions.GraphDBRead),
+		routerInst.GET(fmt.Sprintf("/api/v2/asset-group-tags/{%s}/exposure", api.URIPathVariableAssetGroupTagID), resources.GetAssetGroupTagExposures).CheckFeatureFlag(resources.DB, appcfg.FeatureTierManagement).RequirePermissions(permissions.GraphDBRead),
+		routerInst.GET(fmt.Sprintf("/api/v2/asset-group-tags/{%s}/exposure/chokepoints", api.URIPathVariableAssetGroupTagID), resources.GetAssetGroupTagChokePoints).CheckFeatureFlag(resources.DB, appcfg.FeatureTierManagement).RequirePermissions(permissions.GraphDBRead),
 		routerInst.GET(fmt.Sprintf("/api/v2/asset-group-tags/{%s}/members/{%s}", api.URIPathVariableAssetGroupTagID, api.URIPathVariableAssetGroupTagMemberID), resources.GetAssetGroupTagMemberInfo).CheckFeatureFlag(resources.DB, appcfg.FeatureTierManagement).RequirePermissions(permissions.GraphDBRead),
 
 		// selectors
 		routerInst.GET(fmt.Sprintf("/api/v2/asset-group-tags/{%s}/selectors", api.URIPathVariableAssetGroupTagID), resources.GetAssetGroupTagSelectors).CheckFeatureFlag(resources.DB, appcfg.FeatureTierManagement).RequirePermissions(permissions.GraphDBRead),
 		routerInst.POST(fmt.Sprintf("/api/v2/asset-group-tags/{%s}/selectors", api.URIPathVariableAssetGroupTagID), resources.CreateAssetGroupTagSelector).CheckFeatureFlag(resources.DB, appcfg.FeatureTierManagement).RequirePermissions(permissions.GraphDBWrite),
+		routerInst.POST(fmt.Sprintf("/api/v2/asset-group-tags/{%s}/selectors/impact", api.URIPathVariableAssetGroupTagID), resources.PreviewAssetGroupTagSelectorImpact).CheckFeatureFlag(resources.DB, appcfg.FeatureTierManagement).RequirePermissions(permissions.GraphDBRead),
 		routerInst.GET(fmt.Sprintf("/api/v2/asset-group-tags/{%s}/selectors/{%s}", api.URIPathVariableAssetGroupTagID, api.URIPathVariableAssetGroupTagSelectorID), resources.GetAssetGroupTagSelector).CheckFeatureFlag(resources.DB, appcfg.FeatureTierManagement).RequirePermissions(permissions.GraphDBRead),
 		routerInst.PATCH(fmt.Sprintf("/api/v2/asset-group-tags/{%s}/selectors/{%s}", api.URIPathVariableAssetGroupTagID, api.URIPathVariableAssetGroupTagSelectorID), resources.UpdateAssetGroupTagSelector).CheckFeatureFlag(resources.DB, appcfg.FeatureTierManagement).RequirePermissions(permissions.GraphDBWrite),
 		routerInst.DELETE(fmt.Sprintf("/api/v2/asset-group-tags/{%s}/selectors/{%s}", api.URIPathVariableAssetGroupTagID, api.URIPathVariableAssetGroupTagSelectorID), resources.DeleteAssetGroupTagSelector).CheckFeatureFlag(resources.DB, appcfg.FeatureTierManagement).RequirePermissions(permissions.GraphDBWrite),
 		routerInst.POST("/api/v2/asset-group-tags/preview-selectors", resources.PreviewSelectors).CheckFeatureFlag(resources.DB, appcfg.FeatureTierManagement).RequirePermissions(permissions.GraphDBWrite),
 		routerInst.GET(fmt.Sprintf("/api/v2/asset-group-tags/{%s}/selectors/{%s}/members", api.URIPathVariableAssetGroupTagID, api.URIPathVariableAssetGroupTagSelectorID), resources.GetAssetGroupMembersBySelector).CheckFeatureFlag(resources.DB, appcfg.FeatureTierManagement).RequirePermissions(permissions.GraphDBRead),
 
+		// Findings API
+		routerInst.GET("/api/v2/findings", resources.ListFindings).RequirePermissions(permissions.APsGenerateReport),
+		routerInst.GET("/api/v2/findings/instances", resources.GetFindingInstances).RequirePermissions(permissions.APsGenerateReport),
+		routerInst.PUT(fmt.Sprintf("/api/v2/findings/instances/{%s}/acceptance", api.URIPathVariableFindingID), resources.UpdateFindingAcceptance).RequirePermissions(permissions.APsManageAPs),
+		routerInst.GET("/api/v2/findings/export", resources.ExportFindings).RequirePermissions(permissions.APsGenerateReport),
+
 		// QA API
 		routerInst.GET("/api/v2/completeness", resources.GetDatabaseCompleteness).RequirePermissions(permissions.GraphDBRead),
 
@@ -204,8 +261,7 @@ func NewV2API(resources v2.Resources, routerInst *router.Router) {
 		// TODO discuss if this should be a post endpoint
 		routerInst.GET("/api/v2/graph-search", resources.GetSearchResult).RequirePermissions(permissions.GraphDBRead),
 
-		// Cypher Queries API
-		routerInst.POST("/api/v2/graphs/cypher", resources.CypherQuery).RequirePermissions(permissions.GraphDBRead),
+		// Saved Queries API
 		routerInst.GET("/api/v2/saved-queries", resources.ListSavedQueries).RequirePermissions(permissions.SavedQueriesRead),
 		routerInst.POST("/api/v2/saved-queries", resources.CreateSavedQuery).RequirePermissions(permissions.SavedQueriesWrite),
 		routerInst.GET(fmt.Sprintf("/api/v2/saved-queries/{%s}", api.URIPathVariableSavedQueryID), resources.GetSavedQuery).RequirePermissions(permissions.SavedQueriesRead),
@@ -333,6 +389,7 @@ func NewV2API(resources v2.Resources, routerInst *router.Router) {
 
 		// Data Quality Stats API
 		routerInst.GET(fmt.Sprintf("/api/v2/ad-domains/{%s}/data-quality-stats", api.URIPathVariableDomainID), resources.GetADDataQualityStats).RequirePermissions(permissions.GraphDBRead),
+		routerInst.GET(fmt.Sprintf("/api/v2/ad-domains/{%s}/data-quality-trends", api.URIPathVariableDomainID), resources.GetADDataQualityTrends).RequirePermissions(permissions.GraphDBRead),
 		routerInst.GET(fmt.Sprintf("/api/v2/azure-tenants/{%s}/data-quality-stats", api.URIPathVariableTenantID), resources.GetAzureDataQualityStats).RequirePermissions(permissions.GraphDBRead),
 		routerInst.GET(fmt.Sprintf("/api/v2/platform/{%s}/data-quality-stats", api.URIPathVariablePlatformID), resources.GetPlatformAggregateStats).RequirePermissions(permissions.GraphDBRead),
 
@@ -340,6 +397,7 @@ func NewV2API(resources v2.Resources, routerInst *router.Router) {
 		routerInst.GET("/api/v2/datapipe/status", resources.GetDatapipeStatus).RequireAuth(),
 		// TODO: Update the permission on this once we get something more concrete
 		routerInst.GET("/api/v2/analysis/status", resources.GetAnalysisRequest).RequirePermissions(permissions.GraphDBRead),
+		routerInst.GET("/api/v2/analysis/chokepoints", resources.GetAnalysisChokePoints).RequirePermissions(permissions.GraphDBRead),
 		routerInst.PUT("/api/v2/analysis", resources.RequestAnalysis).RequirePermissions(permissions.GraphDBWrite),
 
 		// Custom Node Management
@@ -349,4 +407,11 @@ func NewV2API(resources v2.Resources, routerInst *router.Router) {
 		routerInst.PUT(fmt.Sprintf("/api/v2/custom-nodes/{%s}", v2.CustomNodeKindParameter), resources.UpdateCustomNodeKind).RequireAuth(),
 		routerInst.DELETE(fmt.Sprintf("/api/v2/custom-nodes/{%s}", v2.CustomNodeKindParameter), resources.DeleteCustomNodeKind).RequireAuth(),
 	)
+
+	router.With(func() mux.MiddlewareFunc {
+		return rateLimiter.Middleware(middleware.RateLimitGroupCypher)
+	},
+		// Cypher Queries API
+		routerInst.POST("/api/v2/graphs/cypher", resources.CypherQuery).RequirePermissions(permissions.GraphDBRead),
+	)
 }
@@ -85,6 +85,12 @@ func (s *Route) AuthorizeUserManagementAccess() *Route {
 	return s
 }
 
+// Ensure that the requestor authenticated with an SSO provider's SCIM token
+func (s *Route) RequireSCIMAuth() *Route {
+	s.handler.Use(middleware.RequireSCIMProvider())
+	return s
+}
+
 func (s *Route) RequireUserId() *Route {
 	s.handler.Use(middleware.RequireUserId())
 	return s
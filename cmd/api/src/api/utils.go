@@ -18,7 +18,11 @@ package api
 
 import (
 	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
 	"strconv"
+	"strings"
 )
 
 func ParseOptionalBool(value string, defaultValue bool) (bool, error) {
@@ -42,3 +46,45 @@ func FilterStructSlice[T any](slice []T, conditional func(t T) bool) []T {
 	}
 	return result
 }
+
+// ClientIP resolves the address of the client that issued the request. When trustedProxies is greater than zero the
+// address is taken from the X-Forwarded-For header, skipping the entries appended by the trusted proxies in front of
+// the API; otherwise the direct remote address of the connection is used. The remote address is also used when the
+// header holds fewer entries than there are trusted proxies, since every entry would then be client controlled.
+func ClientIP(request *http.Request, trustedProxies int) string {
+	var remoteIP string
+
+	if host, _, err := net.SplitHostPort(request.RemoteAddr); err != nil {
+		slog.WarnContext(request.Context(), fmt.Sprintf("Error parsing remoteAddress '%s': %s", request.RemoteAddr, err))
+		remoteIP = request.RemoteAddr
+	} else {
+		remoteIP = host
+	}
+
+	if trustedProxies <= 0 {
+		slog.DebugContext(request.Context(), "Using direct remote IP Address", "IP Address", remoteIP)
+		return remoteIP
+	} else if xff := request.Header.Get("X-Forwarded-For"); xff == "" {
+		slog.DebugContext(request.Context(), "Expected X-Forwarded-For header but none found. Defaulted to remote IP Address", "IP Address", remoteIP)
+		return remoteIP
+	} else {
+		ips := strings.Split(xff, ",")
+
+		// Entries to the left of those appended by the trusted proxies are supplied by the client and can not be
+		// trusted, so a chain too short to be accounted for by the trusted proxies falls back to the remote address
+		idxIP := len(ips) - trustedProxies
+		if idxIP < 0 {
+			slog.WarnContext(request.Context(), "Not enough IPs in X-Forwarded-For, defaulting to remote IP Address", "X-Forwarded-For", xff, "IP Address", remoteIP)
+			return remoteIP
+		}
+
+		finalIP := strings.TrimSpace(ips[idxIP])
+		if net.ParseIP(finalIP) == nil {
+			slog.WarnContext(request.Context(), "Invalid IP in X-Forwarded-For, defaulting to remote IP Address", "X-Forwarded-For", xff, "IP Address", remoteIP)
+			return remoteIP
+		}
+
+		slog.DebugContext(request.Context(), "Found client IP Address in XFF", "IP Address", finalIP, "X-Forwarded-For", xff)
+		return finalIP
+	}
+}
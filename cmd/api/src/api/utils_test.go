@@ -17,6 +17,7 @@
 package api
 
 import (
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -55,3 +56,32 @@ func TestFilterStructSlice(t *testing.T) {
 	})
 	assert.ElementsMatch(t, expected, result)
 }
+
+func TestClientIP(t *testing.T) {
+	newRequest := func(xff string) *http.Request {
+		request, err := http.NewRequest(http.MethodGet, "/", nil)
+		require.NoError(t, err)
+
+		request.RemoteAddr = "10.0.0.2:4433"
+		if xff != "" {
+			request.Header.Set("X-Forwarded-For", xff)
+		}
+		return request
+	}
+
+	t.Run("uses the remote address without trusted proxies", func(t *testing.T) {
+		assert.Equal(t, "10.0.0.2", ClientIP(newRequest("203.0.113.7"), 0))
+	})
+
+	t.Run("skips the entries appended by trusted proxies", func(t *testing.T) {
+		assert.Equal(t, "203.0.113.7", ClientIP(newRequest("198.51.100.1, 203.0.113.7, 10.0.0.1"), 2))
+	})
+
+	t.Run("uses the remote address when the chain is shorter than the trusted proxies", func(t *testing.T) {
+		assert.Equal(t, "10.0.0.2", ClientIP(newRequest("198.51.100.1"), 2))
+	})
+
+	t.Run("uses the remote address when the client entry is not an IP", func(t *testing.T) {
+		assert.Equal(t, "10.0.0.2", ClientIP(newRequest("not-an-ip, 10.0.0.1"), 2))
+	})
+}
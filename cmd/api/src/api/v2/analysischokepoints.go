@@ -0,0 +1,50 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"net/http"
+
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+)
+
+// GetAnalysisChokePoints lists the edges and intermediate principals on attack paths into tier zero that cut off the
+// most principals when remediated, as found by the most recent analysis of the tier zero tag
+func (s *Resources) GetAnalysisChokePoints(response http.ResponseWriter, request *http.Request) {
+	queryParams := request.URL.Query()
+
+	if skip, err := ParseSkipQueryParameter(queryParams, 0); err != nil {
+		api.WriteErrorResponse(request.Context(), ErrBadQueryParameter(request, model.PaginationQueryParameterSkip, err), response)
+	} else if limit, err := ParseLimitQueryParameter(queryParams, 100); err != nil {
+		api.WriteErrorResponse(request.Context(), ErrBadQueryParameter(request, model.PaginationQueryParameterLimit, err), response)
+	} else if sqlFilter, errWrapper := parseAssetGroupTagChokePointSQLFilter(request); errWrapper != nil {
+		api.WriteErrorResponse(request.Context(), errWrapper, response)
+	} else if tags, err := s.DB.GetAssetGroupTags(request.Context(), model.SQLFilter{SQLString: "type = ? AND position = ?", Params: []any{model.AssetGroupTagTypeTier, model.AssetGroupTierZeroPosition}}); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if len(tags) == 0 {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusNotFound, "tier zero tag not found", request), response)
+	} else if chokePoints, count, err := s.DB.GetAssetGroupTagChokePoints(request.Context(), tags[0].ID, sqlFilter, skip, limit); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		if chokePoints == nil {
+			chokePoints = model.AssetGroupTagChokePoints{}
+		}
+
+		api.WriteResponseWrapperWithPagination(request.Context(), chokePoints, limit, skip, count, http.StatusOK, response)
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	v2 "github.com/specterops/bloodhound/cmd/api/src/api/v2"
+	"github.com/specterops/bloodhound/cmd/api/src/api/v2/apitest"
+	mocks_db "github.com/specterops/bloodhound/cmd/api/src/database/mocks"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"go.uber.org/mock/gomock"
+)
+
+func TestResources_GetAnalysisChokePoints(t *testing.T) {
+	var (
+		mockCtrl      = gomock.NewController(t)
+		mockDB        = mocks_db.NewMockDatabase(mockCtrl)
+		resourcesInst = v2.Resources{DB: mockDB}
+		tierZero      = model.AssetGroupTag{ID: 1, Type: model.AssetGroupTagTypeTier, Name: "Tier Zero"}
+		tierZeroQuery = func() *gomock.Call {
+			return mockDB.EXPECT().GetAssetGroupTags(gomock.Any(), model.SQLFilter{SQLString: "type = ? AND position = ?", Params: []any{model.AssetGroupTagTypeTier, model.AssetGroupTierZeroPosition}})
+		}
+	)
+
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resourcesInst.GetAnalysisChokePoints).
+		Run([]apitest.Case{
+			{
+				Name: "InvalidFilterColumn",
+				Input: func(input *apitest.Input) {
+					apitest.AddQueryParam(input, "coverage", "gt:0.5")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, api.ErrorResponseDetailsColumnNotFilterable)
+				},
+			},
+			{
+				Name: "InvalidFilterPredicate",
+				Input: func(input *apitest.Input) {
+					apitest.AddQueryParam(input, "type", "gt:edge")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, api.ErrorResponseDetailsFilterPredicateNotSupported)
+				},
+			},
+			{
+				Name: "TierZeroNotFound",
+				Setup: func() {
+					tierZeroQuery().Return(model.AssetGroupTags{}, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNotFound)
+				},
+			},
+			{
+				Name: "DatabaseError",
+				Setup: func() {
+					tierZeroQuery().Return(model.AssetGroupTags{tierZero}, nil).Times(1)
+					mockDB.EXPECT().GetAssetGroupTagChokePoints(gomock.Any(), tierZero.ID, model.SQLFilter{}, 0, 100).Return(nil, 0, errors.New("failure")).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusInternalServerError)
+				},
+			},
+			{
+				Name: "Success",
+				Input: func(input *apitest.Input) {
+					apitest.AddQueryParam(input, "type", "eq:edge")
+					apitest.AddQueryParam(input, model.PaginationQueryParameterLimit, "10")
+				},
+				Setup: func() {
+					tierZeroQuery().Return(model.AssetGroupTags{tierZero}, nil).Times(1)
+					mockDB.EXPECT().GetAssetGroupTagChokePoints(gomock.Any(), tierZero.ID, model.SQLFilter{SQLString: "type = 'edge'"}, 0, 10).
+						Return(model.AssetGroupTagChokePoints{{
+							ID:              1,
+							AssetGroupTagId: tierZero.ID,
+							Type:            model.AssetGroupTagChokePointTypeEdge,
+							EdgeKind:        "AdminTo",
+							SourceName:      "SERVER ADMIN",
+							TargetNodeId:    null.Int64From(2),
+							TargetName:      "DC01",
+							Principals:      8,
+							Coverage:        0.5,
+						}}, 1, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusOK)
+					apitest.BodyContains(output, `"type":"edge","edge_kind":"AdminTo"`)
+					apitest.BodyContains(output, `"target_node_id":2`)
+					apitest.BodyContains(output, `"principals":8,"coverage":0.5`)
+					apitest.BodyContains(output, `"count":1`)
+				},
+			},
+		})
+}
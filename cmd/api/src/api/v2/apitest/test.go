@@ -29,6 +29,9 @@ import (
 	"go.uber.org/mock/gomock"
 )
 
+// Secrets is the secrets configuration of the resources returned by NewAuthManagementResource
+var Secrets = config.SecretsConfiguration{EncryptionKey: "3q1Qm3MBcC8tOPzAq7yVfWcbB7nS6tIv0iUqRk4Yw2c="}
+
 func NewAuthManagementResource(mockCtrl *gomock.Controller) (auth.ManagementResource, *mocks.MockDatabase) {
 	cfg, err := config.NewDefaultConfiguration()
 	if err != nil {
@@ -38,6 +41,7 @@ func NewAuthManagementResource(mockCtrl *gomock.Controller) (auth.ManagementReso
 
 	cfg.Crypto.Argon2.NumIterations = 1
 	cfg.Crypto.Argon2.NumThreads = 1
+	cfg.Crypto.Secrets = Secrets
 
 	mockDB := mocks.NewMockDatabase(mockCtrl)
 	resources := auth.NewManagementResource(cfg, mockDB, authPkg.NewAuthorizer(mockDB), api.NewAuthenticator(cfg, mockDB, mocks.NewMockAuthContextInitializer(mockCtrl)))
@@ -0,0 +1,109 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+)
+
+const errAssetGroupTagExposureNotTier = "exposure is only measured for tiers"
+
+// GetAssetGroupTagExposures lists the inbound exposure and outbound impact of a tier measured by each analysis run
+// within the requested time window, newest first, for charting the trend of the tier
+func (s *Resources) GetAssetGroupTagExposures(response http.ResponseWriter, request *http.Request) {
+	var (
+		queryParams              = request.URL.Query()
+		defaultEnd, defaultStart = DefaultTimeRange()
+	)
+
+	if tag, ok := s.getAssetGroupTagTier(response, request, errAssetGroupTagExposureNotTier); !ok {
+		return
+	} else if start, err := ParseTimeQueryParameter(queryParams, "start", defaultStart); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf(api.ErrorInvalidRFC3339, queryParams["start"]), request), response)
+	} else if end, err := ParseTimeQueryParameter(queryParams, "end", defaultEnd); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf(api.ErrorInvalidRFC3339, queryParams["end"]), request), response)
+	} else if skip, err := ParseSkipQueryParameter(queryParams, 0); err != nil {
+		api.WriteErrorResponse(request.Context(), ErrBadQueryParameter(request, model.PaginationQueryParameterSkip, err), response)
+	} else if limit, err := ParseLimitQueryParameter(queryParams, 1000); err != nil {
+		api.WriteErrorResponse(request.Context(), ErrBadQueryParameter(request, model.PaginationQueryParameterLimit, err), response)
+	} else if exposures, count, err := s.DB.GetAssetGroupTagExposures(request.Context(), tag.ID, start, end, skip, limit); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		if exposures == nil {
+			exposures = model.AssetGroupTagExposures{}
+		}
+
+		api.WriteResponseWrapperWithTimeWindowAndPagination(request.Context(), exposures, start, end, limit, skip, count, http.StatusOK, response)
+	}
+}
+
+func parseAssetGroupTagChokePointSQLFilter(request *http.Request) (model.SQLFilter, *api.ErrorWrapper) {
+	var chokePoint model.AssetGroupTagChokePoint
+
+	if queryFilters, err := model.NewQueryParameterFilterParser().ParseQueryParameterFilters(request); err != nil {
+		return model.SQLFilter{}, api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsBadQueryParameterFilters, request)
+	} else {
+		for name, filters := range queryFilters {
+			if validPredicates, err := api.GetValidFilterPredicatesAsStrings(chokePoint, name); err != nil {
+				return model.SQLFilter{}, api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("%s: %s", api.ErrorResponseDetailsColumnNotFilterable, name), request)
+			} else {
+				for i, filter := range filters {
+					if !slices.Contains(validPredicates, string(filter.Operator)) {
+						return model.SQLFilter{}, api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("%s: %s %s", api.ErrorResponseDetailsFilterPredicateNotSupported, filter.Name, filter.Operator), request)
+					}
+
+					queryFilters[name][i].IsStringData = chokePoint.IsStringColumn(filter.Name)
+				}
+			}
+		}
+
+		if sqlFilter, err := queryFilters.BuildSQLFilter(); err != nil {
+			return model.SQLFilter{}, api.BuildErrorResponse(http.StatusBadRequest, "error building SQL for filter", request)
+		} else {
+			return sqlFilter, nil
+		}
+	}
+}
+
+// GetAssetGroupTagChokePoints lists the edges and exposed principals on attack paths into a tier that cut off the most
+// exposed principals when remediated, as found by the most recent analysis
+func (s *Resources) GetAssetGroupTagChokePoints(response http.ResponseWriter, request *http.Request) {
+	queryParams := request.URL.Query()
+
+	if tag, ok := s.getAssetGroupTagTier(response, request, errAssetGroupTagExposureNotTier); !ok {
+		return
+	} else if skip, err := ParseSkipQueryParameter(queryParams, 0); err != nil {
+		api.WriteErrorResponse(request.Context(), ErrBadQueryParameter(request, model.PaginationQueryParameterSkip, err), response)
+	} else if limit, err := ParseLimitQueryParameter(queryParams, 100); err != nil {
+		api.WriteErrorResponse(request.Context(), ErrBadQueryParameter(request, model.PaginationQueryParameterLimit, err), response)
+	} else if sqlFilter, errWrapper := parseAssetGroupTagChokePointSQLFilter(request); errWrapper != nil {
+		api.WriteErrorResponse(request.Context(), errWrapper, response)
+	} else if chokePoints, count, err := s.DB.GetAssetGroupTagChokePoints(request.Context(), tag.ID, sqlFilter, skip, limit); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		if chokePoints == nil {
+			chokePoints = model.AssetGroupTagChokePoints{}
+		}
+
+		api.WriteResponseWrapperWithPagination(request.Context(), chokePoints, limit, skip, count, http.StatusOK, response)
+	}
+}
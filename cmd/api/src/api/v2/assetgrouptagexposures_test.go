@@ -0,0 +1,178 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	v2 "github.com/specterops/bloodhound/cmd/api/src/api/v2"
+	"github.com/specterops/bloodhound/cmd/api/src/api/v2/apitest"
+	mocks_db "github.com/specterops/bloodhound/cmd/api/src/database/mocks"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"go.uber.org/mock/gomock"
+)
+
+func TestResources_GetAssetGroupTagExposures(t *testing.T) {
+	var (
+		mockCtrl      = gomock.NewController(t)
+		mockDB        = mocks_db.NewMockDatabase(mockCtrl)
+		resourcesInst = v2.Resources{DB: mockDB}
+		tierZero      = model.AssetGroupTag{ID: 1, Type: model.AssetGroupTagTypeTier, Name: "Tier Zero"}
+		start         = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		end           = time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resourcesInst.GetAssetGroupTagExposures).
+		Run([]apitest.Case{
+			{
+				Name: "NotATier",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "3")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 3).Return(model.AssetGroupTag{ID: 3, Type: model.AssetGroupTagTypeLabel}, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "exposure is only measured for tiers")
+				},
+			},
+			{
+				Name: "InvalidStart",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+					apitest.AddQueryParam(input, "start", "yesterday")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1).Return(tierZero, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+				},
+			},
+			{
+				Name: "DatabaseError",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1).Return(tierZero, nil).Times(1)
+					mockDB.EXPECT().GetAssetGroupTagExposures(gomock.Any(), 1, gomock.Any(), gomock.Any(), 0, 1000).Return(nil, 0, errors.New("failure")).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusInternalServerError)
+				},
+			},
+			{
+				Name: "Success",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+					apitest.AddQueryParam(input, "start", start.Format(time.RFC3339))
+					apitest.AddQueryParam(input, "end", end.Format(time.RFC3339))
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1).Return(tierZero, nil).Times(1)
+					mockDB.EXPECT().GetAssetGroupTagExposures(gomock.Any(), 1, start, end, 0, 1000).
+						Return(model.AssetGroupTagExposures{{AssetGroupTagId: 1, AnalysisRunId: "run-1", Exposure: 12, Impact: 340}}, 1, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusOK)
+					apitest.BodyContains(output, `"exposure":12,"impact":340`)
+					apitest.BodyContains(output, `"start":"2026-01-01T00:00:00Z"`)
+					apitest.BodyContains(output, `"count":1`)
+				},
+			},
+		})
+}
+
+func TestResources_GetAssetGroupTagChokePoints(t *testing.T) {
+	var (
+		mockCtrl      = gomock.NewController(t)
+		mockDB        = mocks_db.NewMockDatabase(mockCtrl)
+		resourcesInst = v2.Resources{DB: mockDB}
+		tierZero      = model.AssetGroupTag{ID: 1, Type: model.AssetGroupTagTypeTier, Name: "Tier Zero"}
+	)
+
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resourcesInst.GetAssetGroupTagChokePoints).
+		Run([]apitest.Case{
+			{
+				Name: "InvalidTagUrlId",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "non-numeric")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNotFound)
+					apitest.BodyContains(output, api.ErrorResponseDetailsIDMalformed)
+				},
+			},
+			{
+				Name: "InvalidLimit",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+					apitest.AddQueryParam(input, model.PaginationQueryParameterLimit, "-1")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1).Return(tierZero, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+				},
+			},
+			{
+				Name: "InvalidFilterColumn",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+					apitest.AddQueryParam(input, "coverage", "gt:0.5")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1).Return(tierZero, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, api.ErrorResponseDetailsColumnNotFilterable)
+				},
+			},
+			{
+				Name: "Success",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+					apitest.AddQueryParam(input, model.PaginationQueryParameterLimit, "10")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1).Return(tierZero, nil).Times(1)
+					mockDB.EXPECT().GetAssetGroupTagChokePoints(gomock.Any(), 1, model.SQLFilter{}, 0, 10).
+						Return(model.AssetGroupTagChokePoints{{ID: 1, AssetGroupTagId: 1, Type: model.AssetGroupTagChokePointTypeEdge, EdgeKind: "AdminTo", SourceName: "SERVER ADMIN", TargetName: "DC01", Principals: 8}}, 1, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusOK)
+					apitest.BodyContains(output, `"type":"edge","edge_kind":"AdminTo"`)
+					apitest.BodyContains(output, `"principals":8`)
+					apitest.BodyContains(output, `"count":1`)
+				},
+			},
+		})
+}
@@ -0,0 +1,108 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+)
+
+const assetGroupMembersAsOfQueryParam = "as_of"
+
+type GetAssetGroupTagMembershipHistoryResponse struct {
+	Events model.AssetGroupTagMembershipEvents `json:"events"`
+}
+
+func parseAssetGroupTagMembershipEventSQLFilter(request *http.Request) (model.SQLFilter, *api.ErrorWrapper) {
+	var event model.AssetGroupTagMembershipEvent
+
+	if queryFilters, err := model.NewQueryParameterFilterParser().ParseQueryParameterFilters(request); err != nil {
+		return model.SQLFilter{}, api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsBadQueryParameterFilters, request)
+	} else {
+		for name, filters := range queryFilters {
+			if validPredicates, err := api.GetValidFilterPredicatesAsStrings(event, name); err != nil {
+				return model.SQLFilter{}, api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("%s: %s", api.ErrorResponseDetailsColumnNotFilterable, name), request)
+			} else {
+				for i, filter := range filters {
+					if !slices.Contains(validPredicates, string(filter.Operator)) {
+						return model.SQLFilter{}, api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("%s: %s %s", api.ErrorResponseDetailsFilterPredicateNotSupported, filter.Name, filter.Operator), request)
+					}
+
+					queryFilters[name][i].IsStringData = event.IsStringColumn(filter.Name)
+				}
+			}
+		}
+
+		if sqlFilter, err := queryFilters.BuildSQLFilter(); err != nil {
+			return model.SQLFilter{}, api.BuildErrorResponse(http.StatusBadRequest, "error building SQL for filter", request)
+		} else {
+			return sqlFilter, nil
+		}
+	}
+}
+
+// GetAssetGroupTagMembershipHistory lists the nodes added to or removed from a tag by each analysis run with the most
+// recent changes first
+func (s *Resources) GetAssetGroupTagMembershipHistory(response http.ResponseWriter, request *http.Request) {
+	queryParams := request.URL.Query()
+
+	if tagId, err := strconv.Atoi(mux.Vars(request)[api.URIPathVariableAssetGroupTagID]); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusNotFound, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if tag, err := s.DB.GetAssetGroupTag(request.Context(), tagId); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if skip, err := ParseSkipQueryParameter(queryParams, 0); err != nil {
+		api.WriteErrorResponse(request.Context(), ErrBadQueryParameter(request, model.PaginationQueryParameterSkip, err), response)
+	} else if limit, err := ParseLimitQueryParameter(queryParams, 100); err != nil {
+		api.WriteErrorResponse(request.Context(), ErrBadQueryParameter(request, model.PaginationQueryParameterLimit, err), response)
+	} else if sqlFilter, errWrapper := parseAssetGroupTagMembershipEventSQLFilter(request); errWrapper != nil {
+		api.WriteErrorResponse(request.Context(), errWrapper, response)
+	} else if events, count, err := s.DB.GetAssetGroupTagMembershipEvents(request.Context(), tag.ID, sqlFilter, skip, limit); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		if events == nil {
+			events = model.AssetGroupTagMembershipEvents{}
+		}
+
+		api.WriteResponseWrapperWithPagination(request.Context(), GetAssetGroupTagMembershipHistoryResponse{Events: events}, limit, skip, count, http.StatusOK, response)
+	}
+}
+
+// getAssetGroupMembersAsOf writes the members of a tag at the given time as reconstructed from its membership history
+func (s *Resources) getAssetGroupMembersAsOf(response http.ResponseWriter, request *http.Request, tag model.AssetGroupTag, asOf time.Time, skip, limit int) {
+	if events, count, err := s.DB.GetAssetGroupTagMembersAsOf(request.Context(), tag.ID, asOf, skip, limit); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		members := make([]AssetGroupMember, 0, len(events))
+		for _, event := range events {
+			members = append(members, AssetGroupMember{
+				NodeId:      event.NodeId,
+				ObjectID:    event.NodeObjectId,
+				PrimaryKind: event.NodeKind,
+				Name:        event.NodeName,
+			})
+		}
+
+		api.WriteResponseWrapperWithPagination(request.Context(), GetAssetGroupMembersResponse{Members: members}, limit, skip, count, http.StatusOK, response)
+	}
+}
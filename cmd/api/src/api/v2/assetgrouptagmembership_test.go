@@ -0,0 +1,226 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	v2 "github.com/specterops/bloodhound/cmd/api/src/api/v2"
+	"github.com/specterops/bloodhound/cmd/api/src/api/v2/apitest"
+	"github.com/specterops/bloodhound/cmd/api/src/database"
+	mocks_db "github.com/specterops/bloodhound/cmd/api/src/database/mocks"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"go.uber.org/mock/gomock"
+)
+
+func TestResources_GetAssetGroupTagMembershipHistory(t *testing.T) {
+	var (
+		mockCtrl      = gomock.NewController(t)
+		mockDB        = mocks_db.NewMockDatabase(mockCtrl)
+		resourcesInst = v2.Resources{DB: mockDB}
+		tierZero      = model.AssetGroupTag{ID: 1, Type: model.AssetGroupTagTypeTier, Name: "Tier Zero"}
+	)
+
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resourcesInst.GetAssetGroupTagMembershipHistory).
+		Run([]apitest.Case{
+			{
+				Name: "InvalidTagUrlId",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "non-numeric")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNotFound)
+					apitest.BodyContains(output, api.ErrorResponseDetailsIDMalformed)
+				},
+			},
+			{
+				Name: "TagNotFound",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "2")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 2).Return(model.AssetGroupTag{}, database.ErrNotFound).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNotFound)
+				},
+			},
+			{
+				Name: "InvalidFilterColumn",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+					apitest.AddQueryParam(input, "asset_group_tag_id", "eq:2")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1).Return(tierZero, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, api.ErrorResponseDetailsColumnNotFilterable)
+				},
+			},
+			{
+				Name: "InvalidFilterPredicate",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+					apitest.AddQueryParam(input, "action", "gt:added")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1).Return(tierZero, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, api.ErrorResponseDetailsFilterPredicateNotSupported)
+				},
+			},
+			{
+				Name: "DatabaseError",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1).Return(tierZero, nil).Times(1)
+					mockDB.EXPECT().GetAssetGroupTagMembershipEvents(gomock.Any(), 1, model.SQLFilter{}, 0, 100).Return(nil, 0, errors.New("failure")).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusInternalServerError)
+				},
+			},
+			{
+				Name: "Success",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+					apitest.AddQueryParam(input, "action", "eq:added")
+					apitest.AddQueryParam(input, model.PaginationQueryParameterLimit, "10")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1).Return(tierZero, nil).Times(1)
+					mockDB.EXPECT().GetAssetGroupTagMembershipEvents(gomock.Any(), 1, model.SQLFilter{SQLString: "action = 'added'"}, 0, 10).
+						Return(model.AssetGroupTagMembershipEvents{{
+							ID:              1,
+							AssetGroupTagId: 1,
+							SelectorId:      null.Int32From(3),
+							NodeId:          7,
+							NodeObjectId:    "S-1-5-21-1-512",
+							NodeName:        "DOMAIN ADMINS@TESTLAB.LOCAL",
+							NodeKind:        "Group",
+							Action:          model.AssetGroupMembershipActionAdded,
+							AnalysisRunId:   "run",
+						}}, 1, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusOK)
+					apitest.BodyContains(output, `"selector_id":3`)
+					apitest.BodyContains(output, `"action":"added"`)
+					apitest.BodyContains(output, `"analysis_run_id":"run"`)
+					apitest.BodyContains(output, `"count":1`)
+				},
+			},
+		})
+}
+
+func TestResources_GetAssetGroupMembersByTag_AsOf(t *testing.T) {
+	var (
+		mockCtrl      = gomock.NewController(t)
+		mockDB        = mocks_db.NewMockDatabase(mockCtrl)
+		resourcesInst = v2.Resources{DB: mockDB}
+		tierZero      = model.AssetGroupTag{ID: 1, Type: model.AssetGroupTagTypeTier, Name: "Tier Zero"}
+		asOf          = time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resourcesInst.GetAssetGroupMembersByTag).
+		Run([]apitest.Case{
+			{
+				Name: "InvalidAsOf",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+					apitest.AddQueryParam(input, "as_of", "yesterday")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1).Return(tierZero, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "as_of")
+				},
+			},
+			{
+				Name: "SortNotSupported",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+					apitest.AddQueryParam(input, "as_of", asOf.Format(time.RFC3339))
+					apitest.AddQueryParam(input, "sort_by", "name")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1).Return(tierZero, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "sorting is not supported with as_of")
+				},
+			},
+			{
+				Name: "DatabaseError",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+					apitest.AddQueryParam(input, "as_of", asOf.Format(time.RFC3339))
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1).Return(tierZero, nil).Times(1)
+					mockDB.EXPECT().GetAssetGroupTagMembersAsOf(gomock.Any(), 1, asOf, 0, 10).Return(nil, 0, errors.New("failure")).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusInternalServerError)
+				},
+			},
+			{
+				Name: "Success",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+					apitest.AddQueryParam(input, "as_of", asOf.Format(time.RFC3339))
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1).Return(tierZero, nil).Times(1)
+					mockDB.EXPECT().GetAssetGroupTagMembersAsOf(gomock.Any(), 1, asOf, 0, 10).
+						Return(model.AssetGroupTagMembershipEvents{{
+							AssetGroupTagId: 1,
+							NodeId:          7,
+							NodeObjectId:    "S-1-5-21-1-512",
+							NodeName:        "DOMAIN ADMINS@TESTLAB.LOCAL",
+							NodeKind:        "Group",
+							Action:          model.AssetGroupMembershipActionAdded,
+						}}, 1, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusOK)
+					apitest.BodyContains(output, `"members":[{"id":7,"object_id":"S-1-5-21-1-512","primary_kind":"Group","name":"DOMAIN ADMINS@TESTLAB.LOCAL"}]`)
+					apitest.BodyContains(output, `"count":1`)
+				},
+			},
+		})
+}
@@ -17,6 +17,7 @@
 package v2
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -148,7 +149,7 @@ func validateSelectorSeeds(graph queries.Graph, seeds []model.SelectorSeed) erro
 	// all seeds must be of the same type
 	seedType := seeds[0].Type
 
-	if seedType != model.SelectorTypeObjectId && seedType != model.SelectorTypeCypher {
+	if seedType != model.SelectorTypeObjectId && seedType != model.SelectorTypeCypher && seedType != model.SelectorTypePropertyFilter {
 		return fmt.Errorf("invalid seed type %v", seedType)
 	}
 
@@ -156,10 +157,15 @@ func validateSelectorSeeds(graph queries.Graph, seeds []model.SelectorSeed) erro
 		if seed.Type != seedType {
 			return fmt.Errorf("all seeds must be of the same type")
 		}
-		if seed.Type == model.SelectorTypeCypher {
+		switch seed.Type {
+		case model.SelectorTypeCypher:
 			if _, err := graph.PrepareCypherQuery(seed.Value, queries.DefaultQueryFitnessLowerBoundSelector); err != nil {
 				return fmt.Errorf("cypher is invalid: %v", err)
 			}
+		case model.SelectorTypePropertyFilter:
+			if _, err := model.ParseSelectorPropertyFilter(seed.Value); err != nil {
+				return fmt.Errorf("property filter is invalid: %v", err)
+			}
 		}
 	}
 	return nil
@@ -176,8 +182,10 @@ func (s *Resources) CreateAssetGroupTagSelector(response http.ResponseWriter, re
 
 	if assetTagId, err := strconv.Atoi(assetTagIdStr); err != nil {
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusNotFound, api.ErrorResponseDetailsIDMalformed, request), response)
-	} else if _, err := s.DB.GetAssetGroupTag(request.Context(), assetTagId); err != nil {
+	} else if tag, err := s.DB.GetAssetGroupTag(request.Context(), assetTagId); err != nil {
 		api.HandleDatabaseError(request, response, err)
+	} else if overrideMaxMembers, err := parseOverrideMaxMembers(request.URL.Query()); err != nil {
+		api.WriteErrorResponse(request.Context(), ErrBadQueryParameter(request, overrideMaxMembersQueryParam, err), response)
 	} else if err := json.NewDecoder(request.Body).Decode(&sel); err != nil {
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponsePayloadUnmarshalError, request), response)
 	} else if errs := validation.Validate(sel); len(errs) > 0 {
@@ -187,6 +195,10 @@ func (s *Resources) CreateAssetGroupTagSelector(response http.ResponseWriter, re
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, "unknown user", request), response)
 	} else if err := validateSelectorSeeds(s.GraphQuery, sel.Seeds); err != nil {
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
+	} else if impact, err := s.projectSelectorImpact(request.Context(), tag, 0, sel.Seeds); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if impact.LimitExceeded && !overrideMaxMembers {
+		api.WriteErrorResponse(request.Context(), errSelectorMaxMembersExceeded(request, impact), response)
 	} else if selector, err := s.DB.CreateAssetGroupTagSelector(request.Context(), assetTagId, actor, sel.Name, sel.Description, false, true, sel.AutoCertify, sel.Seeds); err != nil {
 		api.HandleDatabaseError(request, response, err)
 	} else {
@@ -200,13 +212,14 @@ func (s *Resources) CreateAssetGroupTagSelector(response http.ResponseWriter, re
 				return
 			}
 		}
-		api.WriteBasicResponse(request.Context(), selector, http.StatusCreated, response)
+		api.WriteBasicResponse(request.Context(), AssetGroupTagSelectorResponse{AssetGroupTagSelector: selector, Impact: &impact}, http.StatusCreated, response)
 	}
 }
 
 func (s *Resources) UpdateAssetGroupTagSelector(response http.ResponseWriter, request *http.Request) {
 	var (
 		selUpdateReq  patchAssetGroupTagSelectorRequest
+		impact        *SelectorImpact
 		assetTagIdStr = mux.Vars(request)[api.URIPathVariableAssetGroupTagID]
 		rawSelectorID = mux.Vars(request)[api.URIPathVariableAssetGroupTagSelectorID]
 	)
@@ -217,7 +230,7 @@ func (s *Resources) UpdateAssetGroupTagSelector(response http.ResponseWriter, re
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, "unknown user", request), response)
 	} else if assetTagId, err := strconv.Atoi(assetTagIdStr); err != nil {
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusNotFound, api.ErrorResponseDetailsIDMalformed, request), response)
-	} else if _, err := s.DB.GetAssetGroupTag(request.Context(), assetTagId); err != nil {
+	} else if tag, err := s.DB.GetAssetGroupTag(request.Context(), assetTagId); err != nil {
 		api.HandleDatabaseError(request, response, err)
 	} else if selectorId, err := strconv.Atoi(rawSelectorID); err != nil {
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusNotFound, api.ErrorResponseDetailsIDMalformed, request), response)
@@ -225,6 +238,8 @@ func (s *Resources) UpdateAssetGroupTagSelector(response http.ResponseWriter, re
 		api.HandleDatabaseError(request, response, err)
 	} else if selector.AssetGroupTagId != assetTagId {
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusNotFound, "selector is not part of asset group tag", request), response)
+	} else if overrideMaxMembers, err := parseOverrideMaxMembers(request.URL.Query()); err != nil {
+		api.WriteErrorResponse(request.Context(), ErrBadQueryParameter(request, overrideMaxMembersQueryParam, err), response)
 	} else if err := json.NewDecoder(request.Body).Decode(&selUpdateReq); err != nil {
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponsePayloadUnmarshalError, request), response)
 	} else {
@@ -268,6 +283,14 @@ func (s *Resources) UpdateAssetGroupTagSelector(response http.ResponseWriter, re
 			if err := validateSelectorSeeds(s.GraphQuery, selUpdateReq.Seeds); err != nil {
 				api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
 				return
+			} else if projected, err := s.projectSelectorImpact(request.Context(), tag, selector.ID, selUpdateReq.Seeds); err != nil {
+				api.HandleDatabaseError(request, response, err)
+				return
+			} else if projected.LimitExceeded && !overrideMaxMembers {
+				api.WriteErrorResponse(request.Context(), errSelectorMaxMembersExceeded(request, projected), response)
+				return
+			} else {
+				impact = &projected
 			}
 			selector.Seeds = selUpdateReq.Seeds
 		} else {
@@ -293,7 +316,7 @@ func (s *Resources) UpdateAssetGroupTagSelector(response http.ResponseWriter, re
 					return
 				}
 			}
-			api.WriteBasicResponse(request.Context(), selector, http.StatusOK, response)
+			api.WriteBasicResponse(request.Context(), AssetGroupTagSelectorResponse{AssetGroupTagSelector: selector, Impact: impact}, http.StatusOK, response)
 		}
 	}
 }
@@ -505,6 +528,356 @@ func (s *Resources) GetAssetGroupTag(response http.ResponseWriter, request *http
 	}
 }
 
+type createAssetGroupTagRequest struct {
+	Type           model.AssetGroupTagType `json:"type" validate:"required"`
+	Name           string                  `json:"name" validate:"required"`
+	Description    string                  `json:"description"`
+	Position       null.Int32              `json:"position"`
+	RequireCertify null.Bool               `json:"require_certify"`
+}
+
+type patchAssetGroupTagRequest struct {
+	Name            *string    `json:"name"`
+	Description     *string    `json:"description"`
+	Position        null.Int32 `json:"position"`
+	RequireCertify  null.Bool  `json:"require_certify"`
+	AnalysisEnabled null.Bool  `json:"analysis_enabled"`
+}
+
+// handleAssetGroupTagDatabaseError maps the tag validation errors raised inside the database transaction to client errors
+func handleAssetGroupTagDatabaseError(request *http.Request, response http.ResponseWriter, err error) {
+	if errors.Is(err, database.ErrPositionOutOfRange) {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "position is out of range", request), response)
+	} else if errors.Is(err, database.ErrDuplicateAGName) || errors.Is(err, database.ErrDuplicateKindName) {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusConflict, "tag name must be unique", request), response)
+	} else {
+		api.HandleDatabaseError(request, response, err)
+	}
+}
+
+// refreshTagKinds reloads the graph's kind map after a tag's kind was created, renamed or deleted. Failing to refresh
+// is not fatal since the kinds are reloaded on the next analysis.
+func (s *Resources) refreshTagKinds(ctx context.Context) {
+	if s.Graph == nil {
+		return
+	} else if err := s.Graph.RefreshKinds(ctx); err != nil {
+		slog.WarnContext(ctx, fmt.Sprintf("Failed to refresh graph kinds after asset group tag change: %v", err))
+	}
+}
+
+// requestTagAnalysis requests analysis so that tag membership is recalculated, unless scheduled analysis is enabled
+func (s *Resources) requestTagAnalysis(ctx context.Context, actor model.User) error {
+	if config, err := appcfg.GetScheduledAnalysisParameter(ctx, s.DB); err != nil {
+		return err
+	} else if !config.Enabled {
+		return s.DB.RequestAnalysis(ctx, actor.ID.String())
+	}
+
+	return nil
+}
+
+func (s *Resources) CreateAssetGroupTag(response http.ResponseWriter, request *http.Request) {
+	var reqBody createAssetGroupTagRequest
+	defer measure.ContextMeasure(request.Context(), slog.LevelDebug, "Asset Group Tag Create")()
+
+	if err := json.NewDecoder(request.Body).Decode(&reqBody); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponsePayloadUnmarshalError, request), response)
+	} else if errs := validation.Validate(reqBody); len(errs) > 0 {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, errs.Error(), request), response)
+	} else if reqBody.Type != model.AssetGroupTagTypeTier && reqBody.Type != model.AssetGroupTagTypeLabel {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "type must be a tier or a label", request), response)
+	} else if reqBody.Type != model.AssetGroupTagTypeTier && (reqBody.Position.Valid || reqBody.RequireCertify.Valid) {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "position and require_certify are limited to tiers only", request), response)
+	} else if actor, isUser := auth.GetUserFromAuthCtx(ctx.FromRequest(request).AuthCtx); !isUser {
+		slog.Error("Unable to get user from auth context")
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, "unknown user", request), response)
+	} else if tag, err := s.DB.CreateAssetGroupTag(request.Context(), reqBody.Type, actor, reqBody.Name, reqBody.Description, reqBody.Position, reqBody.RequireCertify); err != nil {
+		handleAssetGroupTagDatabaseError(request, response, err)
+	} else {
+		s.refreshTagKinds(request.Context())
+
+		// Inserting a tier shifts the tiers below it, which changes their membership
+		if tag.Type == model.AssetGroupTagTypeTier {
+			if err := s.requestTagAnalysis(request.Context(), actor); err != nil {
+				api.HandleDatabaseError(request, response, err)
+				return
+			}
+		}
+
+		api.WriteBasicResponse(request.Context(), tag, http.StatusCreated, response)
+	}
+}
+
+func (s *Resources) UpdateAssetGroupTag(response http.ResponseWriter, request *http.Request) {
+	var reqBody patchAssetGroupTagRequest
+	defer measure.ContextMeasure(request.Context(), slog.LevelDebug, "Asset Group Tag Update")()
+
+	if actor, isUser := auth.GetUserFromAuthCtx(ctx.FromRequest(request).AuthCtx); !isUser {
+		slog.Error("Unable to get user from auth context")
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, "unknown user", request), response)
+	} else if tagId, err := strconv.Atoi(mux.Vars(request)[api.URIPathVariableAssetGroupTagID]); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusNotFound, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if tag, err := s.DB.GetAssetGroupTag(request.Context(), tagId); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if err := json.NewDecoder(request.Body).Decode(&reqBody); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponsePayloadUnmarshalError, request), response)
+	} else if tag.Type != model.AssetGroupTagTypeTier && (reqBody.Position.Valid || reqBody.RequireCertify.Valid || reqBody.AnalysisEnabled.Valid) {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "position, require_certify, and analysis_enabled are limited to tiers only", request), response)
+	} else if reqBody.Position.Valid && tag.Position.ValueOrZero() == model.AssetGroupTierZeroPosition && reqBody.Position.Int32 != model.AssetGroupTierZeroPosition {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusForbidden, "the tier in the 1st position cannot be moved", request), response)
+	} else if reqBody.Name != nil && tag.Type == model.AssetGroupTagTypeOwned && *reqBody.Name != tag.Name {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusForbidden, "the owned tag cannot be renamed", request), response)
+	} else if reqBody.Name != nil && strings.TrimSpace(*reqBody.Name) == "" {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "name cannot be empty", request), response)
+	} else {
+		var (
+			renamed           = reqBody.Name != nil && *reqBody.Name != tag.Name
+			membershipChanged = (reqBody.Position.Valid && !reqBody.Position.Equal(tag.Position)) ||
+				(reqBody.RequireCertify.Valid && !reqBody.RequireCertify.Equal(tag.RequireCertify)) ||
+				(reqBody.AnalysisEnabled.Valid && !reqBody.AnalysisEnabled.Equal(tag.AnalysisEnabled))
+		)
+
+		if reqBody.Name != nil {
+			tag.Name = *reqBody.Name
+		}
+
+		if reqBody.Description != nil {
+			tag.Description = *reqBody.Description
+		}
+
+		if reqBody.Position.Valid {
+			tag.Position = reqBody.Position
+		}
+
+		if reqBody.RequireCertify.Valid {
+			tag.RequireCertify = reqBody.RequireCertify
+		}
+
+		if reqBody.AnalysisEnabled.Valid {
+			tag.AnalysisEnabled = reqBody.AnalysisEnabled
+		}
+
+		if tag, err := s.DB.UpdateAssetGroupTag(request.Context(), actor, tag); err != nil {
+			handleAssetGroupTagDatabaseError(request, response, err)
+		} else {
+			if renamed {
+				s.refreshTagKinds(request.Context())
+			}
+
+			if membershipChanged {
+				if err := s.requestTagAnalysis(request.Context(), actor); err != nil {
+					api.HandleDatabaseError(request, response, err)
+					return
+				}
+			}
+
+			api.WriteBasicResponse(request.Context(), tag, http.StatusOK, response)
+		}
+	}
+}
+
+func (s *Resources) DeleteAssetGroupTag(response http.ResponseWriter, request *http.Request) {
+	defer measure.ContextMeasure(request.Context(), slog.LevelDebug, "Asset Group Tag Delete")()
+
+	if actor, isUser := auth.GetUserFromAuthCtx(ctx.FromRequest(request).AuthCtx); !isUser {
+		slog.Error("Unable to get user from auth context")
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, "unknown user", request), response)
+	} else if tagId, err := strconv.Atoi(mux.Vars(request)[api.URIPathVariableAssetGroupTagID]); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusNotFound, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if tag, err := s.DB.GetAssetGroupTag(request.Context(), tagId); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if tag.Type == model.AssetGroupTagTypeTier && tag.Position.ValueOrZero() == model.AssetGroupTierZeroPosition {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusForbidden, "the tier in the 1st position cannot be deleted", request), response)
+	} else if tag.Type == model.AssetGroupTagTypeOwned {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusForbidden, "the owned tag cannot be deleted", request), response)
+	} else if err := datapipe.ClearAssetGroupTagNodeSet(request.Context(), s.Graph, tag); err != nil {
+		// The tag's kind must be removed from its members while the kind still exists
+		slog.ErrorContext(request.Context(), fmt.Sprintf("Failed to clear asset group tag members: %v", err))
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, api.ErrorResponseDetailsInternalServerError, request), response)
+	} else if err := s.DB.DeleteAssetGroupTag(request.Context(), actor, tag); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		s.refreshTagKinds(request.Context())
+
+		// Removing a tier moves its members to the tiers below it
+		if tag.Type == model.AssetGroupTagTypeTier {
+			if err := s.requestTagAnalysis(request.Context(), actor); err != nil {
+				api.HandleDatabaseError(request, response, err)
+				return
+			}
+		}
+
+		response.WriteHeader(http.StatusNoContent)
+	}
+}
+
+const (
+	AssetGroupCertificationActionCertify = "certify"
+	AssetGroupCertificationActionRevoke  = "revoke"
+
+	assetGroupCertificationMaxMembers = 1000
+)
+
+// assetGroupCertificationsByStatus maps the certification status query parameter values to certification states
+var assetGroupCertificationsByStatus = map[string]model.AssetGroupCertification{
+	model.AssetGroupCertificationNone.String():    model.AssetGroupCertificationNone,
+	model.AssetGroupCertificationRevoked.String(): model.AssetGroupCertificationRevoked,
+	model.AssetGroupCertificationManual.String():  model.AssetGroupCertificationManual,
+	model.AssetGroupCertificationAuto.String():    model.AssetGroupCertificationAuto,
+}
+
+type AssetGroupCertificationMember struct {
+	AssetGroupMember
+	SelectorId  int                           `json:"selector_id"`
+	Certified   model.AssetGroupCertification `json:"certified"`
+	CertifiedBy null.String                   `json:"certified_by"`
+	CertifiedAt null.Time                     `json:"certified_at"`
+	CreatedAt   time.Time                     `json:"created_at"`
+}
+
+type GetAssetGroupTagCertificationsResponse struct {
+	Members []AssetGroupCertificationMember `json:"members"`
+}
+
+type UpdateAssetGroupTagCertificationsRequest struct {
+	MemberIds []graph.ID `json:"member_ids"`
+	Action    string     `json:"action"`
+	Note      string     `json:"note"`
+}
+
+type UpdateAssetGroupTagCertificationsResponse struct {
+	Updated int64 `json:"updated"`
+}
+
+// GetAssetGroupTagCertifications lists the members selected for a tag by their certification status, which defaults to
+// members pending review
+func (s *Resources) GetAssetGroupTagCertifications(response http.ResponseWriter, request *http.Request) {
+	const statusQueryParam = "status"
+
+	var (
+		queryParams = request.URL.Query()
+		statuses    = queryParams[statusQueryParam]
+		certified   []model.AssetGroupCertification
+		members     = []AssetGroupCertificationMember{}
+	)
+
+	if len(statuses) == 0 {
+		statuses = []string{model.AssetGroupCertificationNone.String()}
+	}
+
+	for _, status := range statuses {
+		if certification, ok := assetGroupCertificationsByStatus[strings.ToLower(status)]; !ok {
+			api.WriteErrorResponse(request.Context(), ErrBadQueryParameter(request, statusQueryParam, fmt.Errorf("invalid certification status %s", status)), response)
+			return
+		} else {
+			certified = append(certified, certification)
+		}
+	}
+
+	if tagId, err := strconv.Atoi(mux.Vars(request)[api.URIPathVariableAssetGroupTagID]); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusNotFound, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if _, err := s.DB.GetAssetGroupTag(request.Context(), tagId); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if skip, err := ParseSkipQueryParameter(queryParams, 0); err != nil {
+		api.WriteErrorResponse(request.Context(), ErrBadQueryParameter(request, model.PaginationQueryParameterSkip, err), response)
+	} else if limit, err := ParseLimitQueryParameter(queryParams, 100); err != nil {
+		api.WriteErrorResponse(request.Context(), ErrBadQueryParameter(request, model.PaginationQueryParameterLimit, err), response)
+	} else if selectorNodes, count, err := s.DB.GetSelectorNodesByTagIdAndCertification(request.Context(), tagId, certified, skip, limit); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		var (
+			nodeIds   = make([]graph.ID, 0, len(selectorNodes))
+			nodesById = make(map[graph.ID]*graph.Node, len(selectorNodes))
+		)
+
+		for _, selectorNode := range selectorNodes {
+			nodeIds = append(nodeIds, selectorNode.NodeId)
+		}
+
+		if len(nodeIds) > 0 {
+			if nodes, err := s.GraphQuery.GetFilteredAndSortedNodesPaginated(query.SortItems{}, query.InIDs(query.NodeID(), nodeIds...), 0, len(nodeIds)); err != nil {
+				api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, fmt.Sprintf("Error getting members: %v", err), request), response)
+				return
+			} else {
+				for _, node := range nodes {
+					nodesById[node.ID] = node
+				}
+			}
+		}
+
+		for _, selectorNode := range selectorNodes {
+			// Members removed from the graph since selection are listed by ID until the next analysis deselects them
+			member := AssetGroupMember{NodeId: selectorNode.NodeId}
+			if node, ok := nodesById[selectorNode.NodeId]; ok {
+				member = nodeToAssetGroupMember(node, excludeProperties)
+			}
+			member.Source = selectorNode.Source
+
+			members = append(members, AssetGroupCertificationMember{
+				AssetGroupMember: member,
+				SelectorId:       selectorNode.SelectorId,
+				Certified:        selectorNode.Certified,
+				CertifiedBy:      selectorNode.CertifiedBy,
+				CertifiedAt:      selectorNode.CertifiedAt,
+				CreatedAt:        selectorNode.CreatedAt,
+			})
+		}
+
+		api.WriteResponseWrapperWithPagination(request.Context(), GetAssetGroupTagCertificationsResponse{Members: members}, limit, skip, count, http.StatusOK, response)
+	}
+}
+
+// UpdateAssetGroupTagCertifications certifies or revokes members of a tag in bulk. Revoked members are never tagged, and
+// certified members are tagged by tags that require certification.
+func (s *Resources) UpdateAssetGroupTagCertifications(response http.ResponseWriter, request *http.Request) {
+	var (
+		reqBody   UpdateAssetGroupTagCertificationsRequest
+		certified model.AssetGroupCertification
+	)
+	defer measure.ContextMeasure(request.Context(), slog.LevelDebug, "Asset Group Tag Certifications Update")()
+
+	if actor, isUser := auth.GetUserFromAuthCtx(ctx.FromRequest(request).AuthCtx); !isUser {
+		slog.Error("Unable to get user from auth context")
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, "unknown user", request), response)
+	} else if tagId, err := strconv.Atoi(mux.Vars(request)[api.URIPathVariableAssetGroupTagID]); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusNotFound, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if tag, err := s.DB.GetAssetGroupTag(request.Context(), tagId); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if err := json.NewDecoder(request.Body).Decode(&reqBody); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponsePayloadUnmarshalError, request), response)
+	} else if len(reqBody.MemberIds) == 0 || len(reqBody.MemberIds) > assetGroupCertificationMaxMembers {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("between 1 and %d member_ids are required", assetGroupCertificationMaxMembers), request), response)
+	} else {
+		switch reqBody.Action {
+		case AssetGroupCertificationActionCertify:
+			certified = model.AssetGroupCertificationManual
+		case AssetGroupCertificationActionRevoke:
+			certified = model.AssetGroupCertificationRevoked
+		default:
+			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("action must be %s or %s", AssetGroupCertificationActionCertify, AssetGroupCertificationActionRevoke), request), response)
+			return
+		}
+
+		var note null.String
+		if reqBody.Note != "" {
+			note = null.StringFrom(reqBody.Note)
+		}
+
+		if updated, err := s.DB.UpdateSelectorNodesCertification(request.Context(), actor, tag, reqBody.MemberIds, certified, note); err != nil {
+			api.HandleDatabaseError(request, response, err)
+		} else {
+			if updated > 0 {
+				if err := s.requestTagAnalysis(request.Context(), actor); err != nil {
+					api.HandleDatabaseError(request, response, err)
+					return
+				}
+			}
+
+			api.WriteBasicResponse(request.Context(), UpdateAssetGroupTagCertificationsResponse{Updated: updated}, http.StatusOK, response)
+		}
+	}
+}
+
 type GetAssetGroupTagMemberCountsResponse struct {
 	TotalCount int            `json:"total_count"`
 	Counts     map[string]int `json:"counts"`
@@ -624,6 +997,14 @@ func (s *Resources) GetAssetGroupMembersByTag(response http.ResponseWriter, requ
 		api.WriteErrorResponse(request.Context(), ErrBadQueryParameter(request, model.PaginationQueryParameterSkip, err), response)
 	} else if limit, err := ParseOptionalLimitQueryParameter(queryParams, 10); err != nil {
 		api.WriteErrorResponse(request.Context(), ErrBadQueryParameter(request, model.PaginationQueryParameterLimit, err), response)
+	} else if asOf, err := ParseTimeQueryParameter(queryParams, assetGroupMembersAsOfQueryParam, time.Time{}); err != nil {
+		api.WriteErrorResponse(request.Context(), ErrBadQueryParameter(request, assetGroupMembersAsOfQueryParam, err), response)
+	} else if !asOf.IsZero() {
+		if len(sort) > 0 {
+			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "sorting is not supported with as_of", request), response)
+		} else {
+			s.getAssetGroupMembersAsOf(response, request, assetGroupTag, asOf, skip, limit)
+		}
 	} else {
 		if len(sort) == 0 {
 			sort = query.SortItems{{SortCriteria: query.NodeID(), Direction: query.SortDirectionAscending}}
@@ -42,6 +42,7 @@ import (
 	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
 	"github.com/specterops/bloodhound/cmd/api/src/queries"
 	mocks_graph "github.com/specterops/bloodhound/cmd/api/src/queries/mocks"
+	"github.com/specterops/bloodhound/cmd/api/src/test/must"
 	graphmocks "github.com/specterops/bloodhound/cmd/api/src/vendormocks/dawgs/graph"
 	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
 	"github.com/specterops/bloodhound/packages/go/headers"
@@ -297,12 +298,16 @@ func TestResources_CreateAssetGroupTagSelector(t *testing.T) {
 		mockCtrl      = gomock.NewController(t)
 		mockDB        = mocks_db.NewMockDatabase(mockCtrl)
 		mockGraphDb   = mocks_graph.NewMockGraph(mockCtrl)
+		mockGraph     = graphmocks.NewMockDatabase(mockCtrl)
 		resourcesInst = v2.Resources{
 			DB:         mockDB,
+			Graph:      mockGraph,
 			GraphQuery: mockGraphDb,
 		}
 		user    = setupUser()
 		userCtx = setupUserCtx(user)
+
+		maxMembers, _ = types.NewJSONBObject(map[string]any{"max_members": 10})
 	)
 
 	defer mockCtrl.Finish()
@@ -449,6 +454,9 @@ func TestResources_CreateAssetGroupTagSelector(t *testing.T) {
 					})
 				},
 				Setup: func() {
+					mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.SelectorMaxMembers).
+						Return(appcfg.Parameter{Key: appcfg.SelectorMaxMembers, Value: maxMembers}, nil).Times(1)
+					mockGraph.EXPECT().ReadTransaction(gomock.Any(), gomock.Any()).Times(1)
 					mockGraphDb.EXPECT().
 						PrepareCypherQuery(gomock.Any(), gomock.Any()).
 						Return(queries.PreparedQuery{}, nil).Times(1)
@@ -529,9 +537,12 @@ func TestResources_CreateAssetGroupTagSelector(t *testing.T) {
 					})
 				},
 				Setup: func() {
+					mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.SelectorMaxMembers).
+						Return(appcfg.Parameter{Key: appcfg.SelectorMaxMembers, Value: maxMembers}, nil).Times(1)
+					mockGraph.EXPECT().ReadTransaction(gomock.Any(), gomock.Any()).Times(1)
 					value, _ := types.NewJSONBObject(map[string]any{"enabled": true})
 					mockDB.EXPECT().
-						GetConfigurationParameter(gomock.Any(), gomock.Any()).
+						GetConfigurationParameter(gomock.Any(), appcfg.ScheduledAnalysis).
 						Return(appcfg.Parameter{Key: appcfg.ScheduledAnalysis, Value: value}, nil).Times(1)
 					mockDB.EXPECT().
 						CreateAssetGroupTagSelector(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
@@ -770,12 +781,16 @@ func TestResources_UpdateAssetGroupTagSelector(t *testing.T) {
 		mockCtrl      = gomock.NewController(t)
 		mockDB        = mocks_db.NewMockDatabase(mockCtrl)
 		mockGraphDb   = mocks_graph.NewMockGraph(mockCtrl)
+		mockGraph     = graphmocks.NewMockDatabase(mockCtrl)
 		resourcesInst = v2.Resources{
 			DB:         mockDB,
+			Graph:      mockGraph,
 			GraphQuery: mockGraphDb,
 		}
 		user    = setupUser()
 		userCtx = setupUserCtx(user)
+
+		maxMembers, _ = types.NewJSONBObject(map[string]any{"max_members": 10})
 	)
 
 	defer mockCtrl.Finish()
@@ -961,6 +976,9 @@ func TestResources_UpdateAssetGroupTagSelector(t *testing.T) {
 					})
 				},
 				Setup: func() {
+					mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.SelectorMaxMembers).
+						Return(appcfg.Parameter{Key: appcfg.SelectorMaxMembers, Value: maxMembers}, nil).Times(1)
+					mockGraph.EXPECT().ReadTransaction(gomock.Any(), gomock.Any()).Times(1)
 					mockDB.EXPECT().UpdateAssetGroupTagSelector(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 						Return(model.AssetGroupTagSelector{}, errors.New("failure")).Times(1)
 					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), gomock.Any()).
@@ -990,9 +1008,12 @@ func TestResources_UpdateAssetGroupTagSelector(t *testing.T) {
 					})
 				},
 				Setup: func() {
+					mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.SelectorMaxMembers).
+						Return(appcfg.Parameter{Key: appcfg.SelectorMaxMembers, Value: maxMembers}, nil).Times(1)
+					mockGraph.EXPECT().ReadTransaction(gomock.Any(), gomock.Any()).Times(1)
 					value, _ := types.NewJSONBObject(map[string]any{"enabled": true})
 					mockDB.EXPECT().
-						GetConfigurationParameter(gomock.Any(), gomock.Any()).
+						GetConfigurationParameter(gomock.Any(), appcfg.ScheduledAnalysis).
 						Return(appcfg.Parameter{Key: appcfg.ScheduledAnalysis, Value: value}, nil).Times(1)
 					mockDB.EXPECT().
 						UpdateAssetGroupTagSelector(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
@@ -1027,9 +1048,12 @@ func TestResources_UpdateAssetGroupTagSelector(t *testing.T) {
 					})
 				},
 				Setup: func() {
+					mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.SelectorMaxMembers).
+						Return(appcfg.Parameter{Key: appcfg.SelectorMaxMembers, Value: maxMembers}, nil).Times(1)
+					mockGraph.EXPECT().ReadTransaction(gomock.Any(), gomock.Any()).Times(1)
 					value, _ := types.NewJSONBObject(map[string]any{"enabled": true})
 					mockDB.EXPECT().
-						GetConfigurationParameter(gomock.Any(), gomock.Any()).
+						GetConfigurationParameter(gomock.Any(), appcfg.ScheduledAnalysis).
 						Return(appcfg.Parameter{Key: appcfg.ScheduledAnalysis, Value: value}, nil).Times(1)
 					mockDB.EXPECT().
 						UpdateAssetGroupTagSelector(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Cond(func(s model.AssetGroupTagSelector) bool {
@@ -1349,6 +1373,427 @@ func TestResources_DeleteAssetGroupTagSelector(t *testing.T) {
 		})
 }
 
+func TestResources_CreateAssetGroupTag(t *testing.T) {
+	var (
+		mockCtrl      = gomock.NewController(t)
+		mockDB        = mocks_db.NewMockDatabase(mockCtrl)
+		mockGraph     = graphmocks.NewMockDatabase(mockCtrl)
+		resourcesInst = v2.Resources{
+			DB:    mockDB,
+			Graph: mockGraph,
+		}
+		user    = setupUser()
+		userCtx = setupUserCtx(user)
+	)
+
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resourcesInst.CreateAssetGroupTag).
+		Run([]apitest.Case{
+			{
+				Name: "BadRequest",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.BodyString(input, `{"name":["BadRequest"]}`)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, api.ErrorResponsePayloadUnmarshalError)
+				},
+			},
+			{
+				Name: "MissingName",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.BodyStruct(input, map[string]any{"type": model.AssetGroupTagTypeLabel})
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "Name")
+				},
+			},
+			{
+				Name: "OwnedType",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.BodyStruct(input, map[string]any{"type": model.AssetGroupTagTypeOwned, "name": "Owned"})
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "type must be a tier or a label")
+				},
+			},
+			{
+				Name: "LabelWithPosition",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.BodyStruct(input, map[string]any{"type": model.AssetGroupTagTypeLabel, "name": "Label", "position": 2})
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "limited to tiers only")
+				},
+			},
+			{
+				Name: "PositionOutOfRange",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.BodyStruct(input, map[string]any{"type": model.AssetGroupTagTypeTier, "name": "Tier", "position": 1})
+				},
+				Setup: func() {
+					mockDB.EXPECT().CreateAssetGroupTag(gomock.Any(), model.AssetGroupTagTypeTier, gomock.Any(), "Tier", "", null.Int32From(1), null.Bool{}).
+						Return(model.AssetGroupTag{}, database.ErrPositionOutOfRange).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "position is out of range")
+				},
+			},
+			{
+				Name: "DuplicateName",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.BodyStruct(input, map[string]any{"type": model.AssetGroupTagTypeLabel, "name": "Label"})
+				},
+				Setup: func() {
+					mockDB.EXPECT().CreateAssetGroupTag(gomock.Any(), model.AssetGroupTagTypeLabel, gomock.Any(), "Label", "", null.Int32{}, null.Bool{}).
+						Return(model.AssetGroupTag{}, fmt.Errorf("%w: test", database.ErrDuplicateKindName)).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusConflict)
+				},
+			},
+			{
+				Name: "SuccessLabel",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.BodyStruct(input, map[string]any{"type": model.AssetGroupTagTypeLabel, "name": "Label", "description": "desc"})
+				},
+				Setup: func() {
+					mockDB.EXPECT().CreateAssetGroupTag(gomock.Any(), model.AssetGroupTagTypeLabel, gomock.Any(), "Label", "desc", null.Int32{}, null.Bool{}).
+						Return(model.AssetGroupTag{ID: 5, Type: model.AssetGroupTagTypeLabel, Name: "Label", KindId: 100}, nil).Times(1)
+					mockGraph.EXPECT().RefreshKinds(gomock.Any()).Return(nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusCreated)
+					apitest.BodyContains(output, `"kind_id":100`)
+				},
+			},
+			{
+				Name: "SuccessTier",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.BodyStruct(input, map[string]any{"type": model.AssetGroupTagTypeTier, "name": "Tier", "position": 2, "require_certify": true})
+				},
+				Setup: func() {
+					mockDB.EXPECT().CreateAssetGroupTag(gomock.Any(), model.AssetGroupTagTypeTier, gomock.Any(), "Tier", "", null.Int32From(2), null.BoolFrom(true)).
+						Return(model.AssetGroupTag{ID: 6, Type: model.AssetGroupTagTypeTier, Name: "Tier", Position: null.Int32From(2)}, nil).Times(1)
+					mockGraph.EXPECT().RefreshKinds(gomock.Any()).Return(nil).Times(1)
+					mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.ScheduledAnalysis).
+						Return(appcfg.Parameter{Key: appcfg.ScheduledAnalysis, Value: must.NewJSONBObject(map[string]any{"enabled": false})}, nil).Times(1)
+					mockDB.EXPECT().RequestAnalysis(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusCreated)
+				},
+			},
+		})
+}
+
+func TestResources_UpdateAssetGroupTag(t *testing.T) {
+	var (
+		mockCtrl      = gomock.NewController(t)
+		mockDB        = mocks_db.NewMockDatabase(mockCtrl)
+		mockGraph     = graphmocks.NewMockDatabase(mockCtrl)
+		resourcesInst = v2.Resources{
+			DB:    mockDB,
+			Graph: mockGraph,
+		}
+		user    = setupUser()
+		userCtx = setupUserCtx(user)
+
+		tierZero = model.AssetGroupTag{ID: 1, Type: model.AssetGroupTagTypeTier, Name: "Tier Zero", Position: null.Int32From(1)}
+		tierTwo  = model.AssetGroupTag{ID: 2, Type: model.AssetGroupTagTypeTier, Name: "Tier Two", Position: null.Int32From(2)}
+		label    = model.AssetGroupTag{ID: 3, Type: model.AssetGroupTagTypeLabel, Name: "Label"}
+		owned    = model.AssetGroupTag{ID: 4, Type: model.AssetGroupTagTypeOwned, Name: "Owned"}
+	)
+
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resourcesInst.UpdateAssetGroupTag).
+		Run([]apitest.Case{
+			{
+				Name: "InvalidTagUrlId",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "non-numeric")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNotFound)
+					apitest.BodyContains(output, api.ErrorResponseDetailsIDMalformed)
+				},
+			},
+			{
+				Name: "NonExistentTag",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1234")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1234).Return(model.AssetGroupTag{}, database.ErrNotFound).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNotFound)
+				},
+			},
+			{
+				Name: "LabelWithPosition",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "3")
+					apitest.BodyStruct(input, map[string]any{"position": 2})
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 3).Return(label, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "limited to tiers only")
+				},
+			},
+			{
+				Name: "MoveTierZero",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+					apitest.BodyStruct(input, map[string]any{"position": 2})
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1).Return(tierZero, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusForbidden)
+				},
+			},
+			{
+				Name: "RenameOwned",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "4")
+					apitest.BodyStruct(input, map[string]any{"name": "Mine"})
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 4).Return(owned, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusForbidden)
+				},
+			},
+			{
+				Name: "EmptyName",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "3")
+					apitest.BodyStruct(input, map[string]any{"name": " "})
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 3).Return(label, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+				},
+			},
+			{
+				Name: "SuccessRenameLabel",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "3")
+					apitest.BodyStruct(input, map[string]any{"name": "Renamed", "description": "new"})
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 3).Return(label, nil).Times(1)
+					mockDB.EXPECT().UpdateAssetGroupTag(gomock.Any(), gomock.Any(), model.AssetGroupTag{ID: 3, Type: model.AssetGroupTagTypeLabel, Name: "Renamed", Description: "new"}).
+						DoAndReturn(func(_ any, _ model.User, tag model.AssetGroupTag) (model.AssetGroupTag, error) { return tag, nil }).Times(1)
+					mockGraph.EXPECT().RefreshKinds(gomock.Any()).Return(nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusOK)
+					apitest.BodyContains(output, `"name":"Renamed"`)
+				},
+			},
+			{
+				Name: "SuccessReorderTier",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "2")
+					apitest.BodyStruct(input, map[string]any{"position": 3, "analysis_enabled": true})
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 2).Return(tierTwo, nil).Times(1)
+					mockDB.EXPECT().UpdateAssetGroupTag(gomock.Any(), gomock.Any(), model.AssetGroupTag{ID: 2, Type: model.AssetGroupTagTypeTier, Name: "Tier Two", Position: null.Int32From(3), AnalysisEnabled: null.BoolFrom(true)}).
+						DoAndReturn(func(_ any, _ model.User, tag model.AssetGroupTag) (model.AssetGroupTag, error) { return tag, nil }).Times(1)
+					mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.ScheduledAnalysis).
+						Return(appcfg.Parameter{Key: appcfg.ScheduledAnalysis, Value: must.NewJSONBObject(map[string]any{"enabled": false})}, nil).Times(1)
+					mockDB.EXPECT().RequestAnalysis(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusOK)
+					apitest.BodyContains(output, `"position":3`)
+				},
+			},
+			{
+				Name: "SuccessEnableTierAnalysis",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "2")
+					apitest.BodyStruct(input, map[string]any{"analysis_enabled": true})
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 2).Return(tierTwo, nil).Times(1)
+					mockDB.EXPECT().UpdateAssetGroupTag(gomock.Any(), gomock.Any(), model.AssetGroupTag{ID: 2, Type: model.AssetGroupTagTypeTier, Name: "Tier Two", Position: null.Int32From(2), AnalysisEnabled: null.BoolFrom(true)}).
+						DoAndReturn(func(_ any, _ model.User, tag model.AssetGroupTag) (model.AssetGroupTag, error) { return tag, nil }).Times(1)
+					mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.ScheduledAnalysis).
+						Return(appcfg.Parameter{Key: appcfg.ScheduledAnalysis, Value: must.NewJSONBObject(map[string]any{"enabled": false})}, nil).Times(1)
+					mockDB.EXPECT().RequestAnalysis(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusOK)
+					apitest.BodyContains(output, `"analysis_enabled":true`)
+				},
+			},
+			{
+				Name: "PositionOutOfRange",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "2")
+					apitest.BodyStruct(input, map[string]any{"position": 30})
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 2).Return(tierTwo, nil).Times(1)
+					mockDB.EXPECT().UpdateAssetGroupTag(gomock.Any(), gomock.Any(), gomock.Any()).Return(model.AssetGroupTag{}, database.ErrPositionOutOfRange).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+				},
+			},
+		})
+}
+
+func TestResources_DeleteAssetGroupTag(t *testing.T) {
+	var (
+		mockCtrl      = gomock.NewController(t)
+		mockDB        = mocks_db.NewMockDatabase(mockCtrl)
+		mockGraph     = graphmocks.NewMockDatabase(mockCtrl)
+		resourcesInst = v2.Resources{
+			DB:    mockDB,
+			Graph: mockGraph,
+		}
+		user    = setupUser()
+		userCtx = setupUserCtx(user)
+
+		tierZero = model.AssetGroupTag{ID: 1, Type: model.AssetGroupTagTypeTier, Name: "Tier Zero", Position: null.Int32From(1)}
+		tierTwo  = model.AssetGroupTag{ID: 2, Type: model.AssetGroupTagTypeTier, Name: "Tier Two", Position: null.Int32From(2)}
+		label    = model.AssetGroupTag{ID: 3, Type: model.AssetGroupTagTypeLabel, Name: "Label"}
+		owned    = model.AssetGroupTag{ID: 4, Type: model.AssetGroupTagTypeOwned, Name: "Owned"}
+	)
+
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resourcesInst.DeleteAssetGroupTag).
+		Run([]apitest.Case{
+			{
+				Name: "InvalidTagUrlId",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "non-numeric")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNotFound)
+					apitest.BodyContains(output, api.ErrorResponseDetailsIDMalformed)
+				},
+			},
+			{
+				Name: "TierZero",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1).Return(tierZero, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusForbidden)
+				},
+			},
+			{
+				Name: "Owned",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "4")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 4).Return(owned, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusForbidden)
+				},
+			},
+			{
+				Name: "DatabaseError",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "3")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 3).Return(label, nil).Times(1)
+					mockGraph.EXPECT().WriteTransaction(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+					mockDB.EXPECT().DeleteAssetGroupTag(gomock.Any(), gomock.Any(), label).Return(errors.New("failure")).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusInternalServerError)
+				},
+			},
+			{
+				Name: "SuccessLabel",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "3")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 3).Return(label, nil).Times(1)
+					mockGraph.EXPECT().WriteTransaction(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+					mockDB.EXPECT().DeleteAssetGroupTag(gomock.Any(), gomock.Any(), label).Return(nil).Times(1)
+					mockGraph.EXPECT().RefreshKinds(gomock.Any()).Return(nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNoContent)
+				},
+			},
+			{
+				Name: "SuccessTier",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "2")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 2).Return(tierTwo, nil).Times(1)
+					mockGraph.EXPECT().WriteTransaction(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+					mockDB.EXPECT().DeleteAssetGroupTag(gomock.Any(), gomock.Any(), tierTwo).Return(nil).Times(1)
+					mockGraph.EXPECT().RefreshKinds(gomock.Any()).Return(nil).Times(1)
+					mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.ScheduledAnalysis).
+						Return(appcfg.Parameter{Key: appcfg.ScheduledAnalysis, Value: must.NewJSONBObject(map[string]any{"enabled": false})}, nil).Times(1)
+					mockDB.EXPECT().RequestAnalysis(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNoContent)
+				},
+			},
+		})
+}
+
 func TestResources_GetAssetGroupTagSelectorsByTagId(t *testing.T) {
 	var (
 		mockCtrl    = gomock.NewController(t)
@@ -2204,6 +2649,19 @@ func TestResources_PreviewSelectors(t *testing.T) {
 					apitest.StatusCode(output, http.StatusBadRequest)
 				},
 			},
+			{
+				Name: "Bad Request - Invalid Property Filter",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.BodyStruct(input, v2.PreviewSelectorBody{
+						Seeds: model.SelectorSeeds{{Type: model.SelectorTypePropertyFilter, Value: `{"kind":"User","predicates":[{"property":"name","operator":"like","value":"x"}]}`}},
+					})
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "property filter is invalid")
+				},
+			},
 			{
 				Name: "Internal Server Error - Bad User ",
 				Input: func(input *apitest.Input) {
@@ -2248,6 +2706,23 @@ func TestResources_PreviewSelectors(t *testing.T) {
 					apitest.StatusCode(output, http.StatusOK)
 				},
 			},
+			{
+				Name: "Success - Property Filter",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.BodyStruct(input, v2.PreviewSelectorBody{
+						Seeds: model.SelectorSeeds{
+							{Type: model.SelectorTypePropertyFilter, Value: `{"kind":"User","environment_id":"S-1-5-21-1","predicates":[{"property":"admincount","operator":"eq","value":"true"}]}`},
+						},
+					})
+				},
+				Setup: func() {
+					mockGraphDb.EXPECT().ReadTransaction(gomock.Any(), gomock.Any()).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusOK)
+				},
+			},
 		})
 }
 
@@ -2719,3 +3194,184 @@ func TestResources_GetAssetGroupTagHistory(t *testing.T) {
 			},
 		})
 }
+
+func TestResources_GetAssetGroupTagCertifications(t *testing.T) {
+	var (
+		mockCtrl      = gomock.NewController(t)
+		mockDB        = mocks_db.NewMockDatabase(mockCtrl)
+		mockGraphDb   = mocks_graph.NewMockGraph(mockCtrl)
+		resourcesInst = v2.Resources{
+			DB:         mockDB,
+			GraphQuery: mockGraphDb,
+		}
+		user    = setupUser()
+		userCtx = setupUserCtx(user)
+		pending = []model.AssetGroupCertification{model.AssetGroupCertificationNone}
+	)
+
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resourcesInst.GetAssetGroupTagCertifications).
+		Run([]apitest.Case{
+			{
+				Name: "InvalidTagUrlId",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "non-numeric")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNotFound)
+					apitest.BodyContains(output, api.ErrorResponseDetailsIDMalformed)
+				},
+			},
+			{
+				Name: "InvalidStatus",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+					apitest.AddQueryParam(input, "status", "certified-ish")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "invalid certification status")
+				},
+			},
+			{
+				Name: "DatabaseError",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1).Return(model.AssetGroupTag{ID: 1}, nil).Times(1)
+					mockDB.EXPECT().GetSelectorNodesByTagIdAndCertification(gomock.Any(), 1, pending, 0, 100).Return(nil, 0, errors.New("failure")).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusInternalServerError)
+				},
+			},
+			{
+				Name: "Success",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+					apitest.AddQueryParam(input, "status", "pending")
+					apitest.AddQueryParam(input, "status", "revoked")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1).Return(model.AssetGroupTag{ID: 1}, nil).Times(1)
+					mockDB.EXPECT().GetSelectorNodesByTagIdAndCertification(gomock.Any(), 1, []model.AssetGroupCertification{model.AssetGroupCertificationNone, model.AssetGroupCertificationRevoked}, 0, 100).
+						Return([]model.AssetGroupSelectorNode{
+							{SelectorId: 1, NodeId: 1, Certified: model.AssetGroupCertificationNone, Source: model.AssetGroupSelectorNodeSourceSeed},
+							{SelectorId: 1, NodeId: 2, Certified: model.AssetGroupCertificationRevoked, CertifiedBy: null.StringFrom("someone")},
+						}, 2, nil).Times(1)
+					mockGraphDb.EXPECT().GetFilteredAndSortedNodesPaginated(gomock.Any(), gomock.Any(), 0, 2).
+						Return([]*graph.Node{{ID: 1, Kinds: graph.Kinds{ad.Entity, ad.User}, Properties: graph.AsProperties(map[string]any{"objectid": "S-1-5-21-1", "name": "USER@DOMAIN"})}}, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusOK)
+					apitest.BodyContains(output, `"name":"USER@DOMAIN"`)
+					apitest.BodyContains(output, `"certified":-1`)
+					apitest.BodyContains(output, `"count":2`)
+				},
+			},
+		})
+}
+
+func TestResources_UpdateAssetGroupTagCertifications(t *testing.T) {
+	var (
+		mockCtrl      = gomock.NewController(t)
+		mockDB        = mocks_db.NewMockDatabase(mockCtrl)
+		resourcesInst = v2.Resources{
+			DB: mockDB,
+		}
+		user    = setupUser()
+		userCtx = setupUserCtx(user)
+		tag     = model.AssetGroupTag{ID: 1, Type: model.AssetGroupTagTypeTier, RequireCertify: null.BoolFrom(true)}
+	)
+
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resourcesInst.UpdateAssetGroupTagCertifications).
+		Run([]apitest.Case{
+			{
+				Name: "InvalidTagUrlId",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "non-numeric")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNotFound)
+				},
+			},
+			{
+				Name: "MissingMembers",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+					apitest.BodyStruct(input, v2.UpdateAssetGroupTagCertificationsRequest{Action: v2.AssetGroupCertificationActionCertify})
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1).Return(tag, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "member_ids are required")
+				},
+			},
+			{
+				Name: "InvalidAction",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+					apitest.BodyStruct(input, v2.UpdateAssetGroupTagCertificationsRequest{MemberIds: []graph.ID{1}, Action: "approve"})
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1).Return(tag, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "action must be")
+				},
+			},
+			{
+				Name: "SuccessRevoke",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+					apitest.BodyStruct(input, v2.UpdateAssetGroupTagCertificationsRequest{MemberIds: []graph.ID{1, 2}, Action: v2.AssetGroupCertificationActionRevoke, Note: "not tier zero"})
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1).Return(tag, nil).Times(1)
+					mockDB.EXPECT().UpdateSelectorNodesCertification(gomock.Any(), gomock.Any(), tag, []graph.ID{1, 2}, model.AssetGroupCertificationRevoked, null.StringFrom("not tier zero")).
+						Return(int64(2), nil).Times(1)
+					mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.ScheduledAnalysis).
+						Return(appcfg.Parameter{Key: appcfg.ScheduledAnalysis, Value: must.NewJSONBObject(map[string]any{"enabled": false})}, nil).Times(1)
+					mockDB.EXPECT().RequestAnalysis(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusOK)
+					apitest.BodyContains(output, `"updated":2`)
+				},
+			},
+			{
+				Name: "SuccessNothingUpdated",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+					apitest.BodyStruct(input, v2.UpdateAssetGroupTagCertificationsRequest{MemberIds: []graph.ID{9}, Action: v2.AssetGroupCertificationActionCertify})
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1).Return(tag, nil).Times(1)
+					mockDB.EXPECT().UpdateSelectorNodesCertification(gomock.Any(), gomock.Any(), tag, []graph.ID{9}, model.AssetGroupCertificationManual, null.String{}).
+						Return(int64(0), nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusOK)
+					apitest.BodyContains(output, `"updated":0`)
+				},
+			},
+		})
+}
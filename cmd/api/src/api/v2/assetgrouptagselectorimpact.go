@@ -0,0 +1,186 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	"github.com/specterops/bloodhound/cmd/api/src/auth"
+	"github.com/specterops/bloodhound/cmd/api/src/ctx"
+	"github.com/specterops/bloodhound/cmd/api/src/daemons/datapipe"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
+	"github.com/specterops/bloodhound/cmd/api/src/utils/validation"
+)
+
+const overrideMaxMembersQueryParam = "override_max_members"
+
+// SelectorTierMove counts the projected members of a tier selector that are currently members of a lower tier and
+// would move into the selector's tier
+type SelectorTierMove struct {
+	AssetGroupTagId int    `json:"asset_group_tag_id"`
+	Name            string `json:"name"`
+	Members         int    `json:"members"`
+}
+
+// SelectorImpact is the projected effect of saving a selector's seeds. When the max members limit is enabled at most
+// max_members + 1 nodes are projected, so the counts are lower bounds once the limit is exceeded.
+type SelectorImpact struct {
+	ProjectedMembers int                `json:"projected_members"`
+	MaxMembers       int                `json:"max_members"`
+	LimitExceeded    bool               `json:"limit_exceeded"`
+	Added            int                `json:"added"`
+	Removed          int                `json:"removed"`
+	TierMoves        []SelectorTierMove `json:"tier_moves"`
+}
+
+type AssetGroupTagSelectorResponse struct {
+	model.AssetGroupTagSelector
+	Impact *SelectorImpact `json:"impact,omitempty"`
+}
+
+type PreviewSelectorImpactRequest struct {
+	SelectorId int                 `json:"selector_id"`
+	Seeds      model.SelectorSeeds `json:"seeds" validate:"required"`
+}
+
+func parseOverrideMaxMembers(params url.Values) (bool, error) {
+	if param := params.Get(overrideMaxMembersQueryParam); param == "" {
+		return false, nil
+	} else {
+		return strconv.ParseBool(param)
+	}
+}
+
+func errSelectorMaxMembersExceeded(request *http.Request, impact SelectorImpact) *api.ErrorWrapper {
+	return api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("selector would select more than %d members; set %s=true to save it anyway", impact.MaxMembers, overrideMaxMembersQueryParam), request)
+}
+
+// projectSelectorImpact expands the seeds the same way analysis would for the tag and compares the projected members
+// against the tag's current members and, when selectorId is set, the nodes the selector currently selects. For tiers,
+// projected members currently in a lower tier are counted as tier moves.
+func (s *Resources) projectSelectorImpact(ctx context.Context, tag model.AssetGroupTag, selectorId int, seeds []model.SelectorSeed) (SelectorImpact, error) {
+	var (
+		maxMembers = appcfg.GetSelectorMaxMembers(ctx, s.DB).MaxMembers
+		fetchLimit = -1
+		lowerTiers model.AssetGroupTags
+		tierMoves  = map[int]int{}
+		tagKind    = tag.ToKind()
+		impact     = SelectorImpact{MaxMembers: maxMembers, TierMoves: []SelectorTierMove{}}
+	)
+
+	if maxMembers > 0 {
+		fetchLimit = maxMembers + 1
+	}
+
+	nodes := datapipe.FetchNodesFromSeeds(ctx, s.Graph, seeds, tag.GetExpansionMethod(), fetchLimit)
+	impact.ProjectedMembers = len(nodes)
+	impact.LimitExceeded = maxMembers > 0 && len(nodes) > maxMembers
+
+	if selectorId > 0 {
+		if selectedNodes, err := s.DB.GetSelectorNodesBySelectorIds(ctx, selectorId); err != nil {
+			return SelectorImpact{}, err
+		} else {
+			for _, selectedNode := range selectedNodes {
+				if _, ok := nodes[selectedNode.NodeId]; !ok {
+					impact.Removed++
+				}
+			}
+		}
+	}
+
+	if tag.Type == model.AssetGroupTagTypeTier {
+		if tiers, err := s.DB.GetAssetGroupTags(ctx, model.SQLFilter{SQLString: "type = ? AND position > ?", Params: []any{model.AssetGroupTagTypeTier, tag.Position.ValueOrZero()}}); err != nil {
+			return SelectorImpact{}, err
+		} else {
+			lowerTiers = tiers
+		}
+	}
+
+	for _, node := range nodes {
+		if node.Node == nil {
+			continue
+		}
+
+		if !node.Kinds.ContainsOneOf(tagKind) {
+			impact.Added++
+		}
+
+		for _, tier := range lowerTiers {
+			if node.Kinds.ContainsOneOf(tier.ToKind()) {
+				tierMoves[tier.ID]++
+			}
+		}
+	}
+
+	for _, tier := range lowerTiers {
+		if count := tierMoves[tier.ID]; count > 0 {
+			impact.TierMoves = append(impact.TierMoves, SelectorTierMove{AssetGroupTagId: tier.ID, Name: tier.Name, Members: count})
+		}
+	}
+
+	if len(impact.TierMoves) > 0 {
+		slog.WarnContext(ctx, "AGT: Selector would move members between tiers", "tag", tag.Name, "selector", selectorId, "moves", impact.TierMoves)
+	}
+
+	return impact, nil
+}
+
+// PreviewAssetGroupTagSelectorImpact returns the projected member count of a new or updated selector for a tag along
+// with its diff against the current members, without saving the selector
+func (s *Resources) PreviewAssetGroupTagSelectorImpact(response http.ResponseWriter, request *http.Request) {
+	var previewRequest PreviewSelectorImpactRequest
+
+	if tagId, err := strconv.Atoi(mux.Vars(request)[api.URIPathVariableAssetGroupTagID]); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusNotFound, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if tag, err := s.DB.GetAssetGroupTag(request.Context(), tagId); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if err := json.NewDecoder(request.Body).Decode(&previewRequest); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponsePayloadUnmarshalError, request), response)
+	} else if errs := validation.Validate(previewRequest); len(errs) > 0 {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, errs.Error(), request), response)
+	} else if _, isUser := auth.GetUserFromAuthCtx(ctx.FromRequest(request).AuthCtx); !isUser {
+		slog.Error("Unable to get user from auth context")
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, "unknown user", request), response)
+	} else if err := validateSelectorSeeds(s.GraphQuery, previewRequest.Seeds); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
+	} else {
+		if previewRequest.SelectorId > 0 {
+			if selector, err := s.DB.GetAssetGroupTagSelectorBySelectorId(request.Context(), previewRequest.SelectorId); err != nil {
+				api.HandleDatabaseError(request, response, err)
+				return
+			} else if selector.AssetGroupTagId != tag.ID {
+				api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusNotFound, "selector is not part of asset group tag", request), response)
+				return
+			}
+		}
+
+		if impact, err := s.projectSelectorImpact(request.Context(), tag, previewRequest.SelectorId, previewRequest.Seeds); err != nil {
+			api.HandleDatabaseError(request, response, err)
+		} else {
+			api.WriteBasicResponse(request.Context(), impact, http.StatusOK, response)
+		}
+	}
+}
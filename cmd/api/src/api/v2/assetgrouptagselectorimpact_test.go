@@ -0,0 +1,273 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	v2 "github.com/specterops/bloodhound/cmd/api/src/api/v2"
+	"github.com/specterops/bloodhound/cmd/api/src/api/v2/apitest"
+	"github.com/specterops/bloodhound/cmd/api/src/database"
+	mocks_db "github.com/specterops/bloodhound/cmd/api/src/database/mocks"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
+	mocks_graph "github.com/specterops/bloodhound/cmd/api/src/queries/mocks"
+	graphmocks "github.com/specterops/bloodhound/cmd/api/src/vendormocks/dawgs/graph"
+	"github.com/specterops/dawgs/graph"
+	"go.uber.org/mock/gomock"
+)
+
+// expectSeedNodes mocks the graph so that each object id seed resolves to the next of the given nodes
+func expectSeedNodes(mockCtrl *gomock.Controller, mockGraph *graphmocks.MockDatabase, nodes ...*graph.Node) {
+	var (
+		mockTx        = graphmocks.NewMockTransaction(mockCtrl)
+		mockNodeQuery = graphmocks.NewMockNodeQuery(mockCtrl)
+	)
+
+	mockGraph.EXPECT().ReadTransaction(gomock.Any(), gomock.Any()).DoAndReturn(func(_ any, delegate graph.TransactionDelegate, _ ...graph.TransactionOption) error {
+		return delegate(mockTx)
+	}).Times(1)
+	mockTx.EXPECT().Nodes().Return(mockNodeQuery).Times(len(nodes))
+	mockNodeQuery.EXPECT().Filter(gomock.Any()).Return(mockNodeQuery).Times(len(nodes))
+	for _, node := range nodes {
+		mockNodeQuery.EXPECT().First().Return(node, nil).Times(1)
+	}
+}
+
+func objectIdSeeds(count int) model.SelectorSeeds {
+	seeds := make(model.SelectorSeeds, 0, count)
+	for range count {
+		seeds = append(seeds, model.SelectorSeed{Type: model.SelectorTypeObjectId, Value: "S-1-5-21-1"})
+	}
+	return seeds
+}
+
+func TestResources_PreviewAssetGroupTagSelectorImpact(t *testing.T) {
+	var (
+		mockCtrl      = gomock.NewController(t)
+		mockDB        = mocks_db.NewMockDatabase(mockCtrl)
+		mockGraph     = graphmocks.NewMockDatabase(mockCtrl)
+		resourcesInst = v2.Resources{
+			DB:         mockDB,
+			Graph:      mockGraph,
+			GraphQuery: mocks_graph.NewMockGraph(mockCtrl),
+		}
+		user    = setupUser()
+		userCtx = setupUserCtx(user)
+
+		tierZero = model.AssetGroupTag{ID: 1, Type: model.AssetGroupTagTypeTier, Name: "Tier Zero", Position: null.Int32From(1)}
+		tierOne  = model.AssetGroupTag{ID: 2, Type: model.AssetGroupTagTypeTier, Name: "Tier One", Position: null.Int32From(2)}
+
+		maxMembers, _ = types.NewJSONBObject(map[string]any{"max_members": 2})
+	)
+
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resourcesInst.PreviewAssetGroupTagSelectorImpact).
+		Run([]apitest.Case{
+			{
+				Name: "InvalidTagUrlId",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "non-numeric")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNotFound)
+					apitest.BodyContains(output, api.ErrorResponseDetailsIDMalformed)
+				},
+			},
+			{
+				Name: "TagNotFound",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1).Return(model.AssetGroupTag{}, database.ErrNotFound).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNotFound)
+				},
+			},
+			{
+				Name: "MissingSeeds",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+					apitest.BodyStruct(input, v2.PreviewSelectorImpactRequest{Seeds: model.SelectorSeeds{}})
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1).Return(tierZero, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "seeds are required")
+				},
+			},
+			{
+				Name: "SelectorNotPartOfTag",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+					apitest.BodyStruct(input, v2.PreviewSelectorImpactRequest{SelectorId: 5, Seeds: objectIdSeeds(1)})
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1).Return(tierZero, nil).Times(1)
+					mockDB.EXPECT().GetAssetGroupTagSelectorBySelectorId(gomock.Any(), 5).Return(model.AssetGroupTagSelector{ID: 5, AssetGroupTagId: 2}, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNotFound)
+					apitest.BodyContains(output, "selector is not part of asset group tag")
+				},
+			},
+			{
+				Name: "DatabaseError",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+					apitest.BodyStruct(input, v2.PreviewSelectorImpactRequest{SelectorId: 5, Seeds: objectIdSeeds(1)})
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1).Return(tierZero, nil).Times(1)
+					mockDB.EXPECT().GetAssetGroupTagSelectorBySelectorId(gomock.Any(), 5).Return(model.AssetGroupTagSelector{ID: 5, AssetGroupTagId: 1}, nil).Times(1)
+					mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.SelectorMaxMembers).Return(appcfg.Parameter{Key: appcfg.SelectorMaxMembers, Value: maxMembers}, nil).Times(1)
+					expectSeedNodes(mockCtrl, mockGraph, &graph.Node{ID: 10, Kinds: graph.Kinds{graph.StringKind("Custom")}, Properties: graph.NewProperties()})
+					mockDB.EXPECT().GetSelectorNodesBySelectorIds(gomock.Any(), 5).Return(nil, errors.New("failure")).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusInternalServerError)
+				},
+			},
+			{
+				Name: "Success",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+					apitest.BodyStruct(input, v2.PreviewSelectorImpactRequest{SelectorId: 5, Seeds: objectIdSeeds(2)})
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1).Return(tierZero, nil).Times(1)
+					mockDB.EXPECT().GetAssetGroupTagSelectorBySelectorId(gomock.Any(), 5).Return(model.AssetGroupTagSelector{ID: 5, AssetGroupTagId: 1}, nil).Times(1)
+					mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.SelectorMaxMembers).Return(appcfg.Parameter{Key: appcfg.SelectorMaxMembers, Value: maxMembers}, nil).Times(1)
+					expectSeedNodes(mockCtrl, mockGraph,
+						&graph.Node{ID: 10, Kinds: graph.Kinds{graph.StringKind("Custom"), tierZero.ToKind()}, Properties: graph.NewProperties()},
+						&graph.Node{ID: 11, Kinds: graph.Kinds{graph.StringKind("Custom"), tierOne.ToKind()}, Properties: graph.NewProperties()},
+					)
+					mockDB.EXPECT().GetSelectorNodesBySelectorIds(gomock.Any(), 5).Return([]model.AssetGroupSelectorNode{{SelectorId: 5, NodeId: 10}, {SelectorId: 5, NodeId: 12}}, nil).Times(1)
+					mockDB.EXPECT().GetAssetGroupTags(gomock.Any(), model.SQLFilter{SQLString: "type = ? AND position > ?", Params: []any{model.AssetGroupTagTypeTier, int32(1)}}).Return(model.AssetGroupTags{tierOne}, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusOK)
+					apitest.BodyContains(output, `"projected_members":2,"max_members":2,"limit_exceeded":false,"added":1,"removed":1`)
+					apitest.BodyContains(output, `"tier_moves":[{"asset_group_tag_id":2,"name":"Tier One","members":1}]`)
+				},
+			},
+		})
+}
+
+func TestResources_CreateAssetGroupTagSelector_MaxMembers(t *testing.T) {
+	var (
+		mockCtrl      = gomock.NewController(t)
+		mockDB        = mocks_db.NewMockDatabase(mockCtrl)
+		mockGraph     = graphmocks.NewMockDatabase(mockCtrl)
+		resourcesInst = v2.Resources{
+			DB:         mockDB,
+			Graph:      mockGraph,
+			GraphQuery: mocks_graph.NewMockGraph(mockCtrl),
+		}
+		user    = setupUser()
+		userCtx = setupUserCtx(user)
+
+		ownedTag = model.AssetGroupTag{ID: 3, Type: model.AssetGroupTagTypeOwned, Name: "Owned"}
+		selector = model.AssetGroupTagSelector{Name: "Everything", Seeds: objectIdSeeds(2), AutoCertify: null.BoolFrom(false)}
+
+		maxMembers, _        = types.NewJSONBObject(map[string]any{"max_members": 1})
+		scheduledAnalysis, _ = types.NewJSONBObject(map[string]any{"enabled": true})
+	)
+
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resourcesInst.CreateAssetGroupTagSelector).
+		Run([]apitest.Case{
+			{
+				Name: "InvalidOverride",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "3")
+					apitest.AddQueryParam(input, "override_max_members", "maybe")
+					apitest.BodyStruct(input, selector)
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 3).Return(ownedTag, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "override_max_members")
+				},
+			},
+			{
+				Name: "LimitExceeded",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "3")
+					apitest.BodyStruct(input, selector)
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 3).Return(ownedTag, nil).Times(1)
+					mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.SelectorMaxMembers).Return(appcfg.Parameter{Key: appcfg.SelectorMaxMembers, Value: maxMembers}, nil).Times(1)
+					expectSeedNodes(mockCtrl, mockGraph,
+						&graph.Node{ID: 10, Kinds: graph.Kinds{graph.StringKind("Custom")}, Properties: graph.NewProperties()},
+						&graph.Node{ID: 11, Kinds: graph.Kinds{graph.StringKind("Custom")}, Properties: graph.NewProperties()},
+					)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "selector would select more than 1 members")
+				},
+			},
+			{
+				Name: "LimitOverridden",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "3")
+					apitest.AddQueryParam(input, "override_max_members", "true")
+					apitest.BodyStruct(input, selector)
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 3).Return(ownedTag, nil).Times(1)
+					mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.SelectorMaxMembers).Return(appcfg.Parameter{Key: appcfg.SelectorMaxMembers, Value: maxMembers}, nil).Times(1)
+					expectSeedNodes(mockCtrl, mockGraph,
+						&graph.Node{ID: 10, Kinds: graph.Kinds{graph.StringKind("Custom")}, Properties: graph.NewProperties()},
+						&graph.Node{ID: 11, Kinds: graph.Kinds{graph.StringKind("Custom")}, Properties: graph.NewProperties()},
+					)
+					mockDB.EXPECT().CreateAssetGroupTagSelector(gomock.Any(), 3, user, "Everything", "", false, true, null.BoolFrom(false), selector.Seeds).
+						Return(model.AssetGroupTagSelector{ID: 7, AssetGroupTagId: 3, Name: "Everything"}, nil).Times(1)
+					mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.ScheduledAnalysis).Return(appcfg.Parameter{Key: appcfg.ScheduledAnalysis, Value: scheduledAnalysis}, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusCreated)
+					apitest.BodyContains(output, `"name":"Everything"`)
+					apitest.BodyContains(output, `"impact":{"projected_members":2,"max_members":1,"limit_exceeded":true,"added":2,"removed":0,"tier_moves":[]}`)
+				},
+			},
+		})
+}
@@ -0,0 +1,395 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	"github.com/specterops/bloodhound/cmd/api/src/auth"
+	"github.com/specterops/bloodhound/cmd/api/src/ctx"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/queries"
+	bhUtils "github.com/specterops/bloodhound/cmd/api/src/utils"
+	"github.com/specterops/bloodhound/packages/go/analysis"
+	"github.com/specterops/bloodhound/packages/go/bhlog/measure"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/bloodhound/packages/go/headers"
+	"github.com/specterops/bloodhound/packages/go/mediatypes"
+	"github.com/specterops/dawgs/graph"
+)
+
+const (
+	ownedImportMaxEntries     = 5000
+	ownedImportNameQueryParam = "name"
+	ownedImportNoteQueryParam = "note"
+)
+
+// ownedImportCSVHeaders are the first column values that mark the first row of a CSV import as a header row
+var ownedImportCSVHeaders = []string{"principal", "name", "objectid", "object_id", "account", "username", "upn"}
+
+type ImportOwnedPrincipalsRequest struct {
+	Principals []string `json:"principals"`
+	Name       string   `json:"name"`
+	Note       string   `json:"note"`
+}
+
+type OwnedPrincipal struct {
+	Input    string `json:"input"`
+	ObjectId string `json:"object_id"`
+	Name     string `json:"name"`
+	Kind     string `json:"kind"`
+}
+
+type UnresolvedOwnedPrincipal struct {
+	Input  string `json:"input"`
+	Reason string `json:"reason"`
+}
+
+type ImportOwnedPrincipalsResponse struct {
+	Selector   *model.AssetGroupTagSelector `json:"selector"`
+	Resolved   []OwnedPrincipal             `json:"resolved"`
+	Unresolved []UnresolvedOwnedPrincipal   `json:"unresolved"`
+}
+
+// parseImportOwnedPrincipalsRequest reads the principals to import from either a JSON body or a CSV body. For CSV
+// bodies the first column of each row is the principal and the selector name and note are taken from the query.
+func parseImportOwnedPrincipalsRequest(request *http.Request) (ImportOwnedPrincipalsRequest, error) {
+	var reqBody ImportOwnedPrincipalsRequest
+
+	switch {
+	case bhUtils.HeaderMatches(request.Header, headers.ContentType.String(), mediatypes.ApplicationJson.String()):
+		if err := json.NewDecoder(request.Body).Decode(&reqBody); err != nil {
+			return reqBody, errors.New(api.ErrorResponsePayloadUnmarshalError)
+		}
+	case bhUtils.HeaderMatches(request.Header, headers.ContentType.String(), mediatypes.TextCsv.String()):
+		reader := csv.NewReader(request.Body)
+		reader.FieldsPerRecord = -1
+		reader.LazyQuotes = true
+
+		for {
+			if record, err := reader.Read(); errors.Is(err, io.EOF) {
+				break
+			} else if err != nil {
+				return reqBody, fmt.Errorf("could not read csv: %w", err)
+			} else if len(reqBody.Principals) == 0 && isOwnedImportCSVHeader(record[0]) {
+				continue
+			} else {
+				reqBody.Principals = append(reqBody.Principals, record[0])
+			}
+		}
+
+		reqBody.Name = request.URL.Query().Get(ownedImportNameQueryParam)
+		reqBody.Note = request.URL.Query().Get(ownedImportNoteQueryParam)
+	default:
+		return reqBody, fmt.Errorf("content type must be %s or %s", mediatypes.ApplicationJson, mediatypes.TextCsv)
+	}
+
+	return reqBody, nil
+}
+
+func isOwnedImportCSVHeader(value string) bool {
+	value = strings.ToLower(strings.TrimSpace(value))
+
+	for _, header := range ownedImportCSVHeaders {
+		if value == header {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ownedImportEntry is an import entry split into the forms it can be resolved by. Entries may be object IDs, full
+// node names such as UPNs, bare SAM account names or DOMAIN\account strings. Anything after the first colon is
+// dropped so that credential dumper output such as DOMAIN\account:rid:lmhash:nthash::: can be imported as is.
+type ownedImportEntry struct {
+	input   string
+	value   string
+	domain  string
+	account string
+}
+
+func newOwnedImportEntry(input string) ownedImportEntry {
+	entry := ownedImportEntry{input: input, value: input}
+
+	if idx := strings.IndexByte(entry.value, ':'); idx >= 0 {
+		entry.value = entry.value[:idx]
+	}
+
+	entry.value = strings.ToUpper(strings.TrimSpace(entry.value))
+
+	if domain, account, isDownLevel := strings.Cut(entry.value, `\`); isDownLevel {
+		entry.domain = strings.TrimSpace(domain)
+		entry.account = strings.TrimSpace(account)
+	} else if !strings.Contains(entry.value, "@") {
+		entry.account = entry.value
+	}
+
+	return entry
+}
+
+// ownedImportDomains maps the NetBIOS names of the collected domains to their FQDNs
+type ownedImportDomains struct {
+	fqdns     []string
+	byNetBIOS map[string][]string
+}
+
+func newOwnedImportDomains(domains graph.NodeSet) ownedImportDomains {
+	index := ownedImportDomains{byNetBIOS: map[string][]string{}}
+
+	for _, domain := range domains {
+		if fqdn, _ := domain.Properties.GetOrDefault(common.Name.String(), "").String(); fqdn != "" {
+			fqdn = strings.ToUpper(fqdn)
+			index.fqdns = append(index.fqdns, fqdn)
+
+			if netBIOS, _ := domain.Properties.GetOrDefault(ad.NetBIOS.String(), "").String(); netBIOS != "" {
+				index.byNetBIOS[strings.ToUpper(netBIOS)] = append(index.byNetBIOS[strings.ToUpper(netBIOS)], fqdn)
+			}
+		}
+	}
+
+	return index
+}
+
+// resolve returns the FQDNs a domain given by either its NetBIOS name or its FQDN refers to. All collected domains are
+// returned when no domain is given. Domains collected without a NetBIOS name are matched by their first label.
+func (s ownedImportDomains) resolve(domain string) []string {
+	if domain == "" {
+		return s.fqdns
+	} else if fqdns, ok := s.byNetBIOS[domain]; ok {
+		return fqdns
+	}
+
+	var fqdns []string
+	for _, fqdn := range s.fqdns {
+		if fqdn == domain || strings.HasPrefix(fqdn, domain+".") {
+			fqdns = append(fqdns, fqdn)
+		}
+	}
+
+	if len(fqdns) == 0 && strings.Contains(domain, ".") {
+		fqdns = append(fqdns, domain)
+	}
+
+	return fqdns
+}
+
+// ownedAccountNames returns the node names a SAM account name has in the given domains. Users are named
+// ACCOUNT@DOMAIN.FQDN and computers are named HOST.DOMAIN.FQDN with the trailing $ of their SAM account name removed.
+func ownedAccountNames(account string, fqdns []string) []string {
+	var names []string
+
+	if host, isComputer := strings.CutSuffix(account, "$"); isComputer && host != "" {
+		for _, fqdn := range fqdns {
+			names = append(names, host+"."+fqdn)
+		}
+	} else if !isComputer && account != "" {
+		for _, fqdn := range fqdns {
+			names = append(names, account+"@"+fqdn)
+		}
+	}
+
+	return names
+}
+
+type ownedImportResolution struct {
+	input  string
+	node   *graph.Node
+	reason string
+}
+
+// resolveOwnedPrincipals finds the single node each import entry refers to. Exact names and object IDs are preferred
+// and bare SAM account names fall back to the accounts of that name in any collected domain. All candidates are fetched
+// in one batch so that the number of graph round trips does not grow with the number of entries.
+func resolveOwnedPrincipals(ctx context.Context, graphQuery queries.Graph, inputs []string) ([]ownedImportResolution, error) {
+	var (
+		entries      = make([]ownedImportEntry, len(inputs))
+		accountNames = make([][]string, len(inputs))
+		values       = map[string]struct{}{}
+		needsDomains bool
+		domains      ownedImportDomains
+		resolutions  = make([]ownedImportResolution, len(inputs))
+	)
+
+	for idx, input := range inputs {
+		entries[idx] = newOwnedImportEntry(input)
+		needsDomains = needsDomains || entries[idx].account != ""
+	}
+
+	if needsDomains {
+		if domainNodes, err := graphQuery.GetNodesByKind(ctx, ad.Domain); err != nil {
+			return nil, err
+		} else {
+			domains = newOwnedImportDomains(domainNodes)
+		}
+	}
+
+	for idx, entry := range entries {
+		if entry.value != "" && entry.domain == "" {
+			values[entry.value] = struct{}{}
+		}
+
+		if entry.account != "" {
+			accountNames[idx] = ownedAccountNames(entry.account, domains.resolve(entry.domain))
+
+			for _, name := range accountNames[idx] {
+				values[name] = struct{}{}
+			}
+		}
+	}
+
+	byValue := map[string]graph.NodeSet{}
+	if len(values) > 0 {
+		if nodes, err := graphQuery.FetchNodesByNamesOrObjectIDs(ctx, slices.Collect(maps.Keys(values))...); err != nil {
+			return nil, err
+		} else {
+			for _, node := range nodes {
+				for _, property := range []string{common.Name.String(), common.ObjectID.String()} {
+					if value, _ := node.Properties.GetOrDefault(property, "").String(); value != "" {
+						if _, ok := byValue[strings.ToUpper(value)]; !ok {
+							byValue[strings.ToUpper(value)] = graph.NewNodeSet()
+						}
+
+						byValue[strings.ToUpper(value)].Add(node)
+					}
+				}
+			}
+		}
+	}
+
+	for idx, entry := range entries {
+		candidates := graph.NewNodeSet()
+
+		if entry.domain == "" {
+			candidates.AddSet(byValue[entry.value])
+		}
+
+		if len(candidates) == 0 {
+			for _, name := range accountNames[idx] {
+				candidates.AddSet(byValue[name])
+			}
+		}
+
+		resolutions[idx].input = entry.input
+
+		switch {
+		case entry.value == "":
+			resolutions[idx].reason = "entry is empty"
+		case len(candidates) == 0:
+			resolutions[idx].reason = "no matching principal found"
+		case len(candidates) == 1:
+			resolutions[idx].node = candidates.Slice()[0]
+		default:
+			resolutions[idx].reason = fmt.Sprintf("matches %d principals", len(candidates))
+		}
+	}
+
+	return resolutions, nil
+}
+
+// ImportOwnedPrincipals resolves a list of compromised principals and marks them owned by creating a selector on the
+// owned tag seeded with their object IDs. The engagement note is kept as the selector description. Entries that could
+// not be resolved to exactly one node are reported back and are not imported.
+func (s *Resources) ImportOwnedPrincipals(response http.ResponseWriter, request *http.Request) {
+	var (
+		resolved   = []OwnedPrincipal{}
+		unresolved = []UnresolvedOwnedPrincipal{}
+		seeds      []model.SelectorSeed
+		seen       = map[string]struct{}{}
+	)
+	defer measure.ContextMeasure(request.Context(), slog.LevelDebug, "Asset Group Tag Owned Import")()
+
+	actor, isUser := auth.GetUserFromAuthCtx(ctx.FromRequest(request).AuthCtx)
+	if !isUser {
+		slog.Error("Unable to get user from auth context")
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, "unknown user", request), response)
+		return
+	}
+
+	reqBody, err := parseImportOwnedPrincipalsRequest(request)
+	if err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
+		return
+	} else if len(reqBody.Principals) == 0 || len(reqBody.Principals) > ownedImportMaxEntries {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("between 1 and %d principals are required", ownedImportMaxEntries), request), response)
+		return
+	}
+
+	tags, err := s.DB.GetAssetGroupTags(request.Context(), model.SQLFilter{SQLString: "type = ?", Params: []any{model.AssetGroupTagTypeOwned}})
+	if err != nil {
+		api.HandleDatabaseError(request, response, err)
+		return
+	} else if len(tags) == 0 {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusNotFound, "owned tag not found", request), response)
+		return
+	}
+
+	resolutions, err := resolveOwnedPrincipals(request.Context(), s.GraphQuery, reqBody.Principals)
+	if err != nil {
+		slog.ErrorContext(request.Context(), fmt.Sprintf("Error resolving owned principals: %v", err))
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, api.ErrorResponseDetailsInternalServerError, request), response)
+		return
+	}
+
+	for _, resolution := range resolutions {
+		if resolution.node == nil {
+			unresolved = append(unresolved, UnresolvedOwnedPrincipal{Input: resolution.input, Reason: resolution.reason})
+		} else {
+			objectId, _ := resolution.node.Properties.GetOrDefault(common.ObjectID.String(), "").String()
+			name, _ := resolution.node.Properties.GetWithFallback(common.Name.String(), "", common.DisplayName.String(), common.ObjectID.String()).String()
+
+			resolved = append(resolved, OwnedPrincipal{Input: resolution.input, ObjectId: objectId, Name: name, Kind: analysis.GetNodeKindDisplayLabel(resolution.node)})
+
+			if _, ok := seen[objectId]; !ok {
+				seen[objectId] = struct{}{}
+				seeds = append(seeds, model.SelectorSeed{Type: model.SelectorTypeObjectId, Value: objectId})
+			}
+		}
+	}
+
+	if len(seeds) == 0 {
+		api.WriteBasicResponse(request.Context(), ImportOwnedPrincipalsResponse{Resolved: resolved, Unresolved: unresolved}, http.StatusOK, response)
+		return
+	}
+
+	name := strings.TrimSpace(reqBody.Name)
+	if name == "" {
+		name = fmt.Sprintf("Owned Import %s", time.Now().UTC().Format(time.RFC3339))
+	}
+
+	if selector, err := s.DB.CreateAssetGroupTagSelector(request.Context(), tags[0].ID, actor, name, reqBody.Note, false, true, null.BoolFrom(false), seeds); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if err := s.requestTagAnalysis(request.Context(), actor); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		api.WriteBasicResponse(request.Context(), ImportOwnedPrincipalsResponse{Selector: &selector, Resolved: resolved, Unresolved: unresolved}, http.StatusCreated, response)
+	}
+}
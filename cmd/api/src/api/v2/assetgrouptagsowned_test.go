@@ -0,0 +1,227 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"testing"
+
+	v2 "github.com/specterops/bloodhound/cmd/api/src/api/v2"
+	"github.com/specterops/bloodhound/cmd/api/src/api/v2/apitest"
+	mocks_db "github.com/specterops/bloodhound/cmd/api/src/database/mocks"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
+	mocks_graph "github.com/specterops/bloodhound/cmd/api/src/queries/mocks"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/bloodhound/packages/go/headers"
+	"github.com/specterops/bloodhound/packages/go/mediatypes"
+	"github.com/specterops/dawgs/graph"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newOwnedImportNode(id graph.ID, objectId, name string, kind graph.Kind) *graph.Node {
+	return graph.NewNode(id, graph.AsProperties(map[string]any{
+		common.ObjectID.String(): objectId,
+		common.Name.String():     name,
+	}), ad.Entity, kind)
+}
+
+func TestResources_ImportOwnedPrincipals(t *testing.T) {
+	var (
+		mockCtrl      = gomock.NewController(t)
+		mockDB        = mocks_db.NewMockDatabase(mockCtrl)
+		mockGraphDb   = mocks_graph.NewMockGraph(mockCtrl)
+		resourcesInst = v2.Resources{DB: mockDB, GraphQuery: mockGraphDb}
+		user          = setupUser()
+		userCtx       = setupUserCtx(user)
+		ownedTag      = model.AssetGroupTag{ID: 3, Type: model.AssetGroupTagTypeOwned, Name: "Owned"}
+		ownedFilter   = model.SQLFilter{SQLString: "type = ?", Params: []any{model.AssetGroupTagTypeOwned}}
+
+		helpdesk    = newOwnedImportNode(1, "S-1-5-21-1-1105", "HELPDESK@CORP.LOCAL", ad.User)
+		corpUser    = newOwnedImportNode(2, "S-1-5-21-1-1106", "JDOE@CORP.LOCAL", ad.User)
+		otherUser   = newOwnedImportNode(3, "S-1-5-21-2-1106", "JDOE@OTHER.LOCAL", ad.User)
+		workstation = newOwnedImportNode(4, "S-1-5-21-1-1107", "WS01.CORP.LOCAL", ad.Computer)
+		corpDomain  = graph.NewNode(5, graph.AsProperties(map[string]any{
+			common.ObjectID.String(): "S-1-5-21-1",
+			common.Name.String():     "CORP.LOCAL",
+			ad.NetBIOS.String():      "CORPNET",
+		}), ad.Entity, ad.Domain)
+		otherDomain = graph.NewNode(6, graph.AsProperties(map[string]any{
+			common.ObjectID.String(): "S-1-5-21-2",
+			common.Name.String():     "OTHER.LOCAL",
+		}), ad.Entity, ad.Domain)
+
+		scheduledAnalysisDisabled = func() {
+			value, _ := types.NewJSONBObject(map[string]any{"enabled": false})
+			mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.ScheduledAnalysis).
+				Return(appcfg.Parameter{Key: appcfg.ScheduledAnalysis, Value: value}, nil).Times(1)
+			mockDB.EXPECT().RequestAnalysis(gomock.Any(), user.ID.String()).Return(nil).Times(1)
+		}
+		fetchDomains = func() {
+			mockGraphDb.EXPECT().GetNodesByKind(gomock.Any(), ad.Domain).Return(graph.NewNodeSet(corpDomain, otherDomain), nil).Times(1)
+		}
+		fetchNodes = func(nodes ...*graph.Node) {
+			mockGraphDb.EXPECT().FetchNodesByNamesOrObjectIDs(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, values ...string) (graph.NodeSet, error) {
+				matched := graph.NewNodeSet()
+
+				for _, node := range nodes {
+					objectId, _ := node.Properties.GetOrDefault(common.ObjectID.String(), "").String()
+					name, _ := node.Properties.GetOrDefault(common.Name.String(), "").String()
+
+					if slices.Contains(values, objectId) || slices.Contains(values, name) {
+						matched.Add(node)
+					}
+				}
+
+				return matched, nil
+			}).Times(1)
+		}
+	)
+
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resourcesInst.ImportOwnedPrincipals).
+		Run([]apitest.Case{
+			{
+				Name: "NoUser",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, context.Background())
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusInternalServerError)
+				},
+			},
+			{
+				Name: "UnsupportedContentType",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetHeader(input, headers.ContentType.String(), mediatypes.ApplicationXml.String())
+					apitest.BodyString(input, "<principals/>")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "content type must be")
+				},
+			},
+			{
+				Name: "NoPrincipals",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetHeader(input, headers.ContentType.String(), mediatypes.ApplicationJson.String())
+					apitest.BodyStruct(input, v2.ImportOwnedPrincipalsRequest{Note: "nothing"})
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "principals are required")
+				},
+			},
+			{
+				Name: "OwnedTagNotFound",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetHeader(input, headers.ContentType.String(), mediatypes.ApplicationJson.String())
+					apitest.BodyStruct(input, v2.ImportOwnedPrincipalsRequest{Principals: []string{"S-1-5-21-1-1105"}})
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTags(gomock.Any(), ownedFilter).Return(model.AssetGroupTags{}, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNotFound)
+				},
+			},
+			{
+				Name: "GraphError",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetHeader(input, headers.ContentType.String(), mediatypes.ApplicationJson.String())
+					apitest.BodyStruct(input, v2.ImportOwnedPrincipalsRequest{Principals: []string{"S-1-5-21-1-1105"}})
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTags(gomock.Any(), ownedFilter).Return(model.AssetGroupTags{ownedTag}, nil).Times(1)
+					fetchDomains()
+					mockGraphDb.EXPECT().FetchNodesByNamesOrObjectIDs(gomock.Any(), gomock.Any()).Return(nil, errors.New("failure")).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusInternalServerError)
+				},
+			},
+			{
+				Name: "NothingResolved",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetHeader(input, headers.ContentType.String(), mediatypes.ApplicationJson.String())
+					apitest.BodyStruct(input, v2.ImportOwnedPrincipalsRequest{Principals: []string{"jdoe"}})
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTags(gomock.Any(), ownedFilter).Return(model.AssetGroupTags{ownedTag}, nil).Times(1)
+					fetchDomains()
+					fetchNodes(helpdesk, corpUser, otherUser, workstation)
+				},
+				Test: func(output apitest.Output) {
+					var result v2.ImportOwnedPrincipalsResponse
+
+					apitest.StatusCode(output, http.StatusOK)
+					apitest.UnmarshalData(output, &result)
+					require.Nil(t, result.Selector)
+					require.Empty(t, result.Resolved)
+					require.Equal(t, []v2.UnresolvedOwnedPrincipal{{Input: "jdoe", Reason: "matches 2 principals"}}, result.Unresolved)
+				},
+			},
+			{
+				Name: "SuccessCSV",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetHeader(input, headers.ContentType.String(), mediatypes.TextCsv.String())
+					apitest.AddQueryParam(input, "name", "Engagement 42")
+					apitest.AddQueryParam(input, "note", "Phase one credential dump")
+					apitest.BodyString(input, "principal\nS-1-5-21-1-1105\nCORPNET\\jdoe:1106:aad3b435b51404eeaad3b435b51404ee:31d6cfe0d16ae931b73c59d7e0c089c0:::\nWS01$\nhelpdesk@corp.local\nghost\n")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTags(gomock.Any(), ownedFilter).Return(model.AssetGroupTags{ownedTag}, nil).Times(1)
+					fetchDomains()
+					fetchNodes(helpdesk, corpUser, otherUser, workstation)
+
+					mockDB.EXPECT().CreateAssetGroupTagSelector(gomock.Any(), ownedTag.ID, user, "Engagement 42", "Phase one credential dump", false, true, null.BoolFrom(false), []model.SelectorSeed{
+						{Type: model.SelectorTypeObjectId, Value: "S-1-5-21-1-1105"},
+						{Type: model.SelectorTypeObjectId, Value: "S-1-5-21-1-1106"},
+						{Type: model.SelectorTypeObjectId, Value: "S-1-5-21-1-1107"},
+					}).Return(model.AssetGroupTagSelector{ID: 9, AssetGroupTagId: ownedTag.ID, Name: "Engagement 42"}, nil).Times(1)
+					scheduledAnalysisDisabled()
+				},
+				Test: func(output apitest.Output) {
+					var result v2.ImportOwnedPrincipalsResponse
+
+					apitest.StatusCode(output, http.StatusCreated)
+					apitest.UnmarshalData(output, &result)
+					require.NotNil(t, result.Selector)
+					require.Equal(t, 9, result.Selector.ID)
+					require.Len(t, result.Resolved, 4)
+					require.Equal(t, "JDOE@CORP.LOCAL", result.Resolved[1].Name)
+					require.Equal(t, "Computer", result.Resolved[2].Kind)
+					require.Equal(t, []v2.UnresolvedOwnedPrincipal{{Input: "ghost", Reason: "no matching principal found"}}, result.Unresolved)
+				},
+			},
+		})
+}
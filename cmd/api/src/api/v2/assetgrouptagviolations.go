@@ -0,0 +1,125 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	bhUtils "github.com/specterops/bloodhound/cmd/api/src/utils"
+	"github.com/specterops/bloodhound/packages/go/headers"
+)
+
+const errAssetGroupTagViolationsNotTier = "violations are only reported for tiers"
+
+type GetAssetGroupTagViolationsResponse struct {
+	Counts     []model.AssetGroupTagViolationCount `json:"counts"`
+	Violations model.AssetGroupTagViolations       `json:"violations"`
+}
+
+func parseAssetGroupTagViolationSQLFilter(request *http.Request) (model.SQLFilter, *api.ErrorWrapper) {
+	var violation model.AssetGroupTagViolation
+
+	if queryFilters, err := model.NewQueryParameterFilterParser().ParseQueryParameterFilters(request); err != nil {
+		return model.SQLFilter{}, api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsBadQueryParameterFilters, request)
+	} else {
+		for name, filters := range queryFilters {
+			if validPredicates, err := api.GetValidFilterPredicatesAsStrings(violation, name); err != nil {
+				return model.SQLFilter{}, api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("%s: %s", api.ErrorResponseDetailsColumnNotFilterable, name), request)
+			} else {
+				for i, filter := range filters {
+					if !slices.Contains(validPredicates, string(filter.Operator)) {
+						return model.SQLFilter{}, api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("%s: %s %s", api.ErrorResponseDetailsFilterPredicateNotSupported, filter.Name, filter.Operator), request)
+					}
+
+					queryFilters[name][i].IsStringData = violation.IsStringColumn(filter.Name)
+				}
+			}
+		}
+
+		if sqlFilter, err := queryFilters.BuildSQLFilter(); err != nil {
+			return model.SQLFilter{}, api.BuildErrorResponse(http.StatusBadRequest, "error building SQL for filter", request)
+		} else {
+			return sqlFilter, nil
+		}
+	}
+}
+
+// getAssetGroupTagTier looks up the tier named by the request path, writing an error response if the tag is missing or
+// is not a tier. notTierDetails explains why the request only applies to tiers.
+func (s *Resources) getAssetGroupTagTier(response http.ResponseWriter, request *http.Request, notTierDetails string) (model.AssetGroupTag, bool) {
+	if tagId, err := strconv.Atoi(mux.Vars(request)[api.URIPathVariableAssetGroupTagID]); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusNotFound, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if tag, err := s.DB.GetAssetGroupTag(request.Context(), tagId); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if tag.Type != model.AssetGroupTagTypeTier {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, notTierDetails, request), response)
+	} else {
+		return tag, true
+	}
+
+	return model.AssetGroupTag{}, false
+}
+
+// GetAssetGroupTagViolations lists the attack path edges into a tier from untiered principals or lower tiers, as found
+// by the most recent analysis, along with the count of violating principals and edges from each source tier
+func (s *Resources) GetAssetGroupTagViolations(response http.ResponseWriter, request *http.Request) {
+	queryParams := request.URL.Query()
+
+	if tag, ok := s.getAssetGroupTagTier(response, request, errAssetGroupTagViolationsNotTier); !ok {
+		return
+	} else if skip, err := ParseSkipQueryParameter(queryParams, 0); err != nil {
+		api.WriteErrorResponse(request.Context(), ErrBadQueryParameter(request, model.PaginationQueryParameterSkip, err), response)
+	} else if limit, err := ParseLimitQueryParameter(queryParams, 100); err != nil {
+		api.WriteErrorResponse(request.Context(), ErrBadQueryParameter(request, model.PaginationQueryParameterLimit, err), response)
+	} else if sqlFilter, errWrapper := parseAssetGroupTagViolationSQLFilter(request); errWrapper != nil {
+		api.WriteErrorResponse(request.Context(), errWrapper, response)
+	} else if counts, err := s.DB.GetAssetGroupTagViolationCounts(request.Context(), tag.ID); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if violations, count, err := s.DB.GetAssetGroupTagViolations(request.Context(), tag.ID, sqlFilter, skip, limit); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		if counts == nil {
+			counts = []model.AssetGroupTagViolationCount{}
+		}
+		if violations == nil {
+			violations = model.AssetGroupTagViolations{}
+		}
+
+		api.WriteResponseWrapperWithPagination(request.Context(), GetAssetGroupTagViolationsResponse{Counts: counts, Violations: violations}, limit, skip, count, http.StatusOK, response)
+	}
+}
+
+// ExportAssetGroupTagViolations writes every violation into a tier matching the request filters as a CSV attachment
+func (s *Resources) ExportAssetGroupTagViolations(response http.ResponseWriter, request *http.Request) {
+	if tag, ok := s.getAssetGroupTagTier(response, request, errAssetGroupTagViolationsNotTier); !ok {
+		return
+	} else if sqlFilter, errWrapper := parseAssetGroupTagViolationSQLFilter(request); errWrapper != nil {
+		api.WriteErrorResponse(request.Context(), errWrapper, response)
+	} else if violations, _, err := s.DB.GetAssetGroupTagViolations(request.Context(), tag.ID, sqlFilter, 0, 0); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		response.Header().Set(headers.ContentDisposition.String(), fmt.Sprintf(bhUtils.ContentDispositionAttachmentTemplate, fmt.Sprintf("tier-violations-%d-%s.csv", tag.ID, time.Now().UTC().Format("20060102T150405Z"))))
+		api.WriteCSVResponse(request.Context(), violations, http.StatusOK, response)
+	}
+}
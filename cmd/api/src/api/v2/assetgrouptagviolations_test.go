@@ -0,0 +1,203 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	v2 "github.com/specterops/bloodhound/cmd/api/src/api/v2"
+	"github.com/specterops/bloodhound/cmd/api/src/api/v2/apitest"
+	"github.com/specterops/bloodhound/cmd/api/src/database"
+	mocks_db "github.com/specterops/bloodhound/cmd/api/src/database/mocks"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"go.uber.org/mock/gomock"
+)
+
+func TestResources_GetAssetGroupTagViolations(t *testing.T) {
+	var (
+		mockCtrl      = gomock.NewController(t)
+		mockDB        = mocks_db.NewMockDatabase(mockCtrl)
+		resourcesInst = v2.Resources{DB: mockDB}
+		tierOne       = model.AssetGroupTag{ID: 2, Type: model.AssetGroupTagTypeTier, Name: "Tier One"}
+	)
+
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resourcesInst.GetAssetGroupTagViolations).
+		Run([]apitest.Case{
+			{
+				Name: "InvalidTagUrlId",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "non-numeric")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNotFound)
+					apitest.BodyContains(output, api.ErrorResponseDetailsIDMalformed)
+				},
+			},
+			{
+				Name: "TagNotFound",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "2")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 2).Return(model.AssetGroupTag{}, database.ErrNotFound).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNotFound)
+				},
+			},
+			{
+				Name: "NotATier",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "3")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 3).Return(model.AssetGroupTag{ID: 3, Type: model.AssetGroupTagTypeLabel}, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "violations are only reported for tiers")
+				},
+			},
+			{
+				Name: "InvalidFilterColumn",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "2")
+					apitest.AddQueryParam(input, "source_node_id", "eq:1")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 2).Return(tierOne, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, api.ErrorResponseDetailsColumnNotFilterable)
+				},
+			},
+			{
+				Name: "InvalidFilterPredicate",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "2")
+					apitest.AddQueryParam(input, "edge_kind", "gt:GenericAll")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 2).Return(tierOne, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, api.ErrorResponseDetailsFilterPredicateNotSupported)
+				},
+			},
+			{
+				Name: "DatabaseError",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "2")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 2).Return(tierOne, nil).Times(1)
+					mockDB.EXPECT().GetAssetGroupTagViolationCounts(gomock.Any(), 2).Return(nil, errors.New("failure")).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusInternalServerError)
+				},
+			},
+			{
+				Name: "Success",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "2")
+					apitest.AddQueryParam(input, "source_tag_id", "eq:null")
+					apitest.AddQueryParam(input, model.PaginationQueryParameterLimit, "10")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 2).Return(tierOne, nil).Times(1)
+					mockDB.EXPECT().GetAssetGroupTagViolationCounts(gomock.Any(), 2).
+						Return([]model.AssetGroupTagViolationCount{{AssetGroupTagId: 2, Principals: 4, Edges: 1}}, nil).Times(1)
+					mockDB.EXPECT().GetAssetGroupTagViolations(gomock.Any(), 2, model.SQLFilter{SQLString: "source_tag_id is null"}, 0, 10).
+						Return(model.AssetGroupTagViolations{{ID: 1, AssetGroupTagId: 2, EdgeKind: "GenericAll", SourceName: "HELPDESK", TargetName: "SERVER", Principals: 4}}, 1, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusOK)
+					apitest.BodyContains(output, `"counts":[{"source_tag_id":null,"principals":4,"edges":1`)
+					apitest.BodyContains(output, `"source_name":"HELPDESK"`)
+					apitest.BodyContains(output, `"count":1`)
+				},
+			},
+		})
+}
+
+func TestResources_ExportAssetGroupTagViolations(t *testing.T) {
+	var (
+		mockCtrl      = gomock.NewController(t)
+		mockDB        = mocks_db.NewMockDatabase(mockCtrl)
+		resourcesInst = v2.Resources{DB: mockDB}
+		tierZero      = model.AssetGroupTag{ID: 1, Type: model.AssetGroupTagTypeTier, Name: "Tier Zero"}
+	)
+
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resourcesInst.ExportAssetGroupTagViolations).
+		Run([]apitest.Case{
+			{
+				Name: "NotATier",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "3")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 3).Return(model.AssetGroupTag{ID: 3, Type: model.AssetGroupTagTypeOwned}, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+				},
+			},
+			{
+				Name: "Success",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableAssetGroupTagID, "1")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetAssetGroupTag(gomock.Any(), 1).Return(tierZero, nil).Times(1)
+					mockDB.EXPECT().GetAssetGroupTagViolations(gomock.Any(), 1, model.SQLFilter{}, 0, 0).
+						Return(model.AssetGroupTagViolations{{
+							ID:              1,
+							AssetGroupTagId: 1,
+							SourceTagId:     null.Int32From(2),
+							EdgeKind:        "AdminTo",
+							SourceObjectId:  "S-1-5-21-1-1105",
+							SourceName:      "SERVER ADMIN@CORP.LOCAL",
+							SourceKind:      "User",
+							TargetObjectId:  "S-1-5-21-1-1000",
+							TargetName:      "DC01.CORP.LOCAL",
+							TargetKind:      "Computer",
+							Principals:      1,
+							CreatedAt:       time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+						}}, 1, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusOK)
+					apitest.BodyContains(output, "source_tag_id,source_object_id,source_name,source_kind,edge_kind,target_object_id,target_name,target_kind,principals,created_at\n")
+					apitest.BodyContains(output, "2,S-1-5-21-1-1105,SERVER ADMIN@CORP.LOCAL,User,AdminTo,S-1-5-21-1-1000,DC01.CORP.LOCAL,Computer,1,2026-01-02T03:04:05Z\n")
+				},
+			},
+		})
+}
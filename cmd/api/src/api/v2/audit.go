@@ -17,14 +17,24 @@
 package v2
 
 import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
+	"io"
+	"log/slog"
 	"net/http"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/specterops/bloodhound/cmd/api/src/api"
 	"github.com/specterops/bloodhound/cmd/api/src/model"
+	bhUtils "github.com/specterops/bloodhound/cmd/api/src/utils"
+	"github.com/specterops/bloodhound/cmd/api/src/version"
+	"github.com/specterops/bloodhound/packages/go/headers"
+	"github.com/specterops/bloodhound/packages/go/mediatypes"
 )
 
 // AuditLogsResponse holds the data returned to an Audit logs request
@@ -39,6 +49,7 @@ func (s Resources) ListAuditLogs(response http.ResponseWriter, request *http.Req
 		auditLogs     model.AuditLogs
 		sortByColumns = request.URL.Query()[api.QueryParameterSortBy]
 		skip          int
+		err           error
 	)
 
 	const (
@@ -68,31 +79,10 @@ func (s Resources) ListAuditLogs(response http.ResponseWriter, request *http.Req
 
 	}
 
-	queryParameterFilterParser := model.NewQueryParameterFilterParser()
-	if queryFilters, err := queryParameterFilterParser.ParseQueryParameterFilters(request); err != nil {
-		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsBadQueryParameterFilters, request), response)
+	if sqlFilter, errWrapper := parseAuditLogSQLFilter(request); errWrapper != nil {
+		api.WriteErrorResponse(request.Context(), errWrapper, response)
 		return
 	} else {
-		for name, filters := range queryFilters {
-			if valid := slices.Contains(auditLogs.GetFilterableColumns(), name); !valid {
-				api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("%s: %s", api.ErrorResponseDetailsColumnNotFilterable, name), request), response)
-				return
-			}
-
-			if validPredicates, err := auditLogs.GetValidFilterPredicatesAsStrings(name); err != nil {
-				api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("%s: %s", api.ErrorResponseDetailsColumnNotFilterable, name), request), response)
-			} else {
-				for i, filter := range filters {
-					if !slices.Contains(validPredicates, string(filter.Operator)) {
-						api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("%s: %s %s", api.ErrorResponseDetailsFilterPredicateNotSupported, filter.Name, filter.Operator), request), response)
-						return
-					}
-
-					queryFilters[name][i].IsStringData = auditLogs.IsString(filter.Name)
-				}
-			}
-		}
-
 		queryParams := request.URL.Query()
 
 		// Note: Handling both 'skip' and 'offset' query parameters to maintain backward compatibility
@@ -108,11 +98,7 @@ func (s Resources) ListAuditLogs(response http.ResponseWriter, request *http.Req
 			}
 		}
 
-		// ignoring the error here as this would've failed at ParseQueryParameterFilters before getting here
-		if sqlFilter, err := queryFilters.BuildSQLFilter(); err != nil {
-			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "error building SQL for filter", request), response)
-			return
-		} else if limit, err := ParseLimitQueryParameter(queryParams, 1000); err != nil {
+		if limit, err := ParseLimitQueryParameter(queryParams, 1000); err != nil {
 			api.WriteErrorResponse(request.Context(), ErrBadQueryParameter(request, limitQueryParam, err), response)
 		} else if getLogsBefore, err := ParseTimeQueryParameter(queryParams, logsBeforeQueryParam, time.Now()); err != nil {
 			api.WriteErrorResponse(request.Context(), ErrBadQueryParameter(request, logsBeforeQueryParam, err), response)
@@ -125,3 +111,281 @@ func (s Resources) ListAuditLogs(response http.ResponseWriter, request *http.Req
 		}
 	}
 }
+
+// parseAuditLogSQLFilter validates the audit log query parameter filters and builds the SQL filter for them
+func parseAuditLogSQLFilter(request *http.Request) (model.SQLFilter, *api.ErrorWrapper) {
+	var auditLogs model.AuditLogs
+
+	queryParameterFilterParser := model.NewQueryParameterFilterParser()
+	if queryFilters, err := queryParameterFilterParser.ParseQueryParameterFilters(request); err != nil {
+		return model.SQLFilter{}, api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsBadQueryParameterFilters, request)
+	} else {
+		for name, filters := range queryFilters {
+			if valid := slices.Contains(auditLogs.GetFilterableColumns(), name); !valid {
+				return model.SQLFilter{}, api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("%s: %s", api.ErrorResponseDetailsColumnNotFilterable, name), request)
+			}
+
+			if validPredicates, err := auditLogs.GetValidFilterPredicatesAsStrings(name); err != nil {
+				return model.SQLFilter{}, api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("%s: %s", api.ErrorResponseDetailsColumnNotFilterable, name), request)
+			} else {
+				for i, filter := range filters {
+					if !slices.Contains(validPredicates, string(filter.Operator)) {
+						return model.SQLFilter{}, api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("%s: %s %s", api.ErrorResponseDetailsFilterPredicateNotSupported, filter.Name, filter.Operator), request)
+					}
+
+					queryFilters[name][i].IsStringData = auditLogs.IsString(filter.Name)
+				}
+			}
+		}
+
+		// ignoring the error here as this would've failed at ParseQueryParameterFilters before getting here
+		if sqlFilter, err := queryFilters.BuildSQLFilter(); err != nil {
+			return model.SQLFilter{}, api.BuildErrorResponse(http.StatusBadRequest, "error building SQL for filter", request)
+		} else {
+			return sqlFilter, nil
+		}
+	}
+}
+
+const (
+	AuditLogExportFormatNDJSON = "ndjson"
+	AuditLogExportFormatCSV    = "csv"
+	AuditLogExportFormatCEF    = "cef"
+
+	auditLogExportBatchSize = 1000
+)
+
+// auditLogExportColumns are the CSV header columns of an audit log export
+var auditLogExportColumns = []string{"id", "created_at", "actor_id", "actor_name", "actor_email", "action", "fields", "request_id", "source_ip_address", "status", "commit_id", "previous_hash", "hash"}
+
+// auditLogExporter writes audit logs to an export in a single format
+type auditLogExporter interface {
+	ContentType() string
+	Extension() string
+	WriteHeader() error
+	Write(auditLog model.AuditLog) error
+	Flush() error
+}
+
+func newAuditLogExporter(format string, writer io.Writer) (auditLogExporter, error) {
+	switch strings.ToLower(format) {
+	case "", AuditLogExportFormatNDJSON:
+		return &ndjsonAuditLogExporter{encoder: json.NewEncoder(writer)}, nil
+	case AuditLogExportFormatCSV:
+		return &csvAuditLogExporter{writer: csv.NewWriter(writer)}, nil
+	case AuditLogExportFormatCEF:
+		return &cefAuditLogExporter{writer: writer, version: version.GetVersion().String()}, nil
+	default:
+		return nil, fmt.Errorf("unsupported export format %s; expected one of %s, %s or %s", format, AuditLogExportFormatNDJSON, AuditLogExportFormatCSV, AuditLogExportFormatCEF)
+	}
+}
+
+type ndjsonAuditLogExporter struct {
+	encoder *json.Encoder
+}
+
+func (s *ndjsonAuditLogExporter) ContentType() string { return "application/x-ndjson" }
+func (s *ndjsonAuditLogExporter) Extension() string   { return "ndjson" }
+func (s *ndjsonAuditLogExporter) WriteHeader() error  { return nil }
+func (s *ndjsonAuditLogExporter) Flush() error        { return nil }
+
+func (s *ndjsonAuditLogExporter) Write(auditLog model.AuditLog) error {
+	return s.encoder.Encode(auditLog)
+}
+
+type csvAuditLogExporter struct {
+	writer *csv.Writer
+}
+
+func (s *csvAuditLogExporter) ContentType() string { return mediatypes.TextCsv.String() }
+func (s *csvAuditLogExporter) Extension() string   { return "csv" }
+
+func (s *csvAuditLogExporter) WriteHeader() error {
+	return s.writer.Write(auditLogExportColumns)
+}
+
+func (s *csvAuditLogExporter) Write(auditLog model.AuditLog) error {
+	if fields, err := json.Marshal(auditLog.Fields); err != nil {
+		return err
+	} else {
+		return s.writer.Write([]string{
+			strconv.FormatInt(auditLog.ID, 10),
+			auditLog.CreatedAt.UTC().Format(time.RFC3339Nano),
+			auditLog.ActorID,
+			auditLog.ActorName,
+			auditLog.ActorEmail,
+			string(auditLog.Action),
+			string(fields),
+			auditLog.RequestID,
+			auditLog.SourceIpAddress,
+			string(auditLog.Status),
+			auditLog.CommitID.String(),
+			auditLog.PreviousHash,
+			auditLog.Hash,
+		})
+	}
+}
+
+func (s *csvAuditLogExporter) Flush() error {
+	s.writer.Flush()
+	return s.writer.Error()
+}
+
+// cefAuditLogExporter writes one ArcSight Common Event Format line per audit log for SIEM ingestion
+type cefAuditLogExporter struct {
+	writer  io.Writer
+	version string
+}
+
+var (
+	cefHeaderEscaper    = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\r", " ", "\n", " ")
+	cefExtensionEscaper = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\r", `\r`, "\n", `\n`)
+)
+
+func (s *cefAuditLogExporter) ContentType() string { return "text/plain" }
+func (s *cefAuditLogExporter) Extension() string   { return "cef" }
+func (s *cefAuditLogExporter) WriteHeader() error  { return nil }
+func (s *cefAuditLogExporter) Flush() error        { return nil }
+
+func (s *cefAuditLogExporter) Write(auditLog model.AuditLog) error {
+	severity := 3
+	if auditLog.Status == model.AuditLogStatusFailure {
+		severity = 7
+	}
+
+	fields, err := json.Marshal(auditLog.Fields)
+	if err != nil {
+		return err
+	}
+
+	extensions := []string{
+		"rt=" + strconv.FormatInt(auditLog.CreatedAt.UnixMilli(), 10),
+		"externalId=" + strconv.FormatInt(auditLog.ID, 10),
+		"suid=" + cefExtensionEscaper.Replace(auditLog.ActorID),
+		"suser=" + cefExtensionEscaper.Replace(auditLog.ActorName),
+		"src=" + cefExtensionEscaper.Replace(auditLog.SourceIpAddress),
+		"outcome=" + cefExtensionEscaper.Replace(string(auditLog.Status)),
+		"cs1Label=fields cs1=" + cefExtensionEscaper.Replace(string(fields)),
+		"cs2Label=request_id cs2=" + cefExtensionEscaper.Replace(auditLog.RequestID),
+		"cs3Label=commit_id cs3=" + auditLog.CommitID.String(),
+		"cs4Label=actor_email cs4=" + cefExtensionEscaper.Replace(auditLog.ActorEmail),
+		"cs5Label=previous_hash cs5=" + auditLog.PreviousHash,
+		"cs6Label=hash cs6=" + auditLog.Hash,
+	}
+
+	action := cefHeaderEscaper.Replace(string(auditLog.Action))
+	_, err = fmt.Fprintf(s.writer, "CEF:0|SpecterOps|BloodHound|%s|%s|%s|%d|%s\n", cefHeaderEscaper.Replace(s.version), action, action, severity, strings.Join(extensions, " "))
+	return err
+}
+
+// ExportAuditLogs streams the audit logs matching the time range and filters in ascending ID order as NDJSON, CSV or
+// CEF. Unlike ListAuditLogs, the time range is unbounded unless before or after are given.
+func (s Resources) ExportAuditLogs(response http.ResponseWriter, request *http.Request) {
+	const (
+		formatQueryParam     = "format"
+		logsBeforeQueryParam = "before"
+		logsAfterQueryParam  = "after"
+	)
+
+	var (
+		queryParams = request.URL.Query()
+		buffer      = &bytes.Buffer{}
+		exported    int
+	)
+
+	if sqlFilter, errWrapper := parseAuditLogSQLFilter(request); errWrapper != nil {
+		api.WriteErrorResponse(request.Context(), errWrapper, response)
+	} else if exporter, err := newAuditLogExporter(queryParams.Get(formatQueryParam), buffer); err != nil {
+		api.WriteErrorResponse(request.Context(), ErrBadQueryParameter(request, formatQueryParam, err), response)
+	} else if before, err := ParseTimeQueryParameter(queryParams, logsBeforeQueryParam, time.Time{}); err != nil {
+		api.WriteErrorResponse(request.Context(), ErrBadQueryParameter(request, logsBeforeQueryParam, err), response)
+	} else if after, err := ParseTimeQueryParameter(queryParams, logsAfterQueryParam, time.Time{}); err != nil {
+		api.WriteErrorResponse(request.Context(), ErrBadQueryParameter(request, logsAfterQueryParam, err), response)
+	} else if auditLogs, err := s.DB.GetAuditLogsAfterID(request.Context(), 0, before, after, auditLogExportBatchSize, sqlFilter); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		// Record the export before it is written so the export is itself part of the audit trail
+		if auditEntry, err := model.NewAuditEntry(model.AuditLogActionExportAuditLogs, model.AuditLogStatusSuccess, model.AuditData{
+			"format": exporter.Extension(),
+			"before": queryParams.Get(logsBeforeQueryParam),
+			"after":  queryParams.Get(logsAfterQueryParam),
+		}); err != nil {
+			slog.ErrorContext(request.Context(), fmt.Sprintf("Failed to build audit log export audit entry: %v", err))
+		} else if err := s.DB.AppendAuditLog(request.Context(), auditEntry); err != nil {
+			slog.ErrorContext(request.Context(), fmt.Sprintf("Failed to append audit log export audit entry: %v", err))
+		}
+
+		response.Header().Set(headers.ContentType.String(), exporter.ContentType())
+		response.Header().Set(headers.ContentDisposition.String(), fmt.Sprintf(bhUtils.ContentDispositionAttachmentTemplate, fmt.Sprintf("audit-logs-%s.%s", time.Now().UTC().Format("20060102T150405Z"), exporter.Extension())))
+		response.WriteHeader(http.StatusOK)
+
+		if err := exporter.WriteHeader(); err != nil {
+			slog.ErrorContext(request.Context(), fmt.Sprintf("Failed to write audit log export header: %v", err))
+			return
+		}
+
+		for len(auditLogs) > 0 {
+			for _, auditLog := range auditLogs {
+				if err := exporter.Write(auditLog); err != nil {
+					slog.ErrorContext(request.Context(), fmt.Sprintf("Failed to write audit log %d to export: %v", auditLog.ID, err))
+					return
+				}
+			}
+
+			exported += len(auditLogs)
+
+			if err := exporter.Flush(); err != nil {
+				slog.ErrorContext(request.Context(), fmt.Sprintf("Failed to flush audit log export: %v", err))
+				return
+			} else if _, err := buffer.WriteTo(response); err != nil {
+				slog.ErrorContext(request.Context(), fmt.Sprintf("Failed to write audit log export after %d entries: %v", exported, err))
+				return
+			} else if len(auditLogs) < auditLogExportBatchSize {
+				break
+			} else if auditLogs, err = s.DB.GetAuditLogsAfterID(request.Context(), auditLogs[len(auditLogs)-1].ID, before, after, auditLogExportBatchSize, sqlFilter); err != nil {
+				slog.ErrorContext(request.Context(), fmt.Sprintf("Failed to fetch audit logs for export after %d entries: %v", exported, err))
+				return
+			}
+		}
+
+		if _, err := buffer.WriteTo(response); err != nil {
+			slog.ErrorContext(request.Context(), fmt.Sprintf("Failed to write audit log export: %v", err))
+		}
+	}
+}
+
+// VerifyAuditLogs walks the entire audit log hash chain and reports whether any entry was altered, removed or reordered
+func (s Resources) VerifyAuditLogs(response http.ResponseWriter, request *http.Request) {
+	var afterID int64
+
+	auditLogHashKey, err := s.Config.Crypto.AuditLog.HashKeyBytes()
+	if err != nil {
+		slog.ErrorContext(request.Context(), fmt.Sprintf("Error decoding audit log hash key: %v", err))
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, api.ErrorResponseDetailsInternalServerError, request), response)
+		return
+	}
+
+	verification := model.NewAuditLogChainVerification(auditLogHashKey)
+
+	for {
+		if auditLogs, err := s.DB.GetAuditLogsAfterID(request.Context(), afterID, time.Time{}, time.Time{}, auditLogExportBatchSize, model.SQLFilter{}); err != nil {
+			api.HandleDatabaseError(request, response, err)
+			return
+		} else {
+			for _, auditLog := range auditLogs {
+				if !verification.Add(auditLog) {
+					break
+				}
+			}
+
+			if !verification.Verified || len(auditLogs) < auditLogExportBatchSize {
+				break
+			}
+
+			afterID = auditLogs[len(auditLogs)-1].ID
+		}
+	}
+
+	verification.Finish()
+	api.WriteBasicResponse(request.Context(), verification, http.StatusOK, response)
+}
@@ -22,6 +22,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/specterops/bloodhound/cmd/api/src/api"
 	"github.com/specterops/bloodhound/packages/go/headers"
@@ -33,6 +34,7 @@ import (
 
 	v2 "github.com/specterops/bloodhound/cmd/api/src/api/v2"
 	"github.com/specterops/bloodhound/cmd/api/src/database/mocks"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types"
 
 	"github.com/specterops/bloodhound/cmd/api/src/model"
 )
@@ -362,3 +364,182 @@ func TestResources_ListAuditLogs_InvalidSkip(t *testing.T) {
 		require.Contains(t, response.Body.String(), "query parameter \\\"skip\\\" is malformed")
 	}
 }
+
+func TestResources_ExportAuditLogs_InvalidFormat(t *testing.T) {
+	var (
+		mockCtrl  = gomock.NewController(t)
+		mockDB    = mocks.NewMockDatabase(mockCtrl)
+		resources = v2.Resources{DB: mockDB}
+	)
+	defer mockCtrl.Finish()
+
+	endpoint := "/api/v2/audit/export"
+
+	if req, err := http.NewRequest("GET", endpoint, nil); err != nil {
+		t.Fatal(err)
+	} else {
+		q := url.Values{}
+		q.Add("format", "xml")
+		req.URL.RawQuery = q.Encode()
+
+		router := mux.NewRouter()
+		router.HandleFunc(endpoint, resources.ExportAuditLogs).Methods("GET")
+
+		response := httptest.NewRecorder()
+		router.ServeHTTP(response, req)
+		require.Equal(t, http.StatusBadRequest, response.Code)
+		require.Contains(t, response.Body.String(), "unsupported export format")
+	}
+}
+
+func TestResources_ExportAuditLogs_DBError(t *testing.T) {
+	var (
+		mockCtrl  = gomock.NewController(t)
+		mockDB    = mocks.NewMockDatabase(mockCtrl)
+		resources = v2.Resources{DB: mockDB}
+	)
+	defer mockCtrl.Finish()
+
+	mockDB.EXPECT().GetAuditLogsAfterID(gomock.Any(), int64(0), time.Time{}, time.Time{}, 1000, model.SQLFilter{}).Return(nil, fmt.Errorf("foo"))
+
+	endpoint := "/api/v2/audit/export"
+
+	if req, err := http.NewRequest("GET", endpoint, nil); err != nil {
+		t.Fatal(err)
+	} else {
+		router := mux.NewRouter()
+		router.HandleFunc(endpoint, resources.ExportAuditLogs).Methods("GET")
+
+		response := httptest.NewRecorder()
+		router.ServeHTTP(response, req)
+		require.Equal(t, http.StatusInternalServerError, response.Code)
+	}
+}
+
+func TestResources_ExportAuditLogs(t *testing.T) {
+	var (
+		createdAt = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+		auditLogs = model.AuditLogs{{
+			ID:        1,
+			CreatedAt: createdAt,
+			ActorName: "admin",
+			Action:    model.AuditLogActionCreateUser,
+			Fields:    types.JSONUntypedObject{"principal_name": "a=b|c"},
+			Status:    model.AuditLogStatusSuccess,
+			Hash:      "abc",
+		}, {
+			ID:           2,
+			CreatedAt:    createdAt,
+			ActorName:    "admin",
+			Action:       model.AuditLogActionDeleteUser,
+			Status:       model.AuditLogStatusFailure,
+			PreviousHash: "abc",
+			Hash:         "def",
+		}}
+	)
+
+	for _, tc := range []struct {
+		format       string
+		contentType  string
+		extension    string
+		expectedBody []string
+	}{{
+		format:      "",
+		contentType: "application/x-ndjson",
+		extension:   "ndjson",
+		expectedBody: []string{
+			`"id":1,`,
+			`"hash":"def"`,
+		},
+	}, {
+		format:      "csv",
+		contentType: mediatypes.TextCsv.String(),
+		extension:   "csv",
+		expectedBody: []string{
+			"id,created_at,actor_id,actor_name,actor_email,action,fields,request_id,source_ip_address,status,commit_id,previous_hash,hash\n",
+			`1,2026-01-01T12:00:00Z,,admin,,CreateUser,"{""principal_name"":""a=b|c""}",,,success,00000000-0000-0000-0000-000000000000,,abc`,
+		},
+	}, {
+		format:      "cef",
+		contentType: "text/plain",
+		extension:   "cef",
+		expectedBody: []string{
+			"|CreateUser|CreateUser|3|rt=1767268800000 externalId=1 ",
+			`cs1={"principal_name":"a\=b|c"}`,
+			"|DeleteUser|DeleteUser|7|",
+		},
+	}} {
+		t.Run(tc.extension, func(t *testing.T) {
+			var (
+				mockCtrl  = gomock.NewController(t)
+				mockDB    = mocks.NewMockDatabase(mockCtrl)
+				resources = v2.Resources{DB: mockDB}
+			)
+			defer mockCtrl.Finish()
+
+			mockDB.EXPECT().GetAuditLogsAfterID(gomock.Any(), int64(0), time.Time{}, createdAt.Add(-time.Hour), 1000, model.SQLFilter{}).Return(auditLogs, nil)
+			mockDB.EXPECT().AppendAuditLog(gomock.Any(), gomock.Any()).Return(nil)
+
+			endpoint := "/api/v2/audit/export"
+
+			if req, err := http.NewRequest("GET", endpoint, nil); err != nil {
+				t.Fatal(err)
+			} else {
+				q := url.Values{}
+				q.Add("format", tc.format)
+				q.Add("after", createdAt.Add(-time.Hour).Format(time.RFC3339))
+				req.URL.RawQuery = q.Encode()
+
+				router := mux.NewRouter()
+				router.HandleFunc(endpoint, resources.ExportAuditLogs).Methods("GET")
+
+				response := httptest.NewRecorder()
+				router.ServeHTTP(response, req)
+				require.Equal(t, http.StatusOK, response.Code)
+				require.Equal(t, tc.contentType, response.Header().Get(headers.ContentType.String()))
+				require.Contains(t, response.Header().Get(headers.ContentDisposition.String()), "."+tc.extension+`"`)
+
+				for _, expected := range tc.expectedBody {
+					require.Contains(t, response.Body.String(), expected)
+				}
+			}
+		})
+	}
+}
+
+func TestResources_VerifyAuditLogs(t *testing.T) {
+	var (
+		mockCtrl  = gomock.NewController(t)
+		mockDB    = mocks.NewMockDatabase(mockCtrl)
+		resources = v2.Resources{DB: mockDB}
+		auditLog  = model.AuditLog{ID: 1, Action: model.AuditLogActionCreateUser, Status: model.AuditLogStatusSuccess}
+		hashKey   = []byte("audit log hash key")
+	)
+	defer mockCtrl.Finish()
+
+	resources.Config.Crypto.AuditLog.SetHashKeyBytes(hashKey)
+
+	hash, err := auditLog.ComputeHash(hashKey)
+	require.Nil(t, err)
+	auditLog.Hash = hash
+
+	tampered := model.AuditLog{ID: 2, Action: model.AuditLogActionDeleteUser, Status: model.AuditLogStatusSuccess, PreviousHash: hash, Hash: "tampered"}
+
+	mockDB.EXPECT().GetAuditLogsAfterID(gomock.Any(), int64(0), time.Time{}, time.Time{}, 1000, model.SQLFilter{}).Return(model.AuditLogs{auditLog, tampered}, nil)
+
+	endpoint := "/api/v2/audit/verify"
+
+	if req, err := http.NewRequest("GET", endpoint, nil); err != nil {
+		t.Fatal(err)
+	} else {
+		router := mux.NewRouter()
+		router.HandleFunc(endpoint, resources.VerifyAuditLogs).Methods("GET")
+
+		response := httptest.NewRecorder()
+		router.ServeHTTP(response, req)
+		require.Equal(t, http.StatusOK, response.Code)
+		require.Contains(t, response.Body.String(), `"verified":false`)
+		require.Contains(t, response.Body.String(), `"keyed":true`)
+		require.Contains(t, response.Body.String(), `"broken_at_id":2`)
+	}
+}
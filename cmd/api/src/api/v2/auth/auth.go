@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"net/netip"
 	"slices"
 	"strconv"
 	"strings"
@@ -41,8 +42,10 @@ import (
 	"github.com/specterops/bloodhound/cmd/api/src/model"
 	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
 	"github.com/specterops/bloodhound/cmd/api/src/serde"
+	"github.com/specterops/bloodhound/cmd/api/src/services/ldap"
 	"github.com/specterops/bloodhound/cmd/api/src/services/oidc"
 	"github.com/specterops/bloodhound/cmd/api/src/services/saml"
+	"github.com/specterops/bloodhound/cmd/api/src/utils"
 	"github.com/specterops/bloodhound/cmd/api/src/utils/validation"
 	"github.com/specterops/bloodhound/packages/go/crypto"
 )
@@ -52,6 +55,10 @@ const (
 	ErrResponseDetailsInvalidCurrentPassword = "unable to verify current password"
 	ErrResponseDetailsMFAActivated           = "multi-factor authentication already active"
 	ErrResponseDetailsMFAEnrollmentRequired  = "multi-factor authentication enrollment is required before activation"
+	ErrResponseDetailsTokenExpiryInPast      = "token expiration must be in the future"
+	ErrResponseDetailsTokenInvalidCIDR       = "invalid allowed cidr: %s"
+	ErrResponseDetailsTokenPermissionNotHeld = "permission %d is not granted to the token owner"
+	ErrResponseDetailsSecretReused           = "password must not match any of the last %d passwords"
 )
 
 type ManagementResource struct {
@@ -63,6 +70,7 @@ type ManagementResource struct {
 	authenticator              api.Authenticator // Used for secrets
 	OIDC                       oidc.Service
 	SAML                       saml.Service
+	LDAP                       ldap.Service
 }
 
 func NewManagementResource(authConfig config.Configuration, db database.Database, authorizer auth.Authorizer, authenticator api.Authenticator) ManagementResource {
@@ -75,6 +83,7 @@ func NewManagementResource(authConfig config.Configuration, db database.Database
 		authenticator:              authenticator,
 		OIDC:                       &oidc.Client{},
 		SAML:                       &saml.Client{},
+		LDAP:                       &ldap.Client{},
 	}
 }
 
@@ -323,6 +332,12 @@ func (s ManagementResource) CreateUser(response http.ResponseWriter, request *ht
 			if errs := validation.Validate(createUserRequest.SetUserSecretRequest); errs != nil {
 				api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, errs.Error(), request), response)
 				return
+			} else if errs, err := s.checkPasswordPolicy(request.Context(), userTemplate, createUserRequest.Secret); err != nil {
+				api.HandleDatabaseError(request, response, err)
+				return
+			} else if errs != nil {
+				api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, errs.Error(), request), response)
+				return
 			} else if secretDigest, err := s.secretDigester.Digest(createUserRequest.Secret); err != nil {
 				slog.ErrorContext(request.Context(), fmt.Sprintf("Error while attempting to digest secret for user: %v", err))
 				api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, api.ErrorResponseDetailsInternalServerError, request), response)
@@ -423,13 +438,9 @@ func (s ManagementResource) UpdateUser(response http.ResponseWriter, request *ht
 			if user.ID == loggedInUser.ID {
 				api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseUserSelfDisable, request), response)
 				return
-			} else if userSessions, err := s.db.LookupActiveSessionsByUser(request.Context(), user); err != nil {
+			} else if err := s.endUserSessions(request.Context(), user, 0); err != nil {
 				api.HandleDatabaseError(request, response, err)
 				return
-			} else {
-				for _, session := range userSessions {
-					s.db.EndUserSession(request.Context(), session)
-				}
 			}
 		}
 
@@ -541,8 +552,46 @@ func (s ManagementResource) DeleteUser(response http.ResponseWriter, request *ht
 	}
 }
 
+// checkPasswordPolicy validates a new secret against the configured password policy. Secrets of existing users are
+// additionally compared against their current secret and their previously replaced secrets.
+func (s ManagementResource) checkPasswordPolicy(ctx context.Context, user model.User, secret string) (utils.Errors, error) {
+	passwordPolicy := appcfg.GetPasswordPolicy(ctx, s.db)
+
+	if errs := passwordPolicy.ValidateSecret(secret); errs != nil {
+		return errs, nil
+	} else if passwordPolicy.HistoryCount == 0 || user.AuthSecret == nil {
+		return nil, nil
+	}
+
+	previousSecrets := []model.AuthSecret{*user.AuthSecret}
+
+	if passwordPolicy.HistoryCount > 1 {
+		if history, err := s.db.GetAuthSecretHistory(ctx, user.ID, passwordPolicy.HistoryCount-1); err != nil {
+			return nil, err
+		} else {
+			for _, entry := range history {
+				previousSecrets = append(previousSecrets, model.AuthSecret{Digest: entry.Digest, DigestMethod: entry.DigestMethod})
+			}
+		}
+	}
+
+	for _, previousSecret := range previousSecrets {
+		// Secrets digested with a different method can not be compared and are skipped
+		if err := s.authenticator.ValidateSecret(ctx, secret, previousSecret); err == nil {
+			return utils.Errors{fmt.Errorf(ErrResponseDetailsSecretReused, passwordPolicy.HistoryCount)}, nil
+		}
+	}
+
+	return nil, nil
+}
+
 func (s ManagementResource) setUserSecret(ctx context.Context, user model.User, authSecret model.AuthSecret) error {
 	if user.AuthSecret != nil {
+		// Keep the digest of the replaced secret so that the password policy can reject its reuse
+		if err := s.db.CreateAuthSecretHistory(ctx, *user.AuthSecret); err != nil {
+			return api.FormatDatabaseError(err)
+		}
+
 		user.AuthSecret.Digest = authSecret.Digest
 		user.AuthSecret.DigestMethod = authSecret.DigestMethod
 		user.AuthSecret.ExpiresAt = authSecret.ExpiresAt.UTC()
@@ -592,6 +641,14 @@ func (s ManagementResource) PutUserAuthSecret(response http.ResponseWriter, requ
 			}
 		}
 
+		if errs, err := s.checkPasswordPolicy(request.Context(), targetUser, setUserSecretRequest.Secret); err != nil {
+			api.HandleDatabaseError(request, response, err)
+			return
+		} else if errs != nil {
+			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, errs.Error(), request), response)
+			return
+		}
+
 		passwordExpiration := appcfg.GetPasswordExpiration(request.Context(), s.db)
 		if secretDigest, err := s.secretDigester.Digest(setUserSecretRequest.Secret); err != nil {
 			slog.ErrorContext(request.Context(), fmt.Sprintf("Error while attempting to digest secret for user: %v", err))
@@ -608,6 +665,9 @@ func (s ManagementResource) PutUserAuthSecret(response http.ResponseWriter, requ
 
 			if err := s.setUserSecret(request.Context(), targetUser, authSecret); err != nil {
 				api.HandleDatabaseError(request, response, err)
+			} else if err := s.endUserSessions(request.Context(), targetUser, bhCtx.AuthCtx.Session.ID); err != nil {
+				// Sessions established with the previous secret are ended, a user changing their own secret stays logged in
+				api.HandleDatabaseError(request, response, err)
 			} else {
 				response.WriteHeader(http.StatusOK)
 			}
@@ -731,15 +791,79 @@ func (s ManagementResource) CreateAuthToken(response http.ResponseWriter, reques
 		api.HandleDatabaseError(request, response, err)
 	} else if err := verifyUserID(&createUserTokenRequest, user, bhCtx, s.authorizer); err != nil {
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusForbidden, err.Error(), request), response)
+	} else if err := validateAuthTokenRestrictions(createUserTokenRequest, time.Now().UTC()); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
+	} else if owner, err := s.getAuthTokenOwner(request.Context(), createUserTokenRequest, user); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if permissions, err := scopeAuthTokenPermissions(createUserTokenRequest.Permissions, owner); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
 	} else if authToken, err := auth.NewUserAuthToken(createUserTokenRequest.UserID, createUserTokenRequest.TokenName, auth.HMAC_SHA2_256); err != nil {
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, api.ErrorResponseDetailsInternalServerError, request), response)
-	} else if newAuthToken, err := s.db.CreateAuthToken(request.Context(), authToken); err != nil {
-		api.HandleDatabaseError(request, response, err)
 	} else {
-		api.WriteBasicResponse(request.Context(), newAuthToken, http.StatusOK, response)
+		authToken.ExpiresAt = createUserTokenRequest.ExpiresAt
+		authToken.AllowedCIDRs = createUserTokenRequest.AllowedCIDRs
+		authToken.Permissions = permissions
+
+		if newAuthToken, err := s.db.CreateAuthToken(request.Context(), authToken); err != nil {
+			api.HandleDatabaseError(request, response, err)
+		} else {
+			api.WriteBasicResponse(request.Context(), newAuthToken, http.StatusOK, response)
+		}
+	}
+}
+
+// validateAuthTokenRestrictions checks the optional expiration and source CIDR restrictions of a token request
+func validateAuthTokenRestrictions(createUserTokenRequest v2.CreateUserToken, now time.Time) error {
+	if createUserTokenRequest.ExpiresAt.Valid && !createUserTokenRequest.ExpiresAt.Time.After(now) {
+		return errors.New(ErrResponseDetailsTokenExpiryInPast)
+	}
+
+	for _, rawCIDR := range createUserTokenRequest.AllowedCIDRs {
+		if _, err := netip.ParsePrefix(rawCIDR); err != nil {
+			return fmt.Errorf(ErrResponseDetailsTokenInvalidCIDR, rawCIDR)
+		}
+	}
+
+	return nil
+}
+
+// getAuthTokenOwner returns the user the token is being created for. The owner is only looked up when the token is
+// scoped since the owner's permissions bound the scope.
+func (s ManagementResource) getAuthTokenOwner(ctx context.Context, createUserTokenRequest v2.CreateUserToken, authedUser model.User) (model.User, error) {
+	if len(createUserTokenRequest.Permissions) == 0 || createUserTokenRequest.UserID == authedUser.ID.String() {
+		return authedUser, nil
+	} else if ownerID, err := uuid.FromString(createUserTokenRequest.UserID); err != nil {
+		return model.User{}, database.ErrNotFound
+	} else {
+		return s.db.GetUser(ctx, ownerID)
 	}
 }
 
+// scopeAuthTokenPermissions resolves the requested permission IDs against the permissions held by the token owner
+func scopeAuthTokenPermissions(permissionIDs []int32, owner model.User) (model.Permissions, error) {
+	var (
+		ownerPermissions = owner.Roles.Permissions()
+		scoped           = model.Permissions{}
+		seen             = make(map[int32]struct{}, len(permissionIDs))
+	)
+
+	for _, permissionID := range permissionIDs {
+		if _, duplicate := seen[permissionID]; duplicate {
+			continue
+		}
+
+		seen[permissionID] = struct{}{}
+
+		if idx := slices.IndexFunc(ownerPermissions, func(permission model.Permission) bool { return permission.ID == permissionID }); idx < 0 {
+			return nil, fmt.Errorf(ErrResponseDetailsTokenPermissionNotHeld, permissionID)
+		} else {
+			scoped = append(scoped, ownerPermissions[idx])
+		}
+	}
+
+	return scoped, nil
+}
+
 // This is a helper function that selects the correct user_id to use for the token being created.
 // If no user_id is passed in the request, use the authed user's ID and proceed.
 // If the request contains a user_id other than their own, check to make sure they have permissions to create tokens for other users and reject.
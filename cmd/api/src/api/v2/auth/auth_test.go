@@ -84,6 +84,7 @@ func TestManagementResource_PutUserAuthSecret(t *testing.T) {
 
 	// Happy paths
 	mockDB.EXPECT().GetUser(gomock.Any(), goodUser.ID).Return(goodUser, nil).Times(2)
+	mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.PasswordPolicy).Return(appcfg.Parameter{}, database.ErrNotFound).AnyTimes()
 	mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.PasswordExpirationWindow).Return(appcfg.Parameter{
 		Key: appcfg.PasswordExpirationWindow,
 		Value: must.NewJSONBObject(appcfg.PasswordExpiration{
@@ -92,7 +93,11 @@ func TestManagementResource_PutUserAuthSecret(t *testing.T) {
 	}, nil).Times(2)
 
 	// Change own user secret requires current password
+	mockDB.EXPECT().CreateAuthSecretHistory(gomock.Any(), *goodUser.AuthSecret).Return(nil)
 	mockDB.EXPECT().UpdateAuthSecret(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	// The session used to change the secret remains active
+	bhCtx.AuthCtx.Session = model.UserSession{BigSerial: model.BigSerial{ID: 1}}
+	mockDB.EXPECT().LookupActiveSessionsByUser(gomock.Any(), gomock.Any()).Return([]model.UserSession{bhCtx.AuthCtx.Session}, nil)
 	test.Request(t).
 		WithContext(bhCtx).
 		WithMethod(http.MethodPut).
@@ -111,6 +116,10 @@ func TestManagementResource_PutUserAuthSecret(t *testing.T) {
 	// Change another users secret does not require current password
 	mockDB.EXPECT().GetUser(gomock.Any(), otherUser.ID).Return(otherUser, nil)
 	mockDB.EXPECT().CreateAuthSecret(gomock.Any(), gomock.Any()).Return(model.AuthSecret{}, nil).Times(1)
+	// Sessions established with the previous secret are ended
+	otherUserSession := model.UserSession{UserID: otherUser.ID, BigSerial: model.BigSerial{ID: 2}}
+	mockDB.EXPECT().LookupActiveSessionsByUser(gomock.Any(), gomock.Any()).Return([]model.UserSession{otherUserSession}, nil)
+	mockDB.EXPECT().EndUserSession(gomock.Any(), otherUserSession)
 	test.Request(t).
 		WithContext(bhCtx).
 		WithMethod(http.MethodPut).
@@ -168,6 +177,75 @@ func TestManagementResource_PutUserAuthSecret(t *testing.T) {
 		)
 }
 
+func TestManagementResource_PutUserAuthSecret_PasswordPolicy(t *testing.T) {
+	var (
+		previousPassword  = "previousPassword1!"
+		adminUser         = model.User{Unique: model.Unique{ID: must.NewUUIDv4()}}
+		targetUser        = model.User{AuthSecret: defaultDigestAuthSecret(t, "currentPassword1!"), Unique: model.Unique{ID: must.NewUUIDv4()}}
+		previousSecret    = defaultDigestAuthSecret(t, previousPassword)
+		mockCtrl          = gomock.NewController(t)
+		resources, mockDB = apitest.NewAuthManagementResource(mockCtrl)
+		passwordPolicy    = appcfg.Parameter{
+			Key: appcfg.PasswordPolicy,
+			Value: must.NewJSONBObject(appcfg.PasswordPolicyParameters{
+				MinLength:              16,
+				MinLowercase:           1,
+				MinUppercase:           1,
+				MinSpecial:             1,
+				MinNumeric:             1,
+				HistoryCount:           3,
+				LockoutThreshold:       0,
+				LockoutDurationMinutes: 15,
+			}),
+		}
+	)
+	defer mockCtrl.Finish()
+
+	bhCtx := ctx.Get(context.WithValue(context.Background(), ctx.ValueKey, &ctx.Context{}))
+	bhCtx.AuthCtx.Owner = adminUser
+
+	mockDB.EXPECT().GetUser(gomock.Any(), targetUser.ID).Return(targetUser, nil).Times(2)
+	mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.PasswordPolicy).Return(passwordPolicy, nil).Times(2)
+
+	// Secrets that are too short for the configured policy are rejected
+	test.Request(t).
+		WithContext(bhCtx).
+		WithMethod(http.MethodPut).
+		WithHeader(headers.RequestID.String(), "requestID").
+		WithURL(updateUserSecretPathFmt, targetUser.ID.String()).
+		WithURLPathVars(map[string]string{"user_id": targetUser.ID.String()}).
+		WithBody(v2.SetUserSecretRequest{
+			Secret: "tesT12345!@#$",
+		}).
+		OnHandlerFunc(resources.PutUserAuthSecret).
+		Require().
+		ResponseStatusCode(http.StatusBadRequest)
+
+	// Secrets matching a recently used secret are rejected
+	mockDB.EXPECT().GetAuthSecretHistory(gomock.Any(), targetUser.ID, 2).Return([]model.AuthSecretHistory{{
+		UserID:       targetUser.ID,
+		Digest:       previousSecret.Digest,
+		DigestMethod: previousSecret.DigestMethod,
+	}}, nil)
+	test.Request(t).
+		WithContext(bhCtx).
+		WithMethod(http.MethodPut).
+		WithHeader(headers.RequestID.String(), "requestID").
+		WithURL(updateUserSecretPathFmt, targetUser.ID.String()).
+		WithURLPathVars(map[string]string{"user_id": targetUser.ID.String()}).
+		WithBody(v2.SetUserSecretRequest{
+			Secret: previousPassword,
+		}).
+		OnHandlerFunc(resources.PutUserAuthSecret).
+		Require().
+		ResponseJSONBody(
+			api.ErrorWrapper{
+				HTTPStatus: http.StatusBadRequest,
+				Errors:     []api.ErrorDetails{{Message: fmt.Sprintf(auth.ErrResponseDetailsSecretReused, 3)}},
+			},
+		)
+}
+
 func TestManagementResource_EnableUserSAML(t *testing.T) {
 	var (
 		mockCtrl          = gomock.NewController(t)
@@ -935,7 +1013,7 @@ func TestManagementResource_GetRole(t *testing.T) {
 			expected: expected{
 				responseCode:   http.StatusOK,
 				responseHeader: http.Header{"Content-Type": []string{"application/json"}},
-				responseBody:   `{"data":{"created_at":"0001-01-01T00:00:00Z","deleted_at":{"Time":"0001-01-01T00:00:00Z","Valid":false},"description":"System administrator role","id":123,"name":"Administrator","permissions":[{"authority":"read:users","created_at":"0001-01-01T00:00:00Z","deleted_at":{"Time":"0001-01-01T00:00:00Z","Valid":false},"id":1,"name":"Read Users","updated_at":"0001-01-01T00:00:00Z"},{"authority":"write:users","created_at":"0001-01-01T00:00:00Z","deleted_at":{"Time":"0001-01-01T00:00:00Z","Valid":false},"id":2,"name":"Write Users","updated_at":"0001-01-01T00:00:00Z"}],"updated_at":"0001-01-01T00:00:00Z"}}`,
+				responseBody:   `{"data":{"created_at":"0001-01-01T00:00:00Z","custom":false,"deleted_at":{"Time":"0001-01-01T00:00:00Z","Valid":false},"description":"System administrator role","id":123,"name":"Administrator","permissions":[{"authority":"read:users","created_at":"0001-01-01T00:00:00Z","deleted_at":{"Time":"0001-01-01T00:00:00Z","Valid":false},"id":1,"name":"Read Users","updated_at":"0001-01-01T00:00:00Z"},{"authority":"write:users","created_at":"0001-01-01T00:00:00Z","deleted_at":{"Time":"0001-01-01T00:00:00Z","Valid":false},"id":2,"name":"Write Users","updated_at":"0001-01-01T00:00:00Z"}],"updated_at":"0001-01-01T00:00:00Z"}}`,
 			},
 		},
 	}
@@ -1305,6 +1383,7 @@ func TestCreateUser_Failure(t *testing.T) {
 	}
 
 	resources, mockDB := apitest.NewAuthManagementResource(mockCtrl)
+	mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.PasswordPolicy).Return(appcfg.Parameter{}, database.ErrNotFound).AnyTimes()
 	mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.PasswordExpirationWindow).Return(appcfg.Parameter{
 		Key: appcfg.PasswordExpirationWindow,
 		Value: must.NewJSONBObject(appcfg.PasswordExpiration{
@@ -1421,6 +1500,7 @@ func TestCreateUser_FailureDuplicateEmail(t *testing.T) {
 
 	resources, mockDB := apitest.NewAuthManagementResource(mockCtrl)
 	mockDB.EXPECT().GetRoles(gomock.Any(), gomock.Any()).Return(model.Roles{}, nil)
+	mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.PasswordPolicy).Return(appcfg.Parameter{}, database.ErrNotFound).AnyTimes()
 	mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.PasswordExpirationWindow).Return(appcfg.Parameter{
 		Key: appcfg.PasswordExpirationWindow,
 		Value: must.NewJSONBObject(appcfg.PasswordExpiration{
@@ -1465,6 +1545,7 @@ func TestCreateUser_Success(t *testing.T) {
 	goodUser := model.User{PrincipalName: "good user"}
 
 	resources, mockDB := apitest.NewAuthManagementResource(mockCtrl)
+	mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.PasswordPolicy).Return(appcfg.Parameter{}, database.ErrNotFound).AnyTimes()
 	mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.PasswordExpirationWindow).Return(appcfg.Parameter{
 		Key: appcfg.PasswordExpirationWindow,
 		Value: must.NewJSONBObject(appcfg.PasswordExpiration{
@@ -1518,6 +1599,7 @@ func TestCreateUser_ResetPassword(t *testing.T) {
 	defer mockCtrl.Finish()
 
 	resources, mockDB := apitest.NewAuthManagementResource(mockCtrl)
+	mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.PasswordPolicy).Return(appcfg.Parameter{}, database.ErrNotFound).AnyTimes()
 	mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.PasswordExpirationWindow).Return(appcfg.Parameter{
 		Key: appcfg.PasswordExpirationWindow,
 		Value: must.NewJSONBObject(appcfg.PasswordExpiration{
@@ -1591,6 +1673,7 @@ func TestManagementResource_UpdateUser_IDMalformed(t *testing.T) {
 	}
 
 	resources, mockDB := apitest.NewAuthManagementResource(mockCtrl)
+	mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.PasswordPolicy).Return(appcfg.Parameter{}, database.ErrNotFound).AnyTimes()
 	mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.PasswordExpirationWindow).Return(appcfg.Parameter{
 		Key: appcfg.PasswordExpirationWindow,
 		Value: must.NewJSONBObject(appcfg.PasswordExpiration{
@@ -1654,6 +1737,7 @@ func TestManagementResource_UpdateUser_GetUserError(t *testing.T) {
 	}
 
 	resources, mockDB := apitest.NewAuthManagementResource(mockCtrl)
+	mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.PasswordPolicy).Return(appcfg.Parameter{}, database.ErrNotFound).AnyTimes()
 	mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.PasswordExpirationWindow).Return(appcfg.Parameter{
 		Key: appcfg.PasswordExpirationWindow,
 		Value: must.NewJSONBObject(appcfg.PasswordExpiration{
@@ -1718,6 +1802,7 @@ func TestManagementResource_UpdateUser_GetRolesError(t *testing.T) {
 	}
 
 	resources, mockDB := apitest.NewAuthManagementResource(mockCtrl)
+	mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.PasswordPolicy).Return(appcfg.Parameter{}, database.ErrNotFound).AnyTimes()
 	mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.PasswordExpirationWindow).Return(appcfg.Parameter{
 		Key: appcfg.PasswordExpirationWindow,
 		Value: must.NewJSONBObject(appcfg.PasswordExpiration{
@@ -1809,6 +1894,7 @@ func TestManagementResource_UpdateUser_SelfDisable(t *testing.T) {
 		resources, mockDB = apitest.NewAuthManagementResource(mockCtrl)
 	)
 
+	mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.PasswordPolicy).Return(appcfg.Parameter{}, database.ErrNotFound).AnyTimes()
 	mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.PasswordExpirationWindow).Return(appcfg.Parameter{
 		Key: appcfg.PasswordExpirationWindow,
 		Value: must.NewJSONBObject(appcfg.PasswordExpiration{
@@ -1951,6 +2037,7 @@ func TestManagementResource_UpdateUser_LookupActiveSessionsError(t *testing.T) {
 	isDisabled := true
 
 	resources, mockDB := apitest.NewAuthManagementResource(mockCtrl)
+	mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.PasswordPolicy).Return(appcfg.Parameter{}, database.ErrNotFound).AnyTimes()
 	mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.PasswordExpirationWindow).Return(appcfg.Parameter{
 		Key: appcfg.PasswordExpirationWindow,
 		Value: must.NewJSONBObject(appcfg.PasswordExpiration{
@@ -2032,6 +2119,7 @@ func TestManagementResource_UpdateUser_DBError(t *testing.T) {
 	}
 
 	resources, mockDB := apitest.NewAuthManagementResource(mockCtrl)
+	mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.PasswordPolicy).Return(appcfg.Parameter{}, database.ErrNotFound).AnyTimes()
 	mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.PasswordExpirationWindow).Return(appcfg.Parameter{
 		Key: appcfg.PasswordExpirationWindow,
 		Value: must.NewJSONBObject(appcfg.PasswordExpiration{
@@ -2187,7 +2275,7 @@ func TestManagementResource_GetUser(t *testing.T) {
 			expected: expected{
 				responseCode:   http.StatusOK,
 				responseHeader: http.Header{"Content-Type": []string{"application/json"}},
-				responseBody:   `{"data":{"AuthSecret":null,"created_at":"0001-01-01T00:00:00Z","deleted_at":{"Time":"0001-01-01T00:00:00Z","Valid":false},"email_address":"john.doe@example.com","eula_accepted":false,"first_name":"John","id":"00000000-0000-0000-0000-000000000001","is_disabled":false,"last_login":"0001-01-01T00:00:00Z","last_name":"Doe","principal_name":"john.doe","roles":null,"sso_provider_id":null,"updated_at":"0001-01-01T00:00:00Z"}}`,
+				responseBody:   `{"data":{"AuthSecret":null,"created_at":"0001-01-01T00:00:00Z","deleted_at":{"Time":"0001-01-01T00:00:00Z","Valid":false},"email_address":"john.doe@example.com","eula_accepted":false,"first_name":"John","id":"00000000-0000-0000-0000-000000000001","is_disabled":false,"last_login":"0001-01-01T00:00:00Z","last_name":"Doe","locked_until":null,"principal_name":"john.doe","roles":null,"sso_provider_id":null,"updated_at":"0001-01-01T00:00:00Z"}}`,
 			},
 		},
 	}
@@ -2270,7 +2358,7 @@ func TestManagementResource_GetSelf(t *testing.T) {
 			expected: expected{
 				responseCode:   http.StatusOK,
 				responseHeader: http.Header{"Content-Type": []string{"application/json"}},
-				responseBody:   `{"data":{"AuthSecret":null,"created_at":"0001-01-01T00:00:00Z","deleted_at":{"Time":"0001-01-01T00:00:00Z","Valid":false},"email_address":"john.doe@example.com","eula_accepted":false,"first_name":"John","id":"00000000-0000-0000-0000-000000000000","is_disabled":false,"last_login":"0001-01-01T00:00:00Z","last_name":"Doe","principal_name":"john.doe","roles":[{"created_at":"0001-01-01T00:00:00Z","deleted_at":{"Time":"0001-01-01T00:00:00Z","Valid":false},"description":"The big boy.","id":0,"name":"Big Boy","permissions":[],"updated_at":"0001-01-01T00:00:00Z"}],"sso_provider_id":null,"updated_at":"0001-01-01T00:00:00Z"}}`,
+				responseBody:   `{"data":{"AuthSecret":null,"created_at":"0001-01-01T00:00:00Z","deleted_at":{"Time":"0001-01-01T00:00:00Z","Valid":false},"email_address":"john.doe@example.com","eula_accepted":false,"first_name":"John","id":"00000000-0000-0000-0000-000000000000","is_disabled":false,"last_login":"0001-01-01T00:00:00Z","last_name":"Doe","locked_until":null,"principal_name":"john.doe","roles":[{"created_at":"0001-01-01T00:00:00Z","custom":false,"deleted_at":{"Time":"0001-01-01T00:00:00Z","Valid":false},"description":"The big boy.","id":0,"name":"Big Boy","permissions":[],"updated_at":"0001-01-01T00:00:00Z"}],"sso_provider_id":null,"updated_at":"0001-01-01T00:00:00Z"}}`,
 			},
 		},
 		{
@@ -2292,7 +2380,7 @@ func TestManagementResource_GetSelf(t *testing.T) {
 			expected: expected{
 				responseCode:   http.StatusOK,
 				responseHeader: http.Header{"Content-Type": []string{"application/json"}},
-				responseBody:   `{"data":{"AuthSecret":null,"created_at":"0001-01-01T00:00:00Z","deleted_at":{"Time":"0001-01-01T00:00:00Z","Valid":false},"email_address":null,"eula_accepted":false,"first_name":null,"id":"00000000-0000-0000-0000-000000000000","is_disabled":false,"last_login":"0001-01-01T00:00:00Z","last_name":null,"principal_name":"","roles":null,"sso_provider_id":null,"updated_at":"0001-01-01T00:00:00Z"}}`,
+				responseBody:   `{"data":{"AuthSecret":null,"created_at":"0001-01-01T00:00:00Z","deleted_at":{"Time":"0001-01-01T00:00:00Z","Valid":false},"email_address":null,"eula_accepted":false,"first_name":null,"id":"00000000-0000-0000-0000-000000000000","is_disabled":false,"last_login":"0001-01-01T00:00:00Z","last_name":null,"locked_until":null,"principal_name":"","roles":null,"sso_provider_id":null,"updated_at":"0001-01-01T00:00:00Z"}}`,
 			},
 		},
 	}
@@ -2576,6 +2664,7 @@ func TestManagementResource_UpdateUser_Success(t *testing.T) {
 	isDisabled := true
 
 	resources, mockDB := apitest.NewAuthManagementResource(mockCtrl)
+	mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.PasswordPolicy).Return(appcfg.Parameter{}, database.ErrNotFound).AnyTimes()
 	mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.PasswordExpirationWindow).Return(appcfg.Parameter{
 		Key: appcfg.PasswordExpirationWindow,
 		Value: must.NewJSONBObject(appcfg.PasswordExpiration{
@@ -3473,7 +3562,7 @@ func TestManagementResource_CreateAuthToken(t *testing.T) {
 			expected: expected{
 				responseCode:   http.StatusOK,
 				responseHeader: http.Header{"Content-Type": []string{"application/json"}},
-				responseBody:   `{"data":{"created_at":"0001-01-01T00:00:00Z","deleted_at":{"Time":"0001-01-01T00:00:00Z","Valid":false},"hmac_method":"hmac-sha2-256","id":"00000000-0000-0000-0000-000000000000","key":"key","last_access":"0001-01-01T00:00:00Z","name":"name","updated_at":"0001-01-01T00:00:00Z","user_id":null}}`,
+				responseBody:   `{"data":{"allowed_cidrs":null,"created_at":"0001-01-01T00:00:00Z","deleted_at":{"Time":"0001-01-01T00:00:00Z","Valid":false},"expires_at":null,"hmac_method":"hmac-sha2-256","id":"00000000-0000-0000-0000-000000000000","key":"key","last_access":"0001-01-01T00:00:00Z","name":"name","permissions":null,"updated_at":"0001-01-01T00:00:00Z","user_id":null}}`,
 			},
 		},
 	}
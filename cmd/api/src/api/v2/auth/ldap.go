@@ -0,0 +1,308 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	"github.com/specterops/bloodhound/cmd/api/src/config"
+	"github.com/specterops/bloodhound/cmd/api/src/database"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/services/ldap"
+	"github.com/specterops/bloodhound/cmd/api/src/utils/validation"
+)
+
+var ErrLDAPProviderMissing = errors.New("ldap provider missing")
+
+// UpsertLDAPProviderRequest represents the body of create & update provider endpoints. Optional settings that may be
+// cleared are pointers so that an update can tell an omitted field apart from an empty one.
+type UpsertLDAPProviderRequest struct {
+	Name              string                   `json:"name" validate:"required"`
+	URL               string                   `json:"url" validate:"required"`
+	StartTLS          *bool                    `json:"start_tls,omitempty"`
+	AllowInsecure     *bool                    `json:"allow_insecure,omitempty"`
+	CACertificate     *string                  `json:"ca_certificate,omitempty"`
+	BindDN            *string                  `json:"bind_dn,omitempty"`
+	BindPassword      *string                  `json:"bind_password,omitempty"`
+	UserBaseDN        string                   `json:"user_base_dn" validate:"required"`
+	UserFilter        *string                  `json:"user_filter,omitempty"`
+	UsernameAttribute string                   `json:"username_attribute" validate:"required"`
+	EmailAttribute    string                   `json:"email_attribute,omitempty"`
+	GroupAttribute    string                   `json:"group_attribute,omitempty"`
+	Config            *model.SSOProviderConfig `json:"config,omitempty"`
+}
+
+// CreateLDAPProvider creates an LDAP provider entry given a valid request
+func (s ManagementResource) CreateLDAPProvider(response http.ResponseWriter, request *http.Request) {
+	var upsertReq UpsertLDAPProviderRequest
+
+	if err := api.ReadJSONRequestPayloadLimited(&upsertReq, request); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
+	} else if validated := validation.Validate(upsertReq); validated != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, validated.Error(), request), response)
+	} else if upsertReq.Config == nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "config is required", request), response)
+	} else if _, err := s.db.GetRole(request.Context(), upsertReq.Config.AutoProvision.DefaultRoleId); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "role id is invalid", request), response)
+	} else if ssoProvider, err := updateLDAPProvider(request.Context(), model.SSOProvider{LDAPProvider: &model.LDAPProvider{}}, upsertReq, s.config.Crypto.Secrets, s.db); err != nil {
+		writeLDAPProviderError(err, response, request)
+	} else if ldapProvider, err := s.db.CreateLDAPProvider(request.Context(), upsertReq.Name, *ssoProvider.LDAPProvider, *upsertReq.Config); errors.Is(err, database.ErrDuplicateSSOProviderName) {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusConflict, api.ErrorResponseSSOProviderDuplicateName, request), response)
+	} else if err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		api.WriteBasicResponse(request.Context(), ldapProvider, http.StatusCreated, response)
+	}
+}
+
+// UpdateLDAPProviderRequest updates an LDAP provider, support for only partial payloads
+func (s ManagementResource) UpdateLDAPProviderRequest(response http.ResponseWriter, request *http.Request, ssoProvider model.SSOProvider) {
+	var upsertReq UpsertLDAPProviderRequest
+
+	if err := api.ReadJSONRequestPayloadLimited(&upsertReq, request); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
+	} else if ssoProvider, err := updateLDAPProvider(request.Context(), ssoProvider, upsertReq, s.config.Crypto.Secrets, s.db); err != nil {
+		writeLDAPProviderError(err, response, request)
+	} else if ldapProvider, err := s.db.UpdateLDAPProvider(request.Context(), ssoProvider); errors.Is(err, database.ErrDuplicateSSOProviderName) {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusConflict, api.ErrorResponseSSOProviderDuplicateName, request), response)
+	} else if err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		api.WriteBasicResponse(request.Context(), ldapProvider, http.StatusOK, response)
+	}
+}
+
+func writeLDAPProviderError(err error, response http.ResponseWriter, request *http.Request) {
+	if errors.Is(err, ErrLDAPProviderMissing) {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusNotFound, api.ErrorResponseDetailsResourceNotFound, request), response)
+	} else if errors.Is(err, ldap.ErrInvalidURL) || errors.Is(err, ldap.ErrInsecureConnection) || errors.Is(err, ldap.ErrInvalidFilter) || errors.Is(err, ldap.ErrInvalidCACertificate) || errors.Is(err, ErrRoleMappingInvalid) || errors.Is(err, config.ErrSecretsEncryptionKeyMissing) {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
+	} else if errors.Is(err, ErrRoleIDInvalid) {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "role id is invalid", request), response)
+	} else {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, api.ErrorResponseDetailsInternalServerError, request), response)
+	}
+}
+
+func updateLDAPProvider(ctx context.Context, ssoProvider model.SSOProvider, upsertReq UpsertLDAPProviderRequest, secrets config.SecretsConfiguration, r getRoler) (model.SSOProvider, error) {
+	if ssoProvider.LDAPProvider == nil {
+		return ssoProvider, ErrLDAPProviderMissing
+	}
+
+	ldapProvider := ssoProvider.LDAPProvider
+
+	if upsertReq.Name != "" {
+		ssoProvider.Name = upsertReq.Name
+	}
+
+	if upsertReq.URL != "" {
+		if _, _, err := ldap.ParseURL(upsertReq.URL); err != nil {
+			return ssoProvider, err
+		}
+
+		ldapProvider.URL = upsertReq.URL
+	}
+
+	if upsertReq.StartTLS != nil {
+		ldapProvider.StartTLS = *upsertReq.StartTLS
+	}
+
+	if upsertReq.AllowInsecure != nil {
+		ldapProvider.AllowInsecure = *upsertReq.AllowInsecure
+	}
+
+	if upsertReq.CACertificate != nil {
+		ldapProvider.CACertificate = strings.TrimSpace(*upsertReq.CACertificate)
+	}
+
+	if upsertReq.BindDN != nil {
+		ldapProvider.BindDN = *upsertReq.BindDN
+	}
+
+	// The bind password is only ever stored sealed with the configured secrets encryption key
+	if upsertReq.BindPassword == nil {
+		// Keep the existing bind password
+	} else if *upsertReq.BindPassword == "" {
+		ldapProvider.BindPassword = ""
+	} else if secretBox, err := secrets.NewSecretBox(); err != nil {
+		return ssoProvider, err
+	} else if sealedBindPassword, err := secretBox.Seal(*upsertReq.BindPassword); err != nil {
+		return ssoProvider, err
+	} else {
+		ldapProvider.BindPassword = sealedBindPassword
+	}
+
+	if upsertReq.UserBaseDN != "" {
+		ldapProvider.UserBaseDN = upsertReq.UserBaseDN
+	}
+
+	if upsertReq.UserFilter != nil {
+		ldapProvider.UserFilter = strings.TrimSpace(*upsertReq.UserFilter)
+	}
+
+	if upsertReq.UsernameAttribute != "" {
+		ldapProvider.UsernameAttribute = upsertReq.UsernameAttribute
+	}
+
+	if upsertReq.EmailAttribute != "" {
+		ldapProvider.EmailAttribute = upsertReq.EmailAttribute
+	} else if ldapProvider.EmailAttribute == "" {
+		ldapProvider.EmailAttribute = ldap.DefaultEmailAttribute
+	}
+
+	if upsertReq.GroupAttribute != "" {
+		ldapProvider.GroupAttribute = upsertReq.GroupAttribute
+	} else if ldapProvider.GroupAttribute == "" {
+		ldapProvider.GroupAttribute = ldap.DefaultGroupAttribute
+	}
+
+	// Validate the resulting user search filter once to reject an invalid user filter before it is saved
+	if err := ldap.ValidateFilter(ldap.UserSearchFilter(*ldapProvider, "user")); err != nil {
+		return ssoProvider, err
+	}
+
+	if err := ldap.ValidateTransport(*ldapProvider); err != nil {
+		return ssoProvider, err
+	}
+
+	if ldapProvider.CACertificate != "" {
+		if _, err := ldap.TLSConfig(*ldapProvider); err != nil {
+			return ssoProvider, err
+		}
+	}
+
+	// Need to ensure that if no config is specified, we don't accidentally wipe the existing configuration
+	if upsertReq.Config != nil {
+		if !upsertReq.Config.AutoProvision.Enabled {
+			ssoProvider.Config.AutoProvision = model.SSOProviderAutoProvisionConfig{}
+		} else if _, err := r.GetRole(ctx, upsertReq.Config.AutoProvision.DefaultRoleId); err != nil {
+			return ssoProvider, ErrRoleIDInvalid
+		} else if err := validateRoleMappings(ctx, upsertReq.Config.AutoProvision, r); err != nil {
+			return ssoProvider, err
+		} else {
+			ssoProvider.Config.AutoProvision = upsertReq.Config.AutoProvision
+		}
+	}
+
+	return ssoProvider, nil
+}
+
+// LDAPLoginHandler authenticates the username and secret posted by the login form with a bind against the provider's
+// directory. Credentials are only read from the request body.
+func (s ManagementResource) LDAPLoginHandler(response http.ResponseWriter, request *http.Request, ssoProvider model.SSOProvider) {
+	var (
+		username = strings.TrimSpace(request.PostFormValue(api.FormParameterUsername))
+		secret   = request.PostFormValue(api.FormParameterSecret)
+	)
+
+	if ssoProvider.LDAPProvider == nil {
+		// SSO misconfiguration scenario
+		api.RedirectToLoginURL(response, request, "Your SSO connection failed due to misconfiguration, please contact your Administrator")
+	} else if username == "" || secret == "" {
+		api.RedirectToLoginURL(response, request, "Invalid request: username and password are required")
+	} else if ldapProvider, err := openLDAPProvider(*ssoProvider.LDAPProvider, s.config.Crypto.Secrets); err != nil {
+		slog.ErrorContext(request.Context(), fmt.Sprintf("[LDAP] Unable to decrypt the bind password of provider %s, it must be saved again: %v", ssoProvider.Name, err))
+		api.RedirectToLoginURL(response, request, "Your SSO connection failed due to misconfiguration, please contact your Administrator")
+	} else if ldapUser, err := s.LDAP.Authenticate(request.Context(), ldapProvider, username, secret); errors.Is(err, ldap.ErrInvalidCredentials) || errors.Is(err, ldap.ErrUserNotFound) {
+		slog.InfoContext(request.Context(), fmt.Sprintf("[LDAP] Authentication failed for user %s: %v", username, err))
+		api.RedirectToLoginURL(response, request, "Your username or password is incorrect")
+	} else if err != nil {
+		// Directory unreachable, service account rejected, ambiguous user search and the like
+		slog.WarnContext(request.Context(), fmt.Sprintf("[LDAP] Failed to authenticate user %s: %v", username, err))
+		api.RedirectToLoginURL(response, request, "Your SSO connection failed due to misconfiguration, please contact your Administrator")
+	} else {
+		if ssoProvider.Config.AutoProvision.Enabled {
+			if err := jitLDAPUserUpsert(request.Context(), ssoProvider, ldapUser, s.db); err != nil {
+				// It is safe to let this request drop into the CreateSSOSession function below to ensure proper audit logging
+				slog.WarnContext(request.Context(), fmt.Sprintf("[LDAP] Error during JIT User Creation: %v", err))
+			}
+		}
+
+		s.authenticator.CreateSSOSession(request, response, ldapUser.Username, ssoProvider)
+	}
+}
+
+// openLDAPProvider returns a copy of the provider with its stored bind password decrypted
+func openLDAPProvider(ldapProvider model.LDAPProvider, secrets config.SecretsConfiguration) (model.LDAPProvider, error) {
+	if ldapProvider.BindPassword == "" {
+		return ldapProvider, nil
+	} else if secretBox, err := secrets.NewSecretBox(); err != nil {
+		return ldapProvider, err
+	} else if bindPassword, err := secretBox.Open(ldapProvider.BindPassword); err != nil {
+		return ldapProvider, err
+	} else {
+		ldapProvider.BindPassword = bindPassword
+		return ldapProvider, nil
+	}
+}
+
+func jitLDAPUserUpsert(ctx context.Context, ssoProvider model.SSOProvider, ldapUser ldap.User, u jitUserUpserter) error {
+	// Group DNs are the claim values matched by the provider's role mappings
+	if roles, err := SanitizeAndGetRoles(ctx, ssoProvider.Config.AutoProvision, ldapUser.Groups, u); err != nil {
+		return fmt.Errorf("sanitize roles: %v", err)
+	} else if len(roles) != 1 {
+		return fmt.Errorf("invalid roles")
+	} else if user, err := u.LookupUser(ctx, ldapUser.Username); err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return jitLDAPUserCreate(ctx, ssoProvider, ldapUser, u, roles)
+		}
+		return fmt.Errorf("user lookup: %v", err)
+	} else if ssoProvider.Config.AutoProvision.RoleProvision && provisionedRolesChanged(user, roles) {
+		//  roles should only ever have 1 role
+		user.Roles = roles
+		if err := u.UpdateUser(ctx, user); err != nil {
+			return fmt.Errorf("update user: %v", err)
+		}
+	}
+
+	return nil
+}
+
+func jitLDAPUserCreate(ctx context.Context, ssoProvider model.SSOProvider, ldapUser ldap.User, u jitUserUpserter, roles model.Roles) error {
+	user := model.User{
+		PrincipalName: ldapUser.Username,
+		Roles:         roles,
+		SSOProviderID: null.Int32From(ssoProvider.ID),
+		EULAAccepted:  true, // EULA Acceptance does not pertain to Bloodhound Community Edition; this flag is used for Bloodhound Enterprise users
+		FirstName:     null.StringFrom(ldapUser.Username),
+		LastName:      null.StringFrom("Last name not found"),
+	}
+
+	if ldapUser.Email != "" {
+		user.EmailAddress = null.StringFrom(ldapUser.Email)
+	}
+
+	if ldapUser.FirstName != "" {
+		user.FirstName = null.StringFrom(ldapUser.FirstName)
+	}
+
+	if ldapUser.LastName != "" {
+		user.LastName = null.StringFrom(ldapUser.LastName)
+	}
+
+	if _, err := u.CreateUser(ctx, user); err != nil {
+		return fmt.Errorf("create user: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,550 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	apimocks "github.com/specterops/bloodhound/cmd/api/src/api/mocks"
+	"github.com/specterops/bloodhound/cmd/api/src/api/v2/apitest"
+	v2auth "github.com/specterops/bloodhound/cmd/api/src/api/v2/auth"
+	"github.com/specterops/bloodhound/cmd/api/src/auth"
+	"github.com/specterops/bloodhound/cmd/api/src/config"
+	"github.com/specterops/bloodhound/cmd/api/src/ctx"
+	"github.com/specterops/bloodhound/cmd/api/src/database"
+	"github.com/specterops/bloodhound/cmd/api/src/database/mocks"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/services/ldap"
+	ldapmocks "github.com/specterops/bloodhound/cmd/api/src/services/ldap/mocks"
+	"github.com/specterops/bloodhound/cmd/api/src/utils/test"
+	"github.com/specterops/bloodhound/packages/go/headers"
+	"github.com/specterops/bloodhound/packages/go/mediatypes"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var ldapTestRoles = model.Roles{
+	{Name: auth.RoleAdministrator, Serial: model.Serial{ID: 1}},
+	{Name: auth.RoleReadOnly, Serial: model.Serial{ID: 3}},
+}
+
+func TestManagementResource_CreateLDAPProvider(t *testing.T) {
+	var (
+		mockCtrl          = gomock.NewController(t)
+		resources, mockDB = apitest.NewAuthManagementResource(mockCtrl)
+		bindDN            = "cn=bloodhound,ou=services,dc=example,dc=org"
+		bindPassword      = "service-password"
+		userFilter        = "(&(objectClass=user)(!(userAccountControl:1.2.840.113556.1.4.803:=2)))"
+		config            = model.SSOProviderConfig{
+			AutoProvision: model.SSOProviderAutoProvisionConfig{
+				Enabled:       true,
+				DefaultRoleId: 3,
+				RoleProvision: true,
+				RoleMappings:  []model.SSOProviderRoleMapping{{ClaimValue: "CN=BloodHound Admins,OU=Groups,DC=lab,DC=local", RoleID: 1}},
+			},
+		}
+	)
+	defer mockCtrl.Finish()
+
+	newRequest := func() v2auth.UpsertLDAPProviderRequest {
+		return v2auth.UpsertLDAPProviderRequest{
+			Name:              "Lab AD",
+			URL:               "ldaps://dc01.lab.local",
+			BindDN:            &bindDN,
+			BindPassword:      &bindPassword,
+			UserBaseDN:        "dc=lab,dc=local",
+			UserFilter:        &userFilter,
+			UsernameAttribute: "sAMAccountName",
+			Config:            &config,
+		}
+	}
+
+	t.Run("successfully create a new LDAPProvider", func(t *testing.T) {
+		mockDB.EXPECT().GetRole(gomock.Any(), int32(3)).Return(ldapTestRoles[1], nil).Times(2)
+		mockDB.EXPECT().GetRole(gomock.Any(), int32(1)).Return(ldapTestRoles[0], nil)
+		mockDB.EXPECT().CreateLDAPProvider(gomock.Any(), "Lab AD", gomock.Any(), config).DoAndReturn(func(_ context.Context, _ string, ldapProvider model.LDAPProvider, _ model.SSOProviderConfig) (model.LDAPProvider, error) {
+			secretBox, err := apitest.Secrets.NewSecretBox()
+			require.NoError(t, err)
+
+			require.NotEqual(t, bindPassword, ldapProvider.BindPassword)
+			openedBindPassword, err := secretBox.Open(ldapProvider.BindPassword)
+			require.NoError(t, err)
+			require.Equal(t, bindPassword, openedBindPassword)
+
+			ldapProvider.BindPassword = ""
+			require.Equal(t, model.LDAPProvider{
+				URL:               "ldaps://dc01.lab.local",
+				BindDN:            bindDN,
+				UserBaseDN:        "dc=lab,dc=local",
+				UserFilter:        userFilter,
+				UsernameAttribute: "sAMAccountName",
+				EmailAttribute:    ldap.DefaultEmailAttribute,
+				GroupAttribute:    ldap.DefaultGroupAttribute,
+			}, ldapProvider)
+
+			return model.LDAPProvider{URL: "ldaps://dc01.lab.local", SSOProviderID: 1}, nil
+		})
+
+		test.Request(t).
+			WithBody(newRequest()).
+			OnHandlerFunc(resources.CreateLDAPProvider).
+			Require().
+			ResponseStatusCode(http.StatusCreated)
+	})
+
+	t.Run("error parsing body request", func(t *testing.T) {
+		test.Request(t).
+			OnHandlerFunc(resources.CreateLDAPProvider).
+			Require().
+			ResponseStatusCode(http.StatusBadRequest)
+	})
+
+	t.Run("error missing required fields", func(t *testing.T) {
+		request := newRequest()
+		request.UserBaseDN = ""
+
+		test.Request(t).
+			WithBody(request).
+			OnHandlerFunc(resources.CreateLDAPProvider).
+			Require().
+			ResponseStatusCode(http.StatusBadRequest)
+	})
+
+	t.Run("error missing config", func(t *testing.T) {
+		request := newRequest()
+		request.Config = nil
+
+		test.Request(t).
+			WithBody(request).
+			OnHandlerFunc(resources.CreateLDAPProvider).
+			Require().
+			ResponseStatusCode(http.StatusBadRequest)
+	})
+
+	t.Run("error invalid url scheme", func(t *testing.T) {
+		request := newRequest()
+		request.URL = "https://dc01.lab.local"
+
+		mockDB.EXPECT().GetRole(gomock.Any(), int32(3)).Return(ldapTestRoles[1], nil)
+
+		test.Request(t).
+			WithBody(request).
+			OnHandlerFunc(resources.CreateLDAPProvider).
+			Require().
+			ResponseStatusCode(http.StatusBadRequest)
+	})
+
+	t.Run("error invalid user filter", func(t *testing.T) {
+		var (
+			request       = newRequest()
+			invalidFilter = "objectClass=user"
+		)
+
+		request.UserFilter = &invalidFilter
+
+		mockDB.EXPECT().GetRole(gomock.Any(), int32(3)).Return(ldapTestRoles[1], nil)
+
+		test.Request(t).
+			WithBody(request).
+			OnHandlerFunc(resources.CreateLDAPProvider).
+			Require().
+			ResponseStatusCode(http.StatusBadRequest)
+	})
+
+	t.Run("error mapped role does not exist", func(t *testing.T) {
+		var (
+			request       = newRequest()
+			invalidConfig = config
+		)
+
+		invalidConfig.AutoProvision.RoleMappings = []model.SSOProviderRoleMapping{{ClaimValue: "CN=Everyone,DC=lab,DC=local", RoleID: 42}}
+		request.Config = &invalidConfig
+
+		mockDB.EXPECT().GetRole(gomock.Any(), int32(3)).Return(ldapTestRoles[1], nil).Times(2)
+		mockDB.EXPECT().GetRole(gomock.Any(), int32(42)).Return(model.Role{}, database.ErrNotFound)
+
+		test.Request(t).
+			WithBody(request).
+			OnHandlerFunc(resources.CreateLDAPProvider).
+			Require().
+			ResponseStatusCode(http.StatusBadRequest)
+	})
+
+	t.Run("error duplicate name", func(t *testing.T) {
+		mockDB.EXPECT().GetRole(gomock.Any(), int32(3)).Return(ldapTestRoles[1], nil).Times(2)
+		mockDB.EXPECT().GetRole(gomock.Any(), int32(1)).Return(ldapTestRoles[0], nil)
+		mockDB.EXPECT().CreateLDAPProvider(gomock.Any(), "Lab AD", gomock.Any(), config).Return(model.LDAPProvider{}, database.ErrDuplicateSSOProviderName)
+
+		test.Request(t).
+			WithBody(newRequest()).
+			OnHandlerFunc(resources.CreateLDAPProvider).
+			Require().
+			ResponseStatusCode(http.StatusConflict)
+	})
+}
+
+func TestManagementResource_UpdateLDAPProvider(t *testing.T) {
+	var (
+		mockCtrl          = gomock.NewController(t)
+		resources, mockDB = apitest.NewAuthManagementResource(mockCtrl)
+		urlParams         = map[string]string{api.URIPathVariableSSOProviderID: "1"}
+	)
+	defer mockCtrl.Finish()
+
+	newProvider := func() model.SSOProvider {
+		return model.SSOProvider{
+			Type: model.SessionAuthProviderLDAP,
+			Name: "Lab AD",
+			LDAPProvider: &model.LDAPProvider{
+				URL:               "ldap://dc01.lab.local",
+				StartTLS:          true,
+				BindDN:            "cn=bloodhound,dc=lab,dc=local",
+				BindPassword:      "service-password",
+				UserBaseDN:        "dc=lab,dc=local",
+				UsernameAttribute: "sAMAccountName",
+				EmailAttribute:    ldap.DefaultEmailAttribute,
+				GroupAttribute:    ldap.DefaultGroupAttribute,
+				Serial:            model.Serial{ID: 2},
+			},
+			Serial: model.Serial{ID: 1},
+		}
+	}
+
+	t.Run("successfully update an LDAPProvider with a partial payload", func(t *testing.T) {
+		var (
+			expected  = newProvider()
+			startTLS  = false
+			emptyBind = ""
+		)
+
+		expected.Name = "Lab AD 2"
+		expected.LDAPProvider.URL = "ldaps://dc02.lab.local"
+		expected.LDAPProvider.StartTLS = false
+		expected.LDAPProvider.BindDN = ""
+
+		mockDB.EXPECT().GetSSOProviderById(gomock.Any(), int32(1)).Return(newProvider(), nil)
+		mockDB.EXPECT().UpdateLDAPProvider(gomock.Any(), expected).Return(*expected.LDAPProvider, nil)
+
+		test.Request(t).
+			WithURLPathVars(urlParams).
+			WithBody(map[string]any{
+				"name":      "Lab AD 2",
+				"url":       "ldaps://dc02.lab.local",
+				"start_tls": startTLS,
+				"bind_dn":   emptyBind,
+			}).
+			OnHandlerFunc(resources.UpdateSSOProvider).
+			Require().
+			ResponseStatusCode(http.StatusOK)
+	})
+
+	t.Run("successfully update the bind password", func(t *testing.T) {
+		mockDB.EXPECT().GetSSOProviderById(gomock.Any(), int32(1)).Return(newProvider(), nil)
+		mockDB.EXPECT().UpdateLDAPProvider(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, ssoProvider model.SSOProvider) (model.LDAPProvider, error) {
+			secretBox, err := apitest.Secrets.NewSecretBox()
+			require.NoError(t, err)
+
+			openedBindPassword, err := secretBox.Open(ssoProvider.LDAPProvider.BindPassword)
+			require.NoError(t, err)
+			require.Equal(t, "new-service-password", openedBindPassword)
+
+			return *ssoProvider.LDAPProvider, nil
+		})
+
+		test.Request(t).
+			WithURLPathVars(urlParams).
+			WithBody(map[string]any{"bind_password": "new-service-password"}).
+			OnHandlerFunc(resources.UpdateSSOProvider).
+			Require().
+			ResponseStatusCode(http.StatusOK)
+	})
+
+	t.Run("error updating the bind password without a secrets encryption key", func(t *testing.T) {
+		resources := v2auth.NewManagementResource(config.Configuration{}, mockDB, auth.Authorizer{}, nil)
+
+		mockDB.EXPECT().GetSSOProviderById(gomock.Any(), int32(1)).Return(newProvider(), nil)
+
+		test.Request(t).
+			WithURLPathVars(urlParams).
+			WithBody(map[string]any{"bind_password": "new-service-password"}).
+			OnHandlerFunc(resources.UpdateSSOProvider).
+			Require().
+			ResponseStatusCode(http.StatusBadRequest)
+	})
+
+	t.Run("error disabling start tls on a plaintext url", func(t *testing.T) {
+		mockDB.EXPECT().GetSSOProviderById(gomock.Any(), int32(1)).Return(newProvider(), nil)
+
+		test.Request(t).
+			WithURLPathVars(urlParams).
+			WithBody(map[string]any{"start_tls": false}).
+			OnHandlerFunc(resources.UpdateSSOProvider).
+			Require().
+			ResponseStatusCode(http.StatusBadRequest)
+	})
+
+	t.Run("successfully allow a plaintext url explicitly", func(t *testing.T) {
+		expected := newProvider()
+		expected.LDAPProvider.StartTLS = false
+		expected.LDAPProvider.AllowInsecure = true
+
+		mockDB.EXPECT().GetSSOProviderById(gomock.Any(), int32(1)).Return(newProvider(), nil)
+		mockDB.EXPECT().UpdateLDAPProvider(gomock.Any(), expected).Return(*expected.LDAPProvider, nil)
+
+		test.Request(t).
+			WithURLPathVars(urlParams).
+			WithBody(map[string]any{"start_tls": false, "allow_insecure": true}).
+			OnHandlerFunc(resources.UpdateSSOProvider).
+			Require().
+			ResponseStatusCode(http.StatusOK)
+	})
+
+	t.Run("error invalid role id", func(t *testing.T) {
+		mockDB.EXPECT().GetSSOProviderById(gomock.Any(), int32(1)).Return(newProvider(), nil)
+		mockDB.EXPECT().GetRole(gomock.Any(), int32(7)).Return(model.Role{}, database.ErrNotFound)
+
+		test.Request(t).
+			WithURLPathVars(urlParams).
+			WithBody(v2auth.UpsertLDAPProviderRequest{
+				Config: &model.SSOProviderConfig{
+					AutoProvision: model.SSOProviderAutoProvisionConfig{Enabled: true, DefaultRoleId: 7},
+				},
+			}).
+			OnHandlerFunc(resources.UpdateSSOProvider).
+			Require().
+			ResponseStatusCode(http.StatusBadRequest)
+	})
+
+	t.Run("error missing ldap provider", func(t *testing.T) {
+		provider := newProvider()
+		provider.LDAPProvider = nil
+
+		mockDB.EXPECT().GetSSOProviderById(gomock.Any(), int32(1)).Return(provider, nil)
+
+		test.Request(t).
+			WithURLPathVars(urlParams).
+			WithBody(v2auth.UpsertLDAPProviderRequest{Name: "Lab AD 2"}).
+			OnHandlerFunc(resources.UpdateSSOProvider).
+			Require().
+			ResponseStatusCode(http.StatusNotFound)
+	})
+}
+
+func TestManagementResource_LDAPLoginHandler(t *testing.T) {
+	t.Parallel()
+
+	const adminsGroupDN = "CN=BloodHound Admins,OU=Groups,DC=lab,DC=local"
+
+	type mock struct {
+		mockDatabase      *mocks.MockDatabase
+		mockLDAP          *ldapmocks.MockService
+		mockAuthenticator *apimocks.MockAuthenticator
+	}
+	type testData struct {
+		name             string
+		form             url.Values
+		roleProvision    bool
+		withoutProvider  bool
+		bindPassword     string
+		setupMocks       func(t *testing.T, mock *mock, ssoProvider model.SSOProvider)
+		expectedLocation string
+	}
+
+	newProvider := func(roleProvision bool) model.SSOProvider {
+		return model.SSOProvider{
+			Name: "Lab AD",
+			Type: model.SessionAuthProviderLDAP,
+			Slug: "lab-ad",
+			Config: model.SSOProviderConfig{
+				AutoProvision: model.SSOProviderAutoProvisionConfig{
+					Enabled:       true,
+					DefaultRoleId: 3,
+					RoleProvision: roleProvision,
+					RoleMappings:  []model.SSOProviderRoleMapping{{ClaimValue: adminsGroupDN, RoleID: 1}},
+				},
+			},
+			LDAPProvider: &model.LDAPProvider{
+				URL:               "ldaps://dc01.lab.local",
+				UserBaseDN:        "dc=lab,dc=local",
+				UsernameAttribute: "sAMAccountName",
+				Serial:            model.Serial{ID: 2},
+			},
+			Serial: model.Serial{ID: 1},
+		}
+	}
+
+	secretBox, err := apitest.Secrets.NewSecretBox()
+	require.NoError(t, err)
+
+	sealedBindPassword, err := secretBox.Seal("service-password")
+	require.NoError(t, err)
+
+	ldapUser := ldap.User{
+		DN:        "CN=Alice,OU=People,DC=lab,DC=local",
+		Username:  "alice",
+		Email:     "alice@lab.local",
+		FirstName: "Alice",
+		LastName:  "Liddell",
+		Groups:    []string{"cn=bloodhound admins,ou=groups,dc=lab,dc=local"},
+	}
+
+	tt := []testData{
+		{
+			name:             "Error: No LDAP Provider, Redirect to Login - Found",
+			form:             url.Values{"username": {"alice"}, "secret": {"password"}},
+			withoutProvider:  true,
+			setupMocks:       func(t *testing.T, mock *mock, ssoProvider model.SSOProvider) {},
+			expectedLocation: "/ui/login?error=Your+SSO+connection+failed+due+to+misconfiguration%2C+please+contact+your+Administrator",
+		},
+		{
+			name:             "Error: Missing Credentials, Redirect to Login - Found",
+			form:             url.Values{"username": {"alice"}},
+			setupMocks:       func(t *testing.T, mock *mock, ssoProvider model.SSOProvider) {},
+			expectedLocation: "/ui/login?error=Invalid+request%3A+username+and+password+are+required",
+		},
+		{
+			name: "Error: Invalid Credentials, Redirect to Login - Found",
+			form: url.Values{"username": {"alice"}, "secret": {"wrong"}},
+			setupMocks: func(t *testing.T, mock *mock, ssoProvider model.SSOProvider) {
+				mock.mockLDAP.EXPECT().Authenticate(gomock.Any(), *ssoProvider.LDAPProvider, "alice", "wrong").Return(ldap.User{}, ldap.ErrInvalidCredentials)
+			},
+			expectedLocation: "/ui/login?error=Your+username+or+password+is+incorrect",
+		},
+		{
+			name: "Error: Directory Unavailable, Redirect to Login - Found",
+			form: url.Values{"username": {"alice"}, "secret": {"password"}},
+			setupMocks: func(t *testing.T, mock *mock, ssoProvider model.SSOProvider) {
+				mock.mockLDAP.EXPECT().Authenticate(gomock.Any(), *ssoProvider.LDAPProvider, "alice", "password").Return(ldap.User{}, errors.New("connection refused"))
+			},
+			expectedLocation: "/ui/login?error=Your+SSO+connection+failed+due+to+misconfiguration%2C+please+contact+your+Administrator",
+		},
+		{
+			name:             "Error: Bind Password Cannot Be Decrypted, Redirect to Login - Found",
+			form:             url.Values{"username": {"alice"}, "secret": {"password"}},
+			bindPassword:     "service-password",
+			setupMocks:       func(t *testing.T, mock *mock, ssoProvider model.SSOProvider) {},
+			expectedLocation: "/ui/login?error=Your+SSO+connection+failed+due+to+misconfiguration%2C+please+contact+your+Administrator",
+		},
+		{
+			name:         "Success: Bind Password Is Decrypted Before Authenticating",
+			form:         url.Values{"username": {"alice"}, "secret": {"wrong"}},
+			bindPassword: sealedBindPassword,
+			setupMocks: func(t *testing.T, mock *mock, ssoProvider model.SSOProvider) {
+				openedProvider := *ssoProvider.LDAPProvider
+				openedProvider.BindPassword = "service-password"
+
+				mock.mockLDAP.EXPECT().Authenticate(gomock.Any(), openedProvider, "alice", "wrong").Return(ldap.User{}, ldap.ErrInvalidCredentials)
+			},
+			expectedLocation: "/ui/login?error=Your+username+or+password+is+incorrect",
+		},
+		{
+			name:          "Success: JIT Creates User With Mapped Role",
+			form:          url.Values{"username": {" alice "}, "secret": {"password"}},
+			roleProvision: true,
+			setupMocks: func(t *testing.T, mock *mock, ssoProvider model.SSOProvider) {
+				mock.mockLDAP.EXPECT().Authenticate(gomock.Any(), *ssoProvider.LDAPProvider, "alice", "password").Return(ldapUser, nil)
+				mock.mockDatabase.EXPECT().GetAllRoles(gomock.Any(), "", model.SQLFilter{}).Return(ldapTestRoles, nil)
+				mock.mockDatabase.EXPECT().LookupUser(gomock.Any(), "alice").Return(model.User{}, database.ErrNotFound)
+				mock.mockDatabase.EXPECT().CreateUser(gomock.Any(), model.User{
+					PrincipalName: "alice",
+					EmailAddress:  null.StringFrom("alice@lab.local"),
+					FirstName:     null.StringFrom("Alice"),
+					LastName:      null.StringFrom("Liddell"),
+					Roles:         model.Roles{ldapTestRoles[0]},
+					SSOProviderID: null.Int32From(1),
+					EULAAccepted:  true,
+				}).Return(model.User{}, nil)
+				mock.mockAuthenticator.EXPECT().CreateSSOSession(gomock.Any(), gomock.Any(), "alice", ssoProvider)
+			},
+		},
+		{
+			name: "Success: JIT Falls Back To Default Role Without Role Provision",
+			form: url.Values{"username": {"alice"}, "secret": {"password"}},
+			setupMocks: func(t *testing.T, mock *mock, ssoProvider model.SSOProvider) {
+				mock.mockLDAP.EXPECT().Authenticate(gomock.Any(), *ssoProvider.LDAPProvider, "alice", "password").Return(ldapUser, nil)
+				mock.mockDatabase.EXPECT().GetAllRoles(gomock.Any(), "", model.SQLFilter{}).Return(ldapTestRoles, nil)
+				mock.mockDatabase.EXPECT().LookupUser(gomock.Any(), "alice").Return(model.User{Roles: model.Roles{ldapTestRoles[0]}}, nil)
+				mock.mockAuthenticator.EXPECT().CreateSSOSession(gomock.Any(), gomock.Any(), "alice", ssoProvider)
+			},
+		},
+		{
+			name:          "Success: Role Provision Updates Existing User",
+			form:          url.Values{"username": {"alice"}, "secret": {"password"}},
+			roleProvision: true,
+			setupMocks: func(t *testing.T, mock *mock, ssoProvider model.SSOProvider) {
+				mock.mockLDAP.EXPECT().Authenticate(gomock.Any(), *ssoProvider.LDAPProvider, "alice", "password").Return(ldapUser, nil)
+				mock.mockDatabase.EXPECT().GetAllRoles(gomock.Any(), "", model.SQLFilter{}).Return(ldapTestRoles, nil)
+				mock.mockDatabase.EXPECT().LookupUser(gomock.Any(), "alice").Return(model.User{PrincipalName: "alice", Roles: model.Roles{ldapTestRoles[1]}}, nil)
+				mock.mockDatabase.EXPECT().UpdateUser(gomock.Any(), model.User{PrincipalName: "alice", Roles: model.Roles{ldapTestRoles[0]}}).Return(nil)
+				mock.mockAuthenticator.EXPECT().CreateSSOSession(gomock.Any(), gomock.Any(), "alice", ssoProvider)
+			},
+		},
+	}
+
+	for _, testCase := range tt {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				ctrl  = gomock.NewController(t)
+				mocks = &mock{
+					mockDatabase:      mocks.NewMockDatabase(ctrl),
+					mockLDAP:          ldapmocks.NewMockService(ctrl),
+					mockAuthenticator: apimocks.NewMockAuthenticator(ctrl),
+				}
+				ssoProvider = newProvider(testCase.roleProvision)
+			)
+
+			if testCase.withoutProvider {
+				ssoProvider.LDAPProvider = nil
+			} else {
+				ssoProvider.LDAPProvider.BindPassword = testCase.bindPassword
+			}
+
+			mocks.mockDatabase.EXPECT().GetSSOProviderBySlug(gomock.Any(), "lab-ad").Return(ssoProvider, nil)
+			testCase.setupMocks(t, mocks, ssoProvider)
+
+			resource := v2auth.NewManagementResource(config.Configuration{Crypto: config.CryptoConfiguration{Secrets: apitest.Secrets}}, mocks.mockDatabase, auth.Authorizer{}, mocks.mockAuthenticator)
+			resource.LDAP = mocks.mockLDAP
+
+			request := httptest.NewRequest(http.MethodPost, "/api/v2/sso/lab-ad/login", strings.NewReader(testCase.form.Encode()))
+			request.Header.Set(headers.ContentType.String(), mediatypes.ApplicationXWwwFormUrlencoded.String())
+			request = request.WithContext(context.WithValue(request.Context(), ctx.ValueKey, &ctx.Context{Host: &url.URL{}}))
+
+			response := httptest.NewRecorder()
+
+			router := mux.NewRouter()
+			router.HandleFunc(fmt.Sprintf("/api/v2/sso/{%s}/login", api.URIPathVariableSSOProviderSlug), resource.SSOLoginHandler).Methods(http.MethodPost)
+			router.ServeHTTP(response, request)
+
+			if testCase.expectedLocation != "" {
+				require.Equal(t, http.StatusFound, response.Code)
+				require.Equal(t, testCase.expectedLocation, response.Header().Get("Location"))
+			}
+		})
+	}
+}
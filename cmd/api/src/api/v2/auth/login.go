@@ -46,18 +46,26 @@ func NewLoginResource(cfg config.Configuration, authenticator api.Authenticator,
 	}
 }
 
+func writeLoginError(err error, response http.ResponseWriter, request *http.Request) {
+	if errors.Is(err, api.ErrInvalidAuth) || errors.Is(err, api.ErrNoUserSecret) {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusUnauthorized, api.ErrorResponseDetailsAuthenticationInvalid, request), response)
+	} else if errors.Is(err, auth.ErrInvalidOTP) {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsOTPInvalid, request), response)
+	} else if errors.Is(err, auth.ErrInvalidWebAuthn) || errors.Is(err, auth.ErrWebAuthnSignCount) {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsWebAuthnInvalid, request), response)
+	} else if errors.Is(err, api.ErrWebAuthnRequired) || errors.Is(err, api.ErrNoWebAuthnCredentials) {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
+	} else if errors.Is(err, api.ErrUserDisabled) || errors.Is(err, api.ErrUserLocked) || errors.Is(err, api.ErrPhishingResistantMFARequired) {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusForbidden, err.Error(), request), response)
+	} else {
+		slog.ErrorContext(request.Context(), fmt.Sprintf("Error during authentication for request ID %s: %v", ctx.RequestID(request), err))
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, api.ErrorResponseDetailsInternalServerError, request), response)
+	}
+}
+
 func (s LoginResource) loginSecret(loginRequest api.LoginRequest, response http.ResponseWriter, request *http.Request) {
 	if loginDetails, err := s.authenticator.LoginWithSecret(request.Context(), loginRequest); err != nil {
-		if errors.Is(err, api.ErrInvalidAuth) || errors.Is(err, api.ErrNoUserSecret) {
-			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusUnauthorized, api.ErrorResponseDetailsAuthenticationInvalid, request), response)
-		} else if errors.Is(err, auth.ErrInvalidOTP) {
-			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsOTPInvalid, request), response)
-		} else if errors.Is(err, api.ErrUserDisabled) {
-			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusForbidden, err.Error(), request), response)
-		} else {
-			slog.ErrorContext(request.Context(), fmt.Sprintf("Error during authentication for request ID %s: %v", ctx.RequestID(request), err))
-			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, api.ErrorResponseDetailsInternalServerError, request), response)
-		}
+		writeLoginError(err, response, request)
 	} else {
 		api.WriteBasicResponse(request.Context(), api.LoginResponse{
 			UserID:       loginDetails.User.ID.String(),
@@ -67,6 +75,24 @@ func (s LoginResource) loginSecret(loginRequest api.LoginRequest, response http.
 	}
 }
 
+// BeginWebAuthnLogin validates the username and secret of a local user and returns a WebAuthn challenge for one of the
+// user's registered credentials. The signed challenge is submitted to Login to complete the login.
+func (s LoginResource) BeginWebAuthnLogin(response http.ResponseWriter, request *http.Request) {
+	var loginRequest api.LoginRequest
+
+	if err := api.ReadJSONRequestPayloadLimited(&loginRequest, request); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
+	} else {
+		loginRequest.Username = strings.TrimSpace(loginRequest.Username)
+
+		if requestOptions, err := s.authenticator.BeginWebAuthnLogin(request.Context(), loginRequest); err != nil {
+			writeLoginError(err, response, request)
+		} else {
+			api.WriteBasicResponse(request.Context(), requestOptions, http.StatusOK, response)
+		}
+	}
+}
+
 func (s LoginResource) Login(response http.ResponseWriter, request *http.Request) {
 	var loginRequest api.LoginRequest
 	if err := api.ReadJSONRequestPayloadLimited(&loginRequest, request); err != nil {
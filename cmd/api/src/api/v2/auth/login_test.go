@@ -225,3 +225,47 @@ func TestLoginResource_Logout(t *testing.T) {
 		})
 	}
 }
+
+func TestLoginResource_BeginWebAuthnLogin(t *testing.T) {
+	var (
+		mockCtrl          = gomock.NewController(t)
+		mockAuthenticator = apimocks.NewMockAuthenticator(mockCtrl)
+		resources         = v2auth.NewLoginResource(config.Configuration{}, mockAuthenticator, mocks.NewMockDatabase(mockCtrl))
+		goCtx             = context.WithValue(context.Background(), ctx.ValueKey, &ctx.Context{})
+		loginRequest      = api.LoginRequest{LoginMethod: auth.ProviderTypeSecret, Username: "alice", Secret: "secret"}
+	)
+	defer mockCtrl.Finish()
+
+	cases := []struct {
+		Name         string
+		Options      auth.WebAuthnRequestOptions
+		Err          error
+		ExpectedCode int
+	}{
+		{Name: "success", Options: auth.WebAuthnRequestOptions{Challenge: "challenge", RelyingPartyID: "localhost"}, ExpectedCode: http.StatusOK},
+		{Name: "invalid secret", Err: api.ErrInvalidAuth, ExpectedCode: http.StatusUnauthorized},
+		{Name: "no credentials", Err: api.ErrNoWebAuthnCredentials, ExpectedCode: http.StatusBadRequest},
+		{Name: "locked user", Err: api.ErrUserLocked, ExpectedCode: http.StatusForbidden},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			mockAuthenticator.EXPECT().BeginWebAuthnLogin(gomock.Any(), loginRequest).Return(tc.Options, tc.Err)
+
+			payload, err := json.Marshal(api.LoginRequest{LoginMethod: auth.ProviderTypeSecret, Username: " alice ", Secret: "secret"})
+			assert.Nil(t, err)
+
+			req, err := http.NewRequestWithContext(goCtx, http.MethodPost, "/api/v2/login/webauthn-options", bytes.NewReader(payload))
+			assert.Nil(t, err)
+			req.Header.Set(headers.ContentType.String(), mediatypes.ApplicationJson.String())
+
+			response := httptest.NewRecorder()
+			resources.BeginWebAuthnLogin(response, req)
+
+			assert.Equal(t, tc.ExpectedCode, response.Code)
+			if tc.Err == nil {
+				assert.Contains(t, response.Body.String(), `"rpId":"localhost"`)
+			}
+		})
+	}
+}
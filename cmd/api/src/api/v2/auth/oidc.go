@@ -26,6 +26,7 @@ import (
 	"mime"
 	"net/http"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -50,6 +51,7 @@ var (
 	ErrOIDCProviderMissing  = errors.New("oidc provider missing")
 	ErrOIDCIssuerURLInvalid = errors.New("oidc provider issuer url invalid")
 	ErrRoleIDInvalid        = errors.New("role id invalid")
+	ErrRoleMappingInvalid   = errors.New("role mapping invalid")
 	ErrEmailMissing         = errors.New("email missing")
 )
 
@@ -62,6 +64,34 @@ type oidcClaims struct {
 	PreferredUsername string `json:"preferred_username"` // Present in Entra claims, may be an email
 
 	Roles []string `json:"roles"`
+
+	// All claims of the ID token, used to look up the provider's configured group claim
+	raw map[string]any
+}
+
+// defaultOIDCGroupClaim is the claim read for group role mappings when a provider does not configure one
+const defaultOIDCGroupClaim = "groups"
+
+// claimValues returns the roles claim followed by the values of the given group claim, which may be a string or a list
+func (s oidcClaims) claimValues(groupClaim string) []string {
+	if groupClaim == "" {
+		groupClaim = defaultOIDCGroupClaim
+	}
+
+	values := slices.Clone(s.Roles)
+
+	switch groups := s.raw[groupClaim].(type) {
+	case string:
+		values = append(values, groups)
+	case []any:
+		for _, group := range groups {
+			if groupValue, ok := group.(string); ok {
+				values = append(values, groupValue)
+			}
+		}
+	}
+
+	return values
 }
 
 // UpsertOIDCProviderRequest represents the body of create & update provider endpoints
@@ -84,6 +114,8 @@ func (s ManagementResource) UpdateOIDCProviderRequest(response http.ResponseWrit
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "issuer url is invalid", request), response)
 	} else if errors.Is(err, ErrRoleIDInvalid) {
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "role id is invalid", request), response)
+	} else if errors.Is(err, ErrRoleMappingInvalid) {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
 	} else if err != nil {
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, api.ErrorResponseDetailsInternalServerError, request), response)
 	} else if oidcProvider, err := s.db.UpdateOIDCProvider(request.Context(), ssoProvider); errors.Is(err, database.ErrDuplicateSSOProviderName) {
@@ -122,6 +154,8 @@ func updateOIDCProvider(ctx context.Context, ssoProvider model.SSOProvider, upse
 			ssoProvider.Config.AutoProvision = model.SSOProviderAutoProvisionConfig{}
 		} else if _, err := r.GetRole(ctx, upsertReq.Config.AutoProvision.DefaultRoleId); err != nil {
 			return ssoProvider, ErrRoleIDInvalid
+		} else if err := validateRoleMappings(ctx, upsertReq.Config.AutoProvision, r); err != nil {
+			return ssoProvider, err
 		} else {
 			ssoProvider.Config.AutoProvision = upsertReq.Config.AutoProvision
 		}
@@ -142,6 +176,8 @@ func (s ManagementResource) CreateOIDCProvider(response http.ResponseWriter, req
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "config is required", request), response)
 	} else if _, err := s.db.GetRole(request.Context(), upsertReq.Config.AutoProvision.DefaultRoleId); err != nil {
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "role id is invalid", request), response)
+	} else if err := validateRoleMappings(request.Context(), upsertReq.Config.AutoProvision, s.db); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
 	} else if oidcProvider, err := s.db.CreateOIDCProvider(request.Context(), upsertReq.Name, upsertReq.Issuer, upsertReq.ClientID, *upsertReq.Config); errors.Is(err, database.ErrDuplicateSSOProviderName) {
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusConflict, api.ErrorResponseSSOProviderDuplicateName, request), response)
 	} else if err != nil {
@@ -334,6 +370,8 @@ func getOIDCClaims(reqCtx context.Context, provider *oidc.Provider, ssoProvider
 		return claims, fmt.Errorf("id token verification: %v", err)
 	} else if err := idToken.Claims(&claims); err != nil {
 		return claims, fmt.Errorf("parse claims: %v", err)
+	} else if err := idToken.Claims(&claims.raw); err != nil {
+		return claims, fmt.Errorf("parse claims: %v", err)
 	} else {
 		return claims, nil
 	}
@@ -350,7 +388,7 @@ func getEmailFromOIDCClaims(claims oidcClaims) (string, error) {
 }
 
 func jitOIDCUserUpsert(ctx context.Context, ssoProvider model.SSOProvider, email string, claims oidcClaims, u jitUserUpserter) error {
-	if roles, err := SanitizeAndGetRoles(ctx, ssoProvider.Config.AutoProvision, claims.Roles, u); err != nil {
+	if roles, err := SanitizeAndGetRoles(ctx, ssoProvider.Config.AutoProvision, claims.claimValues(ssoProvider.Config.AutoProvision.GroupClaim), u); err != nil {
 		return fmt.Errorf("sanitize roles: %v", err)
 	} else if len(roles) != 1 {
 		return fmt.Errorf("invalid roles")
@@ -359,7 +397,7 @@ func jitOIDCUserUpsert(ctx context.Context, ssoProvider model.SSOProvider, email
 			return jitOIDCUserCreate(ctx, ssoProvider, email, claims, u, roles)
 		}
 		return fmt.Errorf("user lookup: %v", err)
-	} else if ssoProvider.Config.AutoProvision.RoleProvision && !user.Roles.Has(roles[0]) {
+	} else if ssoProvider.Config.AutoProvision.RoleProvision && provisionedRolesChanged(user, roles) {
 		//  roles should only ever have 1 role
 		user.Roles = roles
 		if err := u.UpdateUser(ctx, user); err != nil {
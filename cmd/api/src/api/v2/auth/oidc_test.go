@@ -117,6 +117,51 @@ func TestManagementResource_CreateOIDCProvider(t *testing.T) {
 			ResponseStatusCode(http.StatusBadRequest)
 	})
 
+	t.Run("error invalid role mapping", func(t *testing.T) {
+		mockDB.EXPECT().GetRole(gomock.Any(), int32(3)).Return(model.Role{Serial: model.Serial{ID: 3}}, nil)
+		mockDB.EXPECT().GetRole(gomock.Any(), int32(9)).Return(model.Role{}, database.ErrNotFound)
+
+		test.Request(t).
+			WithBody(v2auth.UpsertOIDCProviderRequest{
+				Name:     "Gotham Net 3",
+				Issuer:   "https://gotham-3.net",
+				ClientID: "gotham-net-3",
+				Config: &model.SSOProviderConfig{
+					AutoProvision: model.SSOProviderAutoProvisionConfig{
+						Enabled:       true,
+						DefaultRoleId: 3,
+						RoleProvision: true,
+						RoleMappings:  []model.SSOProviderRoleMapping{{ClaimValue: "Domain Admins", RoleID: 9}},
+					},
+				},
+			}).
+			OnHandlerFunc(resources.CreateOIDCProvider).
+			Require().
+			ResponseStatusCode(http.StatusBadRequest)
+	})
+
+	t.Run("error invalid role mapping precedence", func(t *testing.T) {
+		mockDB.EXPECT().GetRole(gomock.Any(), int32(3)).Return(model.Role{Serial: model.Serial{ID: 3}}, nil)
+
+		test.Request(t).
+			WithBody(v2auth.UpsertOIDCProviderRequest{
+				Name:     "Gotham Net 4",
+				Issuer:   "https://gotham-4.net",
+				ClientID: "gotham-net-4",
+				Config: &model.SSOProviderConfig{
+					AutoProvision: model.SSOProviderAutoProvisionConfig{
+						Enabled:               true,
+						DefaultRoleId:         3,
+						RoleProvision:         true,
+						RoleMappingPrecedence: "lowest_privilege",
+					},
+				},
+			}).
+			OnHandlerFunc(resources.CreateOIDCProvider).
+			Require().
+			ResponseStatusCode(http.StatusBadRequest)
+	})
+
 	t.Run("error parsing body request", func(t *testing.T) {
 		test.Request(t).
 			OnHandlerFunc(resources.CreateOIDCProvider).
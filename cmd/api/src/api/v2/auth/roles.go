@@ -0,0 +1,152 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	v2 "github.com/specterops/bloodhound/cmd/api/src/api/v2"
+	"github.com/specterops/bloodhound/cmd/api/src/database"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+)
+
+const (
+	ErrResponseDetailsRoleNameRequired     = "role name is required"
+	ErrResponseDetailsRolePermissionsEmpty = "a role must have at least one permission"
+	ErrResponseDetailsRoleNameConflict     = "a role with a conflicting name already exists"
+	ErrResponseDetailsRoleBuiltIn          = "built-in roles cannot be modified or deleted"
+	ErrResponseDetailsRoleInUse            = "role is assigned to one or more users or is the default role of an SSO provider"
+	ErrResponseDetailsInvalidPermissionID  = "invalid permission id: %d"
+)
+
+func (s ManagementResource) CreateRole(response http.ResponseWriter, request *http.Request) {
+	var upsertRoleRequest v2.UpsertRoleRequest
+
+	if err := api.ReadJSONRequestPayloadLimited(&upsertRoleRequest, request); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
+	} else if role, ok := s.buildCustomRole(response, request, model.Role{}, upsertRoleRequest); !ok {
+		return
+	} else if newRole, err := s.db.CreateRole(request.Context(), role); err != nil {
+		s.handleRoleError(response, request, err)
+	} else {
+		api.WriteBasicResponse(request.Context(), newRole, http.StatusCreated, response)
+	}
+}
+
+func (s ManagementResource) UpdateRole(response http.ResponseWriter, request *http.Request) {
+	var upsertRoleRequest v2.UpsertRoleRequest
+
+	if existingRole, ok := s.getCustomRole(response, request); !ok {
+		return
+	} else if err := api.ReadJSONRequestPayloadLimited(&upsertRoleRequest, request); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
+	} else if role, ok := s.buildCustomRole(response, request, existingRole, upsertRoleRequest); !ok {
+		return
+	} else if err := s.db.UpdateRole(request.Context(), role); err != nil {
+		s.handleRoleError(response, request, err)
+	} else {
+		api.WriteBasicResponse(request.Context(), role, http.StatusOK, response)
+	}
+}
+
+func (s ManagementResource) DeleteRole(response http.ResponseWriter, request *http.Request) {
+	if role, ok := s.getCustomRole(response, request); !ok {
+		return
+	} else if ssoProviders, err := s.db.GetAllSSOProviders(request.Context(), "", model.SQLFilter{}); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if slices.ContainsFunc(ssoProviders, func(ssoProvider model.SSOProvider) bool {
+		return ssoProvider.Config.AutoProvision.DefaultRoleId == role.ID
+	}) {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusConflict, ErrResponseDetailsRoleInUse, request), response)
+	} else if err := s.db.DeleteRole(request.Context(), role); err != nil {
+		s.handleRoleError(response, request, err)
+	} else {
+		response.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// getCustomRole fetches the role referenced by the request path and ensures it is a custom role
+func (s ManagementResource) getCustomRole(response http.ResponseWriter, request *http.Request) (model.Role, bool) {
+	if roleID, err := strconv.ParseInt(mux.Vars(request)[api.URIPathVariableRoleID], 10, 32); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if role, err := s.db.GetRole(request.Context(), int32(roleID)); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if !role.Custom {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, ErrResponseDetailsRoleBuiltIn, request), response)
+	} else {
+		return role, true
+	}
+
+	return model.Role{}, false
+}
+
+// buildCustomRole validates the requested role against the existing roles and permissions. The role name must not map
+// to the same SSO role provision slug as any other role so that the roles claim of an SSO provider stays unambiguous.
+func (s ManagementResource) buildCustomRole(response http.ResponseWriter, request *http.Request, role model.Role, upsertRoleRequest v2.UpsertRoleRequest) (model.Role, bool) {
+	role.Name = strings.TrimSpace(upsertRoleRequest.Name)
+	role.Description = upsertRoleRequest.Description
+	role.Permissions = make(model.Permissions, 0, len(upsertRoleRequest.Permissions))
+
+	if role.Name == "" {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, ErrResponseDetailsRoleNameRequired, request), response)
+		return role, false
+	} else if len(upsertRoleRequest.Permissions) == 0 {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, ErrResponseDetailsRolePermissionsEmpty, request), response)
+		return role, false
+	} else if roles, err := s.db.GetAllRoles(request.Context(), "", model.SQLFilter{}); err != nil {
+		api.HandleDatabaseError(request, response, err)
+		return role, false
+	} else if slices.ContainsFunc(roles, func(other model.Role) bool {
+		return other.ID != role.ID && other.Slug() == role.Slug()
+	}) {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusConflict, ErrResponseDetailsRoleNameConflict, request), response)
+		return role, false
+	} else if permissions, err := s.db.GetAllPermissions(request.Context(), "", model.SQLFilter{}); err != nil {
+		api.HandleDatabaseError(request, response, err)
+		return role, false
+	} else {
+		for _, permissionID := range upsertRoleRequest.Permissions {
+			if idx := slices.IndexFunc(permissions, func(permission model.Permission) bool {
+				return permission.ID == permissionID
+			}); idx < 0 {
+				api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf(ErrResponseDetailsInvalidPermissionID, permissionID), request), response)
+				return role, false
+			} else if !role.Permissions.Has(permissions[idx]) {
+				role.Permissions = append(role.Permissions, permissions[idx])
+			}
+		}
+
+		return role, true
+	}
+}
+
+func (s ManagementResource) handleRoleError(response http.ResponseWriter, request *http.Request, err error) {
+	if errors.Is(err, database.ErrDuplicateRoleName) {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusConflict, ErrResponseDetailsRoleNameConflict, request), response)
+	} else if errors.Is(err, database.ErrRoleInUse) {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusConflict, ErrResponseDetailsRoleInUse, request), response)
+	} else {
+		api.HandleDatabaseError(request, response, err)
+	}
+}
@@ -0,0 +1,265 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package auth_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	v2 "github.com/specterops/bloodhound/cmd/api/src/api/v2"
+	"github.com/specterops/bloodhound/cmd/api/src/api/v2/apitest"
+	"github.com/specterops/bloodhound/cmd/api/src/api/v2/auth"
+	"github.com/specterops/bloodhound/cmd/api/src/database"
+	"github.com/specterops/bloodhound/cmd/api/src/database/mocks"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/packages/go/headers"
+	"github.com/specterops/bloodhound/packages/go/mediatypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	testPermissions = model.Permissions{
+		{Authority: "audit", Name: "Read", Serial: model.Serial{ID: 1}},
+		{Authority: "graphdb", Name: "Read", Serial: model.Serial{ID: 2}},
+	}
+	testBuiltInRole = model.Role{Name: "Administrator", Serial: model.Serial{ID: 1}}
+	testCustomRole  = model.Role{Name: "Auditor", Permissions: testPermissions[:1], Custom: true, Serial: model.Serial{ID: 6}}
+)
+
+func newRoleRequest(t *testing.T, method string, roleID string, body any) *http.Request {
+	t.Helper()
+
+	payload, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(method, "/api/v2/roles", bytes.NewReader(payload))
+	require.NoError(t, err)
+
+	req.Header.Set(headers.ContentType.String(), mediatypes.ApplicationJson.String())
+
+	if roleID != "" {
+		req = mux.SetURLVars(req, map[string]string{api.URIPathVariableRoleID: roleID})
+	}
+
+	return req
+}
+
+func TestManagementResource_CreateRole(t *testing.T) {
+	type testData struct {
+		name         string
+		request      v2.UpsertRoleRequest
+		setupMocks   func(mockDB *mocks.MockDatabase)
+		expectedCode int
+		expectedBody string
+	}
+
+	tt := []testData{
+		{
+			name:         "Error: missing name - Bad Request",
+			request:      v2.UpsertRoleRequest{Permissions: []int32{1}},
+			setupMocks:   func(mockDB *mocks.MockDatabase) {},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: auth.ErrResponseDetailsRoleNameRequired,
+		},
+		{
+			name:         "Error: no permissions - Bad Request",
+			request:      v2.UpsertRoleRequest{Name: "Auditor"},
+			setupMocks:   func(mockDB *mocks.MockDatabase) {},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: auth.ErrResponseDetailsRolePermissionsEmpty,
+		},
+		{
+			name:    "Error: name conflicts with the SSO slug of an existing role - Conflict",
+			request: v2.UpsertRoleRequest{Name: "administrator", Permissions: []int32{1}},
+			setupMocks: func(mockDB *mocks.MockDatabase) {
+				mockDB.EXPECT().GetAllRoles(gomock.Any(), "", model.SQLFilter{}).Return(model.Roles{testBuiltInRole}, nil)
+			},
+			expectedCode: http.StatusConflict,
+			expectedBody: auth.ErrResponseDetailsRoleNameConflict,
+		},
+		{
+			name:    "Error: unknown permission - Bad Request",
+			request: v2.UpsertRoleRequest{Name: "Auditor", Permissions: []int32{1, 42}},
+			setupMocks: func(mockDB *mocks.MockDatabase) {
+				mockDB.EXPECT().GetAllRoles(gomock.Any(), "", model.SQLFilter{}).Return(model.Roles{testBuiltInRole}, nil)
+				mockDB.EXPECT().GetAllPermissions(gomock.Any(), "", model.SQLFilter{}).Return(testPermissions, nil)
+			},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: "invalid permission id: 42",
+		},
+		{
+			name:    "Error: duplicate role name - Conflict",
+			request: v2.UpsertRoleRequest{Name: "Auditor", Permissions: []int32{1}},
+			setupMocks: func(mockDB *mocks.MockDatabase) {
+				mockDB.EXPECT().GetAllRoles(gomock.Any(), "", model.SQLFilter{}).Return(model.Roles{testBuiltInRole}, nil)
+				mockDB.EXPECT().GetAllPermissions(gomock.Any(), "", model.SQLFilter{}).Return(testPermissions, nil)
+				mockDB.EXPECT().CreateRole(gomock.Any(), gomock.Any()).Return(model.Role{}, database.ErrDuplicateRoleName)
+			},
+			expectedCode: http.StatusConflict,
+			expectedBody: auth.ErrResponseDetailsRoleNameConflict,
+		},
+		{
+			name:    "Success - Created",
+			request: v2.UpsertRoleRequest{Name: " Auditor ", Description: "Reads audit logs", Permissions: []int32{1, 1}},
+			setupMocks: func(mockDB *mocks.MockDatabase) {
+				mockDB.EXPECT().GetAllRoles(gomock.Any(), "", model.SQLFilter{}).Return(model.Roles{testBuiltInRole}, nil)
+				mockDB.EXPECT().GetAllPermissions(gomock.Any(), "", model.SQLFilter{}).Return(testPermissions, nil)
+				mockDB.EXPECT().CreateRole(gomock.Any(), model.Role{
+					Name:        "Auditor",
+					Description: "Reads audit logs",
+					Permissions: testPermissions[:1],
+				}).Return(testCustomRole, nil)
+			},
+			expectedCode: http.StatusCreated,
+			expectedBody: `"custom":true`,
+		},
+	}
+
+	for _, testCase := range tt {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			resources, mockDB := apitest.NewAuthManagementResource(mockCtrl)
+			testCase.setupMocks(mockDB)
+
+			response := httptest.NewRecorder()
+			http.HandlerFunc(resources.CreateRole).ServeHTTP(response, newRoleRequest(t, http.MethodPost, "", testCase.request))
+
+			assert.Equal(t, testCase.expectedCode, response.Code)
+			assert.Contains(t, response.Body.String(), testCase.expectedBody)
+		})
+	}
+}
+
+func TestManagementResource_UpdateRole(t *testing.T) {
+	type testData struct {
+		name         string
+		roleID       string
+		setupMocks   func(mockDB *mocks.MockDatabase)
+		expectedCode int
+		expectedBody string
+	}
+
+	tt := []testData{
+		{
+			name:         "Error: malformed role id - Bad Request",
+			roleID:       "abc",
+			setupMocks:   func(mockDB *mocks.MockDatabase) {},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: api.ErrorResponseDetailsIDMalformed,
+		},
+		{
+			name:   "Error: built-in role - Bad Request",
+			roleID: "1",
+			setupMocks: func(mockDB *mocks.MockDatabase) {
+				mockDB.EXPECT().GetRole(gomock.Any(), int32(1)).Return(testBuiltInRole, nil)
+			},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: auth.ErrResponseDetailsRoleBuiltIn,
+		},
+		{
+			name:   "Success - OK",
+			roleID: "6",
+			setupMocks: func(mockDB *mocks.MockDatabase) {
+				mockDB.EXPECT().GetRole(gomock.Any(), int32(6)).Return(testCustomRole, nil)
+				mockDB.EXPECT().GetAllRoles(gomock.Any(), "", model.SQLFilter{}).Return(model.Roles{testBuiltInRole, testCustomRole}, nil)
+				mockDB.EXPECT().GetAllPermissions(gomock.Any(), "", model.SQLFilter{}).Return(testPermissions, nil)
+				mockDB.EXPECT().UpdateRole(gomock.Any(), model.Role{
+					Name:        "Auditor",
+					Permissions: testPermissions,
+					Custom:      true,
+					Serial:      model.Serial{ID: 6},
+				}).Return(nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: `"authority":"graphdb"`,
+		},
+	}
+
+	for _, testCase := range tt {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			resources, mockDB := apitest.NewAuthManagementResource(mockCtrl)
+			testCase.setupMocks(mockDB)
+
+			response := httptest.NewRecorder()
+			request := newRoleRequest(t, http.MethodPatch, testCase.roleID, v2.UpsertRoleRequest{Name: "Auditor", Permissions: []int32{1, 2}})
+			http.HandlerFunc(resources.UpdateRole).ServeHTTP(response, request)
+
+			assert.Equal(t, testCase.expectedCode, response.Code)
+			assert.Contains(t, response.Body.String(), testCase.expectedBody)
+		})
+	}
+}
+
+func TestManagementResource_DeleteRole(t *testing.T) {
+	type testData struct {
+		name         string
+		setupMocks   func(mockDB *mocks.MockDatabase)
+		expectedCode int
+	}
+
+	tt := []testData{
+		{
+			name: "Error: default role of an SSO provider - Conflict",
+			setupMocks: func(mockDB *mocks.MockDatabase) {
+				mockDB.EXPECT().GetRole(gomock.Any(), int32(6)).Return(testCustomRole, nil)
+				mockDB.EXPECT().GetAllSSOProviders(gomock.Any(), "", model.SQLFilter{}).Return([]model.SSOProvider{{
+					Config: model.SSOProviderConfig{AutoProvision: model.SSOProviderAutoProvisionConfig{DefaultRoleId: 6}},
+				}}, nil)
+			},
+			expectedCode: http.StatusConflict,
+		},
+		{
+			name: "Error: role assigned to users - Conflict",
+			setupMocks: func(mockDB *mocks.MockDatabase) {
+				mockDB.EXPECT().GetRole(gomock.Any(), int32(6)).Return(testCustomRole, nil)
+				mockDB.EXPECT().GetAllSSOProviders(gomock.Any(), "", model.SQLFilter{}).Return(nil, nil)
+				mockDB.EXPECT().DeleteRole(gomock.Any(), testCustomRole).Return(database.ErrRoleInUse)
+			},
+			expectedCode: http.StatusConflict,
+		},
+		{
+			name: "Success - No Content",
+			setupMocks: func(mockDB *mocks.MockDatabase) {
+				mockDB.EXPECT().GetRole(gomock.Any(), int32(6)).Return(testCustomRole, nil)
+				mockDB.EXPECT().GetAllSSOProviders(gomock.Any(), "", model.SQLFilter{}).Return(nil, nil)
+				mockDB.EXPECT().DeleteRole(gomock.Any(), testCustomRole).Return(nil)
+			},
+			expectedCode: http.StatusNoContent,
+		},
+	}
+
+	for _, testCase := range tt {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			resources, mockDB := apitest.NewAuthManagementResource(mockCtrl)
+			testCase.setupMocks(mockDB)
+
+			response := httptest.NewRecorder()
+			http.HandlerFunc(resources.DeleteRole).ServeHTTP(response, newRoleRequest(t, http.MethodDelete, "6", nil))
+
+			assert.Equal(t, testCase.expectedCode, response.Code)
+		})
+	}
+}
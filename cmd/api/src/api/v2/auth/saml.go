@@ -18,6 +18,7 @@ package auth
 
 import (
 	"context"
+	"encoding/json"
 	"encoding/xml"
 	"errors"
 	"fmt"
@@ -128,14 +129,54 @@ func getSSOProviderConfigFromMultipartRequest(ctx context.Context, multipartForm
 	} else if isRoleProvisioned, err := strconv.ParseBool(roleProvision[0]); err != nil {
 		return nil, fmt.Errorf("\"config.auto_provision.role_provision\" parameter could not be converted to bool")
 	} else {
-		return &model.SSOProviderConfig{
+		config := model.SSOProviderConfig{
 			AutoProvision: model.SSOProviderAutoProvisionConfig{
 				Enabled:       isAutoProvisionEnabled,
 				DefaultRoleId: defaultRole.ID,
 				RoleProvision: isRoleProvisioned,
 			},
-		}, nil
+		}
+
+		if err := setRoleMappingsFromMultipartRequest(ctx, multipartForm, &config.AutoProvision, r); err != nil {
+			return nil, err
+		}
+
+		return &config, nil
+	}
+}
+
+// setRoleMappingsFromMultipartRequest reads the optional group claim and role mapping parameters. Role mappings are
+// submitted as a JSON array of {"claim_value", "role_id"} objects.
+func setRoleMappingsFromMultipartRequest(ctx context.Context, multipartForm *multipart.Form, autoProvision *model.SSOProviderAutoProvisionConfig, r getRoler) error {
+	if groupClaim, hasGroupClaim := multipartForm.Value["config.auto_provision.group_claim"]; hasGroupClaim {
+		if len(groupClaim) > 1 {
+			return fmt.Errorf("\"config.auto_provision.group_claim\" has more than one value")
+		}
+		autoProvision.GroupClaim = strings.TrimSpace(groupClaim[0])
+	}
+
+	if precedence, hasPrecedence := multipartForm.Value["config.auto_provision.role_mapping_precedence"]; hasPrecedence {
+		if len(precedence) > 1 {
+			return fmt.Errorf("\"config.auto_provision.role_mapping_precedence\" has more than one value")
+		}
+		autoProvision.RoleMappingPrecedence = model.SSOProviderRoleMappingPrecedence(precedence[0])
 	}
+
+	if roleMappings, hasRoleMappings := multipartForm.Value["config.auto_provision.role_mappings"]; hasRoleMappings {
+		if len(roleMappings) > 1 {
+			return fmt.Errorf("\"config.auto_provision.role_mappings\" has more than one value")
+		} else if strings.TrimSpace(roleMappings[0]) != "" {
+			if err := json.Unmarshal([]byte(roleMappings[0]), &autoProvision.RoleMappings); err != nil {
+				return fmt.Errorf("\"config.auto_provision.role_mappings\" parameter could not be parsed: %w", err)
+			}
+		}
+	}
+
+	if err := validateRoleMappings(ctx, *autoProvision, r); err != nil {
+		return fmt.Errorf("\"config.auto_provision.role_mappings\" parameter is invalid: %w", err)
+	}
+
+	return nil
 }
 
 // This retains support for the old saml login urls /api/{version}/login/saml/ that were added to their respective IDPs
@@ -471,7 +512,12 @@ func (s ManagementResource) SAMLCallbackHandler(response http.ResponseWriter, re
 }
 
 func jitSAMLUserUpsert(ctx context.Context, ssoProvider model.SSOProvider, principalName string, assertion *saml.Assertion, u jitUserUpserter) error {
-	if roles, err := SanitizeAndGetRoles(ctx, ssoProvider.Config.AutoProvision, ssoProvider.SAMLProvider.GetSAMLUserRolesFromAssertion(assertion), u); err != nil {
+	var (
+		samlRoles  = ssoProvider.SAMLProvider.GetSAMLUserRolesFromAssertion(assertion)
+		samlGroups = ssoProvider.SAMLProvider.GetSAMLUserGroupsFromAssertion(assertion, ssoProvider.Config.AutoProvision.GroupClaim)
+	)
+
+	if roles, err := SanitizeAndGetRoles(ctx, ssoProvider.Config.AutoProvision, append(samlRoles, samlGroups...), u); err != nil {
 		return fmt.Errorf("sanitize roles: %v", err)
 	} else if len(roles) != 1 {
 		return fmt.Errorf("invalid roles detected")
@@ -480,7 +526,7 @@ func jitSAMLUserUpsert(ctx context.Context, ssoProvider model.SSOProvider, princ
 			return jitSAMLUserCreate(ctx, ssoProvider, principalName, assertion, u, roles)
 		}
 		return fmt.Errorf("lookup user: %v", err)
-	} else if ssoProvider.Config.AutoProvision.RoleProvision && !user.Roles.Has(roles[0]) {
+	} else if ssoProvider.Config.AutoProvision.RoleProvision && provisionedRolesChanged(user, roles) {
 		//  roles should only ever have 1 role
 		user.Roles = roles
 		if err := u.UpdateUser(ctx, user); err != nil {
@@ -0,0 +1,890 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/gorilla/mux"
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	"github.com/specterops/bloodhound/cmd/api/src/auth"
+	"github.com/specterops/bloodhound/cmd/api/src/ctx"
+	"github.com/specterops/bloodhound/cmd/api/src/database"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/utils"
+	"github.com/specterops/bloodhound/packages/go/headers"
+	"github.com/specterops/bloodhound/packages/go/mediatypes"
+)
+
+const (
+	SCIMSchemaUser         = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SCIMSchemaGroup        = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	SCIMSchemaListResponse = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	SCIMSchemaPatchOp      = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	SCIMSchemaError        = "urn:ietf:params:scim:api:messages:2.0:Error"
+
+	SCIMErrorTypeInvalidFilter = "invalidFilter"
+	SCIMErrorTypeInvalidValue  = "invalidValue"
+	SCIMErrorTypeInvalidPath   = "invalidPath"
+	SCIMErrorTypeInvalidSyntax = "invalidSyntax"
+	SCIMErrorTypeUniqueness    = "uniqueness"
+
+	SCIMDefaultPageSize = 100
+
+	ErrResponseDetailsSCIMUserNameRequired   = "userName is required"
+	ErrResponseDetailsSCIMUserNameConflict   = "a user with this userName already exists"
+	ErrResponseDetailsSCIMUnsupportedFilter  = "only the eq operator is supported for filtering"
+	ErrResponseDetailsSCIMGroupNotMapped     = "no role matches group %s"
+	ErrResponseDetailsSCIMMemberNotFound     = "member %s is not provisioned by this provider"
+	ErrResponseDetailsSCIMUnsupportedOp      = "unsupported patch operation: %s"
+	ErrResponseDetailsSCIMUnsupportedPath    = "unsupported patch path: %s"
+	ErrResponseDetailsSCIMMalformedPayload   = "malformed SCIM payload"
+	ErrResponseDetailsSCIMUnsupportedContent = "content type must be application/scim+json or application/json"
+
+	scimDefaultLastName = "Last name not found"
+)
+
+var (
+	scimFilterPattern       = regexp.MustCompile(`^\s*(\S+)\s+(?i:eq)\s+"((?:[^"\\]|\\.)*)"\s*$`)
+	scimMemberFilterPattern = regexp.MustCompile(`^members\[value eq "([^"]+)"\]$`)
+)
+
+type SCIMName struct {
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+	Formatted  string `json:"formatted,omitempty"`
+}
+
+type SCIMMultiValuedAttribute struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+type SCIMMeta struct {
+	ResourceType string    `json:"resourceType"`
+	Created      time.Time `json:"created"`
+	LastModified time.Time `json:"lastModified"`
+	Location     string    `json:"location,omitempty"`
+}
+
+type SCIMUser struct {
+	Schemas     []string                   `json:"schemas"`
+	ID          string                     `json:"id,omitempty"`
+	UserName    string                     `json:"userName"`
+	Name        SCIMName                   `json:"name"`
+	DisplayName string                     `json:"displayName,omitempty"`
+	Emails      []SCIMMultiValuedAttribute `json:"emails,omitempty"`
+	Active      *bool                      `json:"active,omitempty"`
+	Groups      []SCIMMultiValuedAttribute `json:"groups,omitempty"`
+	Meta        *SCIMMeta                  `json:"meta,omitempty"`
+}
+
+type SCIMGroup struct {
+	Schemas     []string                   `json:"schemas"`
+	ID          string                     `json:"id,omitempty"`
+	DisplayName string                     `json:"displayName"`
+	Members     []SCIMMultiValuedAttribute `json:"members"`
+	Meta        *SCIMMeta                  `json:"meta,omitempty"`
+}
+
+type SCIMListResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    any      `json:"Resources"`
+}
+
+type SCIMPatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+type SCIMPatchRequest struct {
+	Schemas    []string             `json:"schemas"`
+	Operations []SCIMPatchOperation `json:"Operations"`
+}
+
+type SCIMError struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	SCIMType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail"`
+}
+
+type SCIMTokenResponse struct {
+	Token   string `json:"token"`
+	BaseURL string `json:"base_url"`
+}
+
+func writeSCIMResponse(ctx context.Context, body any, statusCode int, response http.ResponseWriter) {
+	if content, err := json.Marshal(body); err != nil {
+		slog.ErrorContext(ctx, fmt.Sprintf("Failed to marshal SCIM response: %v", err))
+		response.WriteHeader(http.StatusInternalServerError)
+	} else {
+		response.Header().Set(headers.ContentType.String(), mediatypes.ApplicationScimJson.String())
+		response.WriteHeader(statusCode)
+
+		if written, err := response.Write(content); err != nil {
+			slog.ErrorContext(ctx, fmt.Sprintf("Failed to write SCIM response with %d bytes written and error: %v", written, err))
+		}
+	}
+}
+
+func writeSCIMError(ctx context.Context, statusCode int, scimType, detail string, response http.ResponseWriter) {
+	writeSCIMResponse(ctx, SCIMError{
+		Schemas:  []string{SCIMSchemaError},
+		Status:   strconv.Itoa(statusCode),
+		SCIMType: scimType,
+		Detail:   detail,
+	}, statusCode, response)
+}
+
+func handleSCIMDatabaseError(ctx context.Context, err error, response http.ResponseWriter) {
+	if errors.Is(err, database.ErrNotFound) {
+		writeSCIMError(ctx, http.StatusNotFound, "", api.ErrorResponseDetailsResourceNotFound, response)
+	} else {
+		slog.ErrorContext(ctx, fmt.Sprintf("Unexpected database error during SCIM request: %v", err))
+		writeSCIMError(ctx, http.StatusInternalServerError, "", api.ErrorResponseDetailsInternalServerError, response)
+	}
+}
+
+func readSCIMPayload(value any, request *http.Request) error {
+	if !utils.HeaderMatches(request.Header, headers.ContentType.String(), mediatypes.ApplicationScimJson.String(), mediatypes.ApplicationJson.String()) {
+		return errors.New(ErrResponseDetailsSCIMUnsupportedContent)
+	} else if request.Body == nil {
+		return api.ErrNoRequestBody
+	} else if err := json.NewDecoder(http.MaxBytesReader(nil, request.Body, api.DefaultAPIPayloadReadLimitBytes)).Decode(value); err != nil {
+		return errors.New(ErrResponseDetailsSCIMMalformedPayload)
+	}
+
+	return nil
+}
+
+// parseSCIMFilter parses the simple `attribute eq "value"` filters that IdPs send when looking up existing resources
+func parseSCIMFilter(filter string) (string, string, error) {
+	if filter == "" {
+		return "", "", nil
+	} else if matches := scimFilterPattern.FindStringSubmatch(filter); matches == nil {
+		return "", "", errors.New(ErrResponseDetailsSCIMUnsupportedFilter)
+	} else {
+		return strings.ToLower(matches[1]), strings.ReplaceAll(matches[2], `\"`, `"`), nil
+	}
+}
+
+// paginateSCIM applies the 1-based startIndex and count query parameters to a result set
+func paginateSCIM[T any](request *http.Request, resources []T) SCIMListResponse {
+	var (
+		queryParams = request.URL.Query()
+		startIndex  = 1
+		count       = SCIMDefaultPageSize
+	)
+
+	if rawStartIndex, err := strconv.Atoi(queryParams.Get("startIndex")); err == nil && rawStartIndex > 1 {
+		startIndex = rawStartIndex
+	}
+
+	if rawCount, err := strconv.Atoi(queryParams.Get("count")); err == nil && rawCount >= 0 {
+		count = rawCount
+	}
+
+	page := resources[min(startIndex-1, len(resources)):min(startIndex-1+count, len(resources))]
+
+	return SCIMListResponse{
+		Schemas:      []string{SCIMSchemaListResponse},
+		TotalResults: len(resources),
+		StartIndex:   startIndex,
+		ItemsPerPage: len(page),
+		Resources:    page,
+	}
+}
+
+func scimLocation(request *http.Request, resourceType, id string) string {
+	if host := ctx.FromRequest(request).Host; host != nil {
+		return host.JoinPath(api.SCIMPathPrefix, resourceType, id).String()
+	}
+
+	return ""
+}
+
+func scimProvider(request *http.Request) model.SSOProvider {
+	provider, _ := auth.GetSCIMProviderFromAuthCtx(ctx.FromRequest(request).AuthCtx)
+	return provider
+}
+
+func toSCIMUser(request *http.Request, user model.User) SCIMUser {
+	var (
+		active   = !user.IsDisabled
+		scimUser = SCIMUser{
+			Schemas:  []string{SCIMSchemaUser},
+			ID:       user.ID.String(),
+			UserName: user.PrincipalName,
+			Name: SCIMName{
+				GivenName:  user.FirstName.ValueOrZero(),
+				FamilyName: user.LastName.ValueOrZero(),
+			},
+			DisplayName: strings.TrimSpace(user.FirstName.ValueOrZero() + " " + user.LastName.ValueOrZero()),
+			Active:      &active,
+			Groups:      []SCIMMultiValuedAttribute{},
+			Meta: &SCIMMeta{
+				ResourceType: "User",
+				Created:      user.CreatedAt,
+				LastModified: user.UpdatedAt,
+				Location:     scimLocation(request, "Users", user.ID.String()),
+			},
+		}
+	)
+
+	if user.EmailAddress.ValueOrZero() != "" {
+		scimUser.Emails = []SCIMMultiValuedAttribute{{Value: user.EmailAddress.String, Type: "work", Primary: true}}
+	}
+
+	for _, role := range user.Roles {
+		scimUser.Groups = append(scimUser.Groups, SCIMMultiValuedAttribute{Value: strconv.Itoa(int(role.ID)), Display: role.Slug()})
+	}
+
+	return scimUser
+}
+
+func toSCIMGroup(request *http.Request, role model.Role, users model.Users) SCIMGroup {
+	group := SCIMGroup{
+		Schemas:     []string{SCIMSchemaGroup},
+		ID:          strconv.Itoa(int(role.ID)),
+		DisplayName: role.Slug(),
+		Members:     []SCIMMultiValuedAttribute{},
+		Meta: &SCIMMeta{
+			ResourceType: "Group",
+			Created:      role.CreatedAt,
+			LastModified: role.UpdatedAt,
+			Location:     scimLocation(request, "Groups", strconv.Itoa(int(role.ID))),
+		},
+	}
+
+	for _, user := range users {
+		if user.Roles.Has(role) {
+			group.Members = append(group.Members, SCIMMultiValuedAttribute{Value: user.ID.String(), Display: user.PrincipalName})
+		}
+	}
+
+	return group
+}
+
+// applySCIMUser copies the attributes of a SCIM user resource onto a BloodHound user
+func applySCIMUser(user *model.User, scimUser SCIMUser) {
+	user.PrincipalName = scimUser.UserName
+
+	if scimUser.Name.GivenName != "" {
+		user.FirstName = null.StringFrom(scimUser.Name.GivenName)
+	} else if !user.FirstName.Valid {
+		user.FirstName = null.StringFrom(scimUser.UserName)
+	}
+
+	if scimUser.Name.FamilyName != "" {
+		user.LastName = null.StringFrom(scimUser.Name.FamilyName)
+	} else if !user.LastName.Valid {
+		user.LastName = null.StringFrom(scimDefaultLastName)
+	}
+
+	if email := primarySCIMEmail(scimUser.Emails); email != "" {
+		user.EmailAddress = null.StringFrom(email)
+	}
+
+	if scimUser.Active != nil {
+		user.IsDisabled = !*scimUser.Active
+	}
+}
+
+func primarySCIMEmail(emails []SCIMMultiValuedAttribute) string {
+	for _, email := range emails {
+		if email.Primary {
+			return email.Value
+		}
+	}
+
+	if len(emails) > 0 {
+		return emails[0].Value
+	}
+
+	return ""
+}
+
+// parseSCIMBool accepts both JSON booleans and the "True"/"False" strings some IdPs send
+func parseSCIMBool(raw json.RawMessage) (bool, error) {
+	var (
+		value    bool
+		strValue string
+	)
+
+	if err := json.Unmarshal(raw, &value); err == nil {
+		return value, nil
+	} else if err := json.Unmarshal(raw, &strValue); err != nil {
+		return false, err
+	} else {
+		return strconv.ParseBool(strings.ToLower(strValue))
+	}
+}
+
+// applySCIMUserPatch applies a single attribute of a SCIM PATCH operation to a SCIM user resource
+func applySCIMUserPatch(scimUser *SCIMUser, path string, value json.RawMessage) error {
+	var stringValue string
+
+	switch strings.ToLower(path) {
+	case "active":
+		if active, err := parseSCIMBool(value); err != nil {
+			return err
+		} else {
+			scimUser.Active = &active
+		}
+		return nil
+
+	case "emails":
+		return json.Unmarshal(value, &scimUser.Emails)
+
+	case "name":
+		return json.Unmarshal(value, &scimUser.Name)
+	}
+
+	if err := json.Unmarshal(value, &stringValue); err != nil {
+		return err
+	}
+
+	switch strings.ToLower(path) {
+	case "username":
+		scimUser.UserName = stringValue
+	case "name.givenname":
+		scimUser.Name.GivenName = stringValue
+	case "name.familyname":
+		scimUser.Name.FamilyName = stringValue
+	case `emails[type eq "work"].value`, "emails.value":
+		scimUser.Emails = []SCIMMultiValuedAttribute{{Value: stringValue, Type: "work", Primary: true}}
+	case "displayname", "externalid", "name.formatted":
+		// Attributes that BloodHound does not store are accepted and ignored
+	default:
+		return fmt.Errorf(ErrResponseDetailsSCIMUnsupportedPath, path)
+	}
+
+	return nil
+}
+
+func (s ManagementResource) getSCIMUser(request *http.Request, provider model.SSOProvider) (model.User, error) {
+	if userID, err := uuid.FromString(mux.Vars(request)[api.URIPathVariableSCIMResourceID]); err != nil {
+		return model.User{}, database.ErrNotFound
+	} else if user, err := s.db.GetUser(request.Context(), userID); err != nil {
+		return model.User{}, err
+	} else if !user.SSOProviderID.Valid || user.SSOProviderID.Int32 != provider.ID {
+		// SCIM clients may only manage the users that belong to their own provider
+		return model.User{}, database.ErrNotFound
+	} else {
+		return user, nil
+	}
+}
+
+func (s ManagementResource) getSCIMProviderUsers(ctx context.Context, provider model.SSOProvider) (model.Users, error) {
+	return s.db.GetAllUsers(ctx, "created_at", model.SQLFilter{SQLString: "sso_provider_id = ?", Params: []any{provider.ID}})
+}
+
+// saveSCIMUser persists a SCIM driven change to a user. Sessions of deactivated users are terminated immediately.
+func (s ManagementResource) saveSCIMUser(ctx context.Context, user model.User) error {
+	if err := s.db.UpdateUser(ctx, user); err != nil {
+		return err
+	} else if user.IsDisabled {
+		return s.endUserSessions(ctx, user, 0)
+	}
+
+	return nil
+}
+
+func (s ManagementResource) ListSCIMUsers(response http.ResponseWriter, request *http.Request) {
+	var (
+		provider  = scimProvider(request)
+		scimUsers = []SCIMUser{}
+	)
+
+	if attribute, value, err := parseSCIMFilter(request.URL.Query().Get("filter")); err != nil {
+		writeSCIMError(request.Context(), http.StatusBadRequest, SCIMErrorTypeInvalidFilter, err.Error(), response)
+	} else if attribute != "" && attribute != "username" && attribute != "emails.value" {
+		writeSCIMError(request.Context(), http.StatusBadRequest, SCIMErrorTypeInvalidFilter, fmt.Sprintf("unsupported filter attribute: %s", attribute), response)
+	} else if users, err := s.getSCIMProviderUsers(request.Context(), provider); err != nil {
+		handleSCIMDatabaseError(request.Context(), err, response)
+	} else {
+		for _, user := range users {
+			switch attribute {
+			case "username":
+				if !strings.EqualFold(user.PrincipalName, value) {
+					continue
+				}
+			case "emails.value":
+				if !strings.EqualFold(user.EmailAddress.ValueOrZero(), value) {
+					continue
+				}
+			}
+
+			scimUsers = append(scimUsers, toSCIMUser(request, user))
+		}
+
+		writeSCIMResponse(request.Context(), paginateSCIM(request, scimUsers), http.StatusOK, response)
+	}
+}
+
+func (s ManagementResource) GetSCIMUser(response http.ResponseWriter, request *http.Request) {
+	if user, err := s.getSCIMUser(request, scimProvider(request)); err != nil {
+		handleSCIMDatabaseError(request.Context(), err, response)
+	} else {
+		writeSCIMResponse(request.Context(), toSCIMUser(request, user), http.StatusOK, response)
+	}
+}
+
+func (s ManagementResource) CreateSCIMUser(response http.ResponseWriter, request *http.Request) {
+	var (
+		provider = scimProvider(request)
+		scimUser SCIMUser
+		user     = model.User{
+			SSOProviderID: null.Int32From(provider.ID),
+			EULAAccepted:  true, // EULA Acceptance does not pertain to Bloodhound Community Edition; this flag is used for Bloodhound Enterprise users
+			Roles:         model.Roles{},
+		}
+	)
+
+	if err := readSCIMPayload(&scimUser, request); err != nil {
+		writeSCIMError(request.Context(), http.StatusBadRequest, SCIMErrorTypeInvalidSyntax, err.Error(), response)
+	} else if scimUser.UserName == "" {
+		writeSCIMError(request.Context(), http.StatusBadRequest, SCIMErrorTypeInvalidValue, ErrResponseDetailsSCIMUserNameRequired, response)
+	} else if _, err := s.db.LookupUser(request.Context(), scimUser.UserName); err == nil {
+		writeSCIMError(request.Context(), http.StatusConflict, SCIMErrorTypeUniqueness, ErrResponseDetailsSCIMUserNameConflict, response)
+	} else if !errors.Is(err, database.ErrNotFound) {
+		handleSCIMDatabaseError(request.Context(), err, response)
+	} else {
+		applySCIMUser(&user, scimUser)
+
+		// Users start out with the provider's default role until the IdP pushes their group memberships
+		if defaultRoleID := provider.Config.AutoProvision.DefaultRoleId; defaultRoleID != 0 {
+			if role, err := s.db.GetRole(request.Context(), defaultRoleID); err != nil {
+				handleSCIMDatabaseError(request.Context(), err, response)
+				return
+			} else {
+				user.Roles = model.Roles{role}
+			}
+		}
+
+		if newUser, err := s.db.CreateUser(request.Context(), user); errors.Is(err, database.ErrDuplicateUserPrincipal) || errors.Is(err, database.ErrDuplicateEmail) {
+			writeSCIMError(request.Context(), http.StatusConflict, SCIMErrorTypeUniqueness, ErrResponseDetailsSCIMUserNameConflict, response)
+		} else if err != nil {
+			handleSCIMDatabaseError(request.Context(), err, response)
+		} else {
+			scimResponse := toSCIMUser(request, newUser)
+			response.Header().Set(headers.Location.String(), scimResponse.Meta.Location)
+			writeSCIMResponse(request.Context(), scimResponse, http.StatusCreated, response)
+		}
+	}
+}
+
+func (s ManagementResource) ReplaceSCIMUser(response http.ResponseWriter, request *http.Request) {
+	var scimUser SCIMUser
+
+	if user, err := s.getSCIMUser(request, scimProvider(request)); err != nil {
+		handleSCIMDatabaseError(request.Context(), err, response)
+	} else if err := readSCIMPayload(&scimUser, request); err != nil {
+		writeSCIMError(request.Context(), http.StatusBadRequest, SCIMErrorTypeInvalidSyntax, err.Error(), response)
+	} else if scimUser.UserName == "" {
+		writeSCIMError(request.Context(), http.StatusBadRequest, SCIMErrorTypeInvalidValue, ErrResponseDetailsSCIMUserNameRequired, response)
+	} else {
+		applySCIMUser(&user, scimUser)
+
+		if err := s.saveSCIMUser(request.Context(), user); err != nil {
+			handleSCIMDatabaseError(request.Context(), err, response)
+		} else {
+			writeSCIMResponse(request.Context(), toSCIMUser(request, user), http.StatusOK, response)
+		}
+	}
+}
+
+func (s ManagementResource) PatchSCIMUser(response http.ResponseWriter, request *http.Request) {
+	var patchRequest SCIMPatchRequest
+
+	if user, err := s.getSCIMUser(request, scimProvider(request)); err != nil {
+		handleSCIMDatabaseError(request.Context(), err, response)
+	} else if err := readSCIMPayload(&patchRequest, request); err != nil {
+		writeSCIMError(request.Context(), http.StatusBadRequest, SCIMErrorTypeInvalidSyntax, err.Error(), response)
+	} else {
+		scimUser := toSCIMUser(request, user)
+		scimUser.Emails = nil
+
+		for _, operation := range patchRequest.Operations {
+			if op := strings.ToLower(operation.Op); op != "add" && op != "replace" {
+				writeSCIMError(request.Context(), http.StatusBadRequest, SCIMErrorTypeInvalidValue, fmt.Sprintf(ErrResponseDetailsSCIMUnsupportedOp, operation.Op), response)
+				return
+			} else if operation.Path != "" {
+				if err := applySCIMUserPatch(&scimUser, operation.Path, operation.Value); err != nil {
+					writeSCIMError(request.Context(), http.StatusBadRequest, SCIMErrorTypeInvalidPath, err.Error(), response)
+					return
+				}
+			} else {
+				// Operations without a path carry a map of attribute paths to their new values
+				var attributes map[string]json.RawMessage
+
+				if err := json.Unmarshal(operation.Value, &attributes); err != nil {
+					writeSCIMError(request.Context(), http.StatusBadRequest, SCIMErrorTypeInvalidValue, ErrResponseDetailsSCIMMalformedPayload, response)
+					return
+				}
+
+				for path, value := range attributes {
+					if err := applySCIMUserPatch(&scimUser, path, value); err != nil {
+						writeSCIMError(request.Context(), http.StatusBadRequest, SCIMErrorTypeInvalidPath, err.Error(), response)
+						return
+					}
+				}
+			}
+		}
+
+		applySCIMUser(&user, scimUser)
+
+		if err := s.saveSCIMUser(request.Context(), user); err != nil {
+			handleSCIMDatabaseError(request.Context(), err, response)
+		} else {
+			writeSCIMResponse(request.Context(), toSCIMUser(request, user), http.StatusOK, response)
+		}
+	}
+}
+
+func (s ManagementResource) DeleteSCIMUser(response http.ResponseWriter, request *http.Request) {
+	if user, err := s.getSCIMUser(request, scimProvider(request)); err != nil {
+		handleSCIMDatabaseError(request.Context(), err, response)
+	} else if err := s.db.DeleteUser(request.Context(), user); err != nil {
+		handleSCIMDatabaseError(request.Context(), err, response)
+	} else {
+		response.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// findSCIMGroupRole maps an IdP group name to a BloodHound role. The provider's role mappings are evaluated first, using
+// the group name as the claim value, before falling back to the role whose name or SSO role slug matches the group name.
+func findSCIMGroupRole(autoProvisionConfig model.SSOProviderAutoProvisionConfig, roles model.Roles, displayName string) (model.Role, bool) {
+	dbRolesByID := make(map[int32]*model.Role, len(roles))
+	for idx := range roles {
+		dbRolesByID[roles[idx].ID] = &roles[idx]
+	}
+
+	if mapped := mappedRoles(autoProvisionConfig.RoleMappings, []string{displayName}, dbRolesByID); len(mapped) == 1 {
+		return mapped[0], true
+	} else if len(mapped) > 1 {
+		return selectRoleByPrecedence(autoProvisionConfig.RoleMappingPrecedence, mapped)
+	}
+
+	for _, role := range roles {
+		if strings.EqualFold(role.Name, displayName) || strings.EqualFold(role.Slug(), displayName) {
+			return role, true
+		}
+	}
+
+	return model.Role{}, false
+}
+
+func (s ManagementResource) getSCIMGroupRole(request *http.Request) (model.Role, error) {
+	if roleID, err := strconv.ParseInt(mux.Vars(request)[api.URIPathVariableSCIMResourceID], 10, 32); err != nil {
+		return model.Role{}, database.ErrNotFound
+	} else {
+		return s.db.GetRole(request.Context(), int32(roleID))
+	}
+}
+
+// setSCIMGroupMembers reconciles the membership of a role for the provider's users. Members that are added are moved
+// to the role since a user may only hold a single role, members that are removed fall back to the provider's default
+// role. Members removed from the default role itself are left without a role.
+func (s ManagementResource) setSCIMGroupMembers(ctx context.Context, provider model.SSOProvider, role model.Role, users model.Users, add, remove []string) (model.Users, error) {
+	usersByID := make(map[string]int, len(users))
+	for idx, user := range users {
+		usersByID[user.ID.String()] = idx
+	}
+
+	for _, memberID := range append(add, remove...) {
+		if _, found := usersByID[memberID]; !found {
+			return nil, fmt.Errorf(ErrResponseDetailsSCIMMemberNotFound, memberID)
+		}
+	}
+
+	if len(remove) > 0 {
+		fallbackRoles := model.Roles{}
+
+		if defaultRoleID := provider.Config.AutoProvision.DefaultRoleId; defaultRoleID != 0 && defaultRoleID != role.ID {
+			if defaultRole, err := s.db.GetRole(ctx, defaultRoleID); err != nil {
+				return nil, err
+			} else {
+				fallbackRoles = model.Roles{defaultRole}
+			}
+		}
+
+		for _, memberID := range remove {
+			if user := &users[usersByID[memberID]]; user.Roles.Has(role) {
+				user.Roles = fallbackRoles
+
+				if err := s.db.UpdateUser(ctx, *user); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	for _, memberID := range add {
+		if user := &users[usersByID[memberID]]; !user.Roles.Has(role) || len(user.Roles) != 1 {
+			user.Roles = model.Roles{role}
+
+			if err := s.db.UpdateUser(ctx, *user); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return users, nil
+}
+
+func scimMemberIDs(members []SCIMMultiValuedAttribute) []string {
+	ids := make([]string, len(members))
+	for idx, member := range members {
+		ids[idx] = member.Value
+	}
+
+	return ids
+}
+
+// currentSCIMMemberIDs returns the IDs of the provider's users that hold the role and are absent from the keep list
+func currentSCIMMemberIDs(role model.Role, users model.Users, keep []string) []string {
+	var stale []string
+
+	for _, user := range users {
+		if user.Roles.Has(role) && !slices.Contains(keep, user.ID.String()) {
+			stale = append(stale, user.ID.String())
+		}
+	}
+
+	return stale
+}
+
+func (s ManagementResource) writeSCIMGroupMembershipError(ctx context.Context, err error, response http.ResponseWriter) {
+	if strings.HasPrefix(err.Error(), "member ") {
+		writeSCIMError(ctx, http.StatusBadRequest, SCIMErrorTypeInvalidValue, err.Error(), response)
+	} else {
+		handleSCIMDatabaseError(ctx, err, response)
+	}
+}
+
+func (s ManagementResource) ListSCIMGroups(response http.ResponseWriter, request *http.Request) {
+	var (
+		provider   = scimProvider(request)
+		scimGroups = []SCIMGroup{}
+	)
+
+	if attribute, value, err := parseSCIMFilter(request.URL.Query().Get("filter")); err != nil {
+		writeSCIMError(request.Context(), http.StatusBadRequest, SCIMErrorTypeInvalidFilter, err.Error(), response)
+	} else if attribute != "" && attribute != "displayname" {
+		writeSCIMError(request.Context(), http.StatusBadRequest, SCIMErrorTypeInvalidFilter, fmt.Sprintf("unsupported filter attribute: %s", attribute), response)
+	} else if roles, err := s.db.GetAllRoles(request.Context(), "id", model.SQLFilter{}); err != nil {
+		handleSCIMDatabaseError(request.Context(), err, response)
+	} else if users, err := s.getSCIMProviderUsers(request.Context(), provider); err != nil {
+		handleSCIMDatabaseError(request.Context(), err, response)
+	} else {
+		if attribute == "" {
+			for _, role := range roles {
+				scimGroups = append(scimGroups, toSCIMGroup(request, role, users))
+			}
+		} else if role, found := findSCIMGroupRole(provider.Config.AutoProvision, roles, value); found {
+			scimGroups = append(scimGroups, toSCIMGroup(request, role, users))
+		}
+
+		writeSCIMResponse(request.Context(), paginateSCIM(request, scimGroups), http.StatusOK, response)
+	}
+}
+
+func (s ManagementResource) GetSCIMGroup(response http.ResponseWriter, request *http.Request) {
+	if role, err := s.getSCIMGroupRole(request); err != nil {
+		handleSCIMDatabaseError(request.Context(), err, response)
+	} else if users, err := s.getSCIMProviderUsers(request.Context(), scimProvider(request)); err != nil {
+		handleSCIMDatabaseError(request.Context(), err, response)
+	} else {
+		writeSCIMResponse(request.Context(), toSCIMGroup(request, role, users), http.StatusOK, response)
+	}
+}
+
+// CreateSCIMGroup links an IdP group to the BloodHound role it maps to. Roles are never created through SCIM.
+func (s ManagementResource) CreateSCIMGroup(response http.ResponseWriter, request *http.Request) {
+	var (
+		provider  = scimProvider(request)
+		scimGroup SCIMGroup
+	)
+
+	if err := readSCIMPayload(&scimGroup, request); err != nil {
+		writeSCIMError(request.Context(), http.StatusBadRequest, SCIMErrorTypeInvalidSyntax, err.Error(), response)
+	} else if roles, err := s.db.GetAllRoles(request.Context(), "id", model.SQLFilter{}); err != nil {
+		handleSCIMDatabaseError(request.Context(), err, response)
+	} else if role, found := findSCIMGroupRole(provider.Config.AutoProvision, roles, scimGroup.DisplayName); !found {
+		writeSCIMError(request.Context(), http.StatusBadRequest, SCIMErrorTypeInvalidValue, fmt.Sprintf(ErrResponseDetailsSCIMGroupNotMapped, scimGroup.DisplayName), response)
+	} else if users, err := s.getSCIMProviderUsers(request.Context(), provider); err != nil {
+		handleSCIMDatabaseError(request.Context(), err, response)
+	} else if users, err := s.setSCIMGroupMembers(request.Context(), provider, role, users, scimMemberIDs(scimGroup.Members), nil); err != nil {
+		s.writeSCIMGroupMembershipError(request.Context(), err, response)
+	} else {
+		scimResponse := toSCIMGroup(request, role, users)
+		response.Header().Set(headers.Location.String(), scimResponse.Meta.Location)
+		writeSCIMResponse(request.Context(), scimResponse, http.StatusCreated, response)
+	}
+}
+
+func (s ManagementResource) ReplaceSCIMGroup(response http.ResponseWriter, request *http.Request) {
+	var (
+		provider  = scimProvider(request)
+		scimGroup SCIMGroup
+	)
+
+	if role, err := s.getSCIMGroupRole(request); err != nil {
+		handleSCIMDatabaseError(request.Context(), err, response)
+	} else if err := readSCIMPayload(&scimGroup, request); err != nil {
+		writeSCIMError(request.Context(), http.StatusBadRequest, SCIMErrorTypeInvalidSyntax, err.Error(), response)
+	} else if users, err := s.getSCIMProviderUsers(request.Context(), provider); err != nil {
+		handleSCIMDatabaseError(request.Context(), err, response)
+	} else {
+		members := scimMemberIDs(scimGroup.Members)
+
+		if users, err := s.setSCIMGroupMembers(request.Context(), provider, role, users, members, currentSCIMMemberIDs(role, users, members)); err != nil {
+			s.writeSCIMGroupMembershipError(request.Context(), err, response)
+		} else {
+			writeSCIMResponse(request.Context(), toSCIMGroup(request, role, users), http.StatusOK, response)
+		}
+	}
+}
+
+func (s ManagementResource) PatchSCIMGroup(response http.ResponseWriter, request *http.Request) {
+	var (
+		provider     = scimProvider(request)
+		patchRequest SCIMPatchRequest
+		add, remove  []string
+	)
+
+	if role, err := s.getSCIMGroupRole(request); err != nil {
+		handleSCIMDatabaseError(request.Context(), err, response)
+	} else if err := readSCIMPayload(&patchRequest, request); err != nil {
+		writeSCIMError(request.Context(), http.StatusBadRequest, SCIMErrorTypeInvalidSyntax, err.Error(), response)
+	} else if users, err := s.getSCIMProviderUsers(request.Context(), provider); err != nil {
+		handleSCIMDatabaseError(request.Context(), err, response)
+	} else {
+		for _, operation := range patchRequest.Operations {
+			var (
+				op      = strings.ToLower(operation.Op)
+				members []SCIMMultiValuedAttribute
+			)
+
+			if memberMatch := scimMemberFilterPattern.FindStringSubmatch(operation.Path); op == "remove" && memberMatch != nil {
+				remove = append(remove, memberMatch[1])
+				continue
+			} else if !strings.EqualFold(operation.Path, "members") {
+				// Group names are owned by BloodHound roles, other attributes are accepted and ignored
+				continue
+			} else if len(operation.Value) > 0 {
+				if err := json.Unmarshal(operation.Value, &members); err != nil {
+					writeSCIMError(request.Context(), http.StatusBadRequest, SCIMErrorTypeInvalidValue, ErrResponseDetailsSCIMMalformedPayload, response)
+					return
+				}
+			}
+
+			switch op {
+			case "add":
+				add = append(add, scimMemberIDs(members)...)
+			case "remove":
+				if len(members) == 0 {
+					remove = append(remove, currentSCIMMemberIDs(role, users, nil)...)
+				} else {
+					remove = append(remove, scimMemberIDs(members)...)
+				}
+			case "replace":
+				add = scimMemberIDs(members)
+				remove = currentSCIMMemberIDs(role, users, add)
+			default:
+				writeSCIMError(request.Context(), http.StatusBadRequest, SCIMErrorTypeInvalidValue, fmt.Sprintf(ErrResponseDetailsSCIMUnsupportedOp, operation.Op), response)
+				return
+			}
+		}
+
+		if users, err := s.setSCIMGroupMembers(request.Context(), provider, role, users, add, remove); err != nil {
+			s.writeSCIMGroupMembershipError(request.Context(), err, response)
+		} else {
+			writeSCIMResponse(request.Context(), toSCIMGroup(request, role, users), http.StatusOK, response)
+		}
+	}
+}
+
+// DeleteSCIMGroup unlinks an IdP group by removing the role from every user of the provider. The role itself remains.
+func (s ManagementResource) DeleteSCIMGroup(response http.ResponseWriter, request *http.Request) {
+	provider := scimProvider(request)
+
+	if role, err := s.getSCIMGroupRole(request); err != nil {
+		handleSCIMDatabaseError(request.Context(), err, response)
+	} else if users, err := s.getSCIMProviderUsers(request.Context(), provider); err != nil {
+		handleSCIMDatabaseError(request.Context(), err, response)
+	} else if _, err := s.setSCIMGroupMembers(request.Context(), provider, role, users, nil, currentSCIMMemberIDs(role, users, nil)); err != nil {
+		s.writeSCIMGroupMembershipError(request.Context(), err, response)
+	} else {
+		response.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// IssueSCIMToken issues a new SCIM bearer token for an SSO provider, replacing any previously issued token. The token is
+// only returned in this response.
+func (s ManagementResource) IssueSCIMToken(response http.ResponseWriter, request *http.Request) {
+	if ssoProviderID, err := strconv.ParseInt(mux.Vars(request)[api.URIPathVariableSSOProviderID], 10, 32); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if ssoProvider, err := s.db.GetSSOProviderById(request.Context(), int32(ssoProviderID)); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if token, err := auth.NewSCIMToken(); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, api.ErrorResponseDetailsInternalServerError, request), response)
+	} else if err := s.db.SetSSOProviderSCIMTokenDigest(request.Context(), ssoProvider, null.StringFrom(auth.SCIMTokenDigest(token))); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		tokenResponse := SCIMTokenResponse{Token: token}
+
+		if host := ctx.FromRequest(request).Host; host != nil {
+			tokenResponse.BaseURL = host.JoinPath(api.SCIMPathPrefix).String()
+		}
+
+		api.WriteBasicResponse(request.Context(), tokenResponse, http.StatusOK, response)
+	}
+}
+
+// RevokeSCIMToken revokes the SCIM bearer token of an SSO provider
+func (s ManagementResource) RevokeSCIMToken(response http.ResponseWriter, request *http.Request) {
+	if ssoProviderID, err := strconv.ParseInt(mux.Vars(request)[api.URIPathVariableSSOProviderID], 10, 32); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if ssoProvider, err := s.db.GetSSOProviderById(request.Context(), int32(ssoProviderID)); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if err := s.db.SetSSOProviderSCIMTokenDigest(request.Context(), ssoProvider, null.String{}); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		response.WriteHeader(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,363 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package auth_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/gorilla/mux"
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	"github.com/specterops/bloodhound/cmd/api/src/api/v2/apitest"
+	"github.com/specterops/bloodhound/cmd/api/src/api/v2/auth"
+	authz "github.com/specterops/bloodhound/cmd/api/src/auth"
+	"github.com/specterops/bloodhound/cmd/api/src/ctx"
+	"github.com/specterops/bloodhound/cmd/api/src/database"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/packages/go/headers"
+	"github.com/specterops/bloodhound/packages/go/mediatypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	testSCIMProvider = model.SSOProvider{
+		Name: "Okta",
+		Slug: "okta",
+		Config: model.SSOProviderConfig{AutoProvision: model.SSOProviderAutoProvisionConfig{
+			DefaultRoleId: 3,
+			RoleMappings:  []model.SSOProviderRoleMapping{{ClaimValue: "BloodHound Admins", RoleID: 1}},
+		}},
+		Serial: model.Serial{ID: 7},
+	}
+	testSCIMRole = model.Role{Name: "Read-Only", Serial: model.Serial{ID: 3}}
+)
+
+func newSCIMRequest(t *testing.T, method, resourceID string, body any) *http.Request {
+	t.Helper()
+
+	var payload []byte
+
+	if body != nil {
+		var err error
+
+		payload, err = json.Marshal(body)
+		require.NoError(t, err)
+	}
+
+	req, err := http.NewRequest(method, api.SCIMPathPrefix, bytes.NewReader(payload))
+	require.NoError(t, err)
+
+	req.Header.Set(headers.ContentType.String(), mediatypes.ApplicationScimJson.String())
+
+	if resourceID != "" {
+		req = mux.SetURLVars(req, map[string]string{api.URIPathVariableSCIMResourceID: resourceID})
+	}
+
+	return req.WithContext(context.WithValue(req.Context(), ctx.ValueKey, &ctx.Context{
+		AuthCtx: authz.Context{Owner: testSCIMProvider},
+	}))
+}
+
+func newSCIMUser(t *testing.T, principalName string, ssoProviderID int32, roles ...model.Role) model.User {
+	t.Helper()
+
+	userID, err := uuid.NewV4()
+	require.NoError(t, err)
+
+	return model.User{
+		PrincipalName: principalName,
+		FirstName:     null.StringFrom("First"),
+		LastName:      null.StringFrom("Last"),
+		SSOProviderID: null.Int32From(ssoProviderID),
+		Roles:         roles,
+		Unique:        model.Unique{ID: userID},
+	}
+}
+
+func TestManagementResource_ListSCIMUsers(t *testing.T) {
+	var (
+		mockCtrl       = gomock.NewController(t)
+		resources, mdb = apitest.NewAuthManagementResource(mockCtrl)
+		alice          = newSCIMUser(t, "alice@example.com", testSCIMProvider.ID)
+		bob            = newSCIMUser(t, "bob@example.com", testSCIMProvider.ID)
+	)
+	defer mockCtrl.Finish()
+
+	t.Run("unsupported filter", func(t *testing.T) {
+		req := newSCIMRequest(t, http.MethodGet, "", nil)
+		req.URL.RawQuery = "filter=userName+sw+%22a%22"
+
+		response := httptest.NewRecorder()
+		resources.ListSCIMUsers(response, req)
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+		assert.Contains(t, response.Body.String(), auth.SCIMErrorTypeInvalidFilter)
+	})
+
+	t.Run("filters by userName within the provider", func(t *testing.T) {
+		mdb.EXPECT().GetAllUsers(gomock.Any(), "created_at", model.SQLFilter{SQLString: "sso_provider_id = ?", Params: []any{testSCIMProvider.ID}}).Return(model.Users{alice, bob}, nil)
+
+		req := newSCIMRequest(t, http.MethodGet, "", nil)
+		req.URL.RawQuery = "filter=userName+eq+%22BOB%40example.com%22"
+
+		response := httptest.NewRecorder()
+		resources.ListSCIMUsers(response, req)
+
+		require.Equal(t, http.StatusOK, response.Code)
+		assert.Equal(t, mediatypes.ApplicationScimJson.String(), response.Header().Get(headers.ContentType.String()))
+
+		var body struct {
+			TotalResults int             `json:"totalResults"`
+			Resources    []auth.SCIMUser `json:"Resources"`
+		}
+		require.NoError(t, json.Unmarshal(response.Body.Bytes(), &body))
+		require.Equal(t, 1, body.TotalResults)
+		assert.Equal(t, bob.ID.String(), body.Resources[0].ID)
+	})
+}
+
+func TestManagementResource_GetSCIMUser_OtherProvider(t *testing.T) {
+	var (
+		mockCtrl       = gomock.NewController(t)
+		resources, mdb = apitest.NewAuthManagementResource(mockCtrl)
+		user           = newSCIMUser(t, "mallory@example.com", testSCIMProvider.ID+1)
+	)
+	defer mockCtrl.Finish()
+
+	mdb.EXPECT().GetUser(gomock.Any(), user.ID).Return(user, nil)
+
+	response := httptest.NewRecorder()
+	resources.GetSCIMUser(response, newSCIMRequest(t, http.MethodGet, user.ID.String(), nil))
+
+	assert.Equal(t, http.StatusNotFound, response.Code)
+}
+
+func TestManagementResource_CreateSCIMUser(t *testing.T) {
+	var (
+		mockCtrl       = gomock.NewController(t)
+		resources, mdb = apitest.NewAuthManagementResource(mockCtrl)
+		active         = true
+		scimUser       = auth.SCIMUser{
+			Schemas:  []string{auth.SCIMSchemaUser},
+			UserName: "carol@example.com",
+			Name:     auth.SCIMName{GivenName: "Carol"},
+			Emails:   []auth.SCIMMultiValuedAttribute{{Value: "carol@example.com", Primary: true}},
+			Active:   &active,
+		}
+	)
+	defer mockCtrl.Finish()
+
+	t.Run("missing userName", func(t *testing.T) {
+		response := httptest.NewRecorder()
+		resources.CreateSCIMUser(response, newSCIMRequest(t, http.MethodPost, "", auth.SCIMUser{}))
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+		assert.Contains(t, response.Body.String(), auth.ErrResponseDetailsSCIMUserNameRequired)
+	})
+
+	t.Run("duplicate userName", func(t *testing.T) {
+		mdb.EXPECT().LookupUser(gomock.Any(), scimUser.UserName).Return(model.User{}, nil)
+
+		response := httptest.NewRecorder()
+		resources.CreateSCIMUser(response, newSCIMRequest(t, http.MethodPost, "", scimUser))
+
+		assert.Equal(t, http.StatusConflict, response.Code)
+		assert.Contains(t, response.Body.String(), auth.SCIMErrorTypeUniqueness)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		mdb.EXPECT().LookupUser(gomock.Any(), scimUser.UserName).Return(model.User{}, database.ErrNotFound)
+		mdb.EXPECT().GetRole(gomock.Any(), testSCIMRole.ID).Return(testSCIMRole, nil)
+		mdb.EXPECT().CreateUser(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, user model.User) (model.User, error) {
+			assert.Equal(t, "Carol", user.FirstName.String)
+			assert.Equal(t, "Last name not found", user.LastName.String)
+			assert.Equal(t, "carol@example.com", user.EmailAddress.String)
+			assert.Equal(t, testSCIMProvider.ID, user.SSOProviderID.Int32)
+			assert.True(t, user.Roles.Has(testSCIMRole))
+			assert.False(t, user.IsDisabled)
+			return user, nil
+		})
+
+		response := httptest.NewRecorder()
+		resources.CreateSCIMUser(response, newSCIMRequest(t, http.MethodPost, "", scimUser))
+
+		assert.Equal(t, http.StatusCreated, response.Code)
+		assert.Contains(t, response.Body.String(), `"userName":"carol@example.com"`)
+	})
+}
+
+func TestManagementResource_PatchSCIMUser_Deactivate(t *testing.T) {
+	var (
+		mockCtrl       = gomock.NewController(t)
+		resources, mdb = apitest.NewAuthManagementResource(mockCtrl)
+		user           = newSCIMUser(t, "dave@example.com", testSCIMProvider.ID)
+		session        = model.UserSession{BigSerial: model.BigSerial{ID: 42}}
+		patchRequest   = auth.SCIMPatchRequest{
+			Schemas:    []string{auth.SCIMSchemaPatchOp},
+			Operations: []auth.SCIMPatchOperation{{Op: "Replace", Value: json.RawMessage(`{"active":"False"}`)}},
+		}
+	)
+	defer mockCtrl.Finish()
+
+	mdb.EXPECT().GetUser(gomock.Any(), user.ID).Return(user, nil)
+	mdb.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, updated model.User) error {
+		assert.True(t, updated.IsDisabled)
+		assert.Equal(t, user.PrincipalName, updated.PrincipalName)
+		return nil
+	})
+	mdb.EXPECT().LookupActiveSessionsByUser(gomock.Any(), gomock.Any()).Return([]model.UserSession{session}, nil)
+	mdb.EXPECT().EndUserSession(gomock.Any(), session)
+
+	response := httptest.NewRecorder()
+	resources.PatchSCIMUser(response, newSCIMRequest(t, http.MethodPatch, user.ID.String(), patchRequest))
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Contains(t, response.Body.String(), `"active":false`)
+}
+
+func TestManagementResource_PatchSCIMGroup(t *testing.T) {
+	var (
+		mockCtrl       = gomock.NewController(t)
+		resources, mdb = apitest.NewAuthManagementResource(mockCtrl)
+		adminRole      = model.Role{Name: "Administrator", Serial: model.Serial{ID: 1}}
+		erin           = newSCIMUser(t, "erin@example.com", testSCIMProvider.ID, testSCIMRole)
+		frank          = newSCIMUser(t, "frank@example.com", testSCIMProvider.ID, adminRole)
+	)
+	defer mockCtrl.Finish()
+
+	t.Run("unknown member", func(t *testing.T) {
+		mdb.EXPECT().GetRole(gomock.Any(), adminRole.ID).Return(adminRole, nil)
+		mdb.EXPECT().GetAllUsers(gomock.Any(), "created_at", gomock.Any()).Return(model.Users{erin, frank}, nil)
+
+		response := httptest.NewRecorder()
+		resources.PatchSCIMGroup(response, newSCIMRequest(t, http.MethodPatch, "1", auth.SCIMPatchRequest{
+			Operations: []auth.SCIMPatchOperation{{Op: "add", Path: "members", Value: json.RawMessage(`[{"value":"not-a-member"}]`)}},
+		}))
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+
+	t.Run("add and remove members", func(t *testing.T) {
+		mdb.EXPECT().GetRole(gomock.Any(), adminRole.ID).Return(adminRole, nil)
+		mdb.EXPECT().GetAllUsers(gomock.Any(), "created_at", gomock.Any()).Return(model.Users{erin, frank}, nil)
+		mdb.EXPECT().GetRole(gomock.Any(), testSCIMRole.ID).Return(testSCIMRole, nil)
+		mdb.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, user model.User) error {
+			assert.Equal(t, frank.ID, user.ID)
+			assert.Equal(t, model.Roles{testSCIMRole}, user.Roles)
+			return nil
+		})
+		mdb.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, user model.User) error {
+			assert.Equal(t, erin.ID, user.ID)
+			assert.Equal(t, model.Roles{adminRole}, user.Roles)
+			return nil
+		})
+
+		response := httptest.NewRecorder()
+		resources.PatchSCIMGroup(response, newSCIMRequest(t, http.MethodPatch, "1", auth.SCIMPatchRequest{
+			Operations: []auth.SCIMPatchOperation{
+				{Op: "add", Path: "members", Value: json.RawMessage(`[{"value":"` + erin.ID.String() + `"}]`)},
+				{Op: "remove", Path: `members[value eq "` + frank.ID.String() + `"]`},
+			},
+		}))
+
+		require.Equal(t, http.StatusOK, response.Code)
+
+		var group auth.SCIMGroup
+		require.NoError(t, json.Unmarshal(response.Body.Bytes(), &group))
+		require.Len(t, group.Members, 1)
+		assert.Equal(t, erin.ID.String(), group.Members[0].Value)
+		assert.Equal(t, "bh-administrator", group.DisplayName)
+	})
+}
+
+func TestManagementResource_CreateSCIMGroup(t *testing.T) {
+	var (
+		mockCtrl       = gomock.NewController(t)
+		resources, mdb = apitest.NewAuthManagementResource(mockCtrl)
+		adminRole      = model.Role{Name: "Administrator", Serial: model.Serial{ID: 1}}
+		erin           = newSCIMUser(t, "erin@example.com", testSCIMProvider.ID, testSCIMRole)
+	)
+	defer mockCtrl.Finish()
+
+	t.Run("group resolved through the provider role mappings", func(t *testing.T) {
+		mdb.EXPECT().GetAllRoles(gomock.Any(), "id", model.SQLFilter{}).Return(model.Roles{adminRole, testSCIMRole}, nil)
+		mdb.EXPECT().GetAllUsers(gomock.Any(), "created_at", gomock.Any()).Return(model.Users{erin}, nil)
+		mdb.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, user model.User) error {
+			assert.Equal(t, erin.ID, user.ID)
+			assert.Equal(t, model.Roles{adminRole}, user.Roles)
+			return nil
+		})
+
+		response := httptest.NewRecorder()
+		resources.CreateSCIMGroup(response, newSCIMRequest(t, http.MethodPost, "", auth.SCIMGroup{
+			DisplayName: "bloodhound admins",
+			Members:     []auth.SCIMMultiValuedAttribute{{Value: erin.ID.String()}},
+		}))
+
+		require.Equal(t, http.StatusCreated, response.Code)
+
+		var group auth.SCIMGroup
+		require.NoError(t, json.Unmarshal(response.Body.Bytes(), &group))
+		assert.Equal(t, "1", group.ID)
+	})
+
+	t.Run("unmapped group", func(t *testing.T) {
+		mdb.EXPECT().GetAllRoles(gomock.Any(), "id", model.SQLFilter{}).Return(model.Roles{adminRole, testSCIMRole}, nil)
+
+		response := httptest.NewRecorder()
+		resources.CreateSCIMGroup(response, newSCIMRequest(t, http.MethodPost, "", auth.SCIMGroup{DisplayName: "Engineering"}))
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+}
+
+func TestManagementResource_IssueSCIMToken(t *testing.T) {
+	var (
+		mockCtrl       = gomock.NewController(t)
+		resources, mdb = apitest.NewAuthManagementResource(mockCtrl)
+	)
+	defer mockCtrl.Finish()
+
+	mdb.EXPECT().GetSSOProviderById(gomock.Any(), testSCIMProvider.ID).Return(testSCIMProvider, nil)
+	mdb.EXPECT().SetSSOProviderSCIMTokenDigest(gomock.Any(), testSCIMProvider, gomock.Any()).DoAndReturn(func(_ context.Context, _ model.SSOProvider, digest null.String) error {
+		assert.True(t, digest.Valid)
+		return nil
+	})
+
+	req, err := http.NewRequest(http.MethodPut, "/api/v2/sso-providers/7/scim-token", nil)
+	require.NoError(t, err)
+	req = mux.SetURLVars(req, map[string]string{api.URIPathVariableSSOProviderID: "7"})
+
+	response := httptest.NewRecorder()
+	resources.IssueSCIMToken(response, req)
+
+	require.Equal(t, http.StatusOK, response.Code)
+
+	var body struct {
+		Data auth.SCIMTokenResponse `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(response.Body.Bytes(), &body))
+	assert.True(t, authz.IsSCIMToken(body.Data.Token))
+}
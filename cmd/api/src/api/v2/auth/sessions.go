@@ -0,0 +1,145 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/gofrs/uuid"
+	"github.com/gorilla/mux"
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	v2 "github.com/specterops/bloodhound/cmd/api/src/api/v2"
+	"github.com/specterops/bloodhound/cmd/api/src/auth"
+	"github.com/specterops/bloodhound/cmd/api/src/ctx"
+	"github.com/specterops/bloodhound/cmd/api/src/database"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+)
+
+// endUserSessions terminates all active sessions of the given user with the exception of keepSessionID. Passing a
+// keepSessionID of 0 terminates every session.
+func (s ManagementResource) endUserSessions(ctx context.Context, user model.User, keepSessionID int64) error {
+	if userSessions, err := s.db.LookupActiveSessionsByUser(ctx, user); err != nil {
+		return err
+	} else {
+		for _, session := range userSessions {
+			if session.ID != keepSessionID {
+				s.db.EndUserSession(ctx, session)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s ManagementResource) listUserSessions(response http.ResponseWriter, request *http.Request, user model.User) {
+	var (
+		currentSessionID = ctx.FromRequest(request).AuthCtx.Session.ID
+		sessions         = []v2.UserSession{}
+	)
+
+	if userSessions, err := s.db.LookupActiveSessionsByUser(request.Context(), user); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		for _, session := range userSessions {
+			sessions = append(sessions, v2.UserSession{
+				ID:              session.ID,
+				AuthProvider:    session.AuthProviderType.String(),
+				AuthProviderID:  session.AuthProviderID,
+				SourceIPAddress: session.SourceIPAddress,
+				UserAgent:       session.UserAgent,
+				CreatedAt:       session.CreatedAt,
+				ExpiresAt:       session.ExpiresAt,
+				Current:         session.ID == currentSessionID,
+			})
+		}
+
+		api.WriteBasicResponse(request.Context(), v2.ListUserSessionsResponse{Sessions: sessions}, http.StatusOK, response)
+	}
+}
+
+// revokeUserSessions ends every session of the user except for the session the request was made with
+func (s ManagementResource) revokeUserSessions(response http.ResponseWriter, request *http.Request, user model.User) {
+	if err := s.endUserSessions(request.Context(), user, ctx.FromRequest(request).AuthCtx.Session.ID); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		response.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (s ManagementResource) revokeUserSession(response http.ResponseWriter, request *http.Request, user model.User) {
+	if sessionID, err := strconv.ParseInt(mux.Vars(request)[api.URIPathVariableSessionID], 10, 64); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if session, err := s.db.GetUserSession(request.Context(), sessionID); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if session.UserID != user.ID || session.Expired() {
+		// Sessions of other users are reported as missing to avoid disclosing their existence
+		api.HandleDatabaseError(request, response, database.ErrNotFound)
+	} else {
+		s.db.EndUserSession(request.Context(), session)
+		response.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (s ManagementResource) withSelf(response http.ResponseWriter, request *http.Request, delegate func(http.ResponseWriter, *http.Request, model.User)) {
+	if user, isUser := auth.GetUserFromAuthCtx(ctx.FromRequest(request).AuthCtx); !isUser {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "No associated user found", request), response)
+	} else {
+		delegate(response, request, user)
+	}
+}
+
+func (s ManagementResource) withPathUser(response http.ResponseWriter, request *http.Request, delegate func(http.ResponseWriter, *http.Request, model.User)) {
+	if userID, err := uuid.FromString(mux.Vars(request)[api.URIPathVariableUserID]); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if user, err := s.db.GetUser(request.Context(), userID); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		delegate(response, request, user)
+	}
+}
+
+// ListSelfSessions lists the active sessions of the requesting user
+func (s ManagementResource) ListSelfSessions(response http.ResponseWriter, request *http.Request) {
+	s.withSelf(response, request, s.listUserSessions)
+}
+
+// RevokeSelfSessions ends all active sessions of the requesting user other than the one used to make the request
+func (s ManagementResource) RevokeSelfSessions(response http.ResponseWriter, request *http.Request) {
+	s.withSelf(response, request, s.revokeUserSessions)
+}
+
+// RevokeSelfSession ends a single active session of the requesting user
+func (s ManagementResource) RevokeSelfSession(response http.ResponseWriter, request *http.Request) {
+	s.withSelf(response, request, s.revokeUserSession)
+}
+
+// ListUserSessions lists the active sessions of the user identified in the request path
+func (s ManagementResource) ListUserSessions(response http.ResponseWriter, request *http.Request) {
+	s.withPathUser(response, request, s.listUserSessions)
+}
+
+// RevokeUserSessions ends all active sessions of the user identified in the request path
+func (s ManagementResource) RevokeUserSessions(response http.ResponseWriter, request *http.Request) {
+	s.withPathUser(response, request, s.revokeUserSessions)
+}
+
+// RevokeUserSession ends a single active session of the user identified in the request path
+func (s ManagementResource) RevokeUserSession(response http.ResponseWriter, request *http.Request) {
+	s.withPathUser(response, request, s.revokeUserSession)
+}
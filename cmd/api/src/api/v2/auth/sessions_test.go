@@ -0,0 +1,168 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package auth_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	v2 "github.com/specterops/bloodhound/cmd/api/src/api/v2"
+	"github.com/specterops/bloodhound/cmd/api/src/api/v2/apitest"
+	authz "github.com/specterops/bloodhound/cmd/api/src/auth"
+	"github.com/specterops/bloodhound/cmd/api/src/ctx"
+	"github.com/specterops/bloodhound/cmd/api/src/database"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/test/must"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newSessionRequest(t *testing.T, method string, owner model.User, currentSession model.UserSession, pathVars map[string]string) *http.Request {
+	t.Helper()
+
+	req, err := http.NewRequest(method, "/api/v2/self/sessions", nil)
+	require.NoError(t, err)
+
+	req = mux.SetURLVars(req, pathVars)
+
+	return req.WithContext(context.WithValue(req.Context(), ctx.ValueKey, &ctx.Context{
+		AuthCtx: authz.Context{Owner: owner, Session: currentSession},
+	}))
+}
+
+func TestManagementResource_ListSelfSessions(t *testing.T) {
+	var (
+		mockCtrl          = gomock.NewController(t)
+		resources, mockDB = apitest.NewAuthManagementResource(mockCtrl)
+		user              = model.User{Unique: model.Unique{ID: must.NewUUIDv4()}}
+		currentSession    = model.UserSession{UserID: user.ID, AuthProviderType: model.SessionAuthProviderSecret, SourceIPAddress: "10.0.0.1", UserAgent: "Firefox", BigSerial: model.BigSerial{ID: 1}}
+		otherSession      = model.UserSession{UserID: user.ID, AuthProviderType: model.SessionAuthProviderOIDC, SourceIPAddress: "10.0.0.2", UserAgent: "Chrome", BigSerial: model.BigSerial{ID: 2}}
+	)
+	defer mockCtrl.Finish()
+
+	mockDB.EXPECT().LookupActiveSessionsByUser(gomock.Any(), user).Return([]model.UserSession{currentSession, otherSession}, nil)
+
+	response := httptest.NewRecorder()
+	resources.ListSelfSessions(response, newSessionRequest(t, http.MethodGet, user, currentSession, nil))
+
+	require.Equal(t, http.StatusOK, response.Code)
+
+	var body struct {
+		Data v2.ListUserSessionsResponse `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(response.Body.Bytes(), &body))
+	require.Len(t, body.Data.Sessions, 2)
+	assert.True(t, body.Data.Sessions[0].Current)
+	assert.Equal(t, "Secret", body.Data.Sessions[0].AuthProvider)
+	assert.False(t, body.Data.Sessions[1].Current)
+	assert.Equal(t, "OIDC", body.Data.Sessions[1].AuthProvider)
+	assert.Equal(t, "10.0.0.2", body.Data.Sessions[1].SourceIPAddress)
+	assert.Equal(t, "Chrome", body.Data.Sessions[1].UserAgent)
+}
+
+func TestManagementResource_RevokeSelfSessions(t *testing.T) {
+	var (
+		mockCtrl          = gomock.NewController(t)
+		resources, mockDB = apitest.NewAuthManagementResource(mockCtrl)
+		user              = model.User{Unique: model.Unique{ID: must.NewUUIDv4()}}
+		currentSession    = model.UserSession{UserID: user.ID, BigSerial: model.BigSerial{ID: 1}}
+		otherSession      = model.UserSession{UserID: user.ID, BigSerial: model.BigSerial{ID: 2}}
+	)
+	defer mockCtrl.Finish()
+
+	mockDB.EXPECT().LookupActiveSessionsByUser(gomock.Any(), user).Return([]model.UserSession{currentSession, otherSession}, nil)
+	mockDB.EXPECT().EndUserSession(gomock.Any(), otherSession)
+
+	response := httptest.NewRecorder()
+	resources.RevokeSelfSessions(response, newSessionRequest(t, http.MethodDelete, user, currentSession, nil))
+
+	assert.Equal(t, http.StatusNoContent, response.Code)
+}
+
+func TestManagementResource_RevokeUserSession(t *testing.T) {
+	var (
+		mockCtrl          = gomock.NewController(t)
+		resources, mockDB = apitest.NewAuthManagementResource(mockCtrl)
+		admin             = model.User{Unique: model.Unique{ID: must.NewUUIDv4()}}
+		user              = model.User{Unique: model.Unique{ID: must.NewUUIDv4()}}
+		session           = model.UserSession{UserID: user.ID, BigSerial: model.BigSerial{ID: 7}}
+		foreignSession    = model.UserSession{UserID: admin.ID, BigSerial: model.BigSerial{ID: 8}}
+	)
+	defer mockCtrl.Finish()
+
+	session.ExpiresAt = time.Now().UTC().Add(time.Hour)
+	foreignSession.ExpiresAt = session.ExpiresAt
+
+	t.Run("malformed session id", func(t *testing.T) {
+		mockDB.EXPECT().GetUser(gomock.Any(), user.ID).Return(user, nil)
+
+		response := httptest.NewRecorder()
+		resources.RevokeUserSession(response, newSessionRequest(t, http.MethodDelete, admin, model.UserSession{}, map[string]string{
+			api.URIPathVariableUserID:    user.ID.String(),
+			api.URIPathVariableSessionID: "abc",
+		}))
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+
+	t.Run("session belongs to another user", func(t *testing.T) {
+		mockDB.EXPECT().GetUser(gomock.Any(), user.ID).Return(user, nil)
+		mockDB.EXPECT().GetUserSession(gomock.Any(), foreignSession.ID).Return(foreignSession, nil)
+
+		response := httptest.NewRecorder()
+		resources.RevokeUserSession(response, newSessionRequest(t, http.MethodDelete, admin, model.UserSession{}, map[string]string{
+			api.URIPathVariableUserID:    user.ID.String(),
+			api.URIPathVariableSessionID: "8",
+		}))
+
+		assert.Equal(t, http.StatusNotFound, response.Code)
+	})
+
+	t.Run("session not found", func(t *testing.T) {
+		mockDB.EXPECT().GetUser(gomock.Any(), user.ID).Return(user, nil)
+		mockDB.EXPECT().GetUserSession(gomock.Any(), int64(9)).Return(model.UserSession{}, database.ErrNotFound)
+
+		response := httptest.NewRecorder()
+		resources.RevokeUserSession(response, newSessionRequest(t, http.MethodDelete, admin, model.UserSession{}, map[string]string{
+			api.URIPathVariableUserID:    user.ID.String(),
+			api.URIPathVariableSessionID: "9",
+		}))
+
+		assert.Equal(t, http.StatusNotFound, response.Code)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		mockDB.EXPECT().GetUser(gomock.Any(), user.ID).Return(user, nil)
+		mockDB.EXPECT().GetUserSession(gomock.Any(), session.ID).Return(session, nil)
+		mockDB.EXPECT().EndUserSession(gomock.Any(), session)
+
+		response := httptest.NewRecorder()
+		resources.RevokeUserSession(response, newSessionRequest(t, http.MethodDelete, admin, model.UserSession{}, map[string]string{
+			api.URIPathVariableUserID:    user.ID.String(),
+			api.URIPathVariableSessionID: "7",
+		}))
+
+		assert.Equal(t, http.StatusNoContent, response.Code)
+	})
+}
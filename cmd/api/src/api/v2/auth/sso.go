@@ -37,7 +37,7 @@ import (
 	"gorm.io/gorm/utils"
 )
 
-// AuthProvider represents a unified SSO provider (either OIDC or SAML)
+// AuthProvider represents a unified SSO provider (either OIDC, SAML or LDAP)
 type AuthProvider struct {
 	ID      int32                   `json:"id"`
 	Name    string                  `json:"name"`
@@ -74,7 +74,7 @@ type jitUserUpserter interface {
 	UpdateUser(ctx context.Context, user model.User) error
 }
 
-// ListAuthProviders lists all available SSO providers (SAML and OIDC) with sorting and filtering
+// ListAuthProviders lists all available SSO providers (SAML, OIDC and LDAP) with sorting and filtering
 func (s ManagementResource) ListAuthProviders(response http.ResponseWriter, request *http.Request) {
 	var (
 		requestCtx        = request.Context()
@@ -158,6 +158,10 @@ func (s ManagementResource) ListAuthProviders(response http.ResponseWriter, requ
 						ssoProvider.SAMLProvider.FormatSAMLProviderURLs(*ctx.Get(requestCtx).Host)
 						provider.Details = ssoProvider.SAMLProvider
 					}
+				case model.SessionAuthProviderLDAP:
+					if ssoProvider.LDAPProvider != nil {
+						provider.Details = ssoProvider.LDAPProvider
+					}
 				}
 
 				providers = append(providers, provider)
@@ -211,6 +215,8 @@ func (s ManagementResource) UpdateSSOProvider(response http.ResponseWriter, requ
 			s.UpdateSAMLProviderRequest(response, request, ssoProvider)
 		case model.SessionAuthProviderOIDC:
 			s.UpdateOIDCProviderRequest(response, request, ssoProvider)
+		case model.SessionAuthProviderLDAP:
+			s.UpdateLDAPProviderRequest(response, request, ssoProvider)
 		default:
 			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusNotImplemented, api.ErrorResponseDetailsNotImplemented, request), response)
 		}
@@ -228,6 +234,8 @@ func (s ManagementResource) SSOLoginHandler(response http.ResponseWriter, reques
 			s.SAMLLoginHandler(response, request, ssoProvider)
 		case model.SessionAuthProviderOIDC:
 			s.OIDCLoginHandler(response, request, ssoProvider)
+		case model.SessionAuthProviderLDAP:
+			s.LDAPLoginHandler(response, request, ssoProvider)
 		default:
 			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusNotImplemented, api.ErrorResponseDetailsNotImplemented, request), response)
 		}
@@ -251,45 +259,127 @@ func (s ManagementResource) SSOCallbackHandler(response http.ResponseWriter, req
 	}
 }
 
-func SanitizeAndGetRoles(ctx context.Context, autoProvisionConfig model.SSOProviderAutoProvisionConfig, maybeBHRoles []string, r getAllRoler) (model.Roles, error) {
+// SanitizeAndGetRoles returns the single role to provision for a user with the given claim values. When role
+// provisioning is enabled, the provider's explicit role mappings are evaluated first; if none match, claim values
+// naming a role slug (e.g. bh-power-user) are used instead. If more than one role matches, the configured precedence
+// picks one, otherwise the default role is used.
+func SanitizeAndGetRoles(ctx context.Context, autoProvisionConfig model.SSOProviderAutoProvisionConfig, claimValues []string, r getAllRoler) (model.Roles, error) {
 	if dbRoles, err := r.GetAllRoles(ctx, "", model.SQLFilter{}); err != nil {
 		return nil, err
 	} else {
 		var defaultRole model.Role
 		dbRolesBySlug := make(map[string]*model.Role)
+		dbRolesByID := make(map[int32]*model.Role)
 		// Make quick lookup by role slug -> lower cased, dashes for spaces, and prefixed by `bh` e.g. bh-power-user
 		for _, r := range dbRoles {
-			dbRolesBySlug[fmt.Sprintf("bh-%s", strings.ReplaceAll(strings.ToLower(r.Name), " ", "-"))] = &r
+			dbRolesBySlug[r.Slug()] = &r
+			dbRolesByID[r.ID] = &r
 			if r.ID == autoProvisionConfig.DefaultRoleId {
 				defaultRole = r
 			}
 		}
 
 		if autoProvisionConfig.RoleProvision {
-			var validRoles model.Roles
-			validRolesSeen := cardinality.NewBitmap32() // Ensure no dupes
-			// Only add valid roles
-			for _, r := range maybeBHRoles {
-				if dbRole := dbRolesBySlug[strings.ReplaceAll(strings.ToLower(r), " ", "-")]; dbRole != nil && !validRolesSeen.Contains(uint32(dbRole.ID)) {
-					validRoles = append(validRoles, *dbRole)
-					validRolesSeen.Add(uint32(dbRole.ID))
+			validRoles := mappedRoles(autoProvisionConfig.RoleMappings, claimValues, dbRolesByID)
+
+			if len(validRoles) == 0 {
+				validRolesSeen := cardinality.NewBitmap32() // Ensure no dupes
+				// Only add valid roles
+				for _, r := range claimValues {
+					if dbRole := dbRolesBySlug[strings.ReplaceAll(strings.ToLower(r), " ", "-")]; dbRole != nil && !validRolesSeen.Contains(uint32(dbRole.ID)) {
+						validRoles = append(validRoles, *dbRole)
+						validRolesSeen.Add(uint32(dbRole.ID))
+					}
 				}
 			}
+
 			switch {
 			case len(validRoles) == 1:
 				return validRoles, nil
 			case len(validRoles) > 1:
+				if role, ok := selectRoleByPrecedence(autoProvisionConfig.RoleMappingPrecedence, validRoles); ok {
+					return model.Roles{role}, nil
+				}
+
 				slog.WarnContext(ctx, fmt.Sprintf("[SSO] JIT Role Provision detected multiple valid roles - %s , falling back to default role %s", validRoles.Names(), defaultRole.Name))
 			default:
-				slog.WarnContext(ctx, fmt.Sprintf("[SSO] JIT Role Provision detected no valid roles from %s , falling back to default role %s", maybeBHRoles, defaultRole.Name))
+				slog.WarnContext(ctx, fmt.Sprintf("[SSO] JIT Role Provision detected no valid roles from %s , falling back to default role %s", claimValues, defaultRole.Name))
 			}
 		}
 
 		/* Fallback to default role:
 		- Role provision is disabled
 		- Role provision is enabled but no valid roles are found
-		- Role provision is enabled but multiple valid roles are found
+		- Role provision is enabled but multiple valid roles are found and no precedence is configured
 		*/
 		return model.Roles{defaultRole}, nil
 	}
 }
+
+// mappedRoles returns the roles of the mappings matching any of the claim values, in mapping order and without duplicates.
+// Claim values are compared case-insensitively.
+func mappedRoles(mappings []model.SSOProviderRoleMapping, claimValues []string, dbRolesByID map[int32]*model.Role) model.Roles {
+	var (
+		roles     model.Roles
+		rolesSeen = cardinality.NewBitmap32()
+	)
+
+	for _, mapping := range mappings {
+		if dbRole := dbRolesByID[mapping.RoleID]; dbRole == nil || rolesSeen.Contains(uint32(dbRole.ID)) {
+			continue
+		} else {
+			for _, claimValue := range claimValues {
+				if strings.EqualFold(strings.TrimSpace(claimValue), strings.TrimSpace(mapping.ClaimValue)) {
+					roles = append(roles, *dbRole)
+					rolesSeen.Add(uint32(dbRole.ID))
+					break
+				}
+			}
+		}
+	}
+
+	return roles
+}
+
+// selectRoleByPrecedence picks one of several matching roles. Roles are expected in mapping order, which also breaks
+// ties between roles of the same rank for the highest privilege precedence.
+func selectRoleByPrecedence(precedence model.SSOProviderRoleMappingPrecedence, roles model.Roles) (model.Role, bool) {
+	switch precedence {
+	case model.SSOProviderRoleMappingPrecedenceOrdered:
+		return roles[0], true
+	case model.SSOProviderRoleMappingPrecedenceHighestPrivilege:
+		selected := roles[0]
+		for _, role := range roles[1:] {
+			if auth.RolePrecedence(role.Name) > auth.RolePrecedence(selected.Name) {
+				selected = role
+			}
+		}
+
+		return selected, true
+	default:
+		return model.Role{}, false
+	}
+}
+
+// provisionedRolesChanged returns true if the user's roles differ from the single role selected by role provisioning.
+// Any additional roles are removed so that demotions take effect on the next login.
+func provisionedRolesChanged(user model.User, roles model.Roles) bool {
+	return len(user.Roles) != 1 || !user.Roles.Has(roles[0])
+}
+
+// validateRoleMappings ensures the role mapping configuration of a provider refers to existing roles
+func validateRoleMappings(ctx context.Context, autoProvisionConfig model.SSOProviderAutoProvisionConfig, r getRoler) error {
+	if !autoProvisionConfig.RoleMappingPrecedence.IsValid() {
+		return fmt.Errorf("%w: unknown precedence %q", ErrRoleMappingInvalid, autoProvisionConfig.RoleMappingPrecedence)
+	}
+
+	for _, mapping := range autoProvisionConfig.RoleMappings {
+		if strings.TrimSpace(mapping.ClaimValue) == "" {
+			return fmt.Errorf("%w: claim_value is required", ErrRoleMappingInvalid)
+		} else if _, err := r.GetRole(ctx, mapping.RoleID); err != nil {
+			return fmt.Errorf("%w: role id %d is invalid", ErrRoleMappingInvalid, mapping.RoleID)
+		}
+	}
+
+	return nil
+}
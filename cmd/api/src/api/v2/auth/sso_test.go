@@ -360,6 +360,90 @@ func TestManagementResource_SanitizeAndGetRoles(t *testing.T) {
 		require.Len(t, roles, 1)
 		require.Equal(t, roles[0].ID, roleProvisionEnabledConfig.DefaultRoleId)
 	})
+
+	t.Run("role mappings - return mapped role", func(t *testing.T) {
+		config := roleProvisionEnabledConfig
+		config.RoleMappings = []model.SSOProviderRoleMapping{{ClaimValue: "Domain Admins", RoleID: 1}}
+
+		mockDB.EXPECT().GetAllRoles(gomock.Any(), "", model.SQLFilter{}).Return(dbRoles, nil)
+		roles, err := auth.SanitizeAndGetRoles(testCtx, config, []string{"bh-valid-role", "domain admins"}, mockDB)
+		require.Nil(t, err)
+		require.Len(t, roles, 1)
+		require.Equal(t, dbRoles[0].ID, roles[0].ID)
+	})
+
+	t.Run("role mappings - return default role when multiple mapped roles and no precedence", func(t *testing.T) {
+		config := roleProvisionEnabledConfig
+		config.RoleMappings = []model.SSOProviderRoleMapping{{ClaimValue: "Helpdesk", RoleID: 3}, {ClaimValue: "Domain Admins", RoleID: 1}}
+
+		mockDB.EXPECT().GetAllRoles(gomock.Any(), "", model.SQLFilter{}).Return(dbRoles, nil)
+		roles, err := auth.SanitizeAndGetRoles(testCtx, config, []string{"Domain Admins", "Helpdesk"}, mockDB)
+		require.Nil(t, err)
+		require.Len(t, roles, 1)
+		require.Equal(t, config.DefaultRoleId, roles[0].ID)
+	})
+
+	t.Run("role mappings - ordered precedence returns first mapped role", func(t *testing.T) {
+		config := roleProvisionEnabledConfig
+		config.RoleMappingPrecedence = model.SSOProviderRoleMappingPrecedenceOrdered
+		config.RoleMappings = []model.SSOProviderRoleMapping{{ClaimValue: "Helpdesk", RoleID: 3}, {ClaimValue: "Domain Admins", RoleID: 1}}
+
+		mockDB.EXPECT().GetAllRoles(gomock.Any(), "", model.SQLFilter{}).Return(dbRoles, nil)
+		roles, err := auth.SanitizeAndGetRoles(testCtx, config, []string{"Domain Admins", "Helpdesk"}, mockDB)
+		require.Nil(t, err)
+		require.Len(t, roles, 1)
+		require.Equal(t, dbRoles[2].ID, roles[0].ID)
+	})
+
+	t.Run("role mappings - highest privilege precedence returns the highest ranked built-in role", func(t *testing.T) {
+		var (
+			config          = roleProvisionEnabledConfig
+			privilegedRoles = model.Roles{
+				// Permission counts do not decide precedence
+				{Name: bhceauth.RoleAdministrator, Serial: model.Serial{ID: 1}, Permissions: model.Permissions{{Authority: "a"}}},
+				{Name: "Default Role", Serial: model.Serial{ID: 2}},
+				{Name: bhceauth.RolePowerUser, Serial: model.Serial{ID: 3}, Permissions: model.Permissions{{Authority: "a"}, {Authority: "b"}}},
+			}
+		)
+		config.RoleMappingPrecedence = model.SSOProviderRoleMappingPrecedenceHighestPrivilege
+		config.RoleMappings = []model.SSOProviderRoleMapping{{ClaimValue: "Helpdesk", RoleID: 3}, {ClaimValue: "Domain Admins", RoleID: 1}}
+
+		mockDB.EXPECT().GetAllRoles(gomock.Any(), "", model.SQLFilter{}).Return(privilegedRoles, nil)
+		roles, err := auth.SanitizeAndGetRoles(testCtx, config, []string{"Helpdesk", "Domain Admins"}, mockDB)
+		require.Nil(t, err)
+		require.Len(t, roles, 1)
+		require.Equal(t, privilegedRoles[0].ID, roles[0].ID)
+	})
+
+	t.Run("role mappings - highest privilege precedence ranks custom roles below built-in roles", func(t *testing.T) {
+		var (
+			config          = roleProvisionEnabledConfig
+			privilegedRoles = model.Roles{
+				{Name: "Custom Role", Serial: model.Serial{ID: 1}, Permissions: model.Permissions{{Authority: "a"}, {Authority: "b"}}},
+				{Name: "Default Role", Serial: model.Serial{ID: 2}},
+				{Name: bhceauth.RoleReadOnly, Serial: model.Serial{ID: 3}},
+			}
+		)
+		config.RoleMappingPrecedence = model.SSOProviderRoleMappingPrecedenceHighestPrivilege
+		config.RoleMappings = []model.SSOProviderRoleMapping{{ClaimValue: "Domain Admins", RoleID: 1}, {ClaimValue: "Helpdesk", RoleID: 3}}
+
+		mockDB.EXPECT().GetAllRoles(gomock.Any(), "", model.SQLFilter{}).Return(privilegedRoles, nil)
+		roles, err := auth.SanitizeAndGetRoles(testCtx, config, []string{"Helpdesk", "Domain Admins"}, mockDB)
+		require.Nil(t, err)
+		require.Len(t, roles, 1)
+		require.Equal(t, privilegedRoles[2].ID, roles[0].ID)
+	})
+
+	t.Run("role mappings - fall back to role slugs when no mapping matches", func(t *testing.T) {
+		config := roleProvisionEnabledConfig
+		config.RoleMappings = []model.SSOProviderRoleMapping{{ClaimValue: "Domain Admins", RoleID: 1}}
+
+		mockDB.EXPECT().GetAllRoles(gomock.Any(), "", model.SQLFilter{}).Return(dbRoles, nil)
+		roles, err := auth.SanitizeAndGetRoles(testCtx, config, []string{"bh-valid-role"}, mockDB)
+		require.Nil(t, err)
+		require.Len(t, roles, 1)
+		require.Equal(t, dbRoles[2].ID, roles[0].ID)
+	})
 }
 
 func TestManagementResource_SSOLoginHandler(t *testing.T) {
@@ -0,0 +1,59 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"testing"
+	"time"
+
+	v2 "github.com/specterops/bloodhound/cmd/api/src/api/v2"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateAuthTokenRestrictions(t *testing.T) {
+	now := time.Now().UTC()
+
+	require.NoError(t, validateAuthTokenRestrictions(v2.CreateUserToken{}, now))
+	require.NoError(t, validateAuthTokenRestrictions(v2.CreateUserToken{
+		ExpiresAt:    null.TimeFrom(now.Add(time.Hour)),
+		AllowedCIDRs: []string{"10.0.0.0/8", "2001:db8::/32"},
+	}, now))
+
+	require.EqualError(t, validateAuthTokenRestrictions(v2.CreateUserToken{ExpiresAt: null.TimeFrom(now.Add(-time.Hour))}, now), ErrResponseDetailsTokenExpiryInPast)
+	require.EqualError(t, validateAuthTokenRestrictions(v2.CreateUserToken{AllowedCIDRs: []string{"10.0.0.1"}}, now), "invalid allowed cidr: 10.0.0.1")
+}
+
+func TestScopeAuthTokenPermissions(t *testing.T) {
+	var (
+		ingest = model.Permission{Authority: "graphdb", Name: "Ingest", Serial: model.Serial{ID: 1}}
+		read   = model.Permission{Authority: "graphdb", Name: "Read", Serial: model.Serial{ID: 2}}
+		owner  = model.User{Roles: model.Roles{{Permissions: model.Permissions{ingest, read}}}}
+	)
+
+	scoped, err := scopeAuthTokenPermissions(nil, owner)
+	require.NoError(t, err)
+	require.Empty(t, scoped)
+
+	scoped, err = scopeAuthTokenPermissions([]int32{1, 1}, owner)
+	require.NoError(t, err)
+	require.Equal(t, model.Permissions{ingest}, scoped)
+
+	_, err = scopeAuthTokenPermissions([]int32{1, 3}, owner)
+	require.EqualError(t, err, "permission 3 is not granted to the token owner")
+}
@@ -0,0 +1,211 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	"github.com/specterops/bloodhound/cmd/api/src/auth"
+	"github.com/specterops/bloodhound/cmd/api/src/database"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
+)
+
+const (
+	ErrResponseDetailsWebAuthnNameRequired       = "a name of at most 100 characters is required"
+	ErrResponseDetailsWebAuthnCredentialExists   = "the webauthn credential is already registered"
+	ErrResponseDetailsWebAuthnCredentialRequired = "administrators must keep at least one webauthn credential while the mfa policy requires phishing-resistant mfa"
+
+	webAuthnCredentialNameMaxLength = 100
+)
+
+type WebAuthnRegistrationOptionsRequest struct {
+	Secret string `json:"secret"`
+}
+
+type WebAuthnRegistrationRequest struct {
+	Name       string                            `json:"name"`
+	Credential auth.WebAuthnRegistrationResponse `json:"credential"`
+}
+
+type UpdateWebAuthnCredentialRequest struct {
+	Name string `json:"name"`
+}
+
+type ListWebAuthnCredentialsResponse struct {
+	Credentials model.WebAuthnCredentials `json:"credentials"`
+}
+
+func validWebAuthnCredentialName(name string) bool {
+	return name != "" && len(name) <= webAuthnCredentialNameMaxLength
+}
+
+// ListWebAuthnCredentials lists the WebAuthn credentials registered by the user identified in the request path
+func (s ManagementResource) ListWebAuthnCredentials(response http.ResponseWriter, request *http.Request) {
+	s.withPathUser(response, request, func(response http.ResponseWriter, request *http.Request, user model.User) {
+		if credentials, err := s.db.GetWebAuthnCredentials(request.Context(), user.ID); err != nil {
+			api.HandleDatabaseError(request, response, err)
+		} else {
+			api.WriteBasicResponse(request.Context(), ListWebAuthnCredentialsResponse{Credentials: credentials}, http.StatusOK, response)
+		}
+	})
+}
+
+// BeginWebAuthnRegistration revalidates the secret of the user identified in the request path and issues the options
+// for a WebAuthn registration ceremony
+func (s ManagementResource) BeginWebAuthnRegistration(response http.ResponseWriter, request *http.Request) {
+	s.withPathUser(response, request, func(response http.ResponseWriter, request *http.Request, user model.User) {
+		var payload WebAuthnRegistrationOptionsRequest
+
+		if err := api.ReadJSONRequestPayloadLimited(&payload, request); err != nil {
+			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrContentTypeJson.Error(), request), response)
+		} else if user.SSOProviderID.Valid || user.AuthSecret == nil {
+			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "Invalid operation, user is SSO", request), response)
+		} else if err := api.ValidateSecret(s.secretDigester, payload.Secret, *user.AuthSecret); err != nil {
+			// As with MFA enrollment, an invalid secret in the payload of an authenticated request is a bad request
+			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, ErrResponseDetailsInvalidCurrentPassword, request), response)
+		} else if credentials, err := s.db.GetWebAuthnCredentials(request.Context(), user.ID); err != nil {
+			api.HandleDatabaseError(request, response, err)
+		} else if challenge, err := auth.GenerateWebAuthnChallenge(); err != nil {
+			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, api.ErrorResponseDetailsInternalServerError, request), response)
+		} else if err := s.db.CreateWebAuthnChallenge(request.Context(), model.WebAuthnChallenge{
+			Challenge: challenge,
+			UserID:    user.ID,
+			Ceremony:  auth.WebAuthnCeremonyRegistration,
+			ExpiresAt: time.Now().UTC().Add(auth.WebAuthnChallengeTimeout),
+		}); err != nil {
+			api.HandleDatabaseError(request, response, err)
+		} else {
+			var (
+				relyingParty = auth.NewWebAuthnRelyingParty(s.config.RootURL.AsURL())
+				displayName  = strings.TrimSpace(user.FirstName.ValueOrZero() + " " + user.LastName.ValueOrZero())
+			)
+
+			if displayName == "" {
+				displayName = user.PrincipalName
+			}
+
+			api.WriteBasicResponse(request.Context(), relyingParty.CreationOptions(challenge, auth.WebAuthnUserEntity{
+				ID:          user.ID.Bytes(),
+				Name:        user.PrincipalName,
+				DisplayName: displayName,
+			}, auth.WebAuthnCredentialDescriptors(credentials)), http.StatusOK, response)
+		}
+	})
+}
+
+// FinishWebAuthnRegistration verifies the response to a registration ceremony started with BeginWebAuthnRegistration
+// and stores the new credential for the user identified in the request path
+func (s ManagementResource) FinishWebAuthnRegistration(response http.ResponseWriter, request *http.Request) {
+	s.withPathUser(response, request, func(response http.ResponseWriter, request *http.Request, user model.User) {
+		var (
+			payload      WebAuthnRegistrationRequest
+			relyingParty = auth.NewWebAuthnRelyingParty(s.config.RootURL.AsURL())
+		)
+
+		if err := api.ReadJSONRequestPayloadLimited(&payload, request); err != nil {
+			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrContentTypeJson.Error(), request), response)
+		} else if payload.Name = strings.TrimSpace(payload.Name); !validWebAuthnCredentialName(payload.Name) {
+			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, ErrResponseDetailsWebAuthnNameRequired, request), response)
+		} else if user.SSOProviderID.Valid || user.AuthSecret == nil {
+			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "Invalid operation, user is SSO", request), response)
+		} else if challenge, err := auth.WebAuthnClientDataChallenge(payload.Credential.Response.ClientDataJSON); err != nil {
+			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsWebAuthnInvalid, request), response)
+		} else if err := s.db.ConsumeWebAuthnChallenge(request.Context(), user.ID, auth.WebAuthnCeremonyRegistration, challenge); errors.Is(err, database.ErrNotFound) {
+			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsWebAuthnInvalid, request), response)
+		} else if err != nil {
+			api.HandleDatabaseError(request, response, err)
+		} else if registered, err := relyingParty.VerifyRegistration(challenge, payload.Credential); err != nil {
+			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsWebAuthnInvalid, request), response)
+		} else if credentials, err := s.db.GetWebAuthnCredentials(request.Context(), user.ID); err != nil {
+			api.HandleDatabaseError(request, response, err)
+		} else {
+			for _, credential := range credentials {
+				if bytes.Equal(credential.CredentialID, registered.CredentialID) {
+					api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusConflict, ErrResponseDetailsWebAuthnCredentialExists, request), response)
+					return
+				}
+			}
+
+			if credential, err := s.db.CreateWebAuthnCredential(request.Context(), model.WebAuthnCredential{
+				UserID:       user.ID,
+				Name:         payload.Name,
+				CredentialID: registered.CredentialID,
+				PublicKey:    registered.PublicKey,
+				SignCount:    int64(registered.SignCount),
+				AAGUID:       registered.AAGUID,
+				Transports:   registered.Transports,
+			}); err != nil {
+				api.HandleDatabaseError(request, response, err)
+			} else {
+				api.WriteBasicResponse(request.Context(), credential, http.StatusCreated, response)
+			}
+		}
+	})
+}
+
+// UpdateWebAuthnCredential renames a WebAuthn credential of the user identified in the request path
+func (s ManagementResource) UpdateWebAuthnCredential(response http.ResponseWriter, request *http.Request) {
+	s.withPathUser(response, request, func(response http.ResponseWriter, request *http.Request, user model.User) {
+		var payload UpdateWebAuthnCredentialRequest
+
+		if credentialID, err := strconv.ParseInt(mux.Vars(request)[api.URIPathVariableWebAuthnCredentialID], 10, 32); err != nil {
+			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsIDMalformed, request), response)
+		} else if err := api.ReadJSONRequestPayloadLimited(&payload, request); err != nil {
+			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrContentTypeJson.Error(), request), response)
+		} else if payload.Name = strings.TrimSpace(payload.Name); !validWebAuthnCredentialName(payload.Name) {
+			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, ErrResponseDetailsWebAuthnNameRequired, request), response)
+		} else if credential, err := s.db.GetUserWebAuthnCredential(request.Context(), user.ID, int32(credentialID)); err != nil {
+			api.HandleDatabaseError(request, response, err)
+		} else {
+			credential.Name = payload.Name
+
+			if err := s.db.UpdateWebAuthnCredential(request.Context(), credential); err != nil {
+				api.HandleDatabaseError(request, response, err)
+			} else {
+				api.WriteBasicResponse(request.Context(), credential, http.StatusOK, response)
+			}
+		}
+	})
+}
+
+// DeleteWebAuthnCredential removes a WebAuthn credential of the user identified in the request path. Administrators
+// may not remove their last credential while the MFA policy requires them to sign in with one.
+func (s ManagementResource) DeleteWebAuthnCredential(response http.ResponseWriter, request *http.Request) {
+	s.withPathUser(response, request, func(response http.ResponseWriter, request *http.Request, user model.User) {
+		if credentialID, err := strconv.ParseInt(mux.Vars(request)[api.URIPathVariableWebAuthnCredentialID], 10, 32); err != nil {
+			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsIDMalformed, request), response)
+		} else if credential, err := s.db.GetUserWebAuthnCredential(request.Context(), user.ID, int32(credentialID)); err != nil {
+			api.HandleDatabaseError(request, response, err)
+		} else if credentials, err := s.db.GetWebAuthnCredentials(request.Context(), user.ID); err != nil {
+			api.HandleDatabaseError(request, response, err)
+		} else if len(credentials) <= 1 && user.Roles.Has(model.Role{Name: auth.RoleAdministrator}) && appcfg.GetMFAPolicy(request.Context(), s.db).RequirePhishingResistantMFAForAdministrators {
+			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, ErrResponseDetailsWebAuthnCredentialRequired, request), response)
+		} else if err := s.db.DeleteWebAuthnCredential(request.Context(), credential); err != nil {
+			api.HandleDatabaseError(request, response, err)
+		} else {
+			response.WriteHeader(http.StatusNoContent)
+		}
+	})
+}
@@ -0,0 +1,297 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package auth_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	"github.com/specterops/bloodhound/cmd/api/src/api/v2/apitest"
+	"github.com/specterops/bloodhound/cmd/api/src/api/v2/auth"
+	authz "github.com/specterops/bloodhound/cmd/api/src/auth"
+	"github.com/specterops/bloodhound/cmd/api/src/ctx"
+	"github.com/specterops/bloodhound/cmd/api/src/database"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
+	"github.com/specterops/bloodhound/cmd/api/src/test/must"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newWebAuthnRequest(t *testing.T, method string, user model.User, body any, pathVars map[string]string) *http.Request {
+	t.Helper()
+
+	var payload bytes.Buffer
+	if body != nil {
+		require.NoError(t, json.NewEncoder(&payload).Encode(body))
+	}
+
+	req, err := http.NewRequest(method, "/api/v2/bloodhound-users/"+user.ID.String()+"/webauthn-credentials", &payload)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+
+	if pathVars == nil {
+		pathVars = map[string]string{}
+	}
+	pathVars[api.URIPathVariableUserID] = user.ID.String()
+	req = mux.SetURLVars(req, pathVars)
+
+	return req.WithContext(context.WithValue(req.Context(), ctx.ValueKey, &ctx.Context{
+		AuthCtx: authz.Context{Owner: user},
+	}))
+}
+
+func TestManagementResource_ListWebAuthnCredentials(t *testing.T) {
+	var (
+		mockCtrl          = gomock.NewController(t)
+		resources, mockDB = apitest.NewAuthManagementResource(mockCtrl)
+		user              = model.User{Unique: model.Unique{ID: must.NewUUIDv4()}}
+		credentials       = model.WebAuthnCredentials{{UserID: user.ID, Name: "YubiKey", PublicKey: []byte("public key"), Serial: model.Serial{ID: 1}}}
+	)
+	defer mockCtrl.Finish()
+
+	mockDB.EXPECT().GetUser(gomock.Any(), user.ID).Return(user, nil)
+	mockDB.EXPECT().GetWebAuthnCredentials(gomock.Any(), user.ID).Return(credentials, nil)
+
+	response := httptest.NewRecorder()
+	resources.ListWebAuthnCredentials(response, newWebAuthnRequest(t, http.MethodGet, user, nil, nil))
+
+	require.Equal(t, http.StatusOK, response.Code)
+	assert.Contains(t, response.Body.String(), `"name":"YubiKey"`)
+	assert.NotContains(t, response.Body.String(), "public_key")
+}
+
+func TestManagementResource_BeginWebAuthnRegistration(t *testing.T) {
+	var (
+		user = model.User{
+			PrincipalName: "alice",
+			AuthSecret:    defaultDigestAuthSecret(t, "currentPassword1!"),
+			Unique:        model.Unique{ID: must.NewUUIDv4()},
+		}
+	)
+
+	t.Run("invalid secret", func(t *testing.T) {
+		var (
+			mockCtrl          = gomock.NewController(t)
+			resources, mockDB = apitest.NewAuthManagementResource(mockCtrl)
+		)
+		defer mockCtrl.Finish()
+
+		mockDB.EXPECT().GetUser(gomock.Any(), user.ID).Return(user, nil)
+
+		response := httptest.NewRecorder()
+		resources.BeginWebAuthnRegistration(response, newWebAuthnRequest(t, http.MethodPost, user, auth.WebAuthnRegistrationOptionsRequest{Secret: "wrong"}, nil))
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		var (
+			mockCtrl          = gomock.NewController(t)
+			resources, mockDB = apitest.NewAuthManagementResource(mockCtrl)
+			existing          = model.WebAuthnCredential{UserID: user.ID, CredentialID: []byte("existing")}
+			issued            model.WebAuthnChallenge
+		)
+		defer mockCtrl.Finish()
+
+		mockDB.EXPECT().GetUser(gomock.Any(), user.ID).Return(user, nil)
+		mockDB.EXPECT().GetWebAuthnCredentials(gomock.Any(), user.ID).Return(model.WebAuthnCredentials{existing}, nil)
+		mockDB.EXPECT().CreateWebAuthnChallenge(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, challenge model.WebAuthnChallenge) error {
+			issued = challenge
+			return nil
+		})
+
+		response := httptest.NewRecorder()
+		resources.BeginWebAuthnRegistration(response, newWebAuthnRequest(t, http.MethodPost, user, auth.WebAuthnRegistrationOptionsRequest{Secret: "currentPassword1!"}, nil))
+
+		require.Equal(t, http.StatusOK, response.Code)
+
+		var body struct {
+			Data authz.WebAuthnCreationOptions `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(response.Body.Bytes(), &body))
+		assert.Equal(t, user.ID, issued.UserID)
+		assert.Equal(t, authz.WebAuthnCeremonyRegistration, issued.Ceremony)
+		assert.Equal(t, issued.Challenge, body.Data.Challenge)
+		assert.Equal(t, "localhost", body.Data.RelyingParty.ID)
+		assert.Equal(t, authz.Base64URL(user.ID.Bytes()), body.Data.User.ID)
+		require.Len(t, body.Data.ExcludeCredentials, 1)
+		assert.Equal(t, authz.Base64URL("existing"), body.Data.ExcludeCredentials[0].ID)
+	})
+}
+
+func TestManagementResource_FinishWebAuthnRegistration(t *testing.T) {
+	var (
+		user         = model.User{AuthSecret: &model.AuthSecret{}, Unique: model.Unique{ID: must.NewUUIDv4()}}
+		registration = auth.WebAuthnRegistrationRequest{
+			Name: "YubiKey",
+			Credential: authz.WebAuthnRegistrationResponse{
+				Type: authz.WebAuthnCredentialType,
+				Response: authz.WebAuthnAttestationResponse{
+					ClientDataJSON: []byte(`{"type":"webauthn.create","challenge":"challenge","origin":"http://localhost"}`),
+				},
+			},
+		}
+	)
+
+	t.Run("name is required", func(t *testing.T) {
+		var (
+			mockCtrl          = gomock.NewController(t)
+			resources, mockDB = apitest.NewAuthManagementResource(mockCtrl)
+			unnamed           = registration
+		)
+		defer mockCtrl.Finish()
+
+		unnamed.Name = "  "
+		mockDB.EXPECT().GetUser(gomock.Any(), user.ID).Return(user, nil)
+
+		response := httptest.NewRecorder()
+		resources.FinishWebAuthnRegistration(response, newWebAuthnRequest(t, http.MethodPost, user, unnamed, nil))
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+
+	t.Run("unknown challenge", func(t *testing.T) {
+		var (
+			mockCtrl          = gomock.NewController(t)
+			resources, mockDB = apitest.NewAuthManagementResource(mockCtrl)
+		)
+		defer mockCtrl.Finish()
+
+		mockDB.EXPECT().GetUser(gomock.Any(), user.ID).Return(user, nil)
+		mockDB.EXPECT().ConsumeWebAuthnChallenge(gomock.Any(), user.ID, authz.WebAuthnCeremonyRegistration, "challenge").Return(database.ErrNotFound)
+
+		response := httptest.NewRecorder()
+		resources.FinishWebAuthnRegistration(response, newWebAuthnRequest(t, http.MethodPost, user, registration, nil))
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+		assert.Contains(t, response.Body.String(), api.ErrorResponseDetailsWebAuthnInvalid)
+	})
+
+	t.Run("invalid attestation", func(t *testing.T) {
+		var (
+			mockCtrl          = gomock.NewController(t)
+			resources, mockDB = apitest.NewAuthManagementResource(mockCtrl)
+		)
+		defer mockCtrl.Finish()
+
+		mockDB.EXPECT().GetUser(gomock.Any(), user.ID).Return(user, nil)
+		mockDB.EXPECT().ConsumeWebAuthnChallenge(gomock.Any(), user.ID, authz.WebAuthnCeremonyRegistration, "challenge").Return(nil)
+
+		response := httptest.NewRecorder()
+		resources.FinishWebAuthnRegistration(response, newWebAuthnRequest(t, http.MethodPost, user, registration, nil))
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+		assert.Contains(t, response.Body.String(), api.ErrorResponseDetailsWebAuthnInvalid)
+	})
+}
+
+func TestManagementResource_UpdateWebAuthnCredential(t *testing.T) {
+	var (
+		mockCtrl          = gomock.NewController(t)
+		resources, mockDB = apitest.NewAuthManagementResource(mockCtrl)
+		user              = model.User{Unique: model.Unique{ID: must.NewUUIDv4()}}
+		credential        = model.WebAuthnCredential{UserID: user.ID, Name: "YubiKey", Serial: model.Serial{ID: 3}}
+		renamed           = credential
+	)
+	defer mockCtrl.Finish()
+
+	renamed.Name = "Backup YubiKey"
+	mockDB.EXPECT().GetUser(gomock.Any(), user.ID).Return(user, nil)
+	mockDB.EXPECT().GetUserWebAuthnCredential(gomock.Any(), user.ID, int32(3)).Return(credential, nil)
+	mockDB.EXPECT().UpdateWebAuthnCredential(gomock.Any(), renamed).Return(nil)
+
+	response := httptest.NewRecorder()
+	resources.UpdateWebAuthnCredential(response, newWebAuthnRequest(t, http.MethodPatch, user, auth.UpdateWebAuthnCredentialRequest{Name: " Backup YubiKey "}, map[string]string{api.URIPathVariableWebAuthnCredentialID: "3"}))
+
+	require.Equal(t, http.StatusOK, response.Code)
+	assert.Contains(t, response.Body.String(), `"name":"Backup YubiKey"`)
+}
+
+func TestManagementResource_DeleteWebAuthnCredential(t *testing.T) {
+	var (
+		adminUser  = model.User{Roles: model.Roles{{Name: authz.RoleAdministrator}}, Unique: model.Unique{ID: must.NewUUIDv4()}}
+		credential = model.WebAuthnCredential{UserID: adminUser.ID, Name: "YubiKey", Serial: model.Serial{ID: 3}}
+		pathVars   = map[string]string{api.URIPathVariableWebAuthnCredentialID: "3"}
+		mfaPolicy  = appcfg.Parameter{
+			Key:   appcfg.MFAPolicy,
+			Value: types.JSONBObject{Object: appcfg.MFAPolicyParameters{RequirePhishingResistantMFAForAdministrators: true}},
+		}
+	)
+
+	t.Run("last credential of an administrator is kept while required by the MFA policy", func(t *testing.T) {
+		var (
+			mockCtrl          = gomock.NewController(t)
+			resources, mockDB = apitest.NewAuthManagementResource(mockCtrl)
+		)
+		defer mockCtrl.Finish()
+
+		mockDB.EXPECT().GetUser(gomock.Any(), adminUser.ID).Return(adminUser, nil)
+		mockDB.EXPECT().GetUserWebAuthnCredential(gomock.Any(), adminUser.ID, int32(3)).Return(credential, nil)
+		mockDB.EXPECT().GetWebAuthnCredentials(gomock.Any(), adminUser.ID).Return(model.WebAuthnCredentials{credential}, nil)
+		mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.MFAPolicy).Return(mfaPolicy, nil)
+
+		response := httptest.NewRecorder()
+		resources.DeleteWebAuthnCredential(response, newWebAuthnRequest(t, http.MethodDelete, adminUser, nil, pathVars))
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+		assert.Contains(t, response.Body.String(), auth.ErrResponseDetailsWebAuthnCredentialRequired)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		var (
+			mockCtrl          = gomock.NewController(t)
+			resources, mockDB = apitest.NewAuthManagementResource(mockCtrl)
+		)
+		defer mockCtrl.Finish()
+
+		mockDB.EXPECT().GetUser(gomock.Any(), adminUser.ID).Return(adminUser, nil)
+		mockDB.EXPECT().GetUserWebAuthnCredential(gomock.Any(), adminUser.ID, int32(3)).Return(credential, nil)
+		mockDB.EXPECT().GetWebAuthnCredentials(gomock.Any(), adminUser.ID).Return(model.WebAuthnCredentials{credential}, nil)
+		mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.MFAPolicy).Return(appcfg.Parameter{}, database.ErrNotFound)
+		mockDB.EXPECT().DeleteWebAuthnCredential(gomock.Any(), credential).Return(nil)
+
+		response := httptest.NewRecorder()
+		resources.DeleteWebAuthnCredential(response, newWebAuthnRequest(t, http.MethodDelete, adminUser, nil, pathVars))
+
+		assert.Equal(t, http.StatusNoContent, response.Code)
+	})
+
+	t.Run("credential of another user", func(t *testing.T) {
+		var (
+			mockCtrl          = gomock.NewController(t)
+			resources, mockDB = apitest.NewAuthManagementResource(mockCtrl)
+		)
+		defer mockCtrl.Finish()
+
+		mockDB.EXPECT().GetUser(gomock.Any(), adminUser.ID).Return(adminUser, nil)
+		mockDB.EXPECT().GetUserWebAuthnCredential(gomock.Any(), adminUser.ID, int32(3)).Return(model.WebAuthnCredential{}, database.ErrNotFound)
+
+		response := httptest.NewRecorder()
+		resources.DeleteWebAuthnCredential(response, newWebAuthnRequest(t, http.MethodDelete, adminUser, nil, pathVars))
+
+		assert.Equal(t, http.StatusNotFound, response.Code)
+	})
+}
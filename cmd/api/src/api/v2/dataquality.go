@@ -17,14 +17,19 @@
 package v2
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/specterops/bloodhound/cmd/api/src/api"
 	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
+	"github.com/specterops/bloodhound/cmd/api/src/services/dataquality"
 	"github.com/specterops/bloodhound/cmd/api/src/utils"
 	"github.com/specterops/bloodhound/packages/go/analysis/ad"
 	"github.com/specterops/bloodhound/packages/go/bhlog/measure"
@@ -35,6 +40,10 @@ const (
 	ErrNoTenantId        string = "no tenant id specified in url"
 	ErrNoPlatformId      string = "no platform id specified in url"
 	ErrInvalidPlatformId string = "invalid platform id specified in url: %v"
+
+	ErrInvalidRegressionThreshold string = "invalid regression_threshold: %v; expected a number between 0 and 1"
+
+	QueryParameterRegressionThreshold = "regression_threshold"
 )
 
 func (s Resources) GetDatabaseCompleteness(response http.ResponseWriter, request *http.Request) {
@@ -107,6 +116,45 @@ func (s *Resources) GetADDataQualityStats(response http.ResponseWriter, request
 	}
 }
 
+func (s *Resources) GetADDataQualityTrends(response http.ResponseWriter, request *http.Request) {
+	var (
+		queryParams              = request.URL.Query()
+		defaultEnd, defaultStart = DefaultTimeRange()
+		regressionThreshold      = dataquality.DefaultRegressionThreshold
+	)
+
+	if param := queryParams.Get(QueryParameterRegressionThreshold); param != "" {
+		if threshold, err := strconv.ParseFloat(param, 64); err != nil || threshold < 0 || threshold > 1 {
+			api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf(ErrInvalidRegressionThreshold, param), request), response)
+			return
+		} else {
+			regressionThreshold = threshold
+		}
+	}
+
+	if id, hasDomainID := mux.Vars(request)[api.URIPathVariableDomainID]; !hasDomainID {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorNoDomainId, request), response)
+	} else if start, err := ParseTimeQueryParameter(queryParams, "start", defaultStart); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf(api.ErrorInvalidRFC3339, queryParams["start"]), request), response)
+	} else if end, err := ParseTimeQueryParameter(queryParams, "end", defaultEnd); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf(api.ErrorInvalidRFC3339, queryParams["end"]), request), response)
+	} else if stats, _, err := s.DB.GetADDataQualityStats(request.Context(), id, start, end, "created_at", 0, 0); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else if staleEnvironments, err := dataquality.FetchStaleEnvironments(request.Context(), s.Graph, id, staleCutoff(request.Context(), s.DB)); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, fmt.Sprintf("Error fetching stale environments: %v", err), request), response)
+	} else {
+		trend := dataquality.ADDataQualityTrend(id, stats, regressionThreshold)
+		trend.StaleEnvironments = staleEnvironments
+
+		api.WriteTimeWindowedResponse(request.Context(), trend, start, end, http.StatusOK, response)
+	}
+}
+
+// staleCutoff is the time before which an environment that has not been seen in a collection is considered stale
+func staleCutoff(ctx context.Context, parameterService appcfg.ParameterService) time.Time {
+	return time.Now().AddDate(0, 0, -appcfg.GetDataQualityStaleDays(ctx, parameterService).StaleDays)
+}
+
 func (s *Resources) GetAzureDataQualityStats(response http.ResponseWriter, request *http.Request) {
 	var (
 		order                    []string
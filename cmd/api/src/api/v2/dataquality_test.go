@@ -35,6 +35,7 @@ import (
 	v2 "github.com/specterops/bloodhound/cmd/api/src/api/v2"
 	"github.com/specterops/bloodhound/cmd/api/src/database/mocks"
 	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -1002,3 +1003,105 @@ func TestResources_GetDatabaseCompleteness(t *testing.T) {
 		})
 	}
 }
+
+func TestResources_GetADDataQualityTrends(t *testing.T) {
+	t.Parallel()
+
+	const domainSID = "S-1-5-21-3130019616-2776909439-2417379446"
+
+	type mock struct {
+		mockDB    *mocks.MockDatabase
+		mockGraph *graphmocks.MockDatabase
+	}
+	type expected struct {
+		responseBody string
+		responseCode int
+	}
+	type testData struct {
+		name       string
+		query      string
+		setupMocks func(t *testing.T, mock *mock)
+		expected   expected
+	}
+
+	tt := []testData{
+		{
+			name:       "Error: invalid regression threshold - Bad Request",
+			query:      "?regression_threshold=2",
+			setupMocks: func(t *testing.T, mock *mock) {},
+			expected: expected{
+				responseCode: http.StatusBadRequest,
+				responseBody: `{"errors":[{"context":"","message":"invalid regression_threshold: 2; expected a number between 0 and 1"}],"http_status":400,"request_id":"","timestamp":"0001-01-01T00:00:00Z"}`,
+			},
+		},
+		{
+			name:       "Error: invalid start - Bad Request",
+			query:      "?start=invalidRFC3339",
+			setupMocks: func(t *testing.T, mock *mock) {},
+			expected: expected{
+				responseCode: http.StatusBadRequest,
+				responseBody: `{"errors":[{"context":"","message":"invalid RFC-3339 datetime format: [invalidRFC3339]"}],"http_status":400,"request_id":"","timestamp":"0001-01-01T00:00:00Z"}`,
+			},
+		},
+		{
+			name: "Error: database error - Internal Server Error",
+			setupMocks: func(t *testing.T, mock *mock) {
+				t.Helper()
+				mock.mockDB.EXPECT().GetADDataQualityStats(gomock.Any(), domainSID, gomock.Any(), gomock.Any(), "created_at", 0, 0).Return(nil, 0, errors.New("error"))
+			},
+			expected: expected{
+				responseCode: http.StatusInternalServerError,
+				responseBody: `{"errors":[{"context":"","message":"an internal error has occurred that is preventing the service from servicing this request"}],"http_status":500,"request_id":"","timestamp":"0001-01-01T00:00:00Z"}`,
+			},
+		},
+		{
+			name:  "Success - OK",
+			query: "?start=2022-03-23T07:20:50.52Z&end=2022-04-23T07:20:50.52Z&regression_threshold=0.2",
+			setupMocks: func(t *testing.T, mock *mock) {
+				t.Helper()
+				mock.mockDB.EXPECT().GetADDataQualityStats(gomock.Any(), domainSID, gomock.Any(), gomock.Any(), "created_at", 0, 0).Return(model.ADDataQualityStats{
+					{DomainSID: domainSID, Users: 10, SessionCompleteness: 0.9, RunID: "1"},
+					{DomainSID: domainSID, Users: 12, SessionCompleteness: 0.5, RunID: "2"},
+				}, 2, nil)
+				mock.mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.DataQualityStaleDays).Return(appcfg.Parameter{}, errors.New("not found"))
+				mock.mockGraph.EXPECT().ReadTransaction(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expected: expected{
+				responseCode: http.StatusOK,
+				responseBody: `{"start":"2022-03-23T07:20:50.52Z","end":"2022-04-23T07:20:50.52Z","data":{"domain_sid":"S-1-5-21-3130019616-2776909439-2417379446","deltas":[{"run_id":"2","created_at":"0001-01-01T00:00:00Z","users":2,"groups":0,"computers":0,"ous":0,"containers":0,"gpos":0,"acls":0,"sessions":0,"relationships":0,"session_completeness":-0.4,"local_group_completeness":0}],"regressions":[{"run_id":"2","created_at":"0001-01-01T00:00:00Z","metric":"session_completeness","previous":0.9,"current":0.5}],"stale_environments":[]}}`,
+			},
+		},
+	}
+	for _, testCase := range tt {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mocks := &mock{
+				mockDB:    mocks.NewMockDatabase(ctrl),
+				mockGraph: graphmocks.NewMockDatabase(ctrl),
+			}
+
+			testCase.setupMocks(t, mocks)
+
+			resources := v2.Resources{
+				DB:    mocks.mockDB,
+				Graph: mocks.mockGraph,
+			}
+
+			request, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/ad-domains/%s/data-quality-trends%s", domainSID, testCase.query), nil)
+			require.NoError(t, err)
+
+			response := httptest.NewRecorder()
+
+			router := mux.NewRouter()
+			router.HandleFunc(fmt.Sprintf("/api/v2/ad-domains/{%s}/data-quality-trends", api.URIPathVariableDomainID), resources.GetADDataQualityTrends).Methods(request.Method)
+			router.ServeHTTP(response, request)
+
+			status, _, body := test.ProcessResponse(t, response)
+
+			assert.Equal(t, testCase.expected.responseCode, status)
+			assert.JSONEq(t, testCase.expected.responseBody, body)
+		})
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	"github.com/specterops/bloodhound/cmd/api/src/auth"
+	"github.com/specterops/bloodhound/cmd/api/src/ctx"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/services/findings"
+	bhUtils "github.com/specterops/bloodhound/cmd/api/src/utils"
+	"github.com/specterops/bloodhound/packages/go/headers"
+)
+
+type FindingSummary struct {
+	findings.Definition
+	Open     int `json:"open"`
+	Accepted int `json:"accepted"`
+	Resolved int `json:"resolved"`
+}
+
+type UpdateFindingAcceptanceRequest struct {
+	Accepted *bool `json:"accepted"`
+}
+
+func parseFindingSQLFilter(request *http.Request) (model.SQLFilter, bool, *api.ErrorWrapper) {
+	var finding model.Finding
+
+	if queryFilters, err := model.NewQueryParameterFilterParser().ParseQueryParameterFilters(request); err != nil {
+		return model.SQLFilter{}, false, api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsBadQueryParameterFilters, request)
+	} else {
+		for name, filters := range queryFilters {
+			if validPredicates, err := api.GetValidFilterPredicatesAsStrings(finding, name); err != nil {
+				return model.SQLFilter{}, false, api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("%s: %s", api.ErrorResponseDetailsColumnNotFilterable, name), request)
+			} else {
+				for i, filter := range filters {
+					if !slices.Contains(validPredicates, string(filter.Operator)) {
+						return model.SQLFilter{}, false, api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("%s: %s %s", api.ErrorResponseDetailsFilterPredicateNotSupported, filter.Name, filter.Operator), request)
+					}
+
+					queryFilters[name][i].IsStringData = finding.IsStringColumn(filter.Name)
+				}
+			}
+		}
+
+		if sqlFilter, err := queryFilters.BuildSQLFilter(); err != nil {
+			return model.SQLFilter{}, false, api.BuildErrorResponse(http.StatusBadRequest, "error building SQL for filter", request)
+		} else {
+			return sqlFilter, len(queryFilters) > 0, nil
+		}
+	}
+}
+
+// ListFindings lists every finding definition along with the count of its open, accepted and resolved instances
+func (s *Resources) ListFindings(response http.ResponseWriter, request *http.Request) {
+	if counts, err := s.DB.GetFindingCounts(request.Context()); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		var (
+			catalog   = findings.Catalog()
+			summaries = make([]FindingSummary, len(catalog))
+		)
+
+		for idx, definition := range catalog {
+			summaries[idx].Definition = definition
+
+			for _, count := range counts {
+				if count.Finding == definition.Name {
+					summaries[idx].Open = count.Open
+					summaries[idx].Accepted = count.Accepted
+					summaries[idx].Resolved = count.Resolved
+				}
+			}
+		}
+
+		api.WriteBasicResponse(request.Context(), summaries, http.StatusOK, response)
+	}
+}
+
+// GetFindingInstances lists the finding instances matching the request filters. Resolved instances are included unless
+// filtered out with resolved_at=eq:null.
+func (s *Resources) GetFindingInstances(response http.ResponseWriter, request *http.Request) {
+	queryParams := request.URL.Query()
+
+	if skip, err := ParseSkipQueryParameter(queryParams, 0); err != nil {
+		api.WriteErrorResponse(request.Context(), ErrBadQueryParameter(request, model.PaginationQueryParameterSkip, err), response)
+	} else if limit, err := ParseLimitQueryParameter(queryParams, 100); err != nil {
+		api.WriteErrorResponse(request.Context(), ErrBadQueryParameter(request, model.PaginationQueryParameterLimit, err), response)
+	} else if sqlFilter, _, errWrapper := parseFindingSQLFilter(request); errWrapper != nil {
+		api.WriteErrorResponse(request.Context(), errWrapper, response)
+	} else if instances, count, err := s.DB.GetFindings(request.Context(), sqlFilter, skip, limit); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		if instances == nil {
+			instances = model.Findings{}
+		}
+
+		api.WriteResponseWrapperWithPagination(request.Context(), instances, limit, skip, count, http.StatusOK, response)
+	}
+}
+
+// UpdateFindingAcceptance accepts or unaccepts the risk of a single finding instance
+func (s *Resources) UpdateFindingAcceptance(response http.ResponseWriter, request *http.Request) {
+	var reqBody UpdateFindingAcceptanceRequest
+
+	if actor, isUser := auth.GetUserFromAuthCtx(ctx.FromRequest(request).AuthCtx); !isUser {
+		slog.Error("Unable to get user from auth context")
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, "unknown user", request), response)
+	} else if findingId, err := strconv.ParseInt(mux.Vars(request)[api.URIPathVariableFindingID], 10, 64); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusNotFound, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if err := json.NewDecoder(request.Body).Decode(&reqBody); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponsePayloadUnmarshalError, request), response)
+	} else if reqBody.Accepted == nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "accepted is required", request), response)
+	} else if finding, err := s.DB.UpdateFindingAcceptance(request.Context(), actor, findingId, *reqBody.Accepted); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		api.WriteBasicResponse(request.Context(), finding, http.StatusOK, response)
+	}
+}
+
+// ExportFindings writes every finding instance matching the request filters as a CSV attachment. Exports are audited as
+// an export of all risks when unfiltered and as an export of a list of risks otherwise.
+func (s *Resources) ExportFindings(response http.ResponseWriter, request *http.Request) {
+	if sqlFilter, filtered, errWrapper := parseFindingSQLFilter(request); errWrapper != nil {
+		api.WriteErrorResponse(request.Context(), errWrapper, response)
+	} else if instances, _, err := s.DB.GetFindings(request.Context(), sqlFilter, 0, 0); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		action := model.AuditLogActionExportAllRisks
+		if filtered {
+			action = model.AuditLogActionExportListRisks
+		}
+
+		if auditEntry, err := model.NewAuditEntry(action, model.AuditLogStatusSuccess, model.AuditData{
+			"filters": request.URL.RawQuery,
+			"count":   len(instances),
+		}); err != nil {
+			slog.ErrorContext(request.Context(), fmt.Sprintf("Failed to build findings export audit entry: %v", err))
+		} else if err := s.DB.AppendAuditLog(request.Context(), auditEntry); err != nil {
+			slog.ErrorContext(request.Context(), fmt.Sprintf("Failed to append findings export audit entry: %v", err))
+		}
+
+		response.Header().Set(headers.ContentDisposition.String(), fmt.Sprintf(bhUtils.ContentDispositionAttachmentTemplate, fmt.Sprintf("findings-%s.csv", time.Now().UTC().Format("20060102T150405Z"))))
+		api.WriteCSVResponse(request.Context(), instances, http.StatusOK, response)
+	}
+}
@@ -0,0 +1,288 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	v2 "github.com/specterops/bloodhound/cmd/api/src/api/v2"
+	"github.com/specterops/bloodhound/cmd/api/src/api/v2/apitest"
+	"github.com/specterops/bloodhound/cmd/api/src/database"
+	mocks_db "github.com/specterops/bloodhound/cmd/api/src/database/mocks"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/services/findings"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestResources_ListFindings(t *testing.T) {
+	var (
+		mockCtrl      = gomock.NewController(t)
+		mockDB        = mocks_db.NewMockDatabase(mockCtrl)
+		resourcesInst = v2.Resources{DB: mockDB}
+	)
+
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resourcesInst.ListFindings).
+		Run([]apitest.Case{
+			{
+				Name: "DatabaseError",
+				Setup: func() {
+					mockDB.EXPECT().GetFindingCounts(gomock.Any()).Return(nil, errors.New("failure")).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusInternalServerError)
+				},
+			},
+			{
+				Name: "Success",
+				Setup: func() {
+					mockDB.EXPECT().GetFindingCounts(gomock.Any()).
+						Return([]model.FindingCount{{Finding: findings.FindingNonTierZeroDCSync, Open: 2, Accepted: 1, Resolved: 3}}, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					var summaries []v2.FindingSummary
+
+					apitest.StatusCode(output, http.StatusOK)
+					apitest.UnmarshalData(output, &summaries)
+					require.Len(t, summaries, len(findings.Catalog()))
+
+					for _, summary := range summaries {
+						if summary.Name == findings.FindingNonTierZeroDCSync {
+							apitest.Equal(output, 2, summary.Open)
+							apitest.Equal(output, 1, summary.Accepted)
+							apitest.Equal(output, 3, summary.Resolved)
+						} else {
+							apitest.Equal(output, 0, summary.Open)
+						}
+					}
+				},
+			},
+		})
+}
+
+func TestResources_GetFindingInstances(t *testing.T) {
+	var (
+		mockCtrl      = gomock.NewController(t)
+		mockDB        = mocks_db.NewMockDatabase(mockCtrl)
+		resourcesInst = v2.Resources{DB: mockDB}
+	)
+
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resourcesInst.GetFindingInstances).
+		Run([]apitest.Case{
+			{
+				Name: "InvalidFilterColumn",
+				Input: func(input *apitest.Input) {
+					apitest.AddQueryParam(input, "accepted_by", "eq:someone")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, api.ErrorResponseDetailsColumnNotFilterable)
+				},
+			},
+			{
+				Name: "InvalidFilterPredicate",
+				Input: func(input *apitest.Input) {
+					apitest.AddQueryParam(input, "finding", "gt:ESC1DomainUsers")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, api.ErrorResponseDetailsFilterPredicateNotSupported)
+				},
+			},
+			{
+				Name: "DatabaseError",
+				Setup: func() {
+					mockDB.EXPECT().GetFindings(gomock.Any(), model.SQLFilter{}, 0, 100).Return(nil, 0, errors.New("failure")).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusInternalServerError)
+				},
+			},
+			{
+				Name: "Success",
+				Input: func(input *apitest.Input) {
+					apitest.AddQueryParam(input, "resolved_at", "eq:null")
+					apitest.AddQueryParam(input, model.PaginationQueryParameterLimit, "10")
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetFindings(gomock.Any(), model.SQLFilter{SQLString: "resolved_at is null"}, 0, 10).
+						Return(model.Findings{{ID: 1, Finding: findings.FindingNonTierZeroDCSync, PrincipalName: "HELPDESK@CORP.LOCAL"}}, 1, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusOK)
+					apitest.BodyContains(output, `"principal_name":"HELPDESK@CORP.LOCAL"`)
+					apitest.BodyContains(output, `"count":1`)
+				},
+			},
+		})
+}
+
+func TestResources_UpdateFindingAcceptance(t *testing.T) {
+	var (
+		mockCtrl      = gomock.NewController(t)
+		mockDB        = mocks_db.NewMockDatabase(mockCtrl)
+		resourcesInst = v2.Resources{DB: mockDB}
+		user          = setupUser()
+		userCtx       = setupUserCtx(user)
+		accepted      = true
+	)
+
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resourcesInst.UpdateFindingAcceptance).
+		Run([]apitest.Case{
+			{
+				Name: "NoUser",
+				Input: func(input *apitest.Input) {
+					apitest.SetURLVar(input, api.URIPathVariableFindingID, "1")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusInternalServerError)
+				},
+			},
+			{
+				Name: "InvalidFindingId",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableFindingID, "non-numeric")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNotFound)
+					apitest.BodyContains(output, api.ErrorResponseDetailsIDMalformed)
+				},
+			},
+			{
+				Name: "MissingAccepted",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableFindingID, "1")
+					apitest.BodyString(input, `{}`)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "accepted is required")
+				},
+			},
+			{
+				Name: "NotFound",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableFindingID, "2")
+					apitest.BodyStruct(input, v2.UpdateFindingAcceptanceRequest{Accepted: &accepted})
+				},
+				Setup: func() {
+					mockDB.EXPECT().UpdateFindingAcceptance(gomock.Any(), user, int64(2), true).Return(model.Finding{}, database.ErrNotFound).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNotFound)
+				},
+			},
+			{
+				Name: "Success",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.SetURLVar(input, api.URIPathVariableFindingID, "1")
+					apitest.BodyStruct(input, v2.UpdateFindingAcceptanceRequest{Accepted: &accepted})
+				},
+				Setup: func() {
+					mockDB.EXPECT().UpdateFindingAcceptance(gomock.Any(), user, int64(1), true).
+						Return(model.Finding{ID: 1, Finding: findings.FindingESC1DomainUsers, Accepted: true}, nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusOK)
+					apitest.BodyContains(output, `"accepted":true`)
+				},
+			},
+		})
+}
+
+func TestResources_ExportFindings(t *testing.T) {
+	var (
+		mockCtrl      = gomock.NewController(t)
+		mockDB        = mocks_db.NewMockDatabase(mockCtrl)
+		resourcesInst = v2.Resources{DB: mockDB}
+		seen          = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	)
+
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resourcesInst.ExportFindings).
+		Run([]apitest.Case{
+			{
+				Name: "DatabaseError",
+				Setup: func() {
+					mockDB.EXPECT().GetFindings(gomock.Any(), model.SQLFilter{}, 0, 0).Return(nil, 0, errors.New("failure")).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusInternalServerError)
+				},
+			},
+			{
+				Name: "SuccessAll",
+				Setup: func() {
+					mockDB.EXPECT().GetFindings(gomock.Any(), model.SQLFilter{}, 0, 0).
+						Return(model.Findings{{
+							Finding:           findings.FindingNonTierZeroDCSync,
+							EnvironmentId:     "S-1-5-21-1",
+							PrincipalObjectId: "S-1-5-21-1-1105",
+							PrincipalName:     "HELPDESK@CORP.LOCAL",
+							PrincipalKind:     "Group",
+							TargetObjectId:    "S-1-5-21-1",
+							TargetName:        "CORP.LOCAL",
+							TargetKind:        "Domain",
+							FirstSeen:         seen,
+							LastSeen:          seen,
+						}}, 1, nil).Times(1)
+					mockDB.EXPECT().AppendAuditLog(gomock.Any(), gomock.Cond(func(entry model.AuditEntry) bool {
+						return entry.Action == model.AuditLogActionExportAllRisks
+					})).Return(nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusOK)
+					apitest.BodyContains(output, "finding,environment_id,principal_object_id,principal_name,principal_kind,target_object_id,target_name,target_kind,first_seen,last_seen,resolved_at,accepted\n")
+					apitest.BodyContains(output, "NonTierZeroDCSync,S-1-5-21-1,S-1-5-21-1-1105,HELPDESK@CORP.LOCAL,Group,S-1-5-21-1,CORP.LOCAL,Domain,2026-01-02T03:04:05Z,2026-01-02T03:04:05Z,,false\n")
+				},
+			},
+			{
+				Name: "SuccessList",
+				Input: func(input *apitest.Input) {
+					apitest.AddQueryParam(input, "finding", "eq:"+findings.FindingESC1DomainUsers)
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetFindings(gomock.Any(), gomock.Any(), 0, 0).Return(model.Findings{}, 0, nil).Times(1)
+					mockDB.EXPECT().AppendAuditLog(gomock.Any(), gomock.Cond(func(entry model.AuditEntry) bool {
+						return entry.Action == model.AuditLogActionExportListRisks
+					})).Return(nil).Times(1)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusOK)
+				},
+			},
+		})
+}
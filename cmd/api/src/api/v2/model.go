@@ -17,6 +17,8 @@
 package v2
 
 import (
+	"time"
+
 	"github.com/gorilla/schema"
 	"github.com/specterops/bloodhound/cmd/api/src/api"
 	"github.com/specterops/bloodhound/cmd/api/src/auth"
@@ -40,6 +42,13 @@ type ListRolesResponse struct {
 	Roles model.Roles `json:"roles"`
 }
 
+// UpsertRoleRequest describes a custom role; permissions are referenced by their ID
+type UpsertRoleRequest struct {
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Permissions []int32 `json:"permissions"`
+}
+
 type ListUsersResponse struct {
 	Users model.Users `json:"users"`
 }
@@ -48,6 +57,21 @@ type ListTokensResponse struct {
 	Tokens model.AuthTokens `json:"tokens"`
 }
 
+type UserSession struct {
+	ID              int64     `json:"id"`
+	AuthProvider    string    `json:"auth_provider"`
+	AuthProviderID  int32     `json:"auth_provider_id"`
+	SourceIPAddress string    `json:"source_ip_address"`
+	UserAgent       string    `json:"user_agent"`
+	CreatedAt       time.Time `json:"created_at"`
+	ExpiresAt       time.Time `json:"expires_at"`
+	Current         bool      `json:"current"`
+}
+
+type ListUserSessionsResponse struct {
+	Sessions []UserSession `json:"sessions"`
+}
+
 type SAMLSignOnEndpoint struct {
 	Name          string    `json:"name"`
 	InitiationURL serde.URL `json:"initiation_url"`
@@ -88,8 +112,11 @@ type SetUserSecretRequest struct {
 }
 
 type CreateUserToken struct {
-	TokenName string `json:"token_name"`
-	UserID    string `json:"user_id"`
+	TokenName    string    `json:"token_name"`
+	UserID       string    `json:"user_id"`
+	ExpiresAt    null.Time `json:"expires_at"`
+	Permissions  []int32   `json:"permissions"`
+	AllowedCIDRs []string  `json:"allowed_cidrs"`
 }
 
 type CreateOIDCProviderRequest struct {
@@ -121,12 +121,51 @@ func parseRelationshipKindsParamFilter(relationshipKindsParam string) (graph.Cri
 	}
 }
 
+// parseConditionalAccessParamFilter returns criteria that exclude Azure relationships which Conditional Access would
+// block or require MFA for. A nil criteria is returned when no exclusion was requested.
+func parseConditionalAccessParamFilter(conditionalAccessParam string) (graph.Criteria, error) {
+	if conditionalAccessParam == "" {
+		return nil, nil
+	} else if !params.ExcludeConditionalAccess.Regexp().MatchString(conditionalAccessParam) {
+		return nil, fmt.Errorf("invalid query parameter '%s': acceptable values should match the format: block,mfa", params.ExcludeConditionalAccess)
+	}
+
+	var criteria []graph.Criteria
+
+	for _, exclusion := range strings.Split(conditionalAccessParam, ",") {
+		var propertyRef graph.Criteria
+
+		switch strings.TrimSpace(exclusion) {
+		case "block":
+			propertyRef = query.RelationshipProperty(azure.CAPBlocked.String())
+		case "mfa":
+			propertyRef = query.RelationshipProperty(azure.CAPMFARequired.String())
+		}
+
+		criteria = append(criteria, query.Or(
+			query.Not(query.Exists(propertyRef)),
+			query.Equals(propertyRef, false),
+		))
+	}
+
+	return query.And(criteria...), nil
+}
+
+func combinePathfindingFilters(kindFilter graph.Criteria, conditionalAccessFilter graph.Criteria) graph.Criteria {
+	if conditionalAccessFilter == nil {
+		return kindFilter
+	}
+
+	return query.And(kindFilter, conditionalAccessFilter)
+}
+
 func (s Resources) GetShortestPath(response http.ResponseWriter, request *http.Request) {
 	var (
 		queryParams            = request.URL.Query()
 		startNode              = queryParams.Get(params.StartNode.String())
 		endNode                = queryParams.Get(params.EndNode.String())
 		relationshipKindsParam = queryParams.Get(params.RelationshipKinds.String())
+		conditionalAccessParam = queryParams.Get(params.ExcludeConditionalAccess.String())
 	)
 
 	if startNode == "" {
@@ -135,7 +174,9 @@ func (s Resources) GetShortestPath(response http.ResponseWriter, request *http.R
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "Missing query parameter: end_node", request), response)
 	} else if kindFilter, err := parseRelationshipKindsParamFilter(relationshipKindsParam); err != nil {
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
-	} else if paths, err := s.GraphQuery.GetAllShortestPaths(request.Context(), startNode, endNode, kindFilter); err != nil {
+	} else if conditionalAccessFilter, err := parseConditionalAccessParamFilter(conditionalAccessParam); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
+	} else if paths, err := s.GraphQuery.GetAllShortestPaths(request.Context(), startNode, endNode, combinePathfindingFilters(kindFilter, conditionalAccessFilter)); err != nil {
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, err.Error(), request), response)
 	} else {
 		writeShortestPathsResult(paths, response, request)
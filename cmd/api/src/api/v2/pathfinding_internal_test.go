@@ -53,3 +53,26 @@ func Test_parseRelationshipKindsParam(t *testing.T) {
 	_, _, err = parseRelationshipKindsParam(validKinds, "LOLNO:Contains,GenericAll")
 	require.NotNil(t, err)
 }
+
+func Test_parseConditionalAccessParamFilter(t *testing.T) {
+	// Default case
+	criteria, err := parseConditionalAccessParamFilter("")
+
+	require.Nil(t, err)
+	require.Nil(t, criteria)
+
+	// Valid parameter definitions
+	criteria, err = parseConditionalAccessParamFilter("block")
+
+	require.Nil(t, err)
+	require.NotNil(t, criteria)
+
+	criteria, err = parseConditionalAccessParamFilter("block, mfa")
+
+	require.Nil(t, err)
+	require.NotNil(t, criteria)
+
+	// Expect an error if the outcome is unknown
+	_, err = parseConditionalAccessParamFilter("block,compliantDevice")
+	require.NotNil(t, err)
+}
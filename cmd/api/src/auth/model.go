@@ -37,6 +37,7 @@ const (
 	ProviderTypeSecret = "secret"
 	ProviderTypeSAML   = "saml"
 	ProviderTypeOIDC   = "oidc"
+	ProviderTypeLDAP   = "ldap"
 
 	HMAC_SHA2_256 = "hmac-sha2-256"
 )
@@ -76,7 +77,12 @@ func NewIdentityResolver() IdentityResolver {
 }
 
 func (s idResolver) GetIdentity(ctx Context) (SimpleIdentity, error) {
-	if user, ok := GetUserFromAuthCtx(ctx); !ok {
+	if provider, isSCIM := GetSCIMProviderFromAuthCtx(ctx); isSCIM {
+		return SimpleIdentity{
+			Name: "SCIM: " + provider.Name,
+			Key:  "sso_provider_id",
+		}, nil
+	} else if user, ok := GetUserFromAuthCtx(ctx); !ok {
 		return SimpleIdentity{}, errors.New("error retrieving user from auth context")
 	} else {
 		return SimpleIdentity{
@@ -27,6 +27,8 @@ type PermissionSet struct {
 	APsGenerateReport model.Permission
 	APsManageAPs      model.Permission
 
+	AuditLogRead model.Permission
+
 	AuthAcceptEULA                      model.Permission
 	AuthCreateToken                     model.Permission
 	AuthManageApplicationConfigurations model.Permission
@@ -57,6 +59,7 @@ func (s PermissionSet) All() model.Permissions {
 		s.AppWriteApplicationConfiguration,
 		s.APsGenerateReport,
 		s.APsManageAPs,
+		s.AuditLogRead,
 		s.AuthCreateToken,
 		s.AuthManageApplicationConfigurations,
 		s.AuthManageProviders,
@@ -85,6 +88,8 @@ func Permissions() PermissionSet {
 		APsGenerateReport: model.NewPermission("risks", "GenerateReport"),
 		APsManageAPs:      model.NewPermission("risks", "ManageRisks"),
 
+		AuditLogRead: model.NewPermission("audit", "Read"),
+
 		AuthAcceptEULA:                      model.NewPermission("auth", "AcceptEULA"),
 		AuthCreateToken:                     model.NewPermission("auth", "CreateToken"),
 		AuthManageApplicationConfigurations: model.NewPermission("auth", "ManageAppConfig"),
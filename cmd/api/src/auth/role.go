@@ -28,6 +28,21 @@ const (
 	RoleAdministrator = "Administrator"
 )
 
+// rolePrecedence lists the built-in roles from least to most privileged
+var rolePrecedence = []string{RoleUploadOnly, RoleReadOnly, RoleUser, RolePowerUser, RoleAdministrator}
+
+// RolePrecedence returns the rank of the named role in the built-in role order, where a higher rank is more
+// privileged. Roles that are not built-in rank below all built-in roles with a rank of -1.
+func RolePrecedence(roleName string) int {
+	for rank, name := range rolePrecedence {
+		if name == roleName {
+			return rank
+		}
+	}
+
+	return -1
+}
+
 type RoleTemplate struct {
 	Name        string
 	Description string
@@ -0,0 +1,340 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+	"github.com/gofrs/uuid"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+)
+
+const (
+	WebAuthnCeremonyRegistration   = "registration"
+	WebAuthnCeremonyAuthentication = "authentication"
+
+	WebAuthnChallengeTimeout = 5 * time.Minute
+
+	WebAuthnCredentialType            = "public-key"
+	WebAuthnAttestationNone           = "none"
+	WebAuthnResidentKeyDiscouraged    = "discouraged"
+	WebAuthnUserVerificationPreferred = "preferred"
+	WebAuthnRelyingPartyName          = "BloodHound"
+
+	webAuthnChallengeLength = 32
+)
+
+var (
+	ErrInvalidWebAuthn        = errors.New("invalid webauthn credential")
+	ErrWebAuthnSignCount      = errors.New("webauthn signature counter did not increase, the authenticator may have been cloned")
+	ErrUnsupportedWebAuthnKey = errors.New("unsupported webauthn public key")
+
+	// WebAuthnSupportedAlgorithms lists the COSE algorithms that may be used by registered credentials in order of preference
+	WebAuthnSupportedAlgorithms = []webauthncose.COSEAlgorithmIdentifier{webauthncose.AlgES256, webauthncose.AlgEdDSA, webauthncose.AlgRS256}
+)
+
+// Base64URL is a byte slice that is represented in JSON as unpadded base64url, which is the encoding used by the
+// WebAuthn JSON serializations of credential options and responses.
+type Base64URL []byte
+
+func (s Base64URL) MarshalJSON() ([]byte, error) {
+	return json.Marshal(base64.RawURLEncoding.EncodeToString(s))
+}
+
+func (s *Base64URL) UnmarshalJSON(data []byte) error {
+	var encoded string
+
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		return err
+	} else if decoded, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(encoded, "=")); err != nil {
+		return fmt.Errorf("invalid base64url value: %w", err)
+	} else {
+		*s = decoded
+		return nil
+	}
+}
+
+// GenerateWebAuthnChallenge returns a new random challenge encoded as unpadded base64url
+func GenerateWebAuthnChallenge() (string, error) {
+	challenge := make([]byte, webAuthnChallengeLength)
+
+	if _, err := rand.Read(challenge); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(challenge), nil
+}
+
+type WebAuthnRelyingPartyEntity struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type WebAuthnUserEntity struct {
+	ID          Base64URL `json:"id"`
+	Name        string    `json:"name"`
+	DisplayName string    `json:"displayName"`
+}
+
+type WebAuthnCredentialParameter struct {
+	Type      string `json:"type"`
+	Algorithm int64  `json:"alg"`
+}
+
+type WebAuthnCredentialDescriptor struct {
+	Type       string    `json:"type"`
+	ID         Base64URL `json:"id"`
+	Transports []string  `json:"transports,omitempty"`
+}
+
+type WebAuthnAuthenticatorSelection struct {
+	ResidentKey      string `json:"residentKey"`
+	UserVerification string `json:"userVerification"`
+}
+
+// WebAuthnCreationOptions mirrors the JSON serialization of PublicKeyCredentialCreationOptions so that it can be passed
+// to PublicKeyCredential.parseCreationOptionsFromJSON() by the browser
+type WebAuthnCreationOptions struct {
+	Challenge              string                         `json:"challenge"`
+	RelyingParty           WebAuthnRelyingPartyEntity     `json:"rp"`
+	User                   WebAuthnUserEntity             `json:"user"`
+	PubKeyCredParams       []WebAuthnCredentialParameter  `json:"pubKeyCredParams"`
+	Timeout                int64                          `json:"timeout"`
+	ExcludeCredentials     []WebAuthnCredentialDescriptor `json:"excludeCredentials"`
+	AuthenticatorSelection WebAuthnAuthenticatorSelection `json:"authenticatorSelection"`
+	Attestation            string                         `json:"attestation"`
+}
+
+// WebAuthnRequestOptions mirrors the JSON serialization of PublicKeyCredentialRequestOptions so that it can be passed
+// to PublicKeyCredential.parseRequestOptionsFromJSON() by the browser
+type WebAuthnRequestOptions struct {
+	Challenge        string                         `json:"challenge"`
+	Timeout          int64                          `json:"timeout"`
+	RelyingPartyID   string                         `json:"rpId"`
+	AllowCredentials []WebAuthnCredentialDescriptor `json:"allowCredentials"`
+	UserVerification string                         `json:"userVerification"`
+}
+
+type WebAuthnAttestationResponse struct {
+	ClientDataJSON    Base64URL `json:"clientDataJSON"`
+	AttestationObject Base64URL `json:"attestationObject"`
+	Transports        []string  `json:"transports,omitempty"`
+}
+
+// WebAuthnRegistrationResponse mirrors the JSON serialization of a PublicKeyCredential returned by
+// navigator.credentials.create()
+type WebAuthnRegistrationResponse struct {
+	ID       string                      `json:"id"`
+	RawID    Base64URL                   `json:"rawId"`
+	Type     string                      `json:"type"`
+	Response WebAuthnAttestationResponse `json:"response"`
+}
+
+type WebAuthnAssertionResponse struct {
+	ClientDataJSON    Base64URL `json:"clientDataJSON"`
+	AuthenticatorData Base64URL `json:"authenticatorData"`
+	Signature         Base64URL `json:"signature"`
+	UserHandle        Base64URL `json:"userHandle,omitempty"`
+}
+
+// WebAuthnAuthenticationResponse mirrors the JSON serialization of a PublicKeyCredential returned by
+// navigator.credentials.get()
+type WebAuthnAuthenticationResponse struct {
+	ID       string                    `json:"id"`
+	RawID    Base64URL                 `json:"rawId"`
+	Type     string                    `json:"type"`
+	Response WebAuthnAssertionResponse `json:"response"`
+}
+
+// WebAuthnRegisteredCredential contains the details of a newly registered credential that must be stored to verify
+// later assertions made with it
+type WebAuthnRegisteredCredential struct {
+	CredentialID []byte
+	PublicKey    []byte
+	SignCount    uint32
+	AAGUID       uuid.UUID
+	Transports   []string
+}
+
+// WebAuthnRelyingParty verifies WebAuthn registration and authentication ceremonies for a single origin
+type WebAuthnRelyingParty struct {
+	ID     string
+	Name   string
+	Origin string
+}
+
+func NewWebAuthnRelyingParty(rootURL url.URL) WebAuthnRelyingParty {
+	return WebAuthnRelyingParty{
+		ID:     rootURL.Hostname(),
+		Name:   WebAuthnRelyingPartyName,
+		Origin: rootURL.Scheme + "://" + rootURL.Host,
+	}
+}
+
+func (s WebAuthnRelyingParty) CreationOptions(challenge string, user WebAuthnUserEntity, excludeCredentials []WebAuthnCredentialDescriptor) WebAuthnCreationOptions {
+	credentialParameters := make([]WebAuthnCredentialParameter, len(WebAuthnSupportedAlgorithms))
+	for idx, algorithm := range WebAuthnSupportedAlgorithms {
+		credentialParameters[idx] = WebAuthnCredentialParameter{Type: WebAuthnCredentialType, Algorithm: int64(algorithm)}
+	}
+
+	return WebAuthnCreationOptions{
+		Challenge:          challenge,
+		RelyingParty:       WebAuthnRelyingPartyEntity{ID: s.ID, Name: s.Name},
+		User:               user,
+		PubKeyCredParams:   credentialParameters,
+		Timeout:            WebAuthnChallengeTimeout.Milliseconds(),
+		ExcludeCredentials: excludeCredentials,
+		AuthenticatorSelection: WebAuthnAuthenticatorSelection{
+			ResidentKey:      WebAuthnResidentKeyDiscouraged,
+			UserVerification: WebAuthnUserVerificationPreferred,
+		},
+		Attestation: WebAuthnAttestationNone,
+	}
+}
+
+func (s WebAuthnRelyingParty) RequestOptions(challenge string, allowCredentials []WebAuthnCredentialDescriptor) WebAuthnRequestOptions {
+	return WebAuthnRequestOptions{
+		Challenge:        challenge,
+		Timeout:          WebAuthnChallengeTimeout.Milliseconds(),
+		RelyingPartyID:   s.ID,
+		AllowCredentials: allowCredentials,
+		UserVerification: WebAuthnUserVerificationPreferred,
+	}
+}
+
+// VerifyRegistration validates the response to a registration ceremony started with the given challenge and returns
+// the credential to store. Attestation is not requested by the creation options so attestation statements are not
+// evaluated; the credential is trusted on the basis of the authenticated session that registers it.
+func (s WebAuthnRelyingParty) VerifyRegistration(challenge string, registration WebAuthnRegistrationResponse) (WebAuthnRegisteredCredential, error) {
+	if challenge == "" {
+		return WebAuthnRegisteredCredential{}, fmt.Errorf("%w: challenge is missing", ErrInvalidWebAuthn)
+	} else if parsed, err := registration.credentialCreationResponse().Parse(); err != nil {
+		return WebAuthnRegisteredCredential{}, fmt.Errorf("%w: %w", ErrInvalidWebAuthn, err)
+	} else if parsed.Response.CollectedClientData.CrossOrigin {
+		return WebAuthnRegisteredCredential{}, fmt.Errorf("%w: cross origin ceremonies are not allowed", ErrInvalidWebAuthn)
+	} else if _, err := parsed.Verify(challenge, false, true, s.ID, []string{s.Origin}, nil, protocol.TopOriginIgnoreVerificationMode, nil, webAuthnCredentialParameters()); err != nil {
+		return WebAuthnRegisteredCredential{}, fmt.Errorf("%w: %w", ErrInvalidWebAuthn, err)
+	} else if attestedData := parsed.Response.AttestationObject.AuthData.AttData; len(registration.RawID) > 0 && !bytes.Equal(registration.RawID, attestedData.CredentialID) {
+		return WebAuthnRegisteredCredential{}, fmt.Errorf("%w: credential id does not match attested credential data", ErrInvalidWebAuthn)
+	} else if _, err := webauthncose.ParsePublicKey(attestedData.CredentialPublicKey); err != nil {
+		return WebAuthnRegisteredCredential{}, fmt.Errorf("%w: %w", ErrUnsupportedWebAuthnKey, err)
+	} else if aaguid, err := uuid.FromBytes(attestedData.AAGUID); err != nil {
+		return WebAuthnRegisteredCredential{}, fmt.Errorf("%w: %w", ErrInvalidWebAuthn, err)
+	} else {
+		return WebAuthnRegisteredCredential{
+			CredentialID: attestedData.CredentialID,
+			PublicKey:    attestedData.CredentialPublicKey,
+			SignCount:    parsed.Response.AttestationObject.AuthData.Counter,
+			AAGUID:       aaguid,
+			Transports:   registration.Response.Transports,
+		}, nil
+	}
+}
+
+// VerifyAuthentication validates the response to an authentication ceremony started with the given challenge against
+// a stored credential public key and signature counter. The authenticator's new signature counter is returned.
+func (s WebAuthnRelyingParty) VerifyAuthentication(challenge string, publicKey []byte, storedSignCount uint32, authentication WebAuthnAuthenticationResponse) (uint32, error) {
+	if challenge == "" {
+		return 0, fmt.Errorf("%w: challenge is missing", ErrInvalidWebAuthn)
+	} else if parsed, err := authentication.credentialAssertionResponse().Parse(); err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrInvalidWebAuthn, err)
+	} else if parsed.Response.CollectedClientData.CrossOrigin {
+		return 0, fmt.Errorf("%w: cross origin ceremonies are not allowed", ErrInvalidWebAuthn)
+	} else if err := parsed.Verify(challenge, s.ID, []string{s.Origin}, nil, protocol.TopOriginIgnoreVerificationMode, "", false, true, publicKey); err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrInvalidWebAuthn, err)
+	} else if signCount := parsed.Response.AuthenticatorData.Counter; (signCount != 0 || storedSignCount != 0) && signCount <= storedSignCount {
+		return 0, ErrWebAuthnSignCount
+	} else {
+		return signCount, nil
+	}
+}
+
+// WebAuthnClientDataChallenge returns the challenge that a WebAuthn client data JSON claims to respond to so that the
+// challenge can be looked up before the response is verified
+func WebAuthnClientDataChallenge(rawClientData []byte) (string, error) {
+	var clientData protocol.CollectedClientData
+
+	if err := json.Unmarshal(rawClientData, &clientData); err != nil {
+		return "", fmt.Errorf("%w: malformed client data: %w", ErrInvalidWebAuthn, err)
+	} else if clientData.Challenge == "" {
+		return "", fmt.Errorf("%w: challenge is missing", ErrInvalidWebAuthn)
+	}
+
+	return clientData.Challenge, nil
+}
+
+// WebAuthnCredentialDescriptors describes registered credentials for use in allowCredentials and excludeCredentials
+func WebAuthnCredentialDescriptors(credentials model.WebAuthnCredentials) []WebAuthnCredentialDescriptor {
+	descriptors := make([]WebAuthnCredentialDescriptor, len(credentials))
+	for idx, credential := range credentials {
+		descriptors[idx] = WebAuthnCredentialDescriptor{
+			Type:       WebAuthnCredentialType,
+			ID:         credential.CredentialID,
+			Transports: credential.Transports,
+		}
+	}
+
+	return descriptors
+}
+
+func webAuthnCredentialParameters() []protocol.CredentialParameter {
+	credentialParameters := make([]protocol.CredentialParameter, len(WebAuthnSupportedAlgorithms))
+	for idx, algorithm := range WebAuthnSupportedAlgorithms {
+		credentialParameters[idx] = protocol.CredentialParameter{Type: protocol.PublicKeyCredentialType, Algorithm: algorithm}
+	}
+
+	return credentialParameters
+}
+
+func (s WebAuthnRegistrationResponse) credentialCreationResponse() protocol.CredentialCreationResponse {
+	return protocol.CredentialCreationResponse{
+		PublicKeyCredential: protocol.PublicKeyCredential{
+			Credential: protocol.Credential{ID: s.ID, Type: s.Type},
+			RawID:      protocol.URLEncodedBase64(s.RawID),
+		},
+		AttestationResponse: protocol.AuthenticatorAttestationResponse{
+			AuthenticatorResponse: protocol.AuthenticatorResponse{ClientDataJSON: protocol.URLEncodedBase64(s.Response.ClientDataJSON)},
+			AttestationObject:     protocol.URLEncodedBase64(s.Response.AttestationObject),
+			Transports:            s.Response.Transports,
+		},
+	}
+}
+
+func (s WebAuthnAuthenticationResponse) credentialAssertionResponse() protocol.CredentialAssertionResponse {
+	return protocol.CredentialAssertionResponse{
+		PublicKeyCredential: protocol.PublicKeyCredential{
+			Credential: protocol.Credential{ID: s.ID, Type: s.Type},
+			RawID:      protocol.URLEncodedBase64(s.RawID),
+		},
+		AssertionResponse: protocol.AuthenticatorAssertionResponse{
+			AuthenticatorResponse: protocol.AuthenticatorResponse{ClientDataJSON: protocol.URLEncodedBase64(s.Response.ClientDataJSON)},
+			AuthenticatorData:     protocol.URLEncodedBase64(s.Response.AuthenticatorData),
+			Signature:             protocol.URLEncodedBase64(s.Response.Signature),
+			UserHandle:            protocol.URLEncodedBase64(s.Response.UserHandle),
+		},
+	}
+}
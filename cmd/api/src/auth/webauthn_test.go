@@ -0,0 +1,220 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package auth_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+	"github.com/specterops/bloodhound/cmd/api/src/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encodeCBOR(t *testing.T, value any) []byte {
+	encoded, err := webauthncbor.Marshal(value)
+	require.Nil(t, err)
+	return encoded
+}
+
+type testAuthenticator struct {
+	key          *ecdsa.PrivateKey
+	publicKey    []byte
+	credentialID []byte
+	signCount    uint32
+}
+
+func newTestAuthenticator(t *testing.T) *testAuthenticator {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+
+	return &testAuthenticator{
+		key: key,
+		publicKey: encodeCBOR(t, map[any]any{
+			1:  2,
+			3:  int(webauthncose.AlgES256),
+			-1: 1,
+			-2: key.X.FillBytes(make([]byte, 32)),
+			-3: key.Y.FillBytes(make([]byte, 32)),
+		}),
+		credentialID: []byte("test-credential-id"),
+	}
+}
+
+func (s *testAuthenticator) authenticatorData(t *testing.T, rpID string, flags byte, attested bool) []byte {
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	data := append(rpIDHash[:], flags)
+	data = binary.BigEndian.AppendUint32(data, s.signCount)
+
+	if attested {
+		data = append(data, make([]byte, 16)...)
+		data = binary.BigEndian.AppendUint16(data, uint16(len(s.credentialID)))
+		data = append(data, s.credentialID...)
+		data = append(data, s.publicKey...)
+	}
+
+	return data
+}
+
+func clientDataJSON(t *testing.T, ceremonyType, challenge, origin string) []byte {
+	data, err := json.Marshal(map[string]any{"type": ceremonyType, "challenge": challenge, "origin": origin})
+	require.Nil(t, err)
+	return data
+}
+
+func (s *testAuthenticator) register(t *testing.T, rpID, challenge, origin string) auth.WebAuthnRegistrationResponse {
+	return auth.WebAuthnRegistrationResponse{
+		ID:    base64.RawURLEncoding.EncodeToString(s.credentialID),
+		RawID: s.credentialID,
+		Type:  auth.WebAuthnCredentialType,
+		Response: auth.WebAuthnAttestationResponse{
+			ClientDataJSON: clientDataJSON(t, "webauthn.create", challenge, origin),
+			AttestationObject: encodeCBOR(t, map[any]any{
+				"fmt":      "none",
+				"attStmt":  map[any]any{},
+				"authData": s.authenticatorData(t, rpID, 0x41, true),
+			}),
+		},
+	}
+}
+
+func (s *testAuthenticator) authenticate(t *testing.T, rpID, challenge, origin string) auth.WebAuthnAuthenticationResponse {
+	s.signCount++
+
+	var (
+		authenticatorData = s.authenticatorData(t, rpID, 0x01, false)
+		clientData        = clientDataJSON(t, "webauthn.get", challenge, origin)
+		clientDataHash    = sha256.Sum256(clientData)
+		digest            = sha256.Sum256(append(authenticatorData, clientDataHash[:]...))
+	)
+
+	signature, err := ecdsa.SignASN1(rand.Reader, s.key, digest[:])
+	require.Nil(t, err)
+
+	return auth.WebAuthnAuthenticationResponse{
+		ID:    base64.RawURLEncoding.EncodeToString(s.credentialID),
+		RawID: s.credentialID,
+		Type:  auth.WebAuthnCredentialType,
+		Response: auth.WebAuthnAssertionResponse{
+			ClientDataJSON:    clientData,
+			AuthenticatorData: authenticatorData,
+			Signature:         signature,
+		},
+	}
+}
+
+func TestWebAuthnRelyingParty_VerifyRegistration(t *testing.T) {
+	var (
+		relyingParty  = auth.NewWebAuthnRelyingParty(url.URL{Scheme: "https", Host: "bloodhound.example.com:8443"})
+		authenticator = newTestAuthenticator(t)
+	)
+
+	challenge, err := auth.GenerateWebAuthnChallenge()
+	require.Nil(t, err)
+
+	assert.Equal(t, "bloodhound.example.com", relyingParty.ID)
+	assert.Equal(t, "https://bloodhound.example.com:8443", relyingParty.Origin)
+
+	t.Run("success", func(t *testing.T) {
+		credential, err := relyingParty.VerifyRegistration(challenge, authenticator.register(t, relyingParty.ID, challenge, relyingParty.Origin))
+		require.Nil(t, err)
+		assert.Equal(t, authenticator.credentialID, credential.CredentialID)
+		assert.Equal(t, authenticator.publicKey, credential.PublicKey)
+	})
+
+	t.Run("challenge mismatch", func(t *testing.T) {
+		_, err := relyingParty.VerifyRegistration(challenge, authenticator.register(t, relyingParty.ID, "other", relyingParty.Origin))
+		assert.ErrorIs(t, err, auth.ErrInvalidWebAuthn)
+	})
+
+	t.Run("origin mismatch", func(t *testing.T) {
+		_, err := relyingParty.VerifyRegistration(challenge, authenticator.register(t, relyingParty.ID, challenge, "https://phishing.example.com"))
+		assert.ErrorIs(t, err, auth.ErrInvalidWebAuthn)
+	})
+
+	t.Run("relying party mismatch", func(t *testing.T) {
+		_, err := relyingParty.VerifyRegistration(challenge, authenticator.register(t, "phishing.example.com", challenge, relyingParty.Origin))
+		assert.ErrorIs(t, err, auth.ErrInvalidWebAuthn)
+	})
+
+	t.Run("truncated attestation object", func(t *testing.T) {
+		registration := authenticator.register(t, relyingParty.ID, challenge, relyingParty.Origin)
+		registration.Response.AttestationObject = registration.Response.AttestationObject[:len(registration.Response.AttestationObject)-8]
+
+		_, err := relyingParty.VerifyRegistration(challenge, registration)
+		assert.ErrorIs(t, err, auth.ErrInvalidWebAuthn)
+	})
+}
+
+func TestWebAuthnRelyingParty_VerifyAuthentication(t *testing.T) {
+	var (
+		relyingParty  = auth.NewWebAuthnRelyingParty(url.URL{Scheme: "https", Host: "bloodhound.example.com"})
+		authenticator = newTestAuthenticator(t)
+		publicKey     = authenticator.publicKey
+	)
+
+	challenge, err := auth.GenerateWebAuthnChallenge()
+	require.Nil(t, err)
+
+	t.Run("success", func(t *testing.T) {
+		signCount, err := relyingParty.VerifyAuthentication(challenge, publicKey, 0, authenticator.authenticate(t, relyingParty.ID, challenge, relyingParty.Origin))
+		require.Nil(t, err)
+		assert.Equal(t, authenticator.signCount, signCount)
+	})
+
+	t.Run("tampered signature", func(t *testing.T) {
+		authentication := authenticator.authenticate(t, relyingParty.ID, challenge, relyingParty.Origin)
+		authentication.Response.ClientDataJSON = clientDataJSON(t, "webauthn.get", challenge, relyingParty.Origin+"/")
+
+		_, err := relyingParty.VerifyAuthentication(challenge, publicKey, 0, authentication)
+		assert.ErrorIs(t, err, auth.ErrInvalidWebAuthn)
+	})
+
+	t.Run("wrong ceremony type", func(t *testing.T) {
+		authentication := authenticator.authenticate(t, relyingParty.ID, challenge, relyingParty.Origin)
+		authentication.Response.ClientDataJSON = clientDataJSON(t, "webauthn.create", challenge, relyingParty.Origin)
+
+		_, err := relyingParty.VerifyAuthentication(challenge, publicKey, 0, authentication)
+		assert.ErrorIs(t, err, auth.ErrInvalidWebAuthn)
+	})
+
+	t.Run("sign count regression", func(t *testing.T) {
+		_, err := relyingParty.VerifyAuthentication(challenge, publicKey, authenticator.signCount+10, authenticator.authenticate(t, relyingParty.ID, challenge, relyingParty.Origin))
+		assert.ErrorIs(t, err, auth.ErrWebAuthnSignCount)
+	})
+}
+
+func TestBase64URL_JSON(t *testing.T) {
+	var decoded auth.Base64URL
+
+	encoded, err := json.Marshal(auth.Base64URL{0xfb, 0xff})
+	require.Nil(t, err)
+	assert.Equal(t, `"-_8"`, string(encoded))
+
+	require.Nil(t, json.Unmarshal([]byte(`"-_8="`), &decoded))
+	assert.Equal(t, auth.Base64URL{0xfb, 0xff}, decoded)
+	assert.NotNil(t, json.Unmarshal([]byte(`"+/8"`), &decoded))
+}
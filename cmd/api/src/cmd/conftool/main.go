@@ -53,6 +53,26 @@ func newJWTSigningKey() ([]byte, error) {
 	return signingKey, nil
 }
 
+func newAuditLogHashKey() ([]byte, error) {
+	hashKey := make([]byte, 32)
+
+	if _, err := rand.Read(hashKey); err != nil {
+		return nil, err
+	}
+
+	return hashKey, nil
+}
+
+func newSecretsEncryptionKey() ([]byte, error) {
+	encryptionKey := make([]byte, crypto.SecretBoxKeyByteLength)
+
+	if _, err := rand.Read(encryptionKey); err != nil {
+		return nil, err
+	}
+
+	return encryptionKey, nil
+}
+
 func writeNewConfiguration(path string, skipArgon2 bool) error {
 	cfg, err := config.NewDefaultConfiguration()
 	if err != nil {
@@ -88,6 +108,20 @@ func writeNewConfiguration(path string, skipArgon2 bool) error {
 		cfg.Crypto.JWT.SetSigningKeyBytes(jwtSigningKeyBytes)
 	}
 
+	// Set a new random key for encrypting stored secrets
+	if encryptionKeyBytes, err := newSecretsEncryptionKey(); err != nil {
+		return err
+	} else {
+		cfg.Crypto.Secrets.SetEncryptionKeyBytes(encryptionKeyBytes)
+	}
+
+	// Set a new random audit log hash key
+	if hashKeyBytes, err := newAuditLogHashKey(); err != nil {
+		return err
+	} else {
+		cfg.Crypto.AuditLog.SetHashKeyBytes(hashKeyBytes)
+	}
+
 	if err := config.WriteConfigurationFile(path, cfg); err != nil {
 		return fmt.Errorf("error writing config: %v", err)
 	}
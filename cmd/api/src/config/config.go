@@ -40,6 +40,8 @@ const (
 	environmentVariableKeyValueSeparator = "="
 )
 
+var ErrSecretsEncryptionKeyMissing = errors.New("crypto.secrets.encryption_key must be configured to store secrets")
+
 type TLSConfiguration struct {
 	CertFile string `json:"cert_file"`
 	KeyFile  string `json:"key_file"`
@@ -88,8 +90,10 @@ func (s DatabaseConfiguration) Neo4jConnectionString() string {
 }
 
 type CryptoConfiguration struct {
-	JWT    JWTConfiguration    `json:"jwt"`
-	Argon2 Argon2Configuration `json:"argon2"`
+	JWT      JWTConfiguration      `json:"jwt"`
+	Argon2   Argon2Configuration   `json:"argon2"`
+	Secrets  SecretsConfiguration  `json:"secrets"`
+	AuditLog AuditLogConfiguration `json:"audit_log"`
 }
 
 type JWTConfiguration struct {
@@ -118,12 +122,65 @@ func (s Argon2Configuration) NewDigester() crypto.SecretDigester {
 	}
 }
 
+// SecretsConfiguration holds the key used to encrypt secrets that are stored in the database, such as LDAP bind
+// passwords. Unlike the JWT signing key there is no generated default: the key must stay the same across restarts or
+// the stored secrets can no longer be decrypted.
+type SecretsConfiguration struct {
+	EncryptionKey string `json:"encryption_key"`
+}
+
+func (s *SecretsConfiguration) SetEncryptionKeyBytes(encryptionKeyBytes []byte) {
+	s.EncryptionKey = base64.StdEncoding.EncodeToString(encryptionKeyBytes)
+}
+
+func (s SecretsConfiguration) EncryptionKeyBytes() ([]byte, error) {
+	return base64.StdEncoding.DecodeString(s.EncryptionKey)
+}
+
+func (s SecretsConfiguration) NewSecretBox() (crypto.SecretBox, error) {
+	if s.EncryptionKey == "" {
+		return crypto.SecretBox{}, ErrSecretsEncryptionKeyMissing
+	} else if encryptionKeyBytes, err := s.EncryptionKeyBytes(); err != nil {
+		return crypto.SecretBox{}, fmt.Errorf("decoding secrets encryption key: %w", err)
+	} else {
+		return crypto.NewSecretBox(encryptionKeyBytes)
+	}
+}
+
+// AuditLogConfiguration holds the HMAC key of the audit log hash chain. Like the secrets encryption key it has no
+// generated default, as changing the key breaks the verification of every entry written before the change.
+type AuditLogConfiguration struct {
+	HashKey string `json:"hash_key"`
+}
+
+func (s *AuditLogConfiguration) SetHashKeyBytes(hashKeyBytes []byte) {
+	s.HashKey = base64.StdEncoding.EncodeToString(hashKeyBytes)
+}
+
+func (s AuditLogConfiguration) HashKeyBytes() ([]byte, error) {
+	return base64.StdEncoding.DecodeString(s.HashKey)
+}
+
 type SAMLConfiguration struct {
 	ServiceProviderCertificate        string `json:"sp_cert"`
 	ServiceProviderKey                string `json:"sp_key"`
 	ServiceProviderCertificateCAChain string `json:"sp_ca_chain"`
 }
 
+const (
+	RateLimitStoreMemory   = "memory"
+	RateLimitStorePostgres = "postgres"
+)
+
+// RateLimitConfiguration sets the number of requests per second each user, API token or unauthenticated client IP may
+// make against a route. Limits are configured per route group. The postgres store shares counters between API replicas.
+type RateLimitConfiguration struct {
+	Store   string `json:"store"`
+	Default int64  `json:"default"`
+	Login   int64  `json:"login"`
+	Cypher  int64  `json:"cypher"`
+}
+
 type DefaultAdminConfiguration struct {
 	PrincipalName string `json:"principal_name"`
 	Password      string `json:"password"`
@@ -151,6 +208,7 @@ type Configuration struct {
 	Crypto                       CryptoConfiguration       `json:"crypto"`
 	SAML                         SAMLConfiguration         `json:"saml"`
 	DefaultAdmin                 DefaultAdminConfiguration `json:"default_admin"`
+	RateLimit                    RateLimitConfiguration    `json:"rate_limit"`
 	CollectorsBucketURL          serde.URL                 `json:"collectors_bucket_url"`
 	CollectorsBasePath           string                    `json:"collectors_base_path"`
 	DatapipeInterval             int                       `json:"datapipe_interval"`
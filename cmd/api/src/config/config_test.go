@@ -95,6 +95,8 @@ func TestSetValuesFromEnv(t *testing.T) {
 			NEOSECRET         = "bhe_neo4j_secret"
 			NEODB             = "bhe_neo4j_database"
 			JWTSIGNKEY        = "bhe_crypto_jwt_signing_key"
+			SECRETSKEY        = "bhe_crypto_secrets_encryption_key"
+			AUDITLOGKEY       = "bhe_crypto_audit_log_hash_key"
 			DEFADMINPRINCNAME = "bhe_default_admin_principal_name"
 			DEFADMINPASS      = "bhe_default_admin_password"
 			DEFADMINEMAIL     = "bhe_default_admin_email_address"
@@ -122,6 +124,8 @@ func TestSetValuesFromEnv(t *testing.T) {
 				NEOSECRET:         "neo4jsecret",
 				NEODB:             "neo4jdatabase",
 				JWTSIGNKEY:        "jwtsigningkey",
+				SECRETSKEY:        "secretsencryptionkey",
+				AUDITLOGKEY:       "auditloghashkey",
 				DEFADMINPRINCNAME: "defaultadminprincipalname",
 				DEFADMINPASS:      "defaultadminpassword",
 				DEFADMINEMAIL:     "defaultadminemailaddress",
@@ -166,6 +170,8 @@ func TestSetValuesFromEnv(t *testing.T) {
 
 		t.Run("crypto", func(t *testing.T) {
 			assert.Equal(t, options[JWTSIGNKEY], cfg.Crypto.JWT.SigningKey)
+			assert.Equal(t, options[SECRETSKEY], cfg.Crypto.Secrets.EncryptionKey)
+			assert.Equal(t, options[AUDITLOGKEY], cfg.Crypto.AuditLog.HashKey)
 		})
 
 		t.Run("default admin", func(t *testing.T) {
@@ -82,6 +82,12 @@ func NewDefaultConfiguration() (Configuration, error) {
 				LastName:      "User",
 				ExpireNow:     true,
 			},
+			RateLimit: RateLimitConfiguration{
+				Store:   RateLimitStoreMemory,
+				Default: 55, // Requests per second
+				Login:   1,
+				Cypher:  10,
+			},
 		}, nil
 	}
 }
@@ -22,6 +22,7 @@ import (
 	"log/slog"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/specterops/bloodhound/cmd/api/src/database"
 	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
@@ -102,6 +103,29 @@ func FetchNodesFromSeeds(ctx context.Context, graphDb graph.Database, seeds []mo
 						seedNodes.AddIfNotExists(nodeWithSrc)
 					}
 				}
+			case model.SelectorTypePropertyFilter:
+				if filter, err := model.ParseSelectorPropertyFilter(seed.Value); err != nil {
+					slog.WarnContext(ctx, "AGT: Parse Property Filter Err", "propertyFilter", seed.Value, "err", err)
+				} else {
+					nodeQuery := tx.Nodes().Filter(filter.Criteria())
+					if limit > 0 {
+						nodeQuery = nodeQuery.Limit(limit)
+					}
+
+					if nodes, err := ops.FetchNodes(nodeQuery); err != nil {
+						slog.WarnContext(ctx, "AGT: Fetch Property Filter Err", "propertyFilter", seed.Value, "err", err)
+					} else {
+						for _, node := range nodes {
+							nodeWithSrc := &nodeWithSource{Source: model.AssetGroupSelectorNodeSourceSeed, Node: node}
+							if result.AddIfNotExists(nodeWithSrc) {
+								if result.LimitReached(limit) {
+									return nil
+								}
+							}
+							seedNodes.AddIfNotExists(nodeWithSrc)
+						}
+					}
+				}
 			default:
 				slog.WarnContext(ctx, fmt.Sprintf("AGT: Unsupported selector type: %d", seed.Type))
 			}
@@ -511,21 +535,45 @@ func selectAssetGroupNodes(ctx context.Context, db database.Database, graphDb gr
 	return nil
 }
 
-// tagAssetGroupNodesForTag - tags all nodes for a given tag and diffs previous db state for minimal db updates
-func tagAssetGroupNodesForTag(ctx context.Context, db database.Database, graphDb graph.Database, tag model.AssetGroupTag, nodesSeen cardinality.Duplex[uint64], additionalFilters ...graph.Criteria) error {
+// newMembershipEvent builds a membership change event of a tag for the given node
+func newMembershipEvent(tag model.AssetGroupTag, node *graph.Node, selectorId null.Int32, action model.AssetGroupMembershipAction, analysisRunId string) model.AssetGroupTagMembershipEvent {
+	objectId, name := nodeObjectIdAndName(node)
+
+	return model.AssetGroupTagMembershipEvent{
+		AssetGroupTagId: tag.ID,
+		SelectorId:      selectorId,
+		NodeId:          node.ID,
+		NodeObjectId:    objectId,
+		NodeName:        name,
+		NodeKind:        analysis.GetNodeKindDisplayLabel(node),
+		Action:          action,
+		AnalysisRunId:   analysisRunId,
+	}
+}
+
+// tagAssetGroupNodesForTag - tags all nodes for a given tag and diffs previous db state for minimal db updates. Each
+// node added to or removed from the tag is recorded as a membership event of the analysis run.
+func tagAssetGroupNodesForTag(ctx context.Context, db database.Database, graphDb graph.Database, tag model.AssetGroupTag, nodesSeen cardinality.Duplex[uint64], analysisRunId string, additionalFilters ...graph.Criteria) error {
 	if selectors, _, err := db.GetAssetGroupTagSelectorsByTagId(ctx, tag.ID, model.SQLFilter{}, model.SQLFilter{}, 0, 0); err != nil {
 		return err
+	} else if hasEvents, err := db.HasAssetGroupTagMembershipEvents(ctx, tag.ID); err != nil {
+		return err
 	} else {
 		var (
 			countTotal    int
 			selectorIds   []int
 			selectedNodes []model.AssetGroupSelectorNode
+			events        model.AssetGroupTagMembershipEvents
+
+			// The first selector to select each node is credited with its membership
+			nodeSelectors = map[graph.ID]int{}
 
 			tagKind = tag.ToKind()
 
 			oldTaggedNodes         = cardinality.NewBitmap64()
 			newTaggedNodes         = cardinality.NewBitmap64()
 			missingSystemTagsNodes = cardinality.NewBitmap64()
+			retainedNodes          = cardinality.NewBitmap64()
 		)
 
 		for _, selector := range selectors {
@@ -536,6 +584,8 @@ func tagAssetGroupNodesForTag(ctx context.Context, db database.Database, graphDb
 		if selectedNodes, err = db.GetSelectorNodesBySelectorIds(ctx, selectorIds...); err != nil {
 			return err
 		} else if err = graphDb.WriteTransaction(ctx, func(tx graph.Transaction) error {
+			events = nil
+
 			filters := []graph.Criteria{query.Kind(query.Node(), tagKind)}
 			if additionalFilters != nil {
 				filters = append(filters, additionalFilters...)
@@ -550,8 +600,8 @@ func tagAssetGroupNodesForTag(ctx context.Context, db database.Database, graphDb
 				// 3. Diff the sets filling the respective sets for later db updates
 				for _, nodeDb := range selectedNodes {
 					if !nodesSeen.Contains(nodeDb.NodeId.Uint64()) {
-						// Skip any that are not certified when tag requires certification or are selected by disabled selectors
-						if tag.RequireCertify.Bool && nodeDb.Certified <= 0 {
+						// Skip any that were revoked, or are not certified when tag requires certification
+						if nodeDb.Certified == model.AssetGroupCertificationRevoked || (tag.RequireCertify.Bool && nodeDb.Certified <= 0) {
 							continue
 						}
 
@@ -566,12 +616,28 @@ func tagAssetGroupNodesForTag(ctx context.Context, db database.Database, graphDb
 
 							// If it is present, we don't need to update anything and will remove tags from any nodes left in this bitmap
 							oldTaggedNodes.Remove(nodeDb.NodeId.Uint64())
+							retainedNodes.Add(nodeDb.NodeId.Uint64())
 						}
 						// Once a node is processed, we can skip future duplicates that might be selected by other selectors
 						nodesSeen.Add(nodeDb.NodeId.Uint64())
+						nodeSelectors[nodeDb.NodeId] = nodeDb.SelectorId
 						countTotal++
 					}
 				}
+
+				// Record the existing members as added the first time a tag records membership events so that they
+				// are present in point in time membership queries
+				if !hasEvents {
+					retainedNodes.Each(func(nodeId uint64) bool {
+						events = append(events, newMembershipEvent(tag, oldTaggedNodeSet.Get(graph.ID(nodeId)), null.Int32From(int32(nodeSelectors[graph.ID(nodeId)])), model.AssetGroupMembershipActionAdded, analysisRunId))
+						return true
+					})
+				}
+
+				oldTaggedNodes.Each(func(nodeId uint64) bool {
+					events = append(events, newMembershipEvent(tag, oldTaggedNodeSet.Get(graph.ID(nodeId)), null.Int32{}, model.AssetGroupMembershipActionRemoved, analysisRunId))
+					return true
+				})
 			}
 
 			// 4. Tag the new nodes
@@ -589,6 +655,16 @@ func tagAssetGroupNodesForTag(ctx context.Context, db database.Database, graphDb
 			if err != nil {
 				return err
 			}
+
+			if newTaggedNodes.Cardinality() > 0 {
+				if newTaggedNodeSet, err := ops.FetchNodeSet(tx.Nodes().Filter(query.InIDs(query.NodeID(), graph.Uint64SliceToIDs(newTaggedNodes.Slice())...))); err != nil {
+					return err
+				} else {
+					for _, node := range newTaggedNodeSet {
+						events = append(events, newMembershipEvent(tag, node, null.Int32From(int32(nodeSelectors[node.ID])), model.AssetGroupMembershipActionAdded, analysisRunId))
+					}
+				}
+			}
 			/// TODO Cleanup system tagging after Tiering GA
 			// 4.5 Update already tagged nodes missing system tags
 			missingSystemTagsNodes.Each(func(nodeId uint64) bool {
@@ -613,7 +689,9 @@ func tagAssetGroupNodesForTag(ctx context.Context, db database.Database, graphDb
 				return err
 			}
 
-			return nil
+			// 6. Record the membership changes before the tagging is committed so that a failure to record them
+			// rolls back the tagging and the changes are diffed again by the next analysis run
+			return db.CreateAssetGroupTagMembershipEvents(ctx, events)
 		}); err != nil {
 			return err
 		}
@@ -623,8 +701,9 @@ func tagAssetGroupNodesForTag(ctx context.Context, db database.Database, graphDb
 	return nil
 }
 
-// tagAssetGroupNodes - concurrently tags all nodes for all tags
-func tagAssetGroupNodes(ctx context.Context, db database.Database, graphDb graph.Database, additionalFilters ...graph.Criteria) error {
+// tagAssetGroupNodes - concurrently tags all nodes for all tags. Membership changes are recorded against the given
+// analysis run id.
+func tagAssetGroupNodes(ctx context.Context, db database.Database, graphDb graph.Database, analysisRunId string, additionalFilters ...graph.Criteria) error {
 	defer measure.ContextMeasure(ctx, slog.LevelInfo, "Finished tagging asset group nodes")()
 
 	if tags, err := db.GetAssetGroupTagForSelection(ctx); err != nil {
@@ -655,7 +734,7 @@ func tagAssetGroupNodes(ctx context.Context, db database.Database, graphDb graph
 			go func() {
 				defer wg.Done()
 				// Nodes can contain multiple labels therefore there is no need to exclude here
-				if err = tagAssetGroupNodesForTag(ctx, db, graphDb, tag, cardinality.NewBitmap64(), additionalFilters...); err != nil {
+				if err = tagAssetGroupNodesForTag(ctx, db, graphDb, tag, cardinality.NewBitmap64(), analysisRunId, additionalFilters...); err != nil {
 					slog.Error("AGT: Error tagging nodes", tag.ToType(), tag, "err", err)
 				}
 			}()
@@ -664,7 +743,7 @@ func tagAssetGroupNodes(ctx context.Context, db database.Database, graphDb graph
 		// Process the tier tagging synchronously
 		for _, tier := range tiersOrdered {
 			// Nodes cannot contain multiple tiers therefore the nodesSeen serves as a running exclusion bitmap
-			if err := tagAssetGroupNodesForTag(ctx, db, graphDb, tier, nodesSeen, additionalFilters...); err != nil {
+			if err := tagAssetGroupNodesForTag(ctx, db, graphDb, tier, nodesSeen, analysisRunId, additionalFilters...); err != nil {
 				slog.Error("AGT: Error tagging nodes", "tier", tier, "err", err)
 			}
 		}
@@ -767,8 +846,23 @@ func migrateCustomObjectIdSelectorNames(ctx context.Context, db database.Databas
 	return nil
 }
 
+// expireCertifications - reverts manual certifications older than the configured expiry to pending before tagging
+func expireCertifications(ctx context.Context, db database.Database) error {
+	if expiry := appcfg.GetCertificationExpiry(ctx, db); expiry.ExpiryDays > 0 {
+		certifiedBefore := time.Now().Add(-time.Duration(expiry.ExpiryDays) * 24 * time.Hour)
+
+		if expired, err := db.ExpireSelectorNodeCertifications(ctx, certifiedBefore); err != nil {
+			return err
+		} else if expired > 0 {
+			slog.InfoContext(ctx, "AGT: Expired certifications", "countExpired", expired, "certifiedBefore", certifiedBefore)
+		}
+	}
+
+	return nil
+}
+
 // TODO Cleanup tieringEnabled after Tiering GA
-func TagAssetGroupsAndTierZero(ctx context.Context, db database.Database, graphDb graph.Database, additionalFilters ...graph.Criteria) []error {
+func TagAssetGroupsAndTierZero(ctx context.Context, db database.Database, graphDb graph.Database, analysisRunId string, additionalFilters ...graph.Criteria) []error {
 	var errors []error
 
 	if appcfg.GetTieringEnabled(ctx, db) {
@@ -778,6 +872,11 @@ func TagAssetGroupsAndTierZero(ctx context.Context, db database.Database, graphD
 			errors = append(errors, err)
 		}
 
+		if err := expireCertifications(ctx, db); err != nil {
+			slog.Error(fmt.Sprintf("AGT: expiring certifications failed: %v", err))
+			errors = append(errors, err)
+		}
+
 		if err := migrateCustomObjectIdSelectorNames(ctx, db, graphDb); err != nil {
 			slog.Error(fmt.Sprintf("AGT: migrating custom selector names failed: %v", err))
 			errors = append(errors, err)
@@ -788,7 +887,7 @@ func TagAssetGroupsAndTierZero(ctx context.Context, db database.Database, graphD
 			errors = append(errors, err)
 		}
 
-		if err := tagAssetGroupNodes(ctx, db, graphDb, additionalFilters...); err != nil {
+		if err := tagAssetGroupNodes(ctx, db, graphDb, analysisRunId, additionalFilters...); err != nil {
 			slog.Error(fmt.Sprintf("AGT: tagging failed: %v", err))
 			errors = append(errors, err)
 		}
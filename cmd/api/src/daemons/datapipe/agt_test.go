@@ -86,6 +86,17 @@ func TestAGT_FetchNodesFromSeeds_Expansions(t *testing.T) {
 
 		require.Equal(t, result[harness.OU3.ID].Source, model.AssetGroupSelectorNodeSourceSeed)
 	})
+
+	t.Run("FetchNodesFromSeeds with property filter seed", func(t *testing.T) {
+		propertyFilterSeeds := []model.SelectorSeed{{
+			Type:  model.SelectorTypePropertyFilter,
+			Value: `{"kind":"OU","predicates":[{"property":"objectid","operator":"eq","value":"` + seedObjectId + `"}]}`,
+		}}
+
+		result := FetchNodesFromSeeds(context.Background(), testContext.Graph.Database, propertyFilterSeeds, model.AssetGroupExpansionMethodNone, -1)
+		require.Len(t, result, 1)
+		require.Equal(t, result[harness.OU3.ID].Source, model.AssetGroupSelectorNodeSourceSeed)
+	})
 }
 
 func TestAGT_FetchNodesFromSeeds_ChildExpansion(t *testing.T) {
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"log/slog"
 
+	"github.com/gofrs/uuid"
 	"github.com/specterops/bloodhound/cmd/api/src/analysis/ad"
 	"github.com/specterops/bloodhound/cmd/api/src/analysis/azure"
 	"github.com/specterops/bloodhound/cmd/api/src/config"
@@ -29,6 +30,7 @@ import (
 	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
 	"github.com/specterops/bloodhound/cmd/api/src/services/agi"
 	"github.com/specterops/bloodhound/cmd/api/src/services/dataquality"
+	"github.com/specterops/bloodhound/cmd/api/src/services/findings"
 	"github.com/specterops/bloodhound/packages/go/analysis"
 	adAnalysis "github.com/specterops/bloodhound/packages/go/analysis/ad"
 	"github.com/specterops/dawgs/graph"
@@ -47,6 +49,12 @@ func RunAnalysisOperations(ctx context.Context, db database.Database, graphDB gr
 		tieringEnabled       = appcfg.GetTieringEnabled(ctx, db)
 	)
 
+	// The run id ties the membership changes and tier exposure recorded by this analysis together
+	analysisRunId, err := uuid.NewV4()
+	if err != nil {
+		return fmt.Errorf("could not generate analysis run id: %w", err)
+	}
+
 	if err := adAnalysis.FixWellKnownNodeTypes(ctx, graphDB); err != nil {
 		collectedErrors = append(collectedErrors, fmt.Errorf("fix well known node types failed: %w", err))
 	}
@@ -59,7 +67,7 @@ func RunAnalysisOperations(ctx context.Context, db database.Database, graphDB gr
 		collectedErrors = append(collectedErrors, fmt.Errorf("well known group linking failed: %w", err))
 	}
 
-	if errs := TagAssetGroupsAndTierZero(ctx, db, graphDB); len(errs) > 0 {
+	if errs := TagAssetGroupsAndTierZero(ctx, db, graphDB, analysisRunId.String()); len(errs) > 0 {
 		for _, err := range errs {
 			collectedErrors = append(collectedErrors, fmt.Errorf("tagging asset groups and tier zero failed: %w", err))
 		}
@@ -98,6 +106,20 @@ func RunAnalysisOperations(ctx context.Context, db database.Database, graphDB gr
 		}
 	}
 
+	if tieringEnabled {
+		if err := SaveTierViolations(ctx, db, graphDB); err != nil {
+			collectedErrors = append(collectedErrors, fmt.Errorf("tier violation analysis failed: %w", err))
+		}
+
+		if err := SaveTierExposure(ctx, db, graphDB, analysisRunId.String()); err != nil {
+			collectedErrors = append(collectedErrors, fmt.Errorf("tier exposure analysis failed: %w", err))
+		}
+	}
+
+	if err := findings.SaveFindings(ctx, db, graphDB, tieringEnabled); err != nil {
+		collectedErrors = append(collectedErrors, fmt.Errorf("findings analysis failed: %w", err))
+	}
+
 	if err := dataquality.SaveDataQuality(ctx, db, graphDB); err != nil {
 		collectedErrors = append(collectedErrors, fmt.Errorf("error saving data quality stat: %v", err))
 		dataQualityFailed = true
@@ -0,0 +1,117 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package datapipe
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/specterops/bloodhound/cmd/api/src/database"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/packages/go/analysis"
+	"github.com/specterops/bloodhound/packages/go/analysis/tiering"
+	"github.com/specterops/bloodhound/packages/go/bhlog/measure"
+	"github.com/specterops/dawgs/graph"
+)
+
+// tierChokePointLimit is the number of edge and node choke points kept for each tier
+const tierChokePointLimit = 100
+
+// SaveTierExposure measures the inbound exposure and outbound impact of each tier, appending them to the history of
+// the tier under the given analysis run id, and replaces the choke points stored by the previous analysis
+func SaveTierExposure(ctx context.Context, db database.Database, graphDB graph.Database, analysisRunId string) error {
+	slog.InfoContext(ctx, "Started Tier Exposure Analysis")
+	defer measure.ContextMeasure(ctx, slog.LevelInfo, "Finished Tier Exposure Analysis")()
+
+	tiers, err := db.GetOrderedAssetGroupTagTiers(ctx)
+	if err != nil {
+		return fmt.Errorf("could not get tiers: %w", err)
+	}
+
+	tierKinds := make([]graph.Kind, len(tiers))
+	for idx, tier := range tiers {
+		tierKinds[idx] = tier.ToKind()
+	}
+
+	results, err := tiering.FindTierExposure(ctx, graphDB, tierKinds, tierChokePointLimit)
+	if err != nil {
+		return fmt.Errorf("could not find tier exposure: %w", err)
+	}
+
+	var (
+		exposures   = make(model.AssetGroupTagExposures, 0, len(results))
+		chokePoints model.AssetGroupTagChokePoints
+	)
+
+	for _, result := range results {
+		tagId := tiers[result.Tier].ID
+
+		exposures = append(exposures, model.AssetGroupTagExposure{
+			AssetGroupTagId: tagId,
+			AnalysisRunId:   analysisRunId,
+			Exposure:        result.Exposure,
+			Impact:          result.Impact,
+		})
+
+		for _, edge := range result.EdgeChokePoints {
+			sourceObjectId, sourceName := nodeObjectIdAndName(edge.Start)
+			targetObjectId, targetName := nodeObjectIdAndName(edge.End)
+
+			chokePoints = append(chokePoints, model.AssetGroupTagChokePoint{
+				AssetGroupTagId: tagId,
+				AnalysisRunId:   analysisRunId,
+				Type:            model.AssetGroupTagChokePointTypeEdge,
+				EdgeKind:        edge.Relationship.Kind.String(),
+				SourceNodeId:    edge.Start.ID,
+				SourceObjectId:  sourceObjectId,
+				SourceName:      sourceName,
+				SourceKind:      analysis.GetNodeKindDisplayLabel(edge.Start),
+				TargetNodeId:    null.Int64From(int64(edge.End.ID)),
+				TargetObjectId:  targetObjectId,
+				TargetName:      targetName,
+				TargetKind:      analysis.GetNodeKindDisplayLabel(edge.End),
+				Principals:      edge.Principals,
+				Coverage:        edge.Coverage,
+			})
+		}
+
+		for _, node := range result.NodeChokePoints {
+			objectId, name := nodeObjectIdAndName(node.Node)
+
+			chokePoints = append(chokePoints, model.AssetGroupTagChokePoint{
+				AssetGroupTagId: tagId,
+				AnalysisRunId:   analysisRunId,
+				Type:            model.AssetGroupTagChokePointTypeNode,
+				SourceNodeId:    node.Node.ID,
+				SourceObjectId:  objectId,
+				SourceName:      name,
+				SourceKind:      analysis.GetNodeKindDisplayLabel(node.Node),
+				Principals:      node.Principals,
+				Coverage:        node.Coverage,
+			})
+		}
+	}
+
+	if err := db.SaveAssetGroupTagExposures(ctx, exposures, chokePoints); err != nil {
+		return fmt.Errorf("could not save tier exposure: %w", err)
+	}
+
+	slog.InfoContext(ctx, fmt.Sprintf("Measured exposure of %d tiers with %d choke points", len(exposures), len(chokePoints)))
+	return nil
+}
@@ -0,0 +1,114 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package datapipe
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/specterops/bloodhound/cmd/api/src/database"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/packages/go/analysis"
+	"github.com/specterops/bloodhound/packages/go/analysis/tiering"
+	"github.com/specterops/bloodhound/packages/go/bhlog/measure"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/graph"
+)
+
+// SaveTierViolations finds the attack path edges crossing into each tier from untiered principals or lower tiers and
+// replaces the violations stored by the previous analysis
+func SaveTierViolations(ctx context.Context, db database.Database, graphDB graph.Database) error {
+	slog.InfoContext(ctx, "Started Tier Violation Analysis")
+	defer measure.ContextMeasure(ctx, slog.LevelInfo, "Finished Tier Violation Analysis")()
+
+	tiers, err := db.GetOrderedAssetGroupTagTiers(ctx)
+	if err != nil {
+		return fmt.Errorf("could not get tiers: %w", err)
+	}
+
+	tierKinds := make([]graph.Kind, len(tiers))
+	for idx, tier := range tiers {
+		tierKinds[idx] = tier.ToKind()
+	}
+
+	results, err := tiering.FindTierViolations(ctx, graphDB, tierKinds)
+	if err != nil {
+		return fmt.Errorf("could not find tier violations: %w", err)
+	}
+
+	var (
+		violations model.AssetGroupTagViolations
+		counts     []model.AssetGroupTagViolationCount
+		tierTagId  = func(tier int) null.Int32 {
+			if tier == tiering.Untiered {
+				return null.Int32{}
+			}
+			return null.Int32From(int32(tiers[tier].ID))
+		}
+	)
+
+	for _, result := range results {
+		tagId := tiers[result.Tier].ID
+
+		for _, violation := range result.Violations {
+			sourceObjectId, sourceName := nodeObjectIdAndName(violation.Start)
+			targetObjectId, targetName := nodeObjectIdAndName(violation.End)
+
+			violations = append(violations, model.AssetGroupTagViolation{
+				AssetGroupTagId: tagId,
+				SourceTagId:     tierTagId(violation.SourceTier),
+				EdgeKind:        violation.Relationship.Kind.String(),
+				SourceNodeId:    violation.Start.ID,
+				SourceObjectId:  sourceObjectId,
+				SourceName:      sourceName,
+				SourceKind:      analysis.GetNodeKindDisplayLabel(violation.Start),
+				TargetNodeId:    violation.End.ID,
+				TargetObjectId:  targetObjectId,
+				TargetName:      targetName,
+				TargetKind:      analysis.GetNodeKindDisplayLabel(violation.End),
+				Principals:      violation.Principals,
+			})
+		}
+
+		for _, count := range result.Counts {
+			counts = append(counts, model.AssetGroupTagViolationCount{
+				AssetGroupTagId: tagId,
+				SourceTagId:     tierTagId(count.SourceTier),
+				Principals:      count.Principals,
+				Edges:           count.Edges,
+			})
+		}
+	}
+
+	if err := db.ReplaceAssetGroupTagViolations(ctx, violations, counts); err != nil {
+		return fmt.Errorf("could not save tier violations: %w", err)
+	}
+
+	slog.InfoContext(ctx, fmt.Sprintf("Found %d tier violations across %d tiers", len(violations), len(tiers)))
+	return nil
+}
+
+func nodeObjectIdAndName(node *graph.Node) (string, string) {
+	var (
+		objectId, _ = node.Properties.GetOrDefault(common.ObjectID.String(), "").String()
+		name, _     = node.Properties.GetWithFallback(common.Name.String(), "", common.DisplayName.String(), common.ObjectID.String()).String()
+	)
+
+	return objectId, name
+}
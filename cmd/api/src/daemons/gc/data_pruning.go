@@ -18,9 +18,12 @@ package gc
 
 import (
 	"context"
+	"fmt"
+	"log/slog"
 	"time"
 
 	"github.com/specterops/bloodhound/cmd/api/src/database"
+	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
 )
 
 // Daemon holds data relevant to the data daemon
@@ -49,16 +52,22 @@ func (s *Daemon) Start(ctx context.Context) {
 	defer close(s.exitC)
 	defer ticker.Stop()
 
-	// prune sessions and collections once when the daemon starts up
+	// prune sessions, auth tokens, rate limit counters and collections once when the daemon starts up
 	s.db.SweepSessions(ctx)
+	s.db.SweepAuthTokens(ctx)
+	s.db.SweepRateLimitCounters(ctx)
 	s.db.SweepAssetGroupCollections(ctx)
+	s.pruneAuditLogs(ctx)
 
 	// thereafter, prune conditionally once a day
 	for {
 		select {
 		case <-ticker.C:
 			s.db.SweepSessions(ctx)
+			s.db.SweepAuthTokens(ctx)
+			s.db.SweepRateLimitCounters(ctx)
 			s.db.SweepAssetGroupCollections(ctx)
+			s.pruneAuditLogs(ctx)
 
 		case <-s.exitC:
 			return
@@ -66,6 +75,19 @@ func (s *Daemon) Start(ctx context.Context) {
 	}
 }
 
+// pruneAuditLogs deletes audit log entries older than the configured retention period, if one is set
+func (s *Daemon) pruneAuditLogs(ctx context.Context) {
+	if retention := appcfg.GetAuditLogRetention(ctx, s.db); retention.RetentionDays > 0 {
+		before := time.Now().Add(-time.Duration(retention.RetentionDays) * 24 * time.Hour)
+
+		if deleted, err := s.db.PruneAuditLogs(ctx, before); err != nil {
+			slog.ErrorContext(ctx, fmt.Sprintf("Failed to prune audit logs: %v", err))
+		} else if deleted > 0 {
+			slog.InfoContext(ctx, fmt.Sprintf("Pruned %d audit log entries created before %s", deleted, before.Format(time.RFC3339)))
+		}
+	}
+}
+
 // Stop passes in a stop signal to the exit channel, thereby killing the daemon
 func (s *Daemon) Stop(ctx context.Context) error {
 	s.exitC <- struct{}{}
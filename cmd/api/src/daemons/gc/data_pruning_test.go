@@ -22,6 +22,8 @@ import (
 	"time"
 
 	"github.com/specterops/bloodhound/cmd/api/src/database/mocks"
+	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
+	"github.com/specterops/bloodhound/cmd/api/src/test/must"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
@@ -55,9 +57,20 @@ func TestGC_Start(t *testing.T) {
 		// simulate some work being done
 		time.Sleep(1 * time.Millisecond)
 	})
+	mockDB.EXPECT().SweepAuthTokens(gomock.Any()).Do(func(ctx context.Context) {
+		time.Sleep(1 * time.Millisecond)
+	})
+	mockDB.EXPECT().SweepRateLimitCounters(gomock.Any()).Do(func(ctx context.Context) {
+		time.Sleep(1 * time.Millisecond)
+	})
 	mockDB.EXPECT().SweepAssetGroupCollections(gomock.Any()).Do(func(ctx context.Context) {
 		time.Sleep(1 * time.Millisecond)
 	})
+	mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.AuditLogRetention).Return(appcfg.Parameter{
+		Key:   appcfg.AuditLogRetention,
+		Value: must.NewJSONBObject(appcfg.AuditLogRetentionParameters{RetentionDays: 30}),
+	}, nil)
+	mockDB.EXPECT().PruneAuditLogs(gomock.Any(), gomock.Any()).Return(int64(2), nil)
 
 	daemon := NewDataPruningDaemon(mockDB)
 	require.NotNil(t, daemon)
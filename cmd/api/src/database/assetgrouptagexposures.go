@@ -0,0 +1,102 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"gorm.io/gorm"
+)
+
+// AssetGroupTagExposureData defines the methods required to interact with the asset_group_tag_exposures and
+// asset_group_tag_choke_points tables
+type AssetGroupTagExposureData interface {
+	SaveAssetGroupTagExposures(ctx context.Context, exposures model.AssetGroupTagExposures, chokePoints model.AssetGroupTagChokePoints) error
+	GetAssetGroupTagExposures(ctx context.Context, assetGroupTagId int, start, end time.Time, skip, limit int) (model.AssetGroupTagExposures, int, error)
+	GetAssetGroupTagChokePoints(ctx context.Context, assetGroupTagId int, sqlFilter model.SQLFilter, skip, limit int) (model.AssetGroupTagChokePoints, int, error)
+}
+
+// SaveAssetGroupTagExposures appends the exposures measured by an analysis run to the history of each tier and swaps
+// the stored choke points for those found by the same run
+func (s *BloodhoundDB) SaveAssetGroupTagExposures(ctx context.Context, exposures model.AssetGroupTagExposures, chokePoints model.AssetGroupTagChokePoints) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if len(exposures) > 0 {
+			if result := tx.Create(&exposures); result.Error != nil {
+				return CheckError(result)
+			}
+		}
+
+		if result := tx.Exec(fmt.Sprintf("DELETE FROM %s", model.AssetGroupTagChokePoint{}.TableName())); result.Error != nil {
+			return CheckError(result)
+		}
+
+		if len(chokePoints) > 0 {
+			if result := tx.Create(&chokePoints); result.Error != nil {
+				return CheckError(result)
+			}
+		}
+
+		return nil
+	})
+}
+
+// GetAssetGroupTagExposures returns the exposures of a tier measured between start and end, newest first, along with
+// the total count of exposures in the window
+func (s *BloodhoundDB) GetAssetGroupTagExposures(ctx context.Context, assetGroupTagId int, start, end time.Time, skip, limit int) (model.AssetGroupTagExposures, int, error) {
+	var (
+		exposures model.AssetGroupTagExposures
+		count     int64
+		filtered  = func() *gorm.DB {
+			return s.db.WithContext(ctx).Model(&model.AssetGroupTagExposure{}).Where("asset_group_tag_id = ? AND created_at BETWEEN ? AND ?", assetGroupTagId, start, end)
+		}
+	)
+
+	if result := filtered().Count(&count); result.Error != nil {
+		return nil, 0, CheckError(result)
+	} else if result := filtered().Scopes(Paginate(skip, limit)).Order("created_at DESC, id DESC").Find(&exposures); result.Error != nil {
+		return nil, 0, CheckError(result)
+	}
+
+	return exposures, int(count), nil
+}
+
+// GetAssetGroupTagChokePoints returns the choke points into a tier ordered by the most principals cut off first and then
+// by coverage, along with the total count of choke points matching the filter
+func (s *BloodhoundDB) GetAssetGroupTagChokePoints(ctx context.Context, assetGroupTagId int, sqlFilter model.SQLFilter, skip, limit int) (model.AssetGroupTagChokePoints, int, error) {
+	var (
+		chokePoints model.AssetGroupTagChokePoints
+		count       int64
+		filtered    = func() *gorm.DB {
+			query := s.db.WithContext(ctx).Model(&model.AssetGroupTagChokePoint{}).Where("asset_group_tag_id = ?", assetGroupTagId)
+			if sqlFilter.SQLString != "" {
+				query = query.Where(sqlFilter.SQLString, sqlFilter.Params...)
+			}
+			return query
+		}
+	)
+
+	if result := filtered().Count(&count); result.Error != nil {
+		return nil, 0, CheckError(result)
+	} else if result := filtered().Scopes(Paginate(skip, limit)).Order("principals DESC, coverage DESC, id").Find(&chokePoints); result.Error != nil {
+		return nil, 0, CheckError(result)
+	}
+
+	return chokePoints, int(count), nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build integration
+// +build integration
+
+package database_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/test/integration"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatabase_AssetGroupTagExposures(t *testing.T) {
+	var (
+		dbInst  = integration.SetupDB(t)
+		testCtx = context.Background()
+		start   = time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	tierZero, err := dbInst.GetAssetGroupTag(testCtx, 1)
+	require.NoError(t, err)
+
+	require.NoError(t, dbInst.SaveAssetGroupTagExposures(testCtx, model.AssetGroupTagExposures{
+		{AssetGroupTagId: tierZero.ID, AnalysisRunId: "run-1", Exposure: 10, Impact: 100, CreatedAt: start},
+	}, model.AssetGroupTagChokePoints{
+		{AssetGroupTagId: tierZero.ID, AnalysisRunId: "run-1", Type: model.AssetGroupTagChokePointTypeEdge, EdgeKind: "AdminTo", SourceNodeId: 1, SourceName: "OLD ADMIN", TargetNodeId: null.Int64From(2), Principals: 10},
+	}))
+
+	require.NoError(t, dbInst.SaveAssetGroupTagExposures(testCtx, model.AssetGroupTagExposures{
+		{AssetGroupTagId: tierZero.ID, AnalysisRunId: "run-2", Exposure: 6, Impact: 120, CreatedAt: start.Add(48 * time.Hour)},
+	}, model.AssetGroupTagChokePoints{
+		{AssetGroupTagId: tierZero.ID, AnalysisRunId: "run-2", Type: model.AssetGroupTagChokePointTypeEdge, EdgeKind: "AdminTo", SourceNodeId: 3, SourceName: "SERVER ADMIN", TargetNodeId: null.Int64From(2), Principals: 4, Coverage: 0.25},
+		{AssetGroupTagId: tierZero.ID, AnalysisRunId: "run-2", Type: model.AssetGroupTagChokePointTypeEdge, EdgeKind: "GenericAll", SourceNodeId: 4, SourceName: "VENDOR", TargetNodeId: null.Int64From(2), Principals: 4, Coverage: 0.5},
+		{AssetGroupTagId: tierZero.ID, AnalysisRunId: "run-2", Type: model.AssetGroupTagChokePointTypeNode, SourceNodeId: 5, SourceName: "HELPDESK", SourceKind: "Group", Principals: 7},
+	}))
+
+	t.Run("lists exposures with the most recent first", func(t *testing.T) {
+		exposures, count, err := dbInst.GetAssetGroupTagExposures(testCtx, tierZero.ID, start.Add(-time.Hour), start.Add(72*time.Hour), 0, 0)
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+		require.Len(t, exposures, 2)
+		require.Equal(t, "run-2", exposures[0].AnalysisRunId)
+		require.Equal(t, 6, exposures[0].Exposure)
+		require.Equal(t, 120, exposures[0].Impact)
+	})
+
+	t.Run("limits exposures to the time window", func(t *testing.T) {
+		exposures, count, err := dbInst.GetAssetGroupTagExposures(testCtx, tierZero.ID, start.Add(-time.Hour), start.Add(24*time.Hour), 0, 0)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+		require.Equal(t, "run-1", exposures[0].AnalysisRunId)
+	})
+
+	t.Run("keeps only the choke points of the latest run ordered by principals then coverage", func(t *testing.T) {
+		chokePoints, count, err := dbInst.GetAssetGroupTagChokePoints(testCtx, tierZero.ID, model.SQLFilter{}, 0, 0)
+		require.NoError(t, err)
+		require.Equal(t, 3, count)
+		require.Equal(t, "run-2", chokePoints[0].AnalysisRunId)
+		require.Equal(t, "HELPDESK", chokePoints[0].SourceName)
+		require.False(t, chokePoints[0].TargetNodeId.Valid)
+		require.Equal(t, "VENDOR", chokePoints[1].SourceName)
+		require.Equal(t, "SERVER ADMIN", chokePoints[2].SourceName)
+	})
+
+	t.Run("filters and paginates choke points", func(t *testing.T) {
+		chokePoints, count, err := dbInst.GetAssetGroupTagChokePoints(testCtx, tierZero.ID, model.SQLFilter{SQLString: "type = ?", Params: []any{model.AssetGroupTagChokePointTypeEdge}}, 1, 1)
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+		require.Len(t, chokePoints, 1)
+		require.Equal(t, "SERVER ADMIN", chokePoints[0].SourceName)
+	})
+}
@@ -0,0 +1,109 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"gorm.io/gorm"
+)
+
+const assetGroupTagMembershipEventBatchSize = 1000
+
+// AssetGroupTagMembershipEventData defines the methods required to interact with the asset_group_tag_membership_events table
+type AssetGroupTagMembershipEventData interface {
+	CreateAssetGroupTagMembershipEvents(ctx context.Context, events model.AssetGroupTagMembershipEvents) error
+	HasAssetGroupTagMembershipEvents(ctx context.Context, assetGroupTagId int) (bool, error)
+	GetAssetGroupTagMembershipEvents(ctx context.Context, assetGroupTagId int, sqlFilter model.SQLFilter, skip, limit int) (model.AssetGroupTagMembershipEvents, int, error)
+	GetAssetGroupTagMembersAsOf(ctx context.Context, assetGroupTagId int, asOf time.Time, skip, limit int) (model.AssetGroupTagMembershipEvents, int, error)
+}
+
+// CreateAssetGroupTagMembershipEvents records the membership changes of a tag from an analysis run
+func (s *BloodhoundDB) CreateAssetGroupTagMembershipEvents(ctx context.Context, events model.AssetGroupTagMembershipEvents) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	return CheckError(s.db.WithContext(ctx).CreateInBatches(&events, assetGroupTagMembershipEventBatchSize))
+}
+
+// HasAssetGroupTagMembershipEvents returns whether any membership change has been recorded for a tag
+func (s *BloodhoundDB) HasAssetGroupTagMembershipEvents(ctx context.Context, assetGroupTagId int) (bool, error) {
+	var exists bool
+
+	if result := s.db.WithContext(ctx).Raw("SELECT EXISTS (SELECT 1 FROM asset_group_tag_membership_events WHERE asset_group_tag_id = ?)", assetGroupTagId).Scan(&exists); result.Error != nil {
+		return false, CheckError(result)
+	}
+
+	return exists, nil
+}
+
+// GetAssetGroupTagMembershipEvents returns the membership changes of a tag with the most recent first along with the
+// total count of events matching the filter. A limit of 0 returns all events.
+func (s *BloodhoundDB) GetAssetGroupTagMembershipEvents(ctx context.Context, assetGroupTagId int, sqlFilter model.SQLFilter, skip, limit int) (model.AssetGroupTagMembershipEvents, int, error) {
+	var (
+		events   model.AssetGroupTagMembershipEvents
+		count    int64
+		filtered = func() *gorm.DB {
+			query := s.db.WithContext(ctx).Model(&model.AssetGroupTagMembershipEvent{}).Where("asset_group_tag_id = ?", assetGroupTagId)
+			if sqlFilter.SQLString != "" {
+				query = query.Where(sqlFilter.SQLString, sqlFilter.Params...)
+			}
+			return query
+		}
+	)
+
+	if result := filtered().Count(&count); result.Error != nil {
+		return nil, 0, CheckError(result)
+	} else if result := filtered().Scopes(Paginate(skip, limit)).Order("created_at DESC, id DESC").Find(&events); result.Error != nil {
+		return nil, 0, CheckError(result)
+	}
+
+	return events, int(count), nil
+}
+
+// GetAssetGroupTagMembersAsOf reconstructs the members of a tag at the given time from the latest membership event of
+// each object recorded at or before it. Events are keyed by object id rather than graph node id as node ids are not
+// stable across graph rebuilds, falling back to the node id only for nodes without an object id. The added events of
+// the members are returned ordered by object id along with the total count of members. A limit of 0 returns all
+// members.
+func (s *BloodhoundDB) GetAssetGroupTagMembersAsOf(ctx context.Context, assetGroupTagId int, asOf time.Time, skip, limit int) (model.AssetGroupTagMembershipEvents, int, error) {
+	const memberKey = "COALESCE(NULLIF(node_object_id, ''), node_id::text)"
+
+	var (
+		events  model.AssetGroupTagMembershipEvents
+		count   int64
+		members = func() *gorm.DB {
+			latest := s.db.WithContext(ctx).Model(&model.AssetGroupTagMembershipEvent{}).
+				Select("DISTINCT ON ("+memberKey+") *, "+memberKey+" AS member_key").
+				Where("asset_group_tag_id = ? AND created_at <= ?", assetGroupTagId, asOf).
+				Order(memberKey + ", created_at DESC, id DESC")
+
+			return s.db.WithContext(ctx).Table("(?) AS latest", latest).Where("action = ?", model.AssetGroupMembershipActionAdded)
+		}
+	)
+
+	if result := members().Count(&count); result.Error != nil {
+		return nil, 0, CheckError(result)
+	} else if result := members().Scopes(Paginate(skip, limit)).Order("member_key").Find(&events); result.Error != nil {
+		return nil, 0, CheckError(result)
+	}
+
+	return events, int(count), nil
+}
@@ -0,0 +1,109 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build integration
+// +build integration
+
+package database_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/test/integration"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatabase_AssetGroupTagMembershipEvents(t *testing.T) {
+	var (
+		dbInst  = integration.SetupDB(t)
+		testCtx = context.Background()
+		start   = time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	tierZero, err := dbInst.GetAssetGroupTag(testCtx, 1)
+	require.NoError(t, err)
+
+	hasEvents, err := dbInst.HasAssetGroupTagMembershipEvents(testCtx, tierZero.ID)
+	require.NoError(t, err)
+	require.False(t, hasEvents)
+
+	require.NoError(t, dbInst.CreateAssetGroupTagMembershipEvents(testCtx, model.AssetGroupTagMembershipEvents{
+		{AssetGroupTagId: tierZero.ID, SelectorId: null.Int32From(1), NodeId: 1, NodeObjectId: "S-1-5-21-1-512", NodeName: "DOMAIN ADMINS", Action: model.AssetGroupMembershipActionAdded, AnalysisRunId: "run-1", CreatedAt: start},
+		{AssetGroupTagId: tierZero.ID, SelectorId: null.Int32From(1), NodeId: 2, NodeObjectId: "S-1-5-21-1-1105", NodeName: "HELPDESK", Action: model.AssetGroupMembershipActionAdded, AnalysisRunId: "run-1", CreatedAt: start},
+		{AssetGroupTagId: tierZero.ID, NodeId: 2, NodeObjectId: "S-1-5-21-1-1105", NodeName: "HELPDESK", Action: model.AssetGroupMembershipActionRemoved, AnalysisRunId: "run-2", CreatedAt: start.Add(48 * time.Hour)},
+		{AssetGroupTagId: tierZero.ID, SelectorId: null.Int32From(1), NodeId: 3, NodeObjectId: "S-1-5-21-1-1000", NodeName: "DC01", Action: model.AssetGroupMembershipActionAdded, AnalysisRunId: "run-2", CreatedAt: start.Add(48 * time.Hour)},
+	}))
+
+	hasEvents, err = dbInst.HasAssetGroupTagMembershipEvents(testCtx, tierZero.ID)
+	require.NoError(t, err)
+	require.True(t, hasEvents)
+
+	t.Run("lists events with the most recent first", func(t *testing.T) {
+		events, count, err := dbInst.GetAssetGroupTagMembershipEvents(testCtx, tierZero.ID, model.SQLFilter{}, 0, 0)
+		require.NoError(t, err)
+		require.Equal(t, 4, count)
+		require.Len(t, events, 4)
+		require.Equal(t, "run-2", events[0].AnalysisRunId)
+		require.Equal(t, "run-1", events[3].AnalysisRunId)
+	})
+
+	t.Run("filters and paginates events", func(t *testing.T) {
+		events, count, err := dbInst.GetAssetGroupTagMembershipEvents(testCtx, tierZero.ID, model.SQLFilter{SQLString: "action = ?", Params: []any{model.AssetGroupMembershipActionRemoved}}, 0, 1)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+		require.Len(t, events, 1)
+		require.Equal(t, "HELPDESK", events[0].NodeName)
+		require.False(t, events[0].SelectorId.Valid)
+	})
+
+	t.Run("reconstructs members before a removal", func(t *testing.T) {
+		members, count, err := dbInst.GetAssetGroupTagMembersAsOf(testCtx, tierZero.ID, start.Add(24*time.Hour), 0, 0)
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+		require.Equal(t, "HELPDESK", members[0].NodeName)
+		require.Equal(t, "DOMAIN ADMINS", members[1].NodeName)
+	})
+
+	t.Run("reconstructs members after a removal", func(t *testing.T) {
+		members, count, err := dbInst.GetAssetGroupTagMembersAsOf(testCtx, tierZero.ID, start.Add(72*time.Hour), 0, 1)
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+		require.Len(t, members, 1)
+		require.Equal(t, "DC01", members[0].NodeName)
+	})
+
+	t.Run("keys members by object id across graph rebuilds", func(t *testing.T) {
+		require.NoError(t, dbInst.CreateAssetGroupTagMembershipEvents(testCtx, model.AssetGroupTagMembershipEvents{
+			{AssetGroupTagId: tierZero.ID, NodeId: 41, NodeObjectId: "S-1-5-21-1-512", NodeName: "DOMAIN ADMINS", Action: model.AssetGroupMembershipActionRemoved, AnalysisRunId: "run-3", CreatedAt: start.Add(96 * time.Hour)},
+		}))
+
+		members, count, err := dbInst.GetAssetGroupTagMembersAsOf(testCtx, tierZero.ID, start.Add(120*time.Hour), 0, 0)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+		require.Equal(t, "DC01", members[0].NodeName)
+	})
+
+	t.Run("has no members before the first event", func(t *testing.T) {
+		members, count, err := dbInst.GetAssetGroupTagMembersAsOf(testCtx, tierZero.ID, start.Add(-time.Hour), 0, 0)
+		require.NoError(t, err)
+		require.Zero(t, count)
+		require.Empty(t, members)
+	})
+}
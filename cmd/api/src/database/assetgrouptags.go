@@ -67,6 +67,9 @@ type AssetGroupTagSelectorNodeData interface {
 	DeleteSelectorNodesBySelectorIds(ctx context.Context, selectorId ...int) error
 	GetSelectorNodesBySelectorIds(ctx context.Context, selectorIds ...int) ([]model.AssetGroupSelectorNode, error)
 	GetSelectorsByMemberId(ctx context.Context, memberId int, assetGroupTagId int) (model.AssetGroupTagSelectors, error)
+	GetSelectorNodesByTagIdAndCertification(ctx context.Context, assetGroupTagId int, certified []model.AssetGroupCertification, skip, limit int) ([]model.AssetGroupSelectorNode, int, error)
+	UpdateSelectorNodesCertification(ctx context.Context, user model.User, assetGroupTag model.AssetGroupTag, nodeIds []graph.ID, certified model.AssetGroupCertification, note null.String) (int64, error)
+	ExpireSelectorNodeCertifications(ctx context.Context, certifiedBefore time.Time) (int64, error)
 }
 
 func insertSelectorSeeds(tx *gorm.DB, selectorId int, seeds []model.SelectorSeed) ([]model.SelectorSeed, error) {
@@ -103,7 +106,7 @@ func (s *BloodhoundDB) CreateAssetGroupTagSelector(ctx context.Context, assetGro
 	}
 
 	if err := s.AuditableTransaction(ctx, auditEntry, func(tx *gorm.DB) error {
-		bhdb := NewBloodhoundDB(tx, s.idResolver)
+		bhdb := s.withTx(tx)
 		if result := tx.Raw(fmt.Sprintf(`
 			INSERT INTO %s (asset_group_tag_id, created_at, created_by, updated_at, updated_by, name, description, is_default, allow_disable, auto_certify)
 			VALUES (?, NOW(), ?, NOW(), ?, ?, ?, ?, ?, ?)
@@ -161,7 +164,7 @@ func (s *BloodhoundDB) UpdateAssetGroupTagSelector(ctx context.Context, actorId,
 	)
 
 	if err := s.AuditableTransaction(ctx, auditEntry, func(tx *gorm.DB) error {
-		bhdb := NewBloodhoundDB(tx, s.idResolver)
+		bhdb := s.withTx(tx)
 		if result := tx.Exec(fmt.Sprintf(`
 			UPDATE %s SET updated_at = NOW(), updated_by = ?, name = ?, description = ?, disabled_at = ?, disabled_by = ?, auto_certify = ?
 			WHERE id = ?`,
@@ -198,7 +201,7 @@ func (s *BloodhoundDB) DeleteAssetGroupTagSelector(ctx context.Context, user mod
 	)
 
 	if err := s.AuditableTransaction(ctx, auditEntry, func(tx *gorm.DB) error {
-		bhdb := NewBloodhoundDB(tx, s.idResolver)
+		bhdb := s.withTx(tx)
 		if result := tx.Exec(fmt.Sprintf(`DELETE FROM %s WHERE id = ?`, selector.TableName()), selector.ID); result.Error != nil {
 			return CheckError(result)
 		} else if err := bhdb.CreateAssetGroupHistoryRecord(ctx, user.ID.String(), user.EmailAddress.ValueOrZero(), selector.Name, model.AssetGroupHistoryActionDeleteSelector, selector.AssetGroupTagId, null.String{}, null.String{}); err != nil {
@@ -310,7 +313,7 @@ func (s *BloodhoundDB) CreateAssetGroupTag(ctx context.Context, tagType model.As
 	}
 
 	if err := s.AuditableTransaction(ctx, auditEntry, func(tx *gorm.DB) error {
-		bhdb := NewBloodhoundDB(tx, s.idResolver)
+		bhdb := s.withTx(tx)
 
 		if tag.Type == model.AssetGroupTagTypeTier {
 
@@ -387,7 +390,7 @@ func (s *BloodhoundDB) UpdateAssetGroupTag(ctx context.Context, user model.User,
 	}
 
 	if err := s.AuditableTransaction(ctx, auditEntry, func(tx *gorm.DB) error {
-		bhdb := NewBloodhoundDB(tx, s.idResolver)
+		bhdb := s.withTx(tx)
 
 		var newPosition = tag.Position // only set for tiers
 
@@ -491,7 +494,7 @@ func (s *BloodhoundDB) DeleteAssetGroupTag(ctx context.Context, user model.User,
 	)
 
 	if err := s.AuditableTransaction(ctx, auditEntry, func(tx *gorm.DB) error {
-		bhdb := NewBloodhoundDB(tx, s.idResolver)
+		bhdb := s.withTx(tx)
 
 		if selectors, _, err := bhdb.GetAssetGroupTagSelectorsByTagId(ctx, assetGroupTag.ID, model.SQLFilter{}, model.SQLFilter{}, 0, 0); err != nil {
 			return err
@@ -680,11 +683,11 @@ func (s *BloodhoundDB) GetAssetGroupTagForSelection(ctx context.Context) ([]mode
 }
 
 func (s *BloodhoundDB) InsertSelectorNode(ctx context.Context, selectorId int, nodeId graph.ID, certified model.AssetGroupCertification, certifiedBy null.String, source model.AssetGroupSelectorNodeSource) error {
-	return CheckError(s.db.WithContext(ctx).Exec(fmt.Sprintf("INSERT INTO %s (selector_id, node_id, certified, certified_by, source, created_at, updated_at) VALUES(?, ?, ?, ?, ?, current_timestamp, current_timestamp) ON CONFLICT DO NOTHING", model.AssetGroupSelectorNode{}.TableName()), selectorId, nodeId, certified, certifiedBy, source))
+	return CheckError(s.db.WithContext(ctx).Exec(fmt.Sprintf("INSERT INTO %s (selector_id, node_id, certified, certified_by, certified_at, source, created_at, updated_at) VALUES(?, ?, ?, ?, CASE WHEN ? > 0 THEN current_timestamp END, ?, current_timestamp, current_timestamp) ON CONFLICT DO NOTHING", model.AssetGroupSelectorNode{}.TableName()), selectorId, nodeId, certified, certifiedBy, certified, source))
 }
 
 func (s *BloodhoundDB) UpdateSelectorNodesByNodeId(ctx context.Context, selectorId int, certified model.AssetGroupCertification, certifiedBy null.String, nodeId graph.ID) error {
-	return CheckError(s.db.WithContext(ctx).Exec(fmt.Sprintf("UPDATE %s SET certified = ?, certified_by = ?, updated_at = current_timestamp WHERE selector_id = ? AND node_id = ?", model.AssetGroupSelectorNode{}.TableName()), certified, certifiedBy, selectorId, nodeId))
+	return CheckError(s.db.WithContext(ctx).Exec(fmt.Sprintf("UPDATE %s SET certified = ?, certified_by = ?, certified_at = CASE WHEN ? > 0 THEN current_timestamp END, updated_at = current_timestamp WHERE selector_id = ? AND node_id = ?", model.AssetGroupSelectorNode{}.TableName()), certified, certifiedBy, certified, selectorId, nodeId))
 }
 
 func (s *BloodhoundDB) DeleteSelectorNodesByNodeId(ctx context.Context, selectorId int, nodeId graph.ID) error {
@@ -703,7 +706,130 @@ func (s *BloodhoundDB) GetSelectorNodesBySelectorIds(ctx context.Context, select
 	if len(selectorIds) == 0 {
 		return nodes, nil
 	}
-	return nodes, CheckError(s.db.WithContext(ctx).Raw(fmt.Sprintf("SELECT selector_id, node_id, certified, certified_by, source, created_at, updated_at FROM %s WHERE selector_id IN ?", model.AssetGroupSelectorNode{}.TableName()), selectorIds).Find(&nodes))
+	return nodes, CheckError(s.db.WithContext(ctx).Raw(fmt.Sprintf("SELECT selector_id, node_id, certified, certified_by, certified_at, source, created_at, updated_at FROM %s WHERE selector_id IN ?", model.AssetGroupSelectorNode{}.TableName()), selectorIds).Find(&nodes))
+}
+
+// GetSelectorNodesByTagIdAndCertification returns the selected nodes of the tag's enabled selectors that have one of the
+// given certification states, ordered by node
+func (s *BloodhoundDB) GetSelectorNodesByTagIdAndCertification(ctx context.Context, assetGroupTagId int, certified []model.AssetGroupCertification, skip, limit int) ([]model.AssetGroupSelectorNode, int, error) {
+	var (
+		nodes           []model.AssetGroupSelectorNode
+		count           int
+		skipLimitString string
+		whereClause     = fmt.Sprintf("WHERE n.certified IN ? AND n.selector_id IN (SELECT id FROM %s WHERE asset_group_tag_id = ? AND disabled_at IS NULL)", model.AssetGroupTagSelector{}.TableName())
+	)
+
+	if limit > 0 {
+		skipLimitString += fmt.Sprintf(" LIMIT %d", limit)
+	}
+
+	if skip > 0 {
+		skipLimitString += fmt.Sprintf(" OFFSET %d", skip)
+	}
+
+	if result := s.db.WithContext(ctx).Raw(fmt.Sprintf("SELECT count(*) FROM %s n %s", model.AssetGroupSelectorNode{}.TableName(), whereClause), certified, assetGroupTagId).Scan(&count); result.Error != nil {
+		return nil, 0, CheckError(result)
+	} else if result := s.db.WithContext(ctx).Raw(fmt.Sprintf("SELECT n.selector_id, n.node_id, n.certified, n.certified_by, n.certified_at, n.source, n.created_at, n.updated_at FROM %s n %s ORDER BY n.node_id, n.selector_id%s", model.AssetGroupSelectorNode{}.TableName(), whereClause, skipLimitString), certified, assetGroupTagId).Find(&nodes); result.Error != nil {
+		return nil, 0, CheckError(result)
+	}
+
+	return nodes, count, nil
+}
+
+// UpdateSelectorNodesCertification sets the certification of the given nodes for every selector of the tag that selected
+// them and records a history entry with the note for each node. Returns the number of nodes updated.
+func (s *BloodhoundDB) UpdateSelectorNodesCertification(ctx context.Context, user model.User, assetGroupTag model.AssetGroupTag, nodeIds []graph.ID, certified model.AssetGroupCertification, note null.String) (int64, error) {
+	var (
+		updatedNodeIds []graph.ID
+		action         = model.AssetGroupHistoryActionCertifyNodeManual
+		certifiedBy    = null.StringFrom(user.ID.String())
+		auditEntry     = model.AuditEntry{
+			Action: model.AuditLogActionCertifyAssetGroupTagMembers,
+			Model: model.AuditData{
+				"asset_group_tag_id": assetGroupTag.ID,
+				"node_ids":           nodeIds,
+				"certified":          certified.String(),
+				"note":               note,
+			},
+		}
+	)
+
+	switch certified {
+	case model.AssetGroupCertificationManual:
+	case model.AssetGroupCertificationRevoked:
+		action = model.AssetGroupHistoryActionCertifyNodeRevoked
+	default:
+		return 0, fmt.Errorf("members may only be certified or revoked")
+	}
+
+	if len(nodeIds) == 0 {
+		return 0, nil
+	}
+
+	if err := s.AuditableTransaction(ctx, auditEntry, func(tx *gorm.DB) error {
+		bhdb := s.withTx(tx)
+
+		if result := tx.Raw(fmt.Sprintf(`
+			UPDATE %s SET certified = ?, certified_by = ?, certified_at = current_timestamp, updated_at = current_timestamp
+			WHERE node_id IN ? AND selector_id IN (SELECT id FROM %s WHERE asset_group_tag_id = ?)
+			RETURNING node_id`,
+			model.AssetGroupSelectorNode{}.TableName(), model.AssetGroupTagSelector{}.TableName()),
+			certified, certifiedBy, nodeIds, assetGroupTag.ID).Scan(&updatedNodeIds); result.Error != nil {
+			return CheckError(result)
+		}
+
+		slices.Sort(updatedNodeIds)
+		updatedNodeIds = slices.Compact(updatedNodeIds)
+
+		for _, nodeId := range updatedNodeIds {
+			if err := bhdb.CreateAssetGroupHistoryRecord(ctx, user.ID.String(), user.EmailAddress.ValueOrZero(), nodeId.String(), action, assetGroupTag.ID, null.String{}, note); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+
+	return int64(len(updatedNodeIds)), nil
+}
+
+// ExpireSelectorNodeCertifications reverts manual certifications made before the given time to pending and records a
+// history entry for each expired node
+func (s *BloodhoundDB) ExpireSelectorNodeCertifications(ctx context.Context, certifiedBefore time.Time) (int64, error) {
+	var expired []struct {
+		NodeId          graph.ID
+		AssetGroupTagId int
+	}
+
+	if err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		bhdb := s.withTx(tx)
+
+		if result := tx.Raw(fmt.Sprintf(`
+			WITH expired AS (
+				UPDATE %s SET certified = ?, certified_by = null, certified_at = null, updated_at = current_timestamp
+				WHERE certified = ? AND certified_at < ?
+				RETURNING selector_id, node_id
+			)
+			SELECT DISTINCT e.node_id, s.asset_group_tag_id FROM expired e JOIN %s s ON s.id = e.selector_id`,
+			model.AssetGroupSelectorNode{}.TableName(), model.AssetGroupTagSelector{}.TableName()),
+			model.AssetGroupCertificationNone, model.AssetGroupCertificationManual, certifiedBefore).Scan(&expired); result.Error != nil {
+			return CheckError(result)
+		}
+
+		for _, node := range expired {
+			if err := bhdb.CreateAssetGroupHistoryRecord(ctx, model.AssetGroupActorSystem, "", node.NodeId.String(), model.AssetGroupHistoryActionCertifyNodeExpired, node.AssetGroupTagId, null.String{}, null.String{}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+
+	return int64(len(expired)), nil
 }
 
 func (s *BloodhoundDB) UpdateTierPositions(ctx context.Context, user model.User, orderedTags model.AssetGroupTags, ignoredTagIds ...int) error {
@@ -722,7 +848,7 @@ func (s *BloodhoundDB) UpdateTierPositions(ctx context.Context, user model.User,
 		)
 
 		if err := s.AuditableTransaction(ctx, auditEntry, func(tx *gorm.DB) error {
-			bhdb := NewBloodhoundDB(tx, s.idResolver)
+			bhdb := s.withTx(tx)
 
 			tag.UpdatedAt = time.Now()
 			tag.UpdatedBy = user.ID.String()
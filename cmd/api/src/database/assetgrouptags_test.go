@@ -1025,6 +1025,78 @@ func TestDatabase_DeleteAssetGroupTagSelector(t *testing.T) {
 	})
 }
 
+func TestDatabase_SelectorNodeCertification(t *testing.T) {
+	var (
+		dbInst  = integration.SetupDB(t)
+		testCtx = context.Background()
+		pending = []model.AssetGroupCertification{model.AssetGroupCertificationNone}
+		note    = null.StringFrom("reviewed with the domain admins")
+	)
+
+	tag, err := dbInst.GetAssetGroupTag(testCtx, 1)
+	require.NoError(t, err)
+	selector, err := dbInst.CreateAssetGroupTagSelector(testCtx, tag.ID, model.User{}, "test selector name", "test description", false, true, null.BoolFrom(false), []model.SelectorSeed{{Type: model.SelectorTypeObjectId, Value: "ObjectID1234"}})
+	require.NoError(t, err)
+
+	for _, nodeId := range []graph.ID{1, 2} {
+		require.NoError(t, dbInst.InsertSelectorNode(testCtx, selector.ID, nodeId, model.AssetGroupCertificationNone, null.String{}, model.AssetGroupSelectorNodeSourceSeed))
+	}
+
+	nodes, count, err := dbInst.GetSelectorNodesByTagIdAndCertification(testCtx, tag.ID, pending, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+	require.Len(t, nodes, 2)
+
+	t.Run("certifies members", func(t *testing.T) {
+		updated, err := dbInst.UpdateSelectorNodesCertification(testCtx, model.User{}, tag, []graph.ID{1, 3}, model.AssetGroupCertificationManual, note)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), updated)
+
+		nodes, count, err := dbInst.GetSelectorNodesByTagIdAndCertification(testCtx, tag.ID, []model.AssetGroupCertification{model.AssetGroupCertificationManual}, 0, 0)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+		require.Equal(t, graph.ID(1), nodes[0].NodeId)
+		require.True(t, nodes[0].CertifiedAt.Valid)
+
+		history, _, err := dbInst.GetAssetGroupHistoryRecords(testCtx, model.SQLFilter{SQLString: "action = ?", Params: []any{model.AssetGroupHistoryActionCertifyNodeManual}}, model.Sort{}, 0, 0)
+		require.NoError(t, err)
+		require.Len(t, history, 1)
+		require.Equal(t, "1", history[0].Target)
+		require.Equal(t, note, history[0].Note)
+	})
+
+	t.Run("rejects pending as a certification", func(t *testing.T) {
+		_, err := dbInst.UpdateSelectorNodesCertification(testCtx, model.User{}, tag, []graph.ID{1}, model.AssetGroupCertificationNone, note)
+		require.Error(t, err)
+	})
+
+	t.Run("expires certifications", func(t *testing.T) {
+		expired, err := dbInst.ExpireSelectorNodeCertifications(testCtx, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, int64(1), expired)
+
+		_, count, err := dbInst.GetSelectorNodesByTagIdAndCertification(testCtx, tag.ID, pending, 0, 0)
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+	})
+
+	t.Run("revokes members", func(t *testing.T) {
+		updated, err := dbInst.UpdateSelectorNodesCertification(testCtx, model.User{}, tag, []graph.ID{2}, model.AssetGroupCertificationRevoked, null.String{})
+		require.NoError(t, err)
+		require.Equal(t, int64(1), updated)
+
+		// revocations do not expire
+		expired, err := dbInst.ExpireSelectorNodeCertifications(testCtx, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		require.Zero(t, expired)
+
+		nodes, _, err := dbInst.GetSelectorNodesByTagIdAndCertification(testCtx, tag.ID, []model.AssetGroupCertification{model.AssetGroupCertificationRevoked}, 0, 0)
+		require.NoError(t, err)
+		require.Len(t, nodes, 1)
+		require.Equal(t, graph.ID(2), nodes[0].NodeId)
+	})
+}
+
 func TestDatabase_GetOrderedAssetGroupTagTiers(t *testing.T) {
 	var (
 		testCtx      = context.Background()
@@ -0,0 +1,95 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"gorm.io/gorm"
+)
+
+const assetGroupTagViolationBatchSize = 1000
+
+// AssetGroupTagViolationData defines the methods required to interact with the asset_group_tag_violations and
+// asset_group_tag_violation_counts tables
+type AssetGroupTagViolationData interface {
+	ReplaceAssetGroupTagViolations(ctx context.Context, violations model.AssetGroupTagViolations, counts []model.AssetGroupTagViolationCount) error
+	GetAssetGroupTagViolations(ctx context.Context, assetGroupTagId int, sqlFilter model.SQLFilter, skip, limit int) (model.AssetGroupTagViolations, int, error)
+	GetAssetGroupTagViolationCounts(ctx context.Context, assetGroupTagId int) ([]model.AssetGroupTagViolationCount, error)
+}
+
+// ReplaceAssetGroupTagViolations swaps the stored tier violations and counts for those found by the latest analysis
+func (s *BloodhoundDB) ReplaceAssetGroupTagViolations(ctx context.Context, violations model.AssetGroupTagViolations, counts []model.AssetGroupTagViolationCount) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if result := tx.Exec(fmt.Sprintf("DELETE FROM %s", model.AssetGroupTagViolation{}.TableName())); result.Error != nil {
+			return CheckError(result)
+		} else if result := tx.Exec(fmt.Sprintf("DELETE FROM %s", model.AssetGroupTagViolationCount{}.TableName())); result.Error != nil {
+			return CheckError(result)
+		}
+
+		if len(violations) > 0 {
+			if result := tx.CreateInBatches(&violations, assetGroupTagViolationBatchSize); result.Error != nil {
+				return CheckError(result)
+			}
+		}
+
+		if len(counts) > 0 {
+			if result := tx.Create(&counts); result.Error != nil {
+				return CheckError(result)
+			}
+		}
+
+		return nil
+	})
+}
+
+// GetAssetGroupTagViolations returns the violations into a tier ordered by the most principals first along with the
+// total count of violations matching the filter. A limit of 0 returns all violations.
+func (s *BloodhoundDB) GetAssetGroupTagViolations(ctx context.Context, assetGroupTagId int, sqlFilter model.SQLFilter, skip, limit int) (model.AssetGroupTagViolations, int, error) {
+	var (
+		violations model.AssetGroupTagViolations
+		count      int64
+		filtered   = func() *gorm.DB {
+			query := s.db.WithContext(ctx).Model(&model.AssetGroupTagViolation{}).Where("asset_group_tag_id = ?", assetGroupTagId)
+			if sqlFilter.SQLString != "" {
+				query = query.Where(sqlFilter.SQLString, sqlFilter.Params...)
+			}
+			return query
+		}
+	)
+
+	if result := filtered().Count(&count); result.Error != nil {
+		return nil, 0, CheckError(result)
+	} else if result := filtered().Scopes(Paginate(skip, limit)).Order("principals DESC, id").Find(&violations); result.Error != nil {
+		return nil, 0, CheckError(result)
+	}
+
+	return violations, int(count), nil
+}
+
+// GetAssetGroupTagViolationCounts returns the violation counts into a tier by source tier
+func (s *BloodhoundDB) GetAssetGroupTagViolationCounts(ctx context.Context, assetGroupTagId int) ([]model.AssetGroupTagViolationCount, error) {
+	var counts []model.AssetGroupTagViolationCount
+
+	if result := s.db.WithContext(ctx).Where("asset_group_tag_id = ?", assetGroupTagId).Order("principals DESC, source_tag_id NULLS FIRST").Find(&counts); result.Error != nil {
+		return nil, CheckError(result)
+	}
+
+	return counts, nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build integration
+// +build integration
+
+package database_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/test/integration"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatabase_AssetGroupTagViolations(t *testing.T) {
+	var (
+		dbInst  = integration.SetupDB(t)
+		testCtx = context.Background()
+	)
+
+	tierZero, err := dbInst.GetAssetGroupTag(testCtx, 1)
+	require.NoError(t, err)
+	tierOne, err := dbInst.CreateAssetGroupTag(testCtx, model.AssetGroupTagTypeTier, model.User{}, "Tier One", "", null.Int32From(2), null.BoolFrom(false))
+	require.NoError(t, err)
+
+	previous := model.AssetGroupTagViolations{{AssetGroupTagId: tierZero.ID, EdgeKind: "GenericAll", SourceNodeId: 99, TargetNodeId: 98, Principals: 1}}
+	require.NoError(t, dbInst.ReplaceAssetGroupTagViolations(testCtx, previous, nil))
+
+	violations := model.AssetGroupTagViolations{
+		{AssetGroupTagId: tierZero.ID, SourceTagId: null.Int32From(int32(tierOne.ID)), EdgeKind: "AdminTo", SourceNodeId: 1, SourceName: "SERVER ADMIN", TargetNodeId: 2, TargetName: "DC", Principals: 1},
+		{AssetGroupTagId: tierZero.ID, EdgeKind: "GenericAll", SourceNodeId: 3, SourceName: "HELPDESK", TargetNodeId: 2, TargetName: "DC", Principals: 12},
+		{AssetGroupTagId: tierOne.ID, EdgeKind: "GenericWrite", SourceNodeId: 4, SourceName: "USER", TargetNodeId: 1, TargetName: "SERVER ADMIN", Principals: 1},
+	}
+	counts := []model.AssetGroupTagViolationCount{
+		{AssetGroupTagId: tierZero.ID, SourceTagId: null.Int32From(int32(tierOne.ID)), Principals: 1, Edges: 1},
+		{AssetGroupTagId: tierZero.ID, Principals: 12, Edges: 1},
+		{AssetGroupTagId: tierOne.ID, Principals: 1, Edges: 1},
+	}
+	require.NoError(t, dbInst.ReplaceAssetGroupTagViolations(testCtx, violations, counts))
+
+	t.Run("lists violations by most principals", func(t *testing.T) {
+		result, count, err := dbInst.GetAssetGroupTagViolations(testCtx, tierZero.ID, model.SQLFilter{}, 0, 0)
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+		require.Len(t, result, 2)
+		require.Equal(t, "HELPDESK", result[0].SourceName)
+		require.Equal(t, "SERVER ADMIN", result[1].SourceName)
+	})
+
+	t.Run("filters and paginates violations", func(t *testing.T) {
+		result, count, err := dbInst.GetAssetGroupTagViolations(testCtx, tierZero.ID, model.SQLFilter{SQLString: "source_tag_id IS NULL"}, 0, 1)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+		require.Len(t, result, 1)
+		require.Equal(t, "HELPDESK", result[0].SourceName)
+	})
+
+	t.Run("lists violation counts by source tier", func(t *testing.T) {
+		result, err := dbInst.GetAssetGroupTagViolationCounts(testCtx, tierZero.ID)
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		require.False(t, result[0].SourceTagId.Valid)
+		require.Equal(t, 12, result[0].Principals)
+		require.Equal(t, int32(tierOne.ID), result[1].SourceTagId.Int32)
+	})
+}
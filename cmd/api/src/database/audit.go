@@ -35,6 +35,9 @@ var (
 	ErrAuthContextInvalid = errors.New("auth context is invalid")
 )
 
+// auditLogChainLockID is the transaction level advisory lock key held while appending to the audit log hash chain
+const auditLogChainLockID = 7311960140
+
 func newAuditLog(context context.Context, entry model.AuditEntry, idResolver auth.IdentityResolver) (model.AuditLog, error) {
 	bheCtx := ctx.Get(context)
 
@@ -78,8 +81,109 @@ func (s *BloodhoundDB) AppendAuditLog(ctx context.Context, entry model.AuditEntr
 	}
 }
 
+// SetAuditLogHashKey sets the key of the audit log hash chain. Without a key entries are still chained, but anyone able
+// to write to the database can recompute the hashes of altered entries.
+func (s *BloodhoundDB) SetAuditLogHashKey(key []byte) {
+	s.auditLogHashKey = key
+}
+
+// CreateAuditLog appends the entry to the audit log hash chain. Appends are serialized with a transaction level
+// advisory lock so that every entry links to the entry before it.
 func (s *BloodhoundDB) CreateAuditLog(ctx context.Context, auditLog model.AuditLog) error {
-	return CheckError(s.db.WithContext(ctx).Create(&auditLog))
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var previousHash string
+
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", auditLogChainLockID).Error; err != nil {
+			return err
+		} else if err := tx.Raw("SELECT hash FROM audit_logs ORDER BY id DESC LIMIT 1").Scan(&previousHash).Error; err != nil {
+			return err
+		}
+
+		if auditLog.ID == 0 {
+			if err := tx.Raw("SELECT nextval(pg_get_serial_sequence('audit_logs', 'id'))").Scan(&auditLog.ID).Error; err != nil {
+				return err
+			}
+		}
+
+		if auditLog.CreatedAt.IsZero() {
+			auditLog.CreatedAt = time.Now()
+		}
+
+		// Stored timestamps have microsecond precision, the hash must be computed from the stored value
+		auditLog.CreatedAt = auditLog.CreatedAt.UTC().Truncate(time.Microsecond)
+		auditLog.PreviousHash = previousHash
+
+		if hash, err := auditLog.ComputeHash(s.auditLogHashKey); err != nil {
+			return fmt.Errorf("audit log hash: %w", err)
+		} else {
+			auditLog.Hash = hash
+		}
+
+		return CheckError(tx.Create(&auditLog))
+	})
+}
+
+// GetAuditLogsAfterID returns up to limit audit logs with an ID greater than afterID in ascending ID order. Zero
+// before and after times leave the time range unbounded.
+func (s *BloodhoundDB) GetAuditLogsAfterID(ctx context.Context, afterID int64, before, after time.Time, limit int, filter model.SQLFilter) (model.AuditLogs, error) {
+	var (
+		auditLogs model.AuditLogs
+		cursor    = s.db.WithContext(ctx).Where("id > ?", afterID)
+	)
+
+	if !before.IsZero() {
+		cursor = cursor.Where("created_at <= ?", before)
+	}
+
+	if !after.IsZero() {
+		cursor = cursor.Where("created_at >= ?", after)
+	}
+
+	if filter.SQLString != "" {
+		cursor = cursor.Where(filter.SQLString, filter.Params...)
+	}
+
+	return auditLogs, CheckError(cursor.Order("id").Limit(limit).Find(&auditLogs))
+}
+
+// PruneAuditLogs deletes the audit logs created before the given time and records the deletion as a new entry. Only
+// the oldest contiguous run of entries is deleted so the remaining chain stays intact; the new entry records the hash
+// of the last deleted entry, which the first remaining entry links to.
+func (s *BloodhoundDB) PruneAuditLogs(ctx context.Context, before time.Time) (int64, error) {
+	var (
+		lastPruned model.AuditLog
+		deleted    int64
+	)
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", auditLogChainLockID).Error; err != nil {
+			return err
+		} else if result := tx.Raw(`
+			SELECT id, hash FROM audit_logs
+			WHERE id < coalesce((SELECT min(id) FROM audit_logs WHERE created_at >= ?), (SELECT max(id) + 1 FROM audit_logs))
+			ORDER BY id DESC LIMIT 1;`, before).Scan(&lastPruned); result.Error != nil {
+			return result.Error
+		} else if lastPruned.ID == 0 {
+			return nil
+		} else if result := tx.Where("id <= ?", lastPruned.ID).Delete(&model.AuditLog{}); result.Error != nil {
+			return result.Error
+		} else {
+			deleted = result.RowsAffected
+		}
+
+		return s.withTx(tx).CreateAuditLog(ctx, model.AuditLog{
+			Action: model.AuditLogActionPruneAuditLogs,
+			Status: model.AuditLogStatusSuccess,
+			Fields: types.JSONUntypedObject{
+				"pruned_before":    before.UTC().Format(time.RFC3339),
+				"deleted_count":    deleted,
+				"last_pruned_id":   lastPruned.ID,
+				"last_pruned_hash": lastPruned.Hash,
+			},
+		})
+	})
+
+	return deleted, err
 }
 
 func (s *BloodhoundDB) ListAuditLogs(ctx context.Context, before, after time.Time, offset, limit int, order string, filter model.SQLFilter) (model.AuditLogs, int, error) {
@@ -26,8 +26,11 @@ import (
 
 	"github.com/specterops/bloodhound/cmd/api/src/auth"
 	"github.com/specterops/bloodhound/cmd/api/src/ctx"
+	"github.com/specterops/bloodhound/cmd/api/src/database"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types"
 	"github.com/specterops/bloodhound/cmd/api/src/model"
 	"github.com/specterops/bloodhound/cmd/api/src/test/integration"
+	"github.com/stretchr/testify/require"
 )
 
 func TestDatabase_ListAuditLogs(t *testing.T) {
@@ -71,3 +74,83 @@ func TestDatabase_ListAuditLogs(t *testing.T) {
 		t.Fatalf("Expected 3 audit logs to be returned")
 	}
 }
+
+func TestDatabase_AuditLogHashChain(t *testing.T) {
+	var (
+		dbInst  = integration.SetupDB(t)
+		testCtx = context.Background()
+		now     = time.Now()
+	)
+
+	for i := 5; i > 0; i-- {
+		if err := dbInst.CreateAuditLog(testCtx, model.AuditLog{
+			CreatedAt: now.Add(-time.Duration(i) * 24 * time.Hour),
+			Action:    model.AuditLogActionCreateUser,
+			Fields:    types.JSONUntypedObject{"index": i},
+			Status:    model.AuditLogStatusSuccess,
+		}); err != nil {
+			t.Fatalf("Error creating audit log: %v", err)
+		}
+	}
+
+	if deleted, err := dbInst.PruneAuditLogs(testCtx, now.Add(-3*24*time.Hour+time.Hour)); err != nil {
+		t.Fatalf("Failed to prune audit logs: %v", err)
+	} else if deleted != 3 {
+		t.Fatalf("Expected 3 audit logs to be pruned but %d were", deleted)
+	} else if auditLogs, err := dbInst.GetAuditLogsAfterID(testCtx, 0, time.Time{}, time.Time{}, 2, model.SQLFilter{}); err != nil {
+		t.Fatalf("Failed to get audit logs: %v", err)
+	} else if len(auditLogs) != 2 {
+		t.Fatalf("Expected 2 audit logs to be returned but got %d", len(auditLogs))
+	} else if remaining, err := dbInst.GetAuditLogsAfterID(testCtx, auditLogs[1].ID, time.Time{}, time.Time{}, 10, model.SQLFilter{}); err != nil {
+		t.Fatalf("Failed to get audit logs: %v", err)
+	} else if len(remaining) != 1 || remaining[0].Action != model.AuditLogActionPruneAuditLogs {
+		t.Fatalf("Expected the prune audit log to be last")
+	} else {
+		verification := model.NewAuditLogChainVerification(nil)
+
+		for _, auditLog := range append(auditLogs, remaining...) {
+			if !verification.Add(auditLog) {
+				t.Fatalf("Audit log chain broken at %d: %s", verification.BrokenAtID, verification.Reason)
+			}
+		}
+
+		if !verification.Finish() {
+			t.Fatalf("Audit log chain broken at %d: %s", verification.BrokenAtID, verification.Reason)
+		} else if verification.EntriesHashed != 3 {
+			t.Fatalf("Expected 3 hashed audit logs but got %d", verification.EntriesHashed)
+		} else if verification.AnchorHash != remaining[0].Fields["last_pruned_hash"] {
+			t.Fatalf("Expected the chain anchor to match the last pruned hash")
+		}
+	}
+}
+
+func TestDatabase_AuditLogHashChain_SSOProviders(t *testing.T) {
+	var (
+		dbInst  = integration.SetupDB(t)
+		testCtx = context.Background()
+		hashKey = []byte("audit log hash key")
+	)
+
+	// Creating an SSO provider appends audit logs from inside its transaction, which must be keyed as well
+	dbInst.(*database.BloodhoundDB).SetAuditLogHashKey(hashKey)
+
+	_, err := dbInst.CreateLDAPProvider(testCtx, "ldap", model.LDAPProvider{URL: "ldaps://dc.example.com"}, model.SSOProviderConfig{})
+	require.NoError(t, err)
+	_, err = dbInst.CreateOIDCProvider(testCtx, "oidc", "https://oidc.example.com/auth", "bloodhound", model.SSOProviderConfig{})
+	require.NoError(t, err)
+	_, err = dbInst.CreateSAMLIdentityProvider(testCtx, model.SAMLProvider{Name: "saml"}, model.SSOProviderConfig{})
+	require.NoError(t, err)
+
+	auditLogs, err := dbInst.GetAuditLogsAfterID(testCtx, 0, time.Time{}, time.Time{}, 100, model.SQLFilter{})
+	require.NoError(t, err)
+	require.NotEmpty(t, auditLogs)
+
+	verification := model.NewAuditLogChainVerification(hashKey)
+	for _, auditLog := range auditLogs {
+		require.True(t, verification.Add(auditLog), "audit log chain broken at %d: %s", verification.BrokenAtID, verification.Reason)
+	}
+
+	require.True(t, verification.Finish(), "audit log chain broken at %d: %s", verification.BrokenAtID, verification.Reason)
+	require.True(t, verification.Keyed)
+	require.EqualValues(t, len(auditLogs), verification.EntriesHashed)
+}
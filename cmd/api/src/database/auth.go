@@ -82,6 +82,26 @@ func (s contextInitializer) InitContextFromToken(ctx context.Context, authToken
 	if authToken.UserID.Valid {
 		if user, err := s.db.GetUser(ctx, authToken.UserID.UUID); err != nil {
 			return auth.Context{}, err
+		} else if authToken.IsScoped() {
+			// Scoped tokens may never grant more than the owner currently holds
+			var (
+				ownerPermissions = user.Roles.Permissions()
+				tokenPermissions = model.Permissions{}
+			)
+
+			for _, permission := range authToken.Permissions {
+				if ownerPermissions.Has(permission) {
+					tokenPermissions = append(tokenPermissions, permission)
+				}
+			}
+
+			return auth.Context{
+				Owner: user,
+				PermissionOverrides: auth.PermissionOverrides{
+					Enabled:     true,
+					Permissions: tokenPermissions,
+				},
+			}, nil
 		} else {
 			return auth.Context{
 				Owner: user,
@@ -132,6 +152,71 @@ func (s *BloodhoundDB) GetRole(ctx context.Context, id int32) (model.Role, error
 	return role, CheckError(result)
 }
 
+// CreateRole creates a new custom role along with its permission associations
+// INSERT INTO roles (name, description, custom, ...) VALUES (...)
+func (s *BloodhoundDB) CreateRole(ctx context.Context, role model.Role) (model.Role, error) {
+	role.Custom = true
+
+	auditEntry := model.AuditEntry{
+		Action: model.AuditLogActionCreateRole,
+		Model:  &role,
+	}
+
+	return role, s.AuditableTransaction(ctx, auditEntry, func(tx *gorm.DB) error {
+		// Permissions are only associated with the role, never created or updated through it
+		return checkRoleError(tx.WithContext(ctx).Omit("Permissions.*").Create(&role))
+	})
+}
+
+// UpdateRole updates the name, description and permission associations of a custom role
+// UPDATE roles SET name = ..., description = ... WHERE id = ...
+func (s *BloodhoundDB) UpdateRole(ctx context.Context, role model.Role) error {
+	auditEntry := model.AuditEntry{
+		Action: model.AuditLogActionUpdateRole,
+		Model:  &role, // Pointer is required to ensure success log contains updated fields after transaction
+	}
+
+	return s.AuditableTransaction(ctx, auditEntry, func(tx *gorm.DB) error {
+		if err := tx.Model(&role).WithContext(ctx).Omit("Permissions.*").Association("Permissions").Replace(&role.Permissions); err != nil {
+			return err
+		}
+
+		return checkRoleError(tx.WithContext(ctx).Omit("Permissions").Save(&role))
+	})
+}
+
+// DeleteRole removes a custom role and its permission associations. Roles that are still assigned to a user can not be
+// deleted as that would silently revoke the permissions of the user.
+// DELETE FROM roles WHERE id = ...
+func (s *BloodhoundDB) DeleteRole(ctx context.Context, role model.Role) error {
+	auditEntry := model.AuditEntry{
+		Action: model.AuditLogActionDeleteRole,
+		Model:  &role,
+	}
+
+	return s.AuditableTransaction(ctx, auditEntry, func(tx *gorm.DB) error {
+		var assignments int64
+
+		if result := tx.WithContext(ctx).Table("users_roles").Where("role_id = ?", role.ID).Count(&assignments); result.Error != nil {
+			return CheckError(result)
+		} else if assignments > 0 {
+			return ErrRoleInUse
+		} else if err := tx.Model(&role).WithContext(ctx).Association("Permissions").Clear(); err != nil {
+			return err
+		}
+
+		return CheckError(tx.WithContext(ctx).Delete(&role))
+	})
+}
+
+func checkRoleError(result *gorm.DB) error {
+	if result.Error != nil && strings.Contains(result.Error.Error(), "duplicate key value violates unique constraint \"roles_name_key\"") {
+		return fmt.Errorf("%w: %v", ErrDuplicateRoleName, result.Error)
+	}
+
+	return CheckError(result)
+}
+
 // GetAllPermissions retrieves all rows from the Permissions table
 // SELECT * FROM permissions
 func (s *BloodhoundDB) GetAllPermissions(ctx context.Context, order string, filter model.SQLFilter) (model.Permissions, error) {
@@ -303,7 +388,7 @@ func (s *BloodhoundDB) UpdateUser(ctx context.Context, user model.User) error {
 			if err := tx.Raw("SELECT * FROM auth_secrets WHERE user_id = ?", user.ID).First(&authSecret).Error; err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 				return err
 			} else if authSecret.ID > 0 {
-				bhdb := NewBloodhoundDB(tx, s.idResolver)
+				bhdb := s.withTx(tx)
 				if err := bhdb.DeleteAuthSecret(ctx, authSecret); err != nil {
 					return err
 				}
@@ -396,15 +481,16 @@ func (s *BloodhoundDB) CreateAuthToken(ctx context.Context, authToken model.Auth
 	}
 
 	return authToken, s.AuditableTransaction(ctx, auditEntry, func(tx *gorm.DB) error {
-		return CheckError(tx.WithContext(ctx).Create(&authToken))
+		return CheckError(tx.WithContext(ctx).Omit("Permissions.*").Create(&authToken))
 	})
 }
 
-// UpdateAuthToken updates all fields in the AuthToken row as specified in the provided struct
+// UpdateAuthToken updates all fields in the AuthToken row as specified in the provided struct. The token's permission
+// scope is fixed at creation and is not modified.
 // UPDATE auth_tokens SET key = ..., hmac_method = ..., last_access = ...
 // WHERE user_id = ... AND client_id = ...
 func (s *BloodhoundDB) UpdateAuthToken(ctx context.Context, authToken model.AuthToken) error {
-	result := s.db.WithContext(ctx).Save(&authToken)
+	result := s.db.WithContext(ctx).Omit("Permissions").Save(&authToken)
 	return CheckError(result)
 }
 
@@ -413,7 +499,7 @@ func (s *BloodhoundDB) UpdateAuthToken(ctx context.Context, authToken model.Auth
 func (s *BloodhoundDB) GetAuthToken(ctx context.Context, id uuid.UUID) (model.AuthToken, error) {
 	var (
 		authToken model.AuthToken
-		result    = s.db.WithContext(ctx).First(&authToken, id)
+		result    = s.db.WithContext(ctx).Preload("Permissions").First(&authToken, id)
 	)
 
 	return authToken, CheckError(result)
@@ -422,7 +508,7 @@ func (s *BloodhoundDB) GetAuthToken(ctx context.Context, id uuid.UUID) (model.Au
 func (s *BloodhoundDB) GetAllAuthTokens(ctx context.Context, order string, filter model.SQLFilter) (model.AuthTokens, error) {
 	var (
 		tokens model.AuthTokens
-		cursor = s.db.WithContext(ctx)
+		cursor = s.db.WithContext(ctx).Preload("Permissions")
 	)
 
 	if order != "" {
@@ -439,7 +525,7 @@ func (s *BloodhoundDB) GetAllAuthTokens(ctx context.Context, order string, filte
 func (s *BloodhoundDB) GetUserToken(ctx context.Context, userId, tokenId uuid.UUID) (model.AuthToken, error) {
 	var (
 		authToken model.AuthToken
-		result    = s.db.WithContext(ctx).First(&authToken, "id = ? AND user_id = ?", tokenId, userId)
+		result    = s.db.WithContext(ctx).Preload("Permissions").First(&authToken, "id = ? AND user_id = ?", tokenId, userId)
 	)
 	return authToken, CheckError(result)
 }
@@ -501,13 +587,61 @@ func (s *BloodhoundDB) DeleteAuthSecret(ctx context.Context, authSecret model.Au
 	})
 }
 
+// CreateAuthSecretHistory records the digest of an auth secret that is about to be replaced
+// INSERT INTO auth_secret_history (user_id, digest, digest_method, created_at) VALUES (...)
+func (s *BloodhoundDB) CreateAuthSecretHistory(ctx context.Context, authSecret model.AuthSecret) error {
+	return CheckError(s.db.WithContext(ctx).Create(&model.AuthSecretHistory{
+		UserID:       authSecret.UserID,
+		Digest:       authSecret.Digest,
+		DigestMethod: authSecret.DigestMethod,
+	}))
+}
+
+// GetAuthSecretHistory returns the most recently replaced auth secrets of a user, newest first
+// SELECT * FROM auth_secret_history WHERE user_id = ... ORDER BY created_at DESC LIMIT ...
+func (s *BloodhoundDB) GetAuthSecretHistory(ctx context.Context, userID uuid.UUID, limit int) ([]model.AuthSecretHistory, error) {
+	var (
+		history []model.AuthSecretHistory
+		result  = s.db.WithContext(ctx).Where("user_id = ?", userID).Order("created_at DESC, id DESC").Limit(limit).Find(&history)
+	)
+
+	return history, CheckError(result)
+}
+
+// RecordFailedLogin increments the consecutive failed login count of a user and returns the new count
+// UPDATE users SET failed_login_attempts = failed_login_attempts + 1 WHERE id = ... RETURNING failed_login_attempts
+func (s *BloodhoundDB) RecordFailedLogin(ctx context.Context, user model.User) (int, error) {
+	var (
+		attempts int
+		result   = s.db.WithContext(ctx).Raw(`UPDATE users SET failed_login_attempts = failed_login_attempts + 1 WHERE id = ? RETURNING failed_login_attempts`, user.ID).Scan(&attempts)
+	)
+
+	return attempts, CheckError(result)
+}
+
+// LockUser locks the user out of local logins until the given time and resets their failed login count
+// UPDATE users SET locked_until = ..., failed_login_attempts = 0 WHERE id = ...
+func (s *BloodhoundDB) LockUser(ctx context.Context, user model.User, lockedUntil time.Time) error {
+	user.LockedUntil = null.TimeFrom(lockedUntil.UTC())
+
+	auditEntry := model.AuditEntry{
+		Action: model.AuditLogActionLockUser,
+		Model:  &user,
+	}
+
+	return s.AuditableTransaction(ctx, auditEntry, func(tx *gorm.DB) error {
+		return CheckError(tx.Exec(`UPDATE users SET locked_until = ?, failed_login_attempts = 0 WHERE id = ?`, user.LockedUntil, user.ID))
+	})
+}
+
 // CreateUserSession creates a new UserSession row
 // INSERT INTO user_sessions (...) VALUES (..)
 func (s *BloodhoundDB) CreateUserSession(ctx context.Context, userSession model.UserSession) (model.UserSession, error) {
 	var newUserSession = userSession
 
 	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		if err := CheckError(tx.Exec(`UPDATE users SET last_login = ? WHERE id = ?`, time.Now().UTC(), userSession.UserID)); err != nil {
+		// A successful login clears any failed login attempts that have been recorded for the user
+		if err := CheckError(tx.Exec(`UPDATE users SET last_login = ?, failed_login_attempts = 0, locked_until = NULL WHERE id = ?`, time.Now().UTC(), userSession.UserID)); err != nil {
 			return err
 		}
 
@@ -568,3 +702,8 @@ func (s *BloodhoundDB) GetUserSession(ctx context.Context, id int64) (model.User
 func (s *BloodhoundDB) SweepSessions(ctx context.Context) {
 	s.db.WithContext(ctx).Where("expires_at < NOW()").Delete(&model.UserSession{})
 }
+
+// SweepAuthTokens deletes all auth tokens that have already expired
+func (s *BloodhoundDB) SweepAuthTokens(ctx context.Context) {
+	s.db.WithContext(ctx).Where("expires_at IS NOT NULL AND expires_at < NOW()").Delete(&model.AuthToken{})
+}
@@ -21,6 +21,7 @@ package database_test
 
 import (
 	"context"
+	"reflect"
 	"testing"
 	"time"
 
@@ -155,6 +156,59 @@ func TestDatabase_InitializeRoles(t *testing.T) {
 	}
 }
 
+func TestDatabase_CreateUpdateDeleteRole(t *testing.T) {
+	var (
+		ctx        = context.Background()
+		dbInst, _  = initAndGetRoles(t)
+		readAudit  = auth.Permissions().AuditLogRead
+		readGraph  = auth.Permissions().GraphDBRead
+		writeQuery = auth.Permissions().SavedQueriesWrite
+	)
+
+	permissions, err := dbInst.GetAllPermissions(ctx, "", model.SQLFilter{})
+	require.NoError(t, err)
+
+	findPermission := func(template model.Permission) model.Permission {
+		for _, permission := range permissions {
+			if permission.Equals(template) {
+				return permission
+			}
+		}
+
+		t.Fatalf("Missing permission %s", template)
+		return model.Permission{}
+	}
+
+	role, err := dbInst.CreateRole(ctx, model.Role{
+		Name:        "Auditor",
+		Description: "Can read audit logs",
+		Permissions: model.Permissions{findPermission(readAudit), findPermission(readGraph)},
+	})
+	require.NoError(t, err)
+	assert.True(t, role.Custom)
+
+	_, err = dbInst.CreateRole(ctx, model.Role{Name: "Auditor", Permissions: model.Permissions{findPermission(readAudit)}})
+	assert.ErrorIs(t, err, database.ErrDuplicateRoleName)
+
+	role.Permissions = model.Permissions{findPermission(readAudit), findPermission(writeQuery)}
+	require.NoError(t, dbInst.UpdateRole(ctx, role))
+
+	updatedRole, err := dbInst.GetRole(ctx, role.ID)
+	require.NoError(t, err)
+	assert.True(t, updatedRole.Permissions.Has(writeQuery))
+	assert.False(t, updatedRole.Permissions.Has(readGraph))
+
+	user, err := dbInst.CreateUser(ctx, model.User{PrincipalName: userPrincipal, Roles: model.Roles{updatedRole}})
+	require.NoError(t, err)
+	assert.ErrorIs(t, dbInst.DeleteRole(ctx, updatedRole), database.ErrRoleInUse)
+
+	require.NoError(t, dbInst.DeleteUser(ctx, user))
+	require.NoError(t, dbInst.DeleteRole(ctx, updatedRole))
+
+	_, err = dbInst.GetRole(ctx, role.ID)
+	assert.ErrorIs(t, err, database.ErrNotFound)
+}
+
 func TestDatabase_CreateGetDeleteUser(t *testing.T) {
 	var (
 		ctx           = context.Background()
@@ -335,6 +389,50 @@ func TestDatabase_CreateGetDeleteAuthToken(t *testing.T) {
 	}
 }
 
+func TestDatabase_ScopedAuthTokenAndSweep(t *testing.T) {
+	var (
+		ctx          = context.Background()
+		dbInst, user = initAndCreateUser(t)
+	)
+
+	permissions, err := dbInst.GetAllPermissions(ctx, "", model.SQLFilter{})
+	require.NoError(t, err)
+
+	scoped, err := dbInst.CreateAuthToken(ctx, model.AuthToken{
+		UserID:       database.NullUUID(user.ID),
+		Key:          "key",
+		HmacMethod:   "fake",
+		ExpiresAt:    null.TimeFrom(time.Now().Add(time.Hour)),
+		AllowedCIDRs: []string{"10.0.0.0/8"},
+		Permissions:  permissions[:1],
+		Unique:       model.Unique{ID: test.NewUUIDv4(t)},
+	})
+	require.NoError(t, err)
+
+	expired, err := dbInst.CreateAuthToken(ctx, model.AuthToken{
+		UserID:     database.NullUUID(user.ID),
+		Key:        "key",
+		HmacMethod: "fake",
+		ExpiresAt:  null.TimeFrom(time.Now().Add(-time.Hour)),
+		Unique:     model.Unique{ID: test.NewUUIDv4(t)},
+	})
+	require.NoError(t, err)
+
+	fetched, err := dbInst.GetAuthToken(ctx, scoped.ID)
+	require.NoError(t, err)
+	assert.True(t, fetched.IsScoped())
+	assert.Equal(t, permissions[0].ID, fetched.Permissions[0].ID)
+	assert.Equal(t, []string{"10.0.0.0/8"}, []string(fetched.AllowedCIDRs))
+
+	dbInst.SweepAuthTokens(ctx)
+
+	_, err = dbInst.GetAuthToken(ctx, expired.ID)
+	assert.ErrorIs(t, err, database.ErrNotFound)
+
+	_, err = dbInst.GetAuthToken(ctx, scoped.ID)
+	assert.NoError(t, err)
+}
+
 func TestDatabase_CreateGetDeleteAuthSecret(t *testing.T) {
 	const updatedDigest = "updated"
 
@@ -489,7 +587,7 @@ func TestDatabase_CreateUpdateDeleteSSOProvider(t *testing.T) {
 				t.Fatalf("Updated user has SSOProvider ID %d when %v was expected", updatedUser.SSOProvider.ID, newOIDCProvider.ID)
 			} else if updatedUser.SSOProvider.OIDCProvider.Issuer != newOIDCProvider.Issuer {
 				t.Fatalf("Updated user has OIDCProvider Issuer %s when %s was expected", updatedUser.SSOProvider.OIDCProvider.Issuer, newOIDCProvider.Issuer)
-			} else if updatedUser.SSOProvider.Config != emptyConfig {
+			} else if !reflect.DeepEqual(updatedUser.SSOProvider.Config, emptyConfig) {
 				t.Fatalf("Updated user has Config %v when %v was expected", updatedUser.SSOProvider.Config, emptyConfig)
 			} else {
 				updatedSSOProvider := model.SSOProvider{
@@ -49,6 +49,8 @@ var (
 	ErrDuplicateEmail              = errors.New("duplicate user email address")
 	ErrDuplicateCustomNodeKindName = errors.New("duplicate custom node kind name")
 	ErrDuplicateKindName           = errors.New("duplicate kind name")
+	ErrDuplicateRoleName           = errors.New("duplicate role name")
+	ErrRoleInUse                   = errors.New("role is assigned to one or more users")
 	ErrPositionOutOfRange          = errors.New("position out of range")
 )
 
@@ -96,6 +98,8 @@ type Database interface {
 
 	// Audit Logs
 	CreateAuditLog(ctx context.Context, auditLog model.AuditLog) error
+	GetAuditLogsAfterID(ctx context.Context, afterID int64, before, after time.Time, limit int, filter model.SQLFilter) (model.AuditLogs, error)
+	PruneAuditLogs(ctx context.Context, before time.Time) (int64, error)
 	AppendAuditLog(ctx context.Context, entry model.AuditEntry) error
 	ListAuditLogs(ctx context.Context, before, after time.Time, offset, limit int, order string, filter model.SQLFilter) (model.AuditLogs, int, error)
 
@@ -103,6 +107,9 @@ type Database interface {
 	GetAllRoles(ctx context.Context, order string, filter model.SQLFilter) (model.Roles, error)
 	GetRoles(ctx context.Context, ids []int32) (model.Roles, error)
 	GetRole(ctx context.Context, id int32) (model.Role, error)
+	CreateRole(ctx context.Context, role model.Role) (model.Role, error)
+	UpdateRole(ctx context.Context, role model.Role) error
+	DeleteRole(ctx context.Context, role model.Role) error
 
 	// Permissions
 	GetAllPermissions(ctx context.Context, order string, filter model.SQLFilter) (model.Permissions, error)
@@ -127,11 +134,17 @@ type Database interface {
 	GetAuthSecret(ctx context.Context, id int32) (model.AuthSecret, error)
 	UpdateAuthSecret(ctx context.Context, authSecret model.AuthSecret) error
 	DeleteAuthSecret(ctx context.Context, authSecret model.AuthSecret) error
+	CreateAuthSecretHistory(ctx context.Context, authSecret model.AuthSecret) error
+	GetAuthSecretHistory(ctx context.Context, userID uuid.UUID, limit int) ([]model.AuthSecretHistory, error)
+	RecordFailedLogin(ctx context.Context, user model.User) (int, error)
+	LockUser(ctx context.Context, user model.User, lockedUntil time.Time) error
 	InitializeSecretAuth(ctx context.Context, adminUser model.User, authSecret model.AuthSecret) (model.Installation, error)
+	WebAuthnData
 
 	// SSO
 	SSOProviderData
 	OIDCProviderData
+	LDAPProviderData
 	SAMLProviderData
 
 	// Sessions
@@ -141,6 +154,10 @@ type Database interface {
 	EndUserSession(ctx context.Context, userSession model.UserSession)
 	GetUserSession(ctx context.Context, id int64) (model.UserSession, error)
 	SweepSessions(ctx context.Context)
+	SweepAuthTokens(ctx context.Context)
+
+	// Rate Limits
+	RateLimitData
 
 	// Data Quality
 	dataquality.DataQualityData
@@ -168,6 +185,14 @@ type Database interface {
 	AssetGroupTagData
 	AssetGroupTagSelectorData
 	AssetGroupTagSelectorNodeData
+	AssetGroupTagViolationData
+	AssetGroupTagMembershipEventData
+	AssetGroupTagExposureData
+
+	// Findings
+	FindingData
+
+	// Choke Points
 
 	// Custom Node Kinds
 	CustomNodeKindData
@@ -177,8 +202,9 @@ type Database interface {
 }
 
 type BloodhoundDB struct {
-	db         *gorm.DB
-	idResolver auth.IdentityResolver // TODO: this really needs to be elsewhere. something something separation of concerns
+	db              *gorm.DB
+	idResolver      auth.IdentityResolver // TODO: this really needs to be elsewhere. something something separation of concerns
+	auditLogHashKey []byte
 }
 
 func (s *BloodhoundDB) Close(ctx context.Context) {
@@ -211,6 +237,12 @@ func NewBloodhoundDB(db *gorm.DB, idResolver auth.IdentityResolver) *BloodhoundD
 	return &BloodhoundDB{db: db, idResolver: idResolver}
 }
 
+// withTx returns a BloodhoundDB scoped to the given transaction that shares the configuration of this instance, such as
+// the audit log hash key
+func (s *BloodhoundDB) withTx(tx *gorm.DB) *BloodhoundDB {
+	return &BloodhoundDB{db: tx, idResolver: s.idResolver, auditLogHashKey: s.auditLogHashKey}
+}
+
 func OpenDatabase(connection string) (*gorm.DB, error) {
 	gormConfig := &gorm.Config{
 		Logger: &GormLogAdapter{
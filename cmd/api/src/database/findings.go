@@ -0,0 +1,148 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const findingBatchSize = 1000
+
+// FindingData defines the methods required to interact with the findings table
+type FindingData interface {
+	UpsertFindings(ctx context.Context, findings model.Findings) error
+	ResolveFindings(ctx context.Context, finding string, seenBefore time.Time) (int64, error)
+	GetFinding(ctx context.Context, id int64) (model.Finding, error)
+	GetFindings(ctx context.Context, sqlFilter model.SQLFilter, skip, limit int) (model.Findings, int, error)
+	GetFindingCounts(ctx context.Context) ([]model.FindingCount, error)
+	UpdateFindingAcceptance(ctx context.Context, user model.User, id int64, accepted bool) (model.Finding, error)
+}
+
+// UpsertFindings records the given finding instances as seen. New instances are created while existing instances have
+// their last seen time and display details refreshed and are reopened if they had been resolved.
+func (s *BloodhoundDB) UpsertFindings(ctx context.Context, findings model.Findings) error {
+	if len(findings) == 0 {
+		return nil
+	}
+
+	return CheckError(s.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "finding"}, {Name: "environment_id"}, {Name: "principal_object_id"}, {Name: "target_object_id"}},
+		DoUpdates: append(
+			clause.AssignmentColumns([]string{"principal_name", "principal_kind", "target_name", "target_kind", "last_seen"}),
+			clause.Assignment{Column: clause.Column{Name: "resolved_at"}, Value: nil},
+		),
+	}).CreateInBatches(&findings, findingBatchSize))
+}
+
+// ResolveFindings marks the open instances of a finding that were last seen before the given time as resolved and
+// returns the number of instances resolved
+func (s *BloodhoundDB) ResolveFindings(ctx context.Context, finding string, seenBefore time.Time) (int64, error) {
+	result := s.db.WithContext(ctx).Model(&model.Finding{}).
+		Where("finding = ? AND last_seen < ? AND resolved_at IS NULL", finding, seenBefore).
+		Update("resolved_at", time.Now().UTC())
+
+	return result.RowsAffected, CheckError(result)
+}
+
+func (s *BloodhoundDB) GetFinding(ctx context.Context, id int64) (model.Finding, error) {
+	var finding model.Finding
+
+	if result := s.db.WithContext(ctx).Where("id = ?", id).First(&finding); result.Error != nil {
+		return model.Finding{}, CheckError(result)
+	}
+
+	return finding, nil
+}
+
+// GetFindings returns the finding instances matching the filter along with the total count of matching instances. A
+// limit of 0 returns all instances.
+func (s *BloodhoundDB) GetFindings(ctx context.Context, sqlFilter model.SQLFilter, skip, limit int) (model.Findings, int, error) {
+	var (
+		findings model.Findings
+		count    int64
+		filtered = func() *gorm.DB {
+			query := s.db.WithContext(ctx).Model(&model.Finding{})
+			if sqlFilter.SQLString != "" {
+				query = query.Where(sqlFilter.SQLString, sqlFilter.Params...)
+			}
+			return query
+		}
+	)
+
+	if result := filtered().Count(&count); result.Error != nil {
+		return nil, 0, CheckError(result)
+	} else if result := filtered().Scopes(Paginate(skip, limit)).Order("finding, environment_id, id").Find(&findings); result.Error != nil {
+		return nil, 0, CheckError(result)
+	}
+
+	return findings, int(count), nil
+}
+
+// GetFindingCounts totals the open, accepted and resolved instances of each finding with at least one instance.
+// Resolved instances are not counted as accepted.
+func (s *BloodhoundDB) GetFindingCounts(ctx context.Context) ([]model.FindingCount, error) {
+	var counts []model.FindingCount
+
+	if result := s.db.WithContext(ctx).Raw(fmt.Sprintf(`
+		SELECT finding,
+			count(*) FILTER (WHERE resolved_at IS NULL AND NOT accepted) AS open,
+			count(*) FILTER (WHERE resolved_at IS NULL AND accepted) AS accepted,
+			count(*) FILTER (WHERE resolved_at IS NOT NULL) AS resolved
+		FROM %s GROUP BY finding ORDER BY finding`, model.Finding{}.TableName())).Scan(&counts); result.Error != nil {
+		return nil, CheckError(result)
+	}
+
+	return counts, nil
+}
+
+// UpdateFindingAcceptance accepts or unaccepts the risk of a finding instance on behalf of the user
+func (s *BloodhoundDB) UpdateFindingAcceptance(ctx context.Context, user model.User, id int64, accepted bool) (model.Finding, error) {
+	var (
+		finding    model.Finding
+		auditEntry = model.AuditEntry{
+			Action: model.AuditLogActionUnacceptRisk,
+			Model:  model.AuditData{"id": id, "accepted": accepted},
+		}
+		updates = map[string]any{"accepted": false, "accepted_by": null.String{}, "accepted_at": null.Time{}}
+	)
+
+	if accepted {
+		auditEntry.Action = model.AuditLogActionAcceptRisk
+		updates = map[string]any{"accepted": true, "accepted_by": null.StringFrom(user.ID.String()), "accepted_at": null.TimeFrom(time.Now().UTC())}
+	}
+
+	err := s.AuditableTransaction(ctx, auditEntry, func(tx *gorm.DB) error {
+		if result := tx.Model(&model.Finding{}).Where("id = ?", id).Updates(updates); result.Error != nil {
+			return CheckError(result)
+		} else if result.RowsAffected == 0 {
+			return ErrNotFound
+		} else if result := tx.Where("id = ?", id).First(&finding); result.Error != nil {
+			return CheckError(result)
+		}
+
+		return nil
+	})
+
+	return finding, err
+}
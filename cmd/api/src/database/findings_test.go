@@ -0,0 +1,107 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build integration
+// +build integration
+
+package database_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/database"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/test/integration"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatabase_Findings(t *testing.T) {
+	var (
+		dbInst     = integration.SetupDB(t)
+		testCtx    = context.Background()
+		firstRun   = time.Now().UTC().Add(-time.Hour).Truncate(time.Second)
+		secondRun  = firstRun.Add(30 * time.Minute)
+		newFinding = func(finding, principal string, seenAt time.Time) model.Finding {
+			return model.Finding{Finding: finding, EnvironmentId: "S-1-5-21-1", PrincipalObjectId: principal, PrincipalName: principal, FirstSeen: seenAt, LastSeen: seenAt}
+		}
+	)
+
+	require.NoError(t, dbInst.UpsertFindings(testCtx, model.Findings{
+		newFinding("NonTierZeroDCSync", "HELPDESK", firstRun),
+		newFinding("NonTierZeroDCSync", "SERVER ADMIN", firstRun),
+		newFinding("KerberoastableTierZeroUsers", "SVC_SQL", firstRun),
+	}))
+
+	// The second run no longer finds the server admin
+	require.NoError(t, dbInst.UpsertFindings(testCtx, model.Findings{newFinding("NonTierZeroDCSync", "HELPDESK", secondRun)}))
+	resolved, err := dbInst.ResolveFindings(testCtx, "NonTierZeroDCSync", secondRun)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), resolved)
+
+	t.Run("keeps first seen and refreshes last seen", func(t *testing.T) {
+		result, count, err := dbInst.GetFindings(testCtx, model.SQLFilter{SQLString: "principal_object_id = ?", Params: []any{"HELPDESK"}}, 0, 0)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+		require.True(t, result[0].FirstSeen.Equal(firstRun))
+		require.True(t, result[0].LastSeen.Equal(secondRun))
+		require.False(t, result[0].ResolvedAt.Valid)
+	})
+
+	t.Run("resolves findings that were not seen", func(t *testing.T) {
+		result, count, err := dbInst.GetFindings(testCtx, model.SQLFilter{SQLString: "resolved_at is not null"}, 0, 0)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+		require.Equal(t, "SERVER ADMIN", result[0].PrincipalObjectId)
+	})
+
+	t.Run("reopens resolved findings that are seen again", func(t *testing.T) {
+		thirdRun := secondRun.Add(time.Minute)
+		require.NoError(t, dbInst.UpsertFindings(testCtx, model.Findings{newFinding("NonTierZeroDCSync", "SERVER ADMIN", thirdRun)}))
+
+		result, _, err := dbInst.GetFindings(testCtx, model.SQLFilter{SQLString: "principal_object_id = ?", Params: []any{"SERVER ADMIN"}}, 0, 0)
+		require.NoError(t, err)
+		require.False(t, result[0].ResolvedAt.Valid)
+		require.True(t, result[0].FirstSeen.Equal(firstRun))
+	})
+
+	t.Run("accepts and unaccepts the risk of a finding", func(t *testing.T) {
+		result, _, err := dbInst.GetFindings(testCtx, model.SQLFilter{SQLString: "principal_object_id = ?", Params: []any{"SVC_SQL"}}, 0, 0)
+		require.NoError(t, err)
+
+		accepted, err := dbInst.UpdateFindingAcceptance(testCtx, model.User{}, result[0].ID, true)
+		require.NoError(t, err)
+		require.True(t, accepted.Accepted)
+		require.True(t, accepted.AcceptedBy.Valid)
+		require.True(t, accepted.AcceptedAt.Valid)
+
+		counts, err := dbInst.GetFindingCounts(testCtx)
+		require.NoError(t, err)
+		require.Equal(t, []model.FindingCount{
+			{Finding: "KerberoastableTierZeroUsers", Accepted: 1},
+			{Finding: "NonTierZeroDCSync", Open: 2},
+		}, counts)
+
+		unaccepted, err := dbInst.UpdateFindingAcceptance(testCtx, model.User{}, result[0].ID, false)
+		require.NoError(t, err)
+		require.False(t, unaccepted.Accepted)
+		require.False(t, unaccepted.AcceptedBy.Valid)
+
+		_, err = dbInst.UpdateFindingAcceptance(testCtx, model.User{}, 12345, true)
+		require.ErrorIs(t, err, database.ErrNotFound)
+	})
+}
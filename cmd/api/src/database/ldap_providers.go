@@ -0,0 +1,89 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"gorm.io/gorm"
+)
+
+const (
+	ldapProvidersTableName = "ldap_providers"
+)
+
+// LDAPProviderData defines the interface required to interact with the ldap_providers table
+type LDAPProviderData interface {
+	CreateLDAPProvider(ctx context.Context, name string, ldapProvider model.LDAPProvider, config model.SSOProviderConfig) (model.LDAPProvider, error)
+	UpdateLDAPProvider(ctx context.Context, ssoProvider model.SSOProvider) (model.LDAPProvider, error)
+}
+
+// CreateLDAPProvider creates a new entry for an LDAP provider as well as the associated SSO provider
+func (s *BloodhoundDB) CreateLDAPProvider(ctx context.Context, name string, ldapProvider model.LDAPProvider, config model.SSOProviderConfig) (model.LDAPProvider, error) {
+	auditEntry := model.AuditEntry{
+		Action: model.AuditLogActionCreateLDAPIdentityProvider,
+		Model:  &ldapProvider, // Pointer is required to ensure success log contains updated fields after transaction
+	}
+
+	// Create both the sso_providers and ldap_providers rows in a single transaction
+	// If one of these requests errors, both changes will be rolled back
+	err := s.AuditableTransaction(ctx, auditEntry, func(tx *gorm.DB) error {
+		bhdb := s.withTx(tx)
+
+		if ssoProvider, err := bhdb.CreateSSOProvider(ctx, name, model.SessionAuthProviderLDAP, config); err != nil {
+			return err
+		} else {
+			ldapProvider.SSOProviderID = int(ssoProvider.ID)
+			return CheckError(tx.WithContext(ctx).Table(ldapProvidersTableName).Create(&ldapProvider))
+		}
+	})
+
+	return ldapProvider, err
+}
+
+// UpdateLDAPProvider updates an LDAP provider as well as the associated SSO provider
+func (s *BloodhoundDB) UpdateLDAPProvider(ctx context.Context, ssoProvider model.SSOProvider) (model.LDAPProvider, error) {
+	auditEntry := model.AuditEntry{
+		Action: model.AuditLogActionUpdateLDAPIdentityProvider,
+		Model:  ssoProvider.LDAPProvider, // Pointer is required to ensure success log contains updated fields after transaction
+	}
+
+	// update both the sso_providers, ldap_providers, and user_sessions rows in a single transaction
+	// If one of these requests errors, all changes will be rolled back
+	err := s.AuditableTransaction(ctx, auditEntry, func(tx *gorm.DB) error {
+		var (
+			bhdb         = s.withTx(tx)
+			ldapProvider = ssoProvider.LDAPProvider
+		)
+
+		if _, err := bhdb.UpdateSSOProvider(ctx, ssoProvider); err != nil {
+			return err
+		} else if err := CheckError(tx.WithContext(ctx).Exec(fmt.Sprintf("UPDATE %s SET url = ?, start_tls = ?, allow_insecure = ?, ca_certificate = ?, bind_dn = ?, bind_password = ?, user_base_dn = ?, user_filter = ?, username_attribute = ?, email_attribute = ?, group_attribute = ?, updated_at = ? WHERE id = ?;", ldapProvidersTableName),
+			ldapProvider.URL, ldapProvider.StartTLS, ldapProvider.AllowInsecure, ldapProvider.CACertificate, ldapProvider.BindDN, ldapProvider.BindPassword, ldapProvider.UserBaseDN, ldapProvider.UserFilter,
+			ldapProvider.UsernameAttribute, ldapProvider.EmailAttribute, ldapProvider.GroupAttribute, time.Now().UTC(), ldapProvider.ID)); err != nil {
+			return err
+		} else {
+			// Ensure all existing sessions are invalidated within the tx
+			return bhdb.TerminateUserSessionsBySSOProvider(ctx, ssoProvider)
+		}
+	})
+
+	return *ssoProvider.LDAPProvider, err
+}
@@ -40,3 +40,235 @@ WHERE role_id = (SELECT id FROM roles WHERE roles.name = 'Power User')
 
 -- Add name index to asset_group_tag_selectors table for search
 CREATE INDEX IF NOT EXISTS idx_asset_group_tag_selectors_name ON asset_group_tag_selectors USING btree (name);
+
+-- Custom roles are composed by administrators from the existing permissions, built-in roles remain read-only
+ALTER TABLE roles ADD COLUMN IF NOT EXISTS custom boolean NOT NULL DEFAULT false;
+
+-- Add the audit log read permission so that audit logs can be granted without user management
+INSERT INTO permissions (authority, name, created_at, updated_at) VALUES ('audit', 'Read', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
+
+-- Grant the Administrator role the audit log read permission
+INSERT INTO roles_permissions (role_id, permission_id)
+VALUES ((SELECT id FROM roles WHERE roles.name = 'Administrator'),
+        (SELECT id FROM permissions WHERE permissions.authority = 'audit' and permissions.name = 'Read'))
+ON CONFLICT DO NOTHING;
+
+-- Auth tokens may expire, be restricted to source CIDRs and be scoped to a subset of their owner's permissions
+ALTER TABLE auth_tokens
+ADD COLUMN IF NOT EXISTS expires_at timestamp with time zone,
+ADD COLUMN IF NOT EXISTS allowed_cidrs text[] DEFAULT ARRAY[]::text[];
+
+CREATE TABLE IF NOT EXISTS auth_tokens_permissions (
+  auth_token_id text NOT NULL REFERENCES auth_tokens(id) ON DELETE CASCADE,
+  permission_id integer NOT NULL REFERENCES permissions(id) ON DELETE CASCADE,
+  PRIMARY KEY (auth_token_id, permission_id)
+);
+
+-- SSO providers may be issued a bearer token for SCIM provisioning, only the digest of the token is stored
+ALTER TABLE sso_providers ADD COLUMN IF NOT EXISTS scim_token_digest text;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_sso_providers_scim_token_digest ON sso_providers USING btree (scim_token_digest);
+
+-- Record where user sessions originate from so that users and administrators can review and revoke them
+ALTER TABLE user_sessions
+ADD COLUMN IF NOT EXISTS source_ip_address text NOT NULL DEFAULT '',
+ADD COLUMN IF NOT EXISTS user_agent text NOT NULL DEFAULT '';
+
+-- Local accounts are locked after repeated failed logins as configured by the password policy
+ALTER TABLE users
+ADD COLUMN IF NOT EXISTS failed_login_attempts integer NOT NULL DEFAULT 0,
+ADD COLUMN IF NOT EXISTS locked_until timestamp with time zone;
+
+-- Digests of replaced auth secrets are kept so that recently used secrets can be rejected
+CREATE TABLE IF NOT EXISTS auth_secret_history (
+  id bigserial PRIMARY KEY,
+  user_id text NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  digest text NOT NULL,
+  digest_method text NOT NULL,
+  created_at timestamp with time zone NOT NULL DEFAULT current_timestamp
+);
+
+CREATE INDEX IF NOT EXISTS idx_auth_secret_history_user_id ON auth_secret_history USING btree (user_id, created_at);
+
+INSERT INTO parameters (key, name, description, value, created_at, updated_at) VALUES ('auth.password_policy', 'Local Auth Password Policy', 'This configuration parameter sets the complexity and reuse requirements of local auth passwords and the number of consecutive failed logins after which an account is locked for the configured number of minutes. A lockout threshold of 0 disables account lockout.', '{"min_length": 12, "min_lowercase": 1, "min_uppercase": 1, "min_special": 1, "min_numeric": 1, "history_count": 0, "lockout_threshold": 10, "lockout_duration_minutes": 15}', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
+
+-- WebAuthn / FIDO2 credentials registered by local users as a second authentication factor
+CREATE TABLE IF NOT EXISTS webauthn_credentials (
+  id serial PRIMARY KEY,
+  user_id text NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  name text NOT NULL,
+  credential_id bytea NOT NULL,
+  public_key bytea NOT NULL,
+  sign_count bigint NOT NULL DEFAULT 0,
+  aaguid text NOT NULL DEFAULT '',
+  transports text[] DEFAULT ARRAY[]::text[],
+  last_used_at timestamp with time zone,
+  created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+  updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_webauthn_credentials_credential_id ON webauthn_credentials USING btree (credential_id);
+CREATE INDEX IF NOT EXISTS idx_webauthn_credentials_user_id ON webauthn_credentials USING btree (user_id);
+
+-- Single use challenges issued for in progress WebAuthn registration and authentication ceremonies
+CREATE TABLE IF NOT EXISTS webauthn_challenges (
+  challenge text PRIMARY KEY,
+  user_id text NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  ceremony text NOT NULL,
+  expires_at timestamp with time zone NOT NULL,
+  created_at timestamp with time zone NOT NULL DEFAULT current_timestamp
+);
+
+CREATE INDEX IF NOT EXISTS idx_webauthn_challenges_user_id ON webauthn_challenges USING btree (user_id, ceremony);
+
+INSERT INTO parameters (key, name, description, value, created_at, updated_at) VALUES ('auth.mfa_policy', 'Multi-Factor Authentication Policy', 'This configuration parameter determines whether local users with the Administrator role must sign in with a phishing-resistant WebAuthn credential. When enabled, TOTP codes are not accepted as a second factor for these users.', '{"require_phishing_resistant_mfa_for_administrators": false}', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
+
+-- LDAP Provider
+CREATE TABLE IF NOT EXISTS ldap_providers (
+  id serial PRIMARY KEY,
+  url text NOT NULL,
+  start_tls boolean NOT NULL DEFAULT false,
+  allow_insecure boolean NOT NULL DEFAULT false,
+  ca_certificate text NOT NULL DEFAULT '',
+  bind_dn text NOT NULL DEFAULT '',
+  bind_password text NOT NULL DEFAULT '',
+  user_base_dn text NOT NULL,
+  user_filter text NOT NULL DEFAULT '',
+  username_attribute text NOT NULL,
+  email_attribute text NOT NULL DEFAULT '',
+  group_attribute text NOT NULL DEFAULT '',
+  sso_provider_id integer REFERENCES sso_providers (id) ON DELETE CASCADE NULL,
+  updated_at timestamp with time zone DEFAULT now(),
+  created_at timestamp with time zone DEFAULT now()
+);
+
+-- Rate limit counters shared between API replicas
+CREATE UNLOGGED TABLE IF NOT EXISTS rate_limit_counters (
+  key text PRIMARY KEY,
+  count bigint NOT NULL DEFAULT 0,
+  expires_at timestamp with time zone NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_rate_limit_counters_expires_at ON rate_limit_counters USING btree (expires_at);
+
+-- Audit log hash chain and retention
+ALTER TABLE IF EXISTS audit_logs ADD COLUMN IF NOT EXISTS previous_hash text NOT NULL DEFAULT '';
+ALTER TABLE IF EXISTS audit_logs ADD COLUMN IF NOT EXISTS hash text NOT NULL DEFAULT '';
+
+INSERT INTO parameters (key, name, description, value, created_at, updated_at) VALUES ('audit_log.retention', 'Audit Log Retention', 'This configuration parameter sets the number of days audit log entries are kept before they are deleted. Deletions are recorded in the audit log. A retention of 0 days keeps entries forever.', '{"retention_days": 0}', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
+
+-- Asset group tag member certification review
+ALTER TABLE IF EXISTS asset_group_tag_selector_nodes ADD COLUMN IF NOT EXISTS certified_at timestamp with time zone;
+UPDATE asset_group_tag_selector_nodes SET certified_at = updated_at WHERE certified > 0 AND certified_at IS NULL;
+
+INSERT INTO parameters (key, name, description, value, created_at, updated_at) VALUES ('analysis.certification_expiry', 'Certification Expiry', 'This configuration parameter sets the number of days a manual certification of an asset group tag member lasts before the member reverts to pending review. An expiry of 0 days keeps certifications forever.', '{"expiry_days": 0}', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
+
+-- Tier violations found by the most recent analysis
+CREATE TABLE IF NOT EXISTS asset_group_tag_violations (
+  id bigserial PRIMARY KEY,
+  asset_group_tag_id integer NOT NULL REFERENCES asset_group_tags (id) ON DELETE CASCADE,
+  source_tag_id integer REFERENCES asset_group_tags (id) ON DELETE CASCADE,
+  edge_kind text NOT NULL,
+  source_node_id bigint NOT NULL,
+  source_object_id text NOT NULL DEFAULT '',
+  source_name text NOT NULL DEFAULT '',
+  source_kind text NOT NULL DEFAULT '',
+  target_node_id bigint NOT NULL,
+  target_object_id text NOT NULL DEFAULT '',
+  target_name text NOT NULL DEFAULT '',
+  target_kind text NOT NULL DEFAULT '',
+  principals integer NOT NULL DEFAULT 1,
+  created_at timestamp with time zone NOT NULL DEFAULT current_timestamp
+);
+
+CREATE INDEX IF NOT EXISTS idx_asset_group_tag_violations_tag_id ON asset_group_tag_violations USING btree (asset_group_tag_id, principals DESC);
+
+CREATE TABLE IF NOT EXISTS asset_group_tag_violation_counts (
+  asset_group_tag_id integer NOT NULL REFERENCES asset_group_tags (id) ON DELETE CASCADE,
+  source_tag_id integer REFERENCES asset_group_tags (id) ON DELETE CASCADE,
+  principals integer NOT NULL DEFAULT 0,
+  edges integer NOT NULL DEFAULT 0,
+  created_at timestamp with time zone NOT NULL DEFAULT current_timestamp
+);
+
+CREATE INDEX IF NOT EXISTS idx_asset_group_tag_violation_counts_tag_id ON asset_group_tag_violation_counts USING btree (asset_group_tag_id);
+
+-- Attack path finding instances
+CREATE TABLE IF NOT EXISTS findings (
+  id bigserial PRIMARY KEY,
+  finding text NOT NULL,
+  environment_id text NOT NULL DEFAULT '',
+  principal_object_id text NOT NULL,
+  principal_name text NOT NULL DEFAULT '',
+  principal_kind text NOT NULL DEFAULT '',
+  target_object_id text NOT NULL DEFAULT '',
+  target_name text NOT NULL DEFAULT '',
+  target_kind text NOT NULL DEFAULT '',
+  first_seen timestamp with time zone NOT NULL DEFAULT current_timestamp,
+  last_seen timestamp with time zone NOT NULL DEFAULT current_timestamp,
+  resolved_at timestamp with time zone,
+  accepted boolean NOT NULL DEFAULT false,
+  accepted_by text,
+  accepted_at timestamp with time zone,
+  UNIQUE (finding, environment_id, principal_object_id, target_object_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_findings_environment_id ON findings USING btree (environment_id, finding);
+
+-- Asset group tag membership change events recorded by each analysis run
+CREATE TABLE IF NOT EXISTS asset_group_tag_membership_events (
+  id bigserial PRIMARY KEY,
+  asset_group_tag_id integer NOT NULL REFERENCES asset_group_tags (id) ON DELETE CASCADE,
+  selector_id integer,
+  node_id bigint NOT NULL,
+  node_object_id text NOT NULL DEFAULT '',
+  node_name text NOT NULL DEFAULT '',
+  node_kind text NOT NULL DEFAULT '',
+  action text NOT NULL,
+  analysis_run_id text NOT NULL DEFAULT '',
+  created_at timestamp with time zone NOT NULL DEFAULT current_timestamp
+);
+
+CREATE INDEX IF NOT EXISTS idx_asset_group_tag_membership_events_tag_id ON asset_group_tag_membership_events USING btree (asset_group_tag_id, node_object_id, created_at DESC);
+
+-- Maximum members a single asset group tag selector may select without an explicit override
+INSERT INTO parameters (key, name, description, value, created_at, updated_at) VALUES ('analysis.selector_max_members', 'Selector Max Members', 'This configuration parameter sets the maximum number of members a single asset group tag selector may select before saving it requires an explicit override. A limit of 0 disables the check.', '{"max_members": 10000}', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
+
+-- Tier exposure and impact measured by each analysis run
+CREATE TABLE IF NOT EXISTS asset_group_tag_exposures (
+  id bigserial PRIMARY KEY,
+  asset_group_tag_id integer NOT NULL REFERENCES asset_group_tags (id) ON DELETE CASCADE,
+  analysis_run_id text NOT NULL DEFAULT '',
+  exposure integer NOT NULL DEFAULT 0,
+  impact integer NOT NULL DEFAULT 0,
+  created_at timestamp with time zone NOT NULL DEFAULT current_timestamp
+);
+
+CREATE INDEX IF NOT EXISTS idx_asset_group_tag_exposures_tag_id ON asset_group_tag_exposures USING btree (asset_group_tag_id, created_at DESC);
+
+-- Edges and exposed principals on the attack paths into each tier found by the most recent analysis
+CREATE TABLE IF NOT EXISTS asset_group_tag_choke_points (
+  id bigserial PRIMARY KEY,
+  asset_group_tag_id integer NOT NULL REFERENCES asset_group_tags (id) ON DELETE CASCADE,
+  analysis_run_id text NOT NULL DEFAULT '',
+  type text NOT NULL,
+  edge_kind text NOT NULL DEFAULT '',
+  source_node_id bigint NOT NULL,
+  source_object_id text NOT NULL DEFAULT '',
+  source_name text NOT NULL DEFAULT '',
+  source_kind text NOT NULL DEFAULT '',
+  target_node_id bigint,
+  target_object_id text NOT NULL DEFAULT '',
+  target_name text NOT NULL DEFAULT '',
+  target_kind text NOT NULL DEFAULT '',
+  principals integer NOT NULL DEFAULT 0,
+  coverage double precision NOT NULL DEFAULT 0,
+  created_at timestamp with time zone NOT NULL DEFAULT current_timestamp
+);
+
+CREATE INDEX IF NOT EXISTS idx_asset_group_tag_choke_points_tag_id ON asset_group_tag_choke_points USING btree (asset_group_tag_id, type, principals DESC);
+
+-- Time each domain was last ingested as of each data quality run, used to detect stopped collection
+ALTER TABLE IF EXISTS ad_data_quality_stats ADD COLUMN IF NOT EXISTS last_collected timestamp with time zone;
+
+-- Number of days without a collection after which a domain or tenant is reported as stale
+INSERT INTO parameters (key, name, description, value, created_at, updated_at) VALUES ('analysis.data_quality_stale_days', 'Data Quality Stale Days', 'This configuration parameter sets the number of days an AD domain or Azure tenant may go without being seen in a collection before its data quality trend reports it as stale.', '{"stale_days": 7}', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -103,6 +103,20 @@ func (mr *MockDatabaseMockRecorder) Close(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockDatabase)(nil).Close), ctx)
 }
 
+// ConsumeWebAuthnChallenge mocks base method.
+func (m *MockDatabase) ConsumeWebAuthnChallenge(ctx context.Context, userID uuid.UUID, ceremony, challenge string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeWebAuthnChallenge", ctx, userID, ceremony, challenge)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConsumeWebAuthnChallenge indicates an expected call of ConsumeWebAuthnChallenge.
+func (mr *MockDatabaseMockRecorder) ConsumeWebAuthnChallenge(ctx, userID, ceremony, challenge any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeWebAuthnChallenge", reflect.TypeOf((*MockDatabase)(nil).ConsumeWebAuthnChallenge), ctx, userID, ceremony, challenge)
+}
+
 // CountAllIngestTasks mocks base method.
 func (m *MockDatabase) CountAllIngestTasks(ctx context.Context) (int64, error) {
 	m.ctrl.T.Helper()
@@ -206,6 +220,20 @@ func (mr *MockDatabaseMockRecorder) CreateAssetGroupTag(ctx, tagType, user, name
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAssetGroupTag", reflect.TypeOf((*MockDatabase)(nil).CreateAssetGroupTag), ctx, tagType, user, name, description, position, requireCertify)
 }
 
+// CreateAssetGroupTagMembershipEvents mocks base method.
+func (m *MockDatabase) CreateAssetGroupTagMembershipEvents(ctx context.Context, events model.AssetGroupTagMembershipEvents) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAssetGroupTagMembershipEvents", ctx, events)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAssetGroupTagMembershipEvents indicates an expected call of CreateAssetGroupTagMembershipEvents.
+func (mr *MockDatabaseMockRecorder) CreateAssetGroupTagMembershipEvents(ctx, events any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAssetGroupTagMembershipEvents", reflect.TypeOf((*MockDatabase)(nil).CreateAssetGroupTagMembershipEvents), ctx, events)
+}
+
 // CreateAssetGroupTagSelector mocks base method.
 func (m *MockDatabase) CreateAssetGroupTagSelector(ctx context.Context, assetGroupTagId int, user model.User, name, description string, isDefault, allowDisable bool, autoCertify null.Bool, seeds []model.SelectorSeed) (model.AssetGroupTagSelector, error) {
 	m.ctrl.T.Helper()
@@ -250,6 +278,20 @@ func (mr *MockDatabaseMockRecorder) CreateAuthSecret(ctx, authSecret any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuthSecret", reflect.TypeOf((*MockDatabase)(nil).CreateAuthSecret), ctx, authSecret)
 }
 
+// CreateAuthSecretHistory mocks base method.
+func (m *MockDatabase) CreateAuthSecretHistory(ctx context.Context, authSecret model.AuthSecret) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAuthSecretHistory", ctx, authSecret)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAuthSecretHistory indicates an expected call of CreateAuthSecretHistory.
+func (mr *MockDatabaseMockRecorder) CreateAuthSecretHistory(ctx, authSecret any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuthSecretHistory", reflect.TypeOf((*MockDatabase)(nil).CreateAuthSecretHistory), ctx, authSecret)
+}
+
 // CreateAuthToken mocks base method.
 func (m *MockDatabase) CreateAuthToken(ctx context.Context, authToken model.AuthToken) (model.AuthToken, error) {
 	m.ctrl.T.Helper()
@@ -371,6 +413,21 @@ func (mr *MockDatabaseMockRecorder) CreateInstallation(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInstallation", reflect.TypeOf((*MockDatabase)(nil).CreateInstallation), ctx)
 }
 
+// CreateLDAPProvider mocks base method.
+func (m *MockDatabase) CreateLDAPProvider(ctx context.Context, name string, ldapProvider model.LDAPProvider, config model.SSOProviderConfig) (model.LDAPProvider, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLDAPProvider", ctx, name, ldapProvider, config)
+	ret0, _ := ret[0].(model.LDAPProvider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLDAPProvider indicates an expected call of CreateLDAPProvider.
+func (mr *MockDatabaseMockRecorder) CreateLDAPProvider(ctx, name, ldapProvider, config any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLDAPProvider", reflect.TypeOf((*MockDatabase)(nil).CreateLDAPProvider), ctx, name, ldapProvider, config)
+}
+
 // CreateOIDCProvider mocks base method.
 func (m *MockDatabase) CreateOIDCProvider(ctx context.Context, name, issuer, clientID string, config model.SSOProviderConfig) (model.OIDCProvider, error) {
 	m.ctrl.T.Helper()
@@ -386,6 +443,21 @@ func (mr *MockDatabaseMockRecorder) CreateOIDCProvider(ctx, name, issuer, client
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOIDCProvider", reflect.TypeOf((*MockDatabase)(nil).CreateOIDCProvider), ctx, name, issuer, clientID, config)
 }
 
+// CreateRole mocks base method.
+func (m *MockDatabase) CreateRole(ctx context.Context, role model.Role) (model.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRole", ctx, role)
+	ret0, _ := ret[0].(model.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRole indicates an expected call of CreateRole.
+func (mr *MockDatabaseMockRecorder) CreateRole(ctx, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRole", reflect.TypeOf((*MockDatabase)(nil).CreateRole), ctx, role)
+}
+
 // CreateSAMLIdentityProvider mocks base method.
 func (m *MockDatabase) CreateSAMLIdentityProvider(ctx context.Context, samlProvider model.SAMLProvider, config model.SSOProviderConfig) (model.SAMLProvider, error) {
 	m.ctrl.T.Helper()
@@ -510,6 +582,35 @@ func (mr *MockDatabaseMockRecorder) CreateUserSession(ctx, userSession any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserSession", reflect.TypeOf((*MockDatabase)(nil).CreateUserSession), ctx, userSession)
 }
 
+// CreateWebAuthnChallenge mocks base method.
+func (m *MockDatabase) CreateWebAuthnChallenge(ctx context.Context, challenge model.WebAuthnChallenge) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebAuthnChallenge", ctx, challenge)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWebAuthnChallenge indicates an expected call of CreateWebAuthnChallenge.
+func (mr *MockDatabaseMockRecorder) CreateWebAuthnChallenge(ctx, challenge any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebAuthnChallenge", reflect.TypeOf((*MockDatabase)(nil).CreateWebAuthnChallenge), ctx, challenge)
+}
+
+// CreateWebAuthnCredential mocks base method.
+func (m *MockDatabase) CreateWebAuthnCredential(ctx context.Context, credential model.WebAuthnCredential) (model.WebAuthnCredential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebAuthnCredential", ctx, credential)
+	ret0, _ := ret[0].(model.WebAuthnCredential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebAuthnCredential indicates an expected call of CreateWebAuthnCredential.
+func (mr *MockDatabaseMockRecorder) CreateWebAuthnCredential(ctx, credential any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebAuthnCredential", reflect.TypeOf((*MockDatabase)(nil).CreateWebAuthnCredential), ctx, credential)
+}
+
 // DeleteAllDataQuality mocks base method.
 func (m *MockDatabase) DeleteAllDataQuality(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -692,6 +793,34 @@ func (mr *MockDatabaseMockRecorder) DeleteIngestTask(ctx, ingestTask any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIngestTask", reflect.TypeOf((*MockDatabase)(nil).DeleteIngestTask), ctx, ingestTask)
 }
 
+// DeleteRateLimitCounter mocks base method.
+func (m *MockDatabase) DeleteRateLimitCounter(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRateLimitCounter", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRateLimitCounter indicates an expected call of DeleteRateLimitCounter.
+func (mr *MockDatabaseMockRecorder) DeleteRateLimitCounter(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRateLimitCounter", reflect.TypeOf((*MockDatabase)(nil).DeleteRateLimitCounter), ctx, key)
+}
+
+// DeleteRole mocks base method.
+func (m *MockDatabase) DeleteRole(ctx context.Context, role model.Role) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRole", ctx, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRole indicates an expected call of DeleteRole.
+func (mr *MockDatabaseMockRecorder) DeleteRole(ctx, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockDatabase)(nil).DeleteRole), ctx, role)
+}
+
 // DeleteSSOProvider mocks base method.
 func (m *MockDatabase) DeleteSSOProvider(ctx context.Context, id int) error {
 	m.ctrl.T.Helper()
@@ -800,6 +929,20 @@ func (mr *MockDatabaseMockRecorder) DeleteUser(ctx, user any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockDatabase)(nil).DeleteUser), ctx, user)
 }
 
+// DeleteWebAuthnCredential mocks base method.
+func (m *MockDatabase) DeleteWebAuthnCredential(ctx context.Context, credential model.WebAuthnCredential) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebAuthnCredential", ctx, credential)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebAuthnCredential indicates an expected call of DeleteWebAuthnCredential.
+func (mr *MockDatabaseMockRecorder) DeleteWebAuthnCredential(ctx, credential any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebAuthnCredential", reflect.TypeOf((*MockDatabase)(nil).DeleteWebAuthnCredential), ctx, credential)
+}
+
 // EndUserSession mocks base method.
 func (m *MockDatabase) EndUserSession(ctx context.Context, userSession model.UserSession) {
 	m.ctrl.T.Helper()
@@ -812,6 +955,21 @@ func (mr *MockDatabaseMockRecorder) EndUserSession(ctx, userSession any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndUserSession", reflect.TypeOf((*MockDatabase)(nil).EndUserSession), ctx, userSession)
 }
 
+// ExpireSelectorNodeCertifications mocks base method.
+func (m *MockDatabase) ExpireSelectorNodeCertifications(ctx context.Context, certifiedBefore time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireSelectorNodeCertifications", ctx, certifiedBefore)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireSelectorNodeCertifications indicates an expected call of ExpireSelectorNodeCertifications.
+func (mr *MockDatabaseMockRecorder) ExpireSelectorNodeCertifications(ctx, certifiedBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireSelectorNodeCertifications", reflect.TypeOf((*MockDatabase)(nil).ExpireSelectorNodeCertifications), ctx, certifiedBefore)
+}
+
 // GetADDataQualityAggregations mocks base method.
 func (m *MockDatabase) GetADDataQualityAggregations(ctx context.Context, start, end time.Time, sort_by string, limit, skip int) (model.ADDataQualityAggregations, int, error) {
 	m.ctrl.T.Helper()
@@ -1131,6 +1289,38 @@ func (mr *MockDatabaseMockRecorder) GetAssetGroupTag(ctx, assetGroupTagId any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssetGroupTag", reflect.TypeOf((*MockDatabase)(nil).GetAssetGroupTag), ctx, assetGroupTagId)
 }
 
+// GetAssetGroupTagChokePoints mocks base method.
+func (m *MockDatabase) GetAssetGroupTagChokePoints(ctx context.Context, assetGroupTagId int, sqlFilter model.SQLFilter, skip, limit int) (model.AssetGroupTagChokePoints, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssetGroupTagChokePoints", ctx, assetGroupTagId, sqlFilter, skip, limit)
+	ret0, _ := ret[0].(model.AssetGroupTagChokePoints)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAssetGroupTagChokePoints indicates an expected call of GetAssetGroupTagChokePoints.
+func (mr *MockDatabaseMockRecorder) GetAssetGroupTagChokePoints(ctx, assetGroupTagId, sqlFilter, skip, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssetGroupTagChokePoints", reflect.TypeOf((*MockDatabase)(nil).GetAssetGroupTagChokePoints), ctx, assetGroupTagId, sqlFilter, skip, limit)
+}
+
+// GetAssetGroupTagExposures mocks base method.
+func (m *MockDatabase) GetAssetGroupTagExposures(ctx context.Context, assetGroupTagId int, start, end time.Time, skip, limit int) (model.AssetGroupTagExposures, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssetGroupTagExposures", ctx, assetGroupTagId, start, end, skip, limit)
+	ret0, _ := ret[0].(model.AssetGroupTagExposures)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAssetGroupTagExposures indicates an expected call of GetAssetGroupTagExposures.
+func (mr *MockDatabaseMockRecorder) GetAssetGroupTagExposures(ctx, assetGroupTagId, start, end, skip, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssetGroupTagExposures", reflect.TypeOf((*MockDatabase)(nil).GetAssetGroupTagExposures), ctx, assetGroupTagId, start, end, skip, limit)
+}
+
 // GetAssetGroupTagForSelection mocks base method.
 func (m *MockDatabase) GetAssetGroupTagForSelection(ctx context.Context) ([]model.AssetGroupTag, error) {
 	m.ctrl.T.Helper()
@@ -1146,6 +1336,38 @@ func (mr *MockDatabaseMockRecorder) GetAssetGroupTagForSelection(ctx any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssetGroupTagForSelection", reflect.TypeOf((*MockDatabase)(nil).GetAssetGroupTagForSelection), ctx)
 }
 
+// GetAssetGroupTagMembersAsOf mocks base method.
+func (m *MockDatabase) GetAssetGroupTagMembersAsOf(ctx context.Context, assetGroupTagId int, asOf time.Time, skip, limit int) (model.AssetGroupTagMembershipEvents, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssetGroupTagMembersAsOf", ctx, assetGroupTagId, asOf, skip, limit)
+	ret0, _ := ret[0].(model.AssetGroupTagMembershipEvents)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAssetGroupTagMembersAsOf indicates an expected call of GetAssetGroupTagMembersAsOf.
+func (mr *MockDatabaseMockRecorder) GetAssetGroupTagMembersAsOf(ctx, assetGroupTagId, asOf, skip, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssetGroupTagMembersAsOf", reflect.TypeOf((*MockDatabase)(nil).GetAssetGroupTagMembersAsOf), ctx, assetGroupTagId, asOf, skip, limit)
+}
+
+// GetAssetGroupTagMembershipEvents mocks base method.
+func (m *MockDatabase) GetAssetGroupTagMembershipEvents(ctx context.Context, assetGroupTagId int, sqlFilter model.SQLFilter, skip, limit int) (model.AssetGroupTagMembershipEvents, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssetGroupTagMembershipEvents", ctx, assetGroupTagId, sqlFilter, skip, limit)
+	ret0, _ := ret[0].(model.AssetGroupTagMembershipEvents)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAssetGroupTagMembershipEvents indicates an expected call of GetAssetGroupTagMembershipEvents.
+func (mr *MockDatabaseMockRecorder) GetAssetGroupTagMembershipEvents(ctx, assetGroupTagId, sqlFilter, skip, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssetGroupTagMembershipEvents", reflect.TypeOf((*MockDatabase)(nil).GetAssetGroupTagMembershipEvents), ctx, assetGroupTagId, sqlFilter, skip, limit)
+}
+
 // GetAssetGroupTagSelectorBySelectorId mocks base method.
 func (m *MockDatabase) GetAssetGroupTagSelectorBySelectorId(ctx context.Context, assetGroupTagSelectorId int) (model.AssetGroupTagSelector, error) {
 	m.ctrl.T.Helper()
@@ -1207,6 +1429,37 @@ func (mr *MockDatabaseMockRecorder) GetAssetGroupTagSelectorsByTagId(ctx, assetG
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssetGroupTagSelectorsByTagId", reflect.TypeOf((*MockDatabase)(nil).GetAssetGroupTagSelectorsByTagId), ctx, assetGroupTagId, selectorSqlFilter, selectorSeedSqlFilter, skip, limit)
 }
 
+// GetAssetGroupTagViolationCounts mocks base method.
+func (m *MockDatabase) GetAssetGroupTagViolationCounts(ctx context.Context, assetGroupTagId int) ([]model.AssetGroupTagViolationCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssetGroupTagViolationCounts", ctx, assetGroupTagId)
+	ret0, _ := ret[0].([]model.AssetGroupTagViolationCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssetGroupTagViolationCounts indicates an expected call of GetAssetGroupTagViolationCounts.
+func (mr *MockDatabaseMockRecorder) GetAssetGroupTagViolationCounts(ctx, assetGroupTagId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssetGroupTagViolationCounts", reflect.TypeOf((*MockDatabase)(nil).GetAssetGroupTagViolationCounts), ctx, assetGroupTagId)
+}
+
+// GetAssetGroupTagViolations mocks base method.
+func (m *MockDatabase) GetAssetGroupTagViolations(ctx context.Context, assetGroupTagId int, sqlFilter model.SQLFilter, skip, limit int) (model.AssetGroupTagViolations, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssetGroupTagViolations", ctx, assetGroupTagId, sqlFilter, skip, limit)
+	ret0, _ := ret[0].(model.AssetGroupTagViolations)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAssetGroupTagViolations indicates an expected call of GetAssetGroupTagViolations.
+func (mr *MockDatabaseMockRecorder) GetAssetGroupTagViolations(ctx, assetGroupTagId, sqlFilter, skip, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssetGroupTagViolations", reflect.TypeOf((*MockDatabase)(nil).GetAssetGroupTagViolations), ctx, assetGroupTagId, sqlFilter, skip, limit)
+}
+
 // GetAssetGroupTags mocks base method.
 func (m *MockDatabase) GetAssetGroupTags(ctx context.Context, sqlFilter model.SQLFilter) (model.AssetGroupTags, error) {
 	m.ctrl.T.Helper()
@@ -1222,6 +1475,21 @@ func (mr *MockDatabaseMockRecorder) GetAssetGroupTags(ctx, sqlFilter any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssetGroupTags", reflect.TypeOf((*MockDatabase)(nil).GetAssetGroupTags), ctx, sqlFilter)
 }
 
+// GetAuditLogsAfterID mocks base method.
+func (m *MockDatabase) GetAuditLogsAfterID(ctx context.Context, afterID int64, before, after time.Time, limit int, filter model.SQLFilter) (model.AuditLogs, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditLogsAfterID", ctx, afterID, before, after, limit, filter)
+	ret0, _ := ret[0].(model.AuditLogs)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditLogsAfterID indicates an expected call of GetAuditLogsAfterID.
+func (mr *MockDatabaseMockRecorder) GetAuditLogsAfterID(ctx, afterID, before, after, limit, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditLogsAfterID", reflect.TypeOf((*MockDatabase)(nil).GetAuditLogsAfterID), ctx, afterID, before, after, limit, filter)
+}
+
 // GetAuthSecret mocks base method.
 func (m *MockDatabase) GetAuthSecret(ctx context.Context, id int32) (model.AuthSecret, error) {
 	m.ctrl.T.Helper()
@@ -1237,6 +1505,21 @@ func (mr *MockDatabaseMockRecorder) GetAuthSecret(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthSecret", reflect.TypeOf((*MockDatabase)(nil).GetAuthSecret), ctx, id)
 }
 
+// GetAuthSecretHistory mocks base method.
+func (m *MockDatabase) GetAuthSecretHistory(ctx context.Context, userID uuid.UUID, limit int) ([]model.AuthSecretHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuthSecretHistory", ctx, userID, limit)
+	ret0, _ := ret[0].([]model.AuthSecretHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuthSecretHistory indicates an expected call of GetAuthSecretHistory.
+func (mr *MockDatabaseMockRecorder) GetAuthSecretHistory(ctx, userID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthSecretHistory", reflect.TypeOf((*MockDatabase)(nil).GetAuthSecretHistory), ctx, userID, limit)
+}
+
 // GetAuthToken mocks base method.
 func (m *MockDatabase) GetAuthToken(ctx context.Context, id uuid.UUID) (model.AuthToken, error) {
 	m.ctrl.T.Helper()
@@ -1359,6 +1642,52 @@ func (mr *MockDatabaseMockRecorder) GetDatapipeStatus(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDatapipeStatus", reflect.TypeOf((*MockDatabase)(nil).GetDatapipeStatus), ctx)
 }
 
+// GetFinding mocks base method.
+func (m *MockDatabase) GetFinding(ctx context.Context, id int64) (model.Finding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFinding", ctx, id)
+	ret0, _ := ret[0].(model.Finding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFinding indicates an expected call of GetFinding.
+func (mr *MockDatabaseMockRecorder) GetFinding(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFinding", reflect.TypeOf((*MockDatabase)(nil).GetFinding), ctx, id)
+}
+
+// GetFindingCounts mocks base method.
+func (m *MockDatabase) GetFindingCounts(ctx context.Context) ([]model.FindingCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFindingCounts", ctx)
+	ret0, _ := ret[0].([]model.FindingCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFindingCounts indicates an expected call of GetFindingCounts.
+func (mr *MockDatabaseMockRecorder) GetFindingCounts(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFindingCounts", reflect.TypeOf((*MockDatabase)(nil).GetFindingCounts), ctx)
+}
+
+// GetFindings mocks base method.
+func (m *MockDatabase) GetFindings(ctx context.Context, sqlFilter model.SQLFilter, skip, limit int) (model.Findings, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFindings", ctx, sqlFilter, skip, limit)
+	ret0, _ := ret[0].(model.Findings)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetFindings indicates an expected call of GetFindings.
+func (mr *MockDatabaseMockRecorder) GetFindings(ctx, sqlFilter, skip, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFindings", reflect.TypeOf((*MockDatabase)(nil).GetFindings), ctx, sqlFilter, skip, limit)
+}
+
 // GetFlag mocks base method.
 func (m *MockDatabase) GetFlag(ctx context.Context, id int32) (appcfg.FeatureFlag, error) {
 	m.ctrl.T.Helper()
@@ -1509,6 +1838,21 @@ func (mr *MockDatabaseMockRecorder) GetPublicSavedQueries(ctx any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicSavedQueries", reflect.TypeOf((*MockDatabase)(nil).GetPublicSavedQueries), ctx)
 }
 
+// GetRateLimitCounter mocks base method.
+func (m *MockDatabase) GetRateLimitCounter(ctx context.Context, key string) (model.RateLimitCounter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRateLimitCounter", ctx, key)
+	ret0, _ := ret[0].(model.RateLimitCounter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRateLimitCounter indicates an expected call of GetRateLimitCounter.
+func (mr *MockDatabaseMockRecorder) GetRateLimitCounter(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRateLimitCounter", reflect.TypeOf((*MockDatabase)(nil).GetRateLimitCounter), ctx, key)
+}
+
 // GetRole mocks base method.
 func (m *MockDatabase) GetRole(ctx context.Context, id int32) (model.Role, error) {
 	m.ctrl.T.Helper()
@@ -1584,6 +1928,21 @@ func (mr *MockDatabaseMockRecorder) GetSSOProviderById(ctx, id any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSSOProviderById", reflect.TypeOf((*MockDatabase)(nil).GetSSOProviderById), ctx, id)
 }
 
+// GetSSOProviderBySCIMTokenDigest mocks base method.
+func (m *MockDatabase) GetSSOProviderBySCIMTokenDigest(ctx context.Context, digest string) (model.SSOProvider, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSSOProviderBySCIMTokenDigest", ctx, digest)
+	ret0, _ := ret[0].(model.SSOProvider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSSOProviderBySCIMTokenDigest indicates an expected call of GetSSOProviderBySCIMTokenDigest.
+func (mr *MockDatabaseMockRecorder) GetSSOProviderBySCIMTokenDigest(ctx, digest any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSSOProviderBySCIMTokenDigest", reflect.TypeOf((*MockDatabase)(nil).GetSSOProviderBySCIMTokenDigest), ctx, digest)
+}
+
 // GetSSOProviderBySlug mocks base method.
 func (m *MockDatabase) GetSSOProviderBySlug(ctx context.Context, slug string) (model.SSOProvider, error) {
 	m.ctrl.T.Helper()
@@ -1679,6 +2038,22 @@ func (mr *MockDatabaseMockRecorder) GetSelectorNodesBySelectorIds(ctx any, selec
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSelectorNodesBySelectorIds", reflect.TypeOf((*MockDatabase)(nil).GetSelectorNodesBySelectorIds), varargs...)
 }
 
+// GetSelectorNodesByTagIdAndCertification mocks base method.
+func (m *MockDatabase) GetSelectorNodesByTagIdAndCertification(ctx context.Context, assetGroupTagId int, certified []model.AssetGroupCertification, skip, limit int) ([]model.AssetGroupSelectorNode, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSelectorNodesByTagIdAndCertification", ctx, assetGroupTagId, certified, skip, limit)
+	ret0, _ := ret[0].([]model.AssetGroupSelectorNode)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSelectorNodesByTagIdAndCertification indicates an expected call of GetSelectorNodesByTagIdAndCertification.
+func (mr *MockDatabaseMockRecorder) GetSelectorNodesByTagIdAndCertification(ctx, assetGroupTagId, certified, skip, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSelectorNodesByTagIdAndCertification", reflect.TypeOf((*MockDatabase)(nil).GetSelectorNodesByTagIdAndCertification), ctx, assetGroupTagId, certified, skip, limit)
+}
+
 // GetSelectorsByMemberId mocks base method.
 func (m *MockDatabase) GetSelectorsByMemberId(ctx context.Context, memberId, assetGroupTagId int) (model.AssetGroupTagSelectors, error) {
 	m.ctrl.T.Helper()
@@ -1784,6 +2159,36 @@ func (mr *MockDatabaseMockRecorder) GetUserToken(ctx, userId, tokenId any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserToken", reflect.TypeOf((*MockDatabase)(nil).GetUserToken), ctx, userId, tokenId)
 }
 
+// GetUserWebAuthnCredential mocks base method.
+func (m *MockDatabase) GetUserWebAuthnCredential(ctx context.Context, userID uuid.UUID, id int32) (model.WebAuthnCredential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserWebAuthnCredential", ctx, userID, id)
+	ret0, _ := ret[0].(model.WebAuthnCredential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserWebAuthnCredential indicates an expected call of GetUserWebAuthnCredential.
+func (mr *MockDatabaseMockRecorder) GetUserWebAuthnCredential(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserWebAuthnCredential", reflect.TypeOf((*MockDatabase)(nil).GetUserWebAuthnCredential), ctx, userID, id)
+}
+
+// GetWebAuthnCredentials mocks base method.
+func (m *MockDatabase) GetWebAuthnCredentials(ctx context.Context, userID uuid.UUID) (model.WebAuthnCredentials, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebAuthnCredentials", ctx, userID)
+	ret0, _ := ret[0].(model.WebAuthnCredentials)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebAuthnCredentials indicates an expected call of GetWebAuthnCredentials.
+func (mr *MockDatabaseMockRecorder) GetWebAuthnCredentials(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebAuthnCredentials", reflect.TypeOf((*MockDatabase)(nil).GetWebAuthnCredentials), ctx, userID)
+}
+
 // HasAnalysisRequest mocks base method.
 func (m *MockDatabase) HasAnalysisRequest(ctx context.Context) bool {
 	m.ctrl.T.Helper()
@@ -1798,6 +2203,21 @@ func (mr *MockDatabaseMockRecorder) HasAnalysisRequest(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasAnalysisRequest", reflect.TypeOf((*MockDatabase)(nil).HasAnalysisRequest), ctx)
 }
 
+// HasAssetGroupTagMembershipEvents mocks base method.
+func (m *MockDatabase) HasAssetGroupTagMembershipEvents(ctx context.Context, assetGroupTagId int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasAssetGroupTagMembershipEvents", ctx, assetGroupTagId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasAssetGroupTagMembershipEvents indicates an expected call of HasAssetGroupTagMembershipEvents.
+func (mr *MockDatabaseMockRecorder) HasAssetGroupTagMembershipEvents(ctx, assetGroupTagId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasAssetGroupTagMembershipEvents", reflect.TypeOf((*MockDatabase)(nil).HasAssetGroupTagMembershipEvents), ctx, assetGroupTagId)
+}
+
 // HasCollectedGraphDataDeletionRequest mocks base method.
 func (m *MockDatabase) HasCollectedGraphDataDeletionRequest(ctx context.Context) (model.AnalysisRequest, bool) {
 	m.ctrl.T.Helper()
@@ -1828,6 +2248,21 @@ func (mr *MockDatabaseMockRecorder) HasInstallation(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasInstallation", reflect.TypeOf((*MockDatabase)(nil).HasInstallation), ctx)
 }
 
+// IncrementRateLimitCounter mocks base method.
+func (m *MockDatabase) IncrementRateLimitCounter(ctx context.Context, key string, count int64, period time.Duration) (model.RateLimitCounter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementRateLimitCounter", ctx, key, count, period)
+	ret0, _ := ret[0].(model.RateLimitCounter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementRateLimitCounter indicates an expected call of IncrementRateLimitCounter.
+func (mr *MockDatabaseMockRecorder) IncrementRateLimitCounter(ctx, key, count, period any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementRateLimitCounter", reflect.TypeOf((*MockDatabase)(nil).IncrementRateLimitCounter), ctx, key, count, period)
+}
+
 // InitializeSecretAuth mocks base method.
 func (m *MockDatabase) InitializeSecretAuth(ctx context.Context, adminUser model.User, authSecret model.AuthSecret) (model.Installation, error) {
 	m.ctrl.T.Helper()
@@ -1934,6 +2369,20 @@ func (mr *MockDatabaseMockRecorder) ListSavedQueries(ctx, userID, order, filter,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSavedQueries", reflect.TypeOf((*MockDatabase)(nil).ListSavedQueries), ctx, userID, order, filter, skip, limit)
 }
 
+// LockUser mocks base method.
+func (m *MockDatabase) LockUser(ctx context.Context, user model.User, lockedUntil time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockUser", ctx, user, lockedUntil)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockUser indicates an expected call of LockUser.
+func (mr *MockDatabaseMockRecorder) LockUser(ctx, user, lockedUntil any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockUser", reflect.TypeOf((*MockDatabase)(nil).LockUser), ctx, user, lockedUntil)
+}
+
 // LookupActiveSessionsByUser mocks base method.
 func (m *MockDatabase) LookupActiveSessionsByUser(ctx context.Context, user model.User) ([]model.UserSession, error) {
 	m.ctrl.T.Helper()
@@ -1978,6 +2427,36 @@ func (mr *MockDatabaseMockRecorder) Migrate(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Migrate", reflect.TypeOf((*MockDatabase)(nil).Migrate), ctx)
 }
 
+// PruneAuditLogs mocks base method.
+func (m *MockDatabase) PruneAuditLogs(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PruneAuditLogs", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PruneAuditLogs indicates an expected call of PruneAuditLogs.
+func (mr *MockDatabaseMockRecorder) PruneAuditLogs(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneAuditLogs", reflect.TypeOf((*MockDatabase)(nil).PruneAuditLogs), ctx, before)
+}
+
+// RecordFailedLogin mocks base method.
+func (m *MockDatabase) RecordFailedLogin(ctx context.Context, user model.User) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordFailedLogin", ctx, user)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordFailedLogin indicates an expected call of RecordFailedLogin.
+func (mr *MockDatabaseMockRecorder) RecordFailedLogin(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordFailedLogin", reflect.TypeOf((*MockDatabase)(nil).RecordFailedLogin), ctx, user)
+}
+
 // RegisterSourceKind mocks base method.
 func (m *MockDatabase) RegisterSourceKind(ctx context.Context) func(graph.Kind) error {
 	m.ctrl.T.Helper()
@@ -1992,6 +2471,20 @@ func (mr *MockDatabaseMockRecorder) RegisterSourceKind(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterSourceKind", reflect.TypeOf((*MockDatabase)(nil).RegisterSourceKind), ctx)
 }
 
+// ReplaceAssetGroupTagViolations mocks base method.
+func (m *MockDatabase) ReplaceAssetGroupTagViolations(ctx context.Context, violations model.AssetGroupTagViolations, counts []model.AssetGroupTagViolationCount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceAssetGroupTagViolations", ctx, violations, counts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceAssetGroupTagViolations indicates an expected call of ReplaceAssetGroupTagViolations.
+func (mr *MockDatabaseMockRecorder) ReplaceAssetGroupTagViolations(ctx, violations, counts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceAssetGroupTagViolations", reflect.TypeOf((*MockDatabase)(nil).ReplaceAssetGroupTagViolations), ctx, violations, counts)
+}
+
 // RequestAnalysis mocks base method.
 func (m *MockDatabase) RequestAnalysis(ctx context.Context, requester string) error {
 	m.ctrl.T.Helper()
@@ -2020,6 +2513,35 @@ func (mr *MockDatabaseMockRecorder) RequestCollectedGraphDataDeletion(ctx, reque
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestCollectedGraphDataDeletion", reflect.TypeOf((*MockDatabase)(nil).RequestCollectedGraphDataDeletion), ctx, request)
 }
 
+// ResolveFindings mocks base method.
+func (m *MockDatabase) ResolveFindings(ctx context.Context, finding string, seenBefore time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveFindings", ctx, finding, seenBefore)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveFindings indicates an expected call of ResolveFindings.
+func (mr *MockDatabaseMockRecorder) ResolveFindings(ctx, finding, seenBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveFindings", reflect.TypeOf((*MockDatabase)(nil).ResolveFindings), ctx, finding, seenBefore)
+}
+
+// SaveAssetGroupTagExposures mocks base method.
+func (m *MockDatabase) SaveAssetGroupTagExposures(ctx context.Context, exposures model.AssetGroupTagExposures, chokePoints model.AssetGroupTagChokePoints) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveAssetGroupTagExposures", ctx, exposures, chokePoints)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveAssetGroupTagExposures indicates an expected call of SaveAssetGroupTagExposures.
+func (mr *MockDatabaseMockRecorder) SaveAssetGroupTagExposures(ctx, exposures, chokePoints any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAssetGroupTagExposures", reflect.TypeOf((*MockDatabase)(nil).SaveAssetGroupTagExposures), ctx, exposures, chokePoints)
+}
+
 // SavedQueryBelongsToUser mocks base method.
 func (m *MockDatabase) SavedQueryBelongsToUser(ctx context.Context, userID uuid.UUID, savedQueryID int64) (bool, error) {
 	m.ctrl.T.Helper()
@@ -2077,6 +2599,20 @@ func (mr *MockDatabaseMockRecorder) SetFlag(ctx, value any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFlag", reflect.TypeOf((*MockDatabase)(nil).SetFlag), ctx, value)
 }
 
+// SetSSOProviderSCIMTokenDigest mocks base method.
+func (m *MockDatabase) SetSSOProviderSCIMTokenDigest(ctx context.Context, ssoProvider model.SSOProvider, digest null.String) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSSOProviderSCIMTokenDigest", ctx, ssoProvider, digest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSSOProviderSCIMTokenDigest indicates an expected call of SetSSOProviderSCIMTokenDigest.
+func (mr *MockDatabaseMockRecorder) SetSSOProviderSCIMTokenDigest(ctx, ssoProvider, digest any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSSOProviderSCIMTokenDigest", reflect.TypeOf((*MockDatabase)(nil).SetSSOProviderSCIMTokenDigest), ctx, ssoProvider, digest)
+}
+
 // SetUserSessionFlag mocks base method.
 func (m *MockDatabase) SetUserSessionFlag(ctx context.Context, userSession *model.UserSession, key model.SessionFlagKey, state bool) error {
 	m.ctrl.T.Helper()
@@ -2103,6 +2639,30 @@ func (mr *MockDatabaseMockRecorder) SweepAssetGroupCollections(ctx any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SweepAssetGroupCollections", reflect.TypeOf((*MockDatabase)(nil).SweepAssetGroupCollections), ctx)
 }
 
+// SweepAuthTokens mocks base method.
+func (m *MockDatabase) SweepAuthTokens(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SweepAuthTokens", ctx)
+}
+
+// SweepAuthTokens indicates an expected call of SweepAuthTokens.
+func (mr *MockDatabaseMockRecorder) SweepAuthTokens(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SweepAuthTokens", reflect.TypeOf((*MockDatabase)(nil).SweepAuthTokens), ctx)
+}
+
+// SweepRateLimitCounters mocks base method.
+func (m *MockDatabase) SweepRateLimitCounters(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SweepRateLimitCounters", ctx)
+}
+
+// SweepRateLimitCounters indicates an expected call of SweepRateLimitCounters.
+func (mr *MockDatabaseMockRecorder) SweepRateLimitCounters(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SweepRateLimitCounters", reflect.TypeOf((*MockDatabase)(nil).SweepRateLimitCounters), ctx)
+}
+
 // SweepSessions mocks base method.
 func (m *MockDatabase) SweepSessions(ctx context.Context) {
 	m.ctrl.T.Helper()
@@ -2231,6 +2791,21 @@ func (mr *MockDatabaseMockRecorder) UpdateCustomNodeKind(ctx, customNodeKind any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomNodeKind", reflect.TypeOf((*MockDatabase)(nil).UpdateCustomNodeKind), ctx, customNodeKind)
 }
 
+// UpdateFindingAcceptance mocks base method.
+func (m *MockDatabase) UpdateFindingAcceptance(ctx context.Context, user model.User, id int64, accepted bool) (model.Finding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFindingAcceptance", ctx, user, id, accepted)
+	ret0, _ := ret[0].(model.Finding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFindingAcceptance indicates an expected call of UpdateFindingAcceptance.
+func (mr *MockDatabaseMockRecorder) UpdateFindingAcceptance(ctx, user, id, accepted any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFindingAcceptance", reflect.TypeOf((*MockDatabase)(nil).UpdateFindingAcceptance), ctx, user, id, accepted)
+}
+
 // UpdateIngestJob mocks base method.
 func (m *MockDatabase) UpdateIngestJob(ctx context.Context, job model.IngestJob) error {
 	m.ctrl.T.Helper()
@@ -2245,6 +2820,21 @@ func (mr *MockDatabaseMockRecorder) UpdateIngestJob(ctx, job any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIngestJob", reflect.TypeOf((*MockDatabase)(nil).UpdateIngestJob), ctx, job)
 }
 
+// UpdateLDAPProvider mocks base method.
+func (m *MockDatabase) UpdateLDAPProvider(ctx context.Context, ssoProvider model.SSOProvider) (model.LDAPProvider, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLDAPProvider", ctx, ssoProvider)
+	ret0, _ := ret[0].(model.LDAPProvider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateLDAPProvider indicates an expected call of UpdateLDAPProvider.
+func (mr *MockDatabaseMockRecorder) UpdateLDAPProvider(ctx, ssoProvider any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLDAPProvider", reflect.TypeOf((*MockDatabase)(nil).UpdateLDAPProvider), ctx, ssoProvider)
+}
+
 // UpdateLastAnalysisCompleteTime mocks base method.
 func (m *MockDatabase) UpdateLastAnalysisCompleteTime(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -2274,6 +2864,20 @@ func (mr *MockDatabaseMockRecorder) UpdateOIDCProvider(ctx, ssoProvider any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOIDCProvider", reflect.TypeOf((*MockDatabase)(nil).UpdateOIDCProvider), ctx, ssoProvider)
 }
 
+// UpdateRole mocks base method.
+func (m *MockDatabase) UpdateRole(ctx context.Context, role model.Role) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRole", ctx, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRole indicates an expected call of UpdateRole.
+func (mr *MockDatabaseMockRecorder) UpdateRole(ctx, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRole", reflect.TypeOf((*MockDatabase)(nil).UpdateRole), ctx, role)
+}
+
 // UpdateSAMLIdentityProvider mocks base method.
 func (m *MockDatabase) UpdateSAMLIdentityProvider(ctx context.Context, ssoProvider model.SSOProvider) (model.SAMLProvider, error) {
 	m.ctrl.T.Helper()
@@ -2333,6 +2937,21 @@ func (mr *MockDatabaseMockRecorder) UpdateSelectorNodesByNodeId(ctx, selectorId,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSelectorNodesByNodeId", reflect.TypeOf((*MockDatabase)(nil).UpdateSelectorNodesByNodeId), ctx, selectorId, certified, certifiedBy, nodeId)
 }
 
+// UpdateSelectorNodesCertification mocks base method.
+func (m *MockDatabase) UpdateSelectorNodesCertification(ctx context.Context, user model.User, assetGroupTag model.AssetGroupTag, nodeIds []graph.ID, certified model.AssetGroupCertification, note null.String) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSelectorNodesCertification", ctx, user, assetGroupTag, nodeIds, certified, note)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSelectorNodesCertification indicates an expected call of UpdateSelectorNodesCertification.
+func (mr *MockDatabaseMockRecorder) UpdateSelectorNodesCertification(ctx, user, assetGroupTag, nodeIds, certified, note any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSelectorNodesCertification", reflect.TypeOf((*MockDatabase)(nil).UpdateSelectorNodesCertification), ctx, user, assetGroupTag, nodeIds, certified, note)
+}
+
 // UpdateUser mocks base method.
 func (m *MockDatabase) UpdateUser(ctx context.Context, user model.User) error {
 	m.ctrl.T.Helper()
@@ -2347,6 +2966,48 @@ func (mr *MockDatabaseMockRecorder) UpdateUser(ctx, user any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockDatabase)(nil).UpdateUser), ctx, user)
 }
 
+// UpdateWebAuthnCredential mocks base method.
+func (m *MockDatabase) UpdateWebAuthnCredential(ctx context.Context, credential model.WebAuthnCredential) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebAuthnCredential", ctx, credential)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWebAuthnCredential indicates an expected call of UpdateWebAuthnCredential.
+func (mr *MockDatabaseMockRecorder) UpdateWebAuthnCredential(ctx, credential any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebAuthnCredential", reflect.TypeOf((*MockDatabase)(nil).UpdateWebAuthnCredential), ctx, credential)
+}
+
+// UpdateWebAuthnCredentialUsage mocks base method.
+func (m *MockDatabase) UpdateWebAuthnCredentialUsage(ctx context.Context, credential model.WebAuthnCredential, signCount int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebAuthnCredentialUsage", ctx, credential, signCount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWebAuthnCredentialUsage indicates an expected call of UpdateWebAuthnCredentialUsage.
+func (mr *MockDatabaseMockRecorder) UpdateWebAuthnCredentialUsage(ctx, credential, signCount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebAuthnCredentialUsage", reflect.TypeOf((*MockDatabase)(nil).UpdateWebAuthnCredentialUsage), ctx, credential, signCount)
+}
+
+// UpsertFindings mocks base method.
+func (m *MockDatabase) UpsertFindings(ctx context.Context, findings model.Findings) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertFindings", ctx, findings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertFindings indicates an expected call of UpsertFindings.
+func (mr *MockDatabaseMockRecorder) UpsertFindings(ctx, findings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertFindings", reflect.TypeOf((*MockDatabase)(nil).UpsertFindings), ctx, findings)
+}
+
 // Wipe mocks base method.
 func (m *MockDatabase) Wipe(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,74 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+)
+
+// RateLimitData defines the interface required to share rate limit counters between API replicas
+type RateLimitData interface {
+	IncrementRateLimitCounter(ctx context.Context, key string, count int64, period time.Duration) (model.RateLimitCounter, error)
+	GetRateLimitCounter(ctx context.Context, key string) (model.RateLimitCounter, error)
+	DeleteRateLimitCounter(ctx context.Context, key string) error
+	SweepRateLimitCounters(ctx context.Context)
+}
+
+// IncrementRateLimitCounter atomically adds count to the counter for key. If the counter has expired, a new window of
+// the given period is started.
+func (s *BloodhoundDB) IncrementRateLimitCounter(ctx context.Context, key string, count int64, period time.Duration) (model.RateLimitCounter, error) {
+	var (
+		counter model.RateLimitCounter
+		sqlStr  = `
+			INSERT INTO rate_limit_counters (key, count, expires_at)
+			VALUES (@key, @count, now() + make_interval(secs => @period))
+			ON CONFLICT (key) DO UPDATE SET
+				count = CASE WHEN rate_limit_counters.expires_at <= now() THEN excluded.count ELSE rate_limit_counters.count + excluded.count END,
+				expires_at = CASE WHEN rate_limit_counters.expires_at <= now() THEN excluded.expires_at ELSE rate_limit_counters.expires_at END
+			RETURNING key, count, expires_at;`
+	)
+
+	result := s.db.WithContext(ctx).Raw(sqlStr, map[string]any{
+		"key":    key,
+		"count":  count,
+		"period": period.Seconds(),
+	}).Scan(&counter)
+
+	return counter, CheckError(result)
+}
+
+// GetRateLimitCounter returns the unexpired counter for key
+func (s *BloodhoundDB) GetRateLimitCounter(ctx context.Context, key string) (model.RateLimitCounter, error) {
+	var counter model.RateLimitCounter
+
+	result := s.db.WithContext(ctx).Where("key = ? AND expires_at > now()", key).First(&counter)
+
+	return counter, CheckError(result)
+}
+
+// DeleteRateLimitCounter removes the counter for key
+func (s *BloodhoundDB) DeleteRateLimitCounter(ctx context.Context, key string) error {
+	return CheckError(s.db.WithContext(ctx).Where("key = ?", key).Delete(&model.RateLimitCounter{}))
+}
+
+// SweepRateLimitCounters deletes all rate limit counters that have already expired
+func (s *BloodhoundDB) SweepRateLimitCounters(ctx context.Context) {
+	s.db.WithContext(ctx).Where("expires_at < NOW()").Delete(&model.RateLimitCounter{})
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build integration
+// +build integration
+
+package database_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/database"
+	"github.com/specterops/bloodhound/cmd/api/src/test/integration"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatabase_RateLimitCounters(t *testing.T) {
+	var (
+		testCtx = context.Background()
+		dbInst  = integration.SetupDB(t)
+	)
+
+	counter, err := dbInst.IncrementRateLimitCounter(testCtx, "default:user:1", 1, time.Minute)
+	require.Nil(t, err)
+	require.Equal(t, int64(1), counter.Count)
+
+	counter, err = dbInst.IncrementRateLimitCounter(testCtx, "default:user:1", 2, time.Minute)
+	require.Nil(t, err)
+	require.Equal(t, int64(3), counter.Count)
+
+	fetched, err := dbInst.GetRateLimitCounter(testCtx, "default:user:1")
+	require.Nil(t, err)
+	require.Equal(t, int64(3), fetched.Count)
+
+	// An expired window starts over from the incremented count
+	_, err = dbInst.IncrementRateLimitCounter(testCtx, "default:user:2", 5, time.Millisecond)
+	require.Nil(t, err)
+	time.Sleep(10 * time.Millisecond)
+
+	_, err = dbInst.GetRateLimitCounter(testCtx, "default:user:2")
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	counter, err = dbInst.IncrementRateLimitCounter(testCtx, "default:user:2", 1, time.Minute)
+	require.Nil(t, err)
+	require.Equal(t, int64(1), counter.Count)
+
+	require.Nil(t, dbInst.DeleteRateLimitCounter(testCtx, "default:user:1"))
+	_, err = dbInst.GetRateLimitCounter(testCtx, "default:user:1")
+	require.ErrorIs(t, err, database.ErrNotFound)
+}
@@ -22,6 +22,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
 	"github.com/specterops/bloodhound/cmd/api/src/model"
 	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
 	"gorm.io/gorm"
@@ -38,9 +39,11 @@ type SSOProviderData interface {
 	GetAllSSOProviders(ctx context.Context, order string, sqlFilter model.SQLFilter) ([]model.SSOProvider, error)
 	GetSSOProviderById(ctx context.Context, id int32) (model.SSOProvider, error)
 	GetSSOProviderBySlug(ctx context.Context, slug string) (model.SSOProvider, error)
+	GetSSOProviderBySCIMTokenDigest(ctx context.Context, digest string) (model.SSOProvider, error)
 	GetSSOProviderUsers(ctx context.Context, id int) (model.Users, error)
 	TerminateUserSessionsBySSOProvider(ctx context.Context, ssoProvider model.SSOProvider) error
 	UpdateSSOProvider(ctx context.Context, ssoProvider model.SSOProvider) (model.SSOProvider, error)
+	SetSSOProviderSCIMTokenDigest(ctx context.Context, ssoProvider model.SSOProvider, digest null.String) error
 }
 
 // CreateSSOProvider creates an entry in the sso_providers table
@@ -93,7 +96,7 @@ func (s *BloodhoundDB) DeleteSSOProvider(ctx context.Context, id int) error {
 	)
 
 	// Populate the OIDCProvider and SAMLProvider fields, used in AuditData to log the details of the provider based on its type
-	if result := s.db.Preload("OIDCProvider").Preload("SAMLProvider").
+	if result := s.db.Preload("OIDCProvider").Preload("SAMLProvider").Preload("LDAPProvider").
 		Table(ssoProviderTableName).
 		Where("id = ?", id).
 		First(&ssoProvider); result.Error != nil {
@@ -136,8 +139,8 @@ func (s *BloodhoundDB) GetAllSSOProviders(ctx context.Context, order string, sql
 		query = query.Order("created_at")
 	}
 
-	// Preload the associated OIDC and SAML providers
-	query = query.Preload("OIDCProvider").Preload("SAMLProvider")
+	// Preload the associated OIDC, SAML and LDAP providers
+	query = query.Preload("OIDCProvider").Preload("SAMLProvider").Preload("LDAPProvider")
 
 	result := query.Find(&providers)
 	return providers, CheckError(result)
@@ -145,11 +148,36 @@ func (s *BloodhoundDB) GetAllSSOProviders(ctx context.Context, order string, sql
 
 func (s *BloodhoundDB) GetSSOProviderBySlug(ctx context.Context, slug string) (model.SSOProvider, error) {
 	var provider model.SSOProvider
-	result := s.db.WithContext(ctx).Preload("OIDCProvider").Preload("SAMLProvider").Where("slug = ?", slug).Find(&provider)
+	result := s.db.WithContext(ctx).Preload("OIDCProvider").Preload("SAMLProvider").Preload("LDAPProvider").Where("slug = ?", slug).Find(&provider)
 
 	return provider, CheckError(result)
 }
 
+// GetSSOProviderBySCIMTokenDigest returns the sso provider that was issued the SCIM token with the given digest
+func (s *BloodhoundDB) GetSSOProviderBySCIMTokenDigest(ctx context.Context, digest string) (model.SSOProvider, error) {
+	var provider model.SSOProvider
+	result := s.db.WithContext(ctx).Table(ssoProviderTableName).Where("scim_token_digest = ?", digest).First(&provider)
+
+	return provider, CheckError(result)
+}
+
+// SetSSOProviderSCIMTokenDigest stores the digest of a newly issued SCIM token for the sso provider. An invalid digest
+// revokes the provider's SCIM token.
+func (s *BloodhoundDB) SetSSOProviderSCIMTokenDigest(ctx context.Context, ssoProvider model.SSOProvider, digest null.String) error {
+	auditEntry := model.AuditEntry{
+		Action: model.AuditLogActionIssueSCIMToken,
+		Model:  &ssoProvider,
+	}
+
+	if !digest.Valid {
+		auditEntry.Action = model.AuditLogActionRevokeSCIMToken
+	}
+
+	return s.AuditableTransaction(ctx, auditEntry, func(tx *gorm.DB) error {
+		return CheckError(tx.WithContext(ctx).Exec(fmt.Sprintf("UPDATE %s SET scim_token_digest = ?, updated_at = ? WHERE id = ?;", ssoProviderTableName), digest, time.Now().UTC(), ssoProvider.ID))
+	})
+}
+
 // GetSSOProviderUsers returns all the users associated with a given sso provider
 func (s *BloodhoundDB) GetSSOProviderUsers(ctx context.Context, id int) (model.Users, error) {
 	var (
@@ -161,7 +189,7 @@ func (s *BloodhoundDB) GetSSOProviderUsers(ctx context.Context, id int) (model.U
 
 func (s *BloodhoundDB) GetSSOProviderById(ctx context.Context, id int32) (model.SSOProvider, error) {
 	var provider model.SSOProvider
-	result := s.db.WithContext(ctx).Preload("OIDCProvider").Preload("SAMLProvider").Table(ssoProviderTableName).Where("id = ?", id).First(&provider)
+	result := s.db.WithContext(ctx).Preload("OIDCProvider").Preload("SAMLProvider").Preload("LDAPProvider").Table(ssoProviderTableName).Where("id = ?", id).First(&provider)
 
 	return provider, CheckError(result)
 }
@@ -179,6 +207,10 @@ func (s *BloodhoundDB) TerminateUserSessionsBySSOProvider(ctx context.Context, s
 		if ssoProvider.OIDCProvider != nil {
 			childId = ssoProvider.OIDCProvider.ID
 		}
+	case model.SessionAuthProviderLDAP:
+		if ssoProvider.LDAPProvider != nil {
+			childId = ssoProvider.LDAPProvider.ID
+		}
 	}
 
 	if childId == 0 {
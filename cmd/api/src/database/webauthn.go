@@ -0,0 +1,136 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"gorm.io/gorm"
+)
+
+// WebAuthnData defines the methods required to interact with the webauthn_credentials and webauthn_challenges tables
+type WebAuthnData interface {
+	CreateWebAuthnCredential(ctx context.Context, credential model.WebAuthnCredential) (model.WebAuthnCredential, error)
+	GetWebAuthnCredentials(ctx context.Context, userID uuid.UUID) (model.WebAuthnCredentials, error)
+	GetUserWebAuthnCredential(ctx context.Context, userID uuid.UUID, id int32) (model.WebAuthnCredential, error)
+	UpdateWebAuthnCredential(ctx context.Context, credential model.WebAuthnCredential) error
+	UpdateWebAuthnCredentialUsage(ctx context.Context, credential model.WebAuthnCredential, signCount int64) error
+	DeleteWebAuthnCredential(ctx context.Context, credential model.WebAuthnCredential) error
+	CreateWebAuthnChallenge(ctx context.Context, challenge model.WebAuthnChallenge) error
+	ConsumeWebAuthnChallenge(ctx context.Context, userID uuid.UUID, ceremony, challenge string) error
+}
+
+// CreateWebAuthnCredential creates a new WebAuthnCredential row
+// INSERT INTO webauthn_credentials (...) VALUES (...)
+func (s *BloodhoundDB) CreateWebAuthnCredential(ctx context.Context, credential model.WebAuthnCredential) (model.WebAuthnCredential, error) {
+	auditEntry := model.AuditEntry{
+		Action: model.AuditLogActionCreateWebAuthnCredential,
+		Model:  &credential,
+	}
+
+	return credential, s.AuditableTransaction(ctx, auditEntry, func(tx *gorm.DB) error {
+		return CheckError(tx.WithContext(ctx).Create(&credential))
+	})
+}
+
+// GetWebAuthnCredentials returns all WebAuthn credentials registered by a user
+// SELECT * FROM webauthn_credentials WHERE user_id = ... ORDER BY id
+func (s *BloodhoundDB) GetWebAuthnCredentials(ctx context.Context, userID uuid.UUID) (model.WebAuthnCredentials, error) {
+	var (
+		credentials model.WebAuthnCredentials
+		result      = s.db.WithContext(ctx).Where("user_id = ?", userID).Order("id").Find(&credentials)
+	)
+
+	return credentials, CheckError(result)
+}
+
+// GetUserWebAuthnCredential retrieves a single WebAuthn credential registered by a user
+// SELECT * FROM webauthn_credentials WHERE id = ... AND user_id = ...
+func (s *BloodhoundDB) GetUserWebAuthnCredential(ctx context.Context, userID uuid.UUID, id int32) (model.WebAuthnCredential, error) {
+	var (
+		credential model.WebAuthnCredential
+		result     = s.db.WithContext(ctx).First(&credential, "id = ? AND user_id = ?", id, userID)
+	)
+
+	return credential, CheckError(result)
+}
+
+// UpdateWebAuthnCredential updates the user editable fields of a WebAuthn credential
+// UPDATE webauthn_credentials SET name = ... WHERE id = ...
+func (s *BloodhoundDB) UpdateWebAuthnCredential(ctx context.Context, credential model.WebAuthnCredential) error {
+	auditEntry := model.AuditEntry{
+		Action: model.AuditLogActionUpdateWebAuthnCredential,
+		Model:  &credential,
+	}
+
+	return s.AuditableTransaction(ctx, auditEntry, func(tx *gorm.DB) error {
+		return CheckError(tx.WithContext(ctx).Model(&credential).Update("name", credential.Name))
+	})
+}
+
+// UpdateWebAuthnCredentialUsage records a successful authentication with a WebAuthn credential
+// UPDATE webauthn_credentials SET sign_count = ..., last_used_at = ... WHERE id = ...
+func (s *BloodhoundDB) UpdateWebAuthnCredentialUsage(ctx context.Context, credential model.WebAuthnCredential, signCount int64) error {
+	return CheckError(s.db.WithContext(ctx).Model(&credential).Updates(map[string]any{
+		"sign_count":   signCount,
+		"last_used_at": time.Now().UTC(),
+	}))
+}
+
+// DeleteWebAuthnCredential deletes the provided WebAuthn credential
+// DELETE FROM webauthn_credentials WHERE id = ...
+func (s *BloodhoundDB) DeleteWebAuthnCredential(ctx context.Context, credential model.WebAuthnCredential) error {
+	auditEntry := model.AuditEntry{
+		Action: model.AuditLogActionDeleteWebAuthnCredential,
+		Model:  &credential,
+	}
+
+	return s.AuditableTransaction(ctx, auditEntry, func(tx *gorm.DB) error {
+		return CheckError(tx.WithContext(ctx).Delete(&credential))
+	})
+}
+
+// CreateWebAuthnChallenge stores a new challenge for a user, replacing any challenge previously issued to them for the
+// same ceremony
+// DELETE FROM webauthn_challenges WHERE user_id = ... AND ceremony = ...; INSERT INTO webauthn_challenges (...) VALUES (...)
+func (s *BloodhoundDB) CreateWebAuthnChallenge(ctx context.Context, challenge model.WebAuthnChallenge) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := CheckError(tx.Where("user_id = ? AND ceremony = ?", challenge.UserID, challenge.Ceremony).Delete(&model.WebAuthnChallenge{})); err != nil {
+			return err
+		}
+
+		return CheckError(tx.Create(&challenge))
+	})
+}
+
+// ConsumeWebAuthnChallenge deletes an unexpired challenge issued to a user for the given ceremony. ErrNotFound is
+// returned if no such challenge exists.
+// DELETE FROM webauthn_challenges WHERE challenge = ... AND user_id = ... AND ceremony = ... AND expires_at > NOW()
+func (s *BloodhoundDB) ConsumeWebAuthnChallenge(ctx context.Context, userID uuid.UUID, ceremony, challenge string) error {
+	result := s.db.WithContext(ctx).Where("challenge = ? AND user_id = ? AND ceremony = ? AND expires_at > NOW()", challenge, userID, ceremony).Delete(&model.WebAuthnChallenge{})
+
+	if err := CheckError(result); err != nil {
+		return err
+	} else if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
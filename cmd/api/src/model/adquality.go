@@ -18,6 +18,7 @@ package model
 
 import (
 	"github.com/specterops/bloodhound/cmd/api/src/database/types/nan"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
 )
 
 type ADDataQualityStat struct {
@@ -39,6 +40,7 @@ type ADDataQualityStat struct {
 	Relationships          int         `json:"relationships"`
 	SessionCompleteness    nan.Float64 `json:"session_completeness"`
 	LocalGroupCompleteness nan.Float64 `json:"local_group_completeness"`
+	LastCollected          null.Time   `json:"last_collected"`
 	RunID                  string      `json:"run_id" gorm:"index"`
 
 	Serial
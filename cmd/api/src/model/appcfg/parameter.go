@@ -38,10 +38,16 @@ type ParameterKey string
 const (
 	PasswordExpirationWindow ParameterKey = "auth.password_expiration_window"
 	SessionTTLHours          ParameterKey = "auth.session_ttl_hours"
+	PasswordPolicy           ParameterKey = "auth.password_policy"
+	MFAPolicy                ParameterKey = "auth.mfa_policy"
+	AuditLogRetention        ParameterKey = "audit_log.retention"
 	Neo4jConfigs             ParameterKey = "neo4j.configuration"
 	CitrixRDPSupportKey      ParameterKey = "analysis.citrix_rdp_support"
 	PruneTTL                 ParameterKey = "prune.ttl"
 	ReconciliationKey        ParameterKey = "analysis.reconciliation"
+	CertificationExpiry      ParameterKey = "analysis.certification_expiry"
+	SelectorMaxMembers       ParameterKey = "analysis.selector_max_members"
+	DataQualityStaleDays     ParameterKey = "analysis.data_quality_stale_days"
 
 	// The below keys are not intended to be user updateable, so should not be added to IsValidKey
 	ScheduledAnalysis          ParameterKey = "analysis.scheduled"
@@ -55,11 +61,20 @@ const (
 
 	DefaultSessionTTLHours = 8
 
+	DefaultPasswordMinLength              = 12
+	DefaultPasswordMinCharacterClassCount = 1
+	DefaultLockoutThreshold               = 10
+	DefaultLockoutDurationMinutes         = 15
+
 	DefaultPruneBaseTTL           = time.Hour * 24 * 7
 	DefaultPruneHasSessionEdgeTTL = time.Hour * 24 * 3
 
 	DefaultTierLimit  = 1
 	DefaultLabelLimit = 0
+
+	DefaultSelectorMaxMembers = 10000
+
+	DefaultDataQualityStaleDays = 7
 )
 
 // Parameter is a runtime configuration parameter that can be fetched from the appcfg.ParameterService interface. The
@@ -82,7 +97,7 @@ func (s *Parameter) Map(value any) error {
 
 func (s *Parameter) IsValidKey(parameterKey ParameterKey) bool {
 	switch parameterKey {
-	case PasswordExpirationWindow, Neo4jConfigs, PruneTTL, CitrixRDPSupportKey, ReconciliationKey:
+	case PasswordExpirationWindow, PasswordPolicy, MFAPolicy, AuditLogRetention, Neo4jConfigs, PruneTTL, CitrixRDPSupportKey, ReconciliationKey, CertificationExpiry, SelectorMaxMembers, DataQualityStaleDays:
 		return true
 	default:
 		return false
@@ -115,8 +130,20 @@ func (s *Parameter) Validate() utils.Errors {
 	switch s.Key {
 	case PasswordExpirationWindow:
 		v = &PasswordExpiration{}
+	case PasswordPolicy:
+		v = &PasswordPolicyParameters{}
+	case MFAPolicy:
+		v = &MFAPolicyParameters{}
+	case AuditLogRetention:
+		v = &AuditLogRetentionParameters{}
 	case Neo4jConfigs:
 		v = &Neo4jParameters{}
+	case CertificationExpiry:
+		v = &CertificationExpiryParameters{}
+	case SelectorMaxMembers:
+		v = &SelectorMaxMembersParameters{}
+	case DataQualityStaleDays:
+		v = &DataQualityStaleDaysParameters{}
 	case PruneTTL:
 		v = &PruneTTLParameters{}
 	case CitrixRDPSupportKey:
@@ -228,6 +255,166 @@ func GetPasswordExpiration(ctx context.Context, service ParameterService) time.D
 	return expiration.Duration
 }
 
+// PasswordPolicy
+
+// PasswordPolicyParameters defines the complexity and reuse requirements of local auth secrets as well as the lockout
+// applied to accounts after repeated failed logins. The complexity minimums cannot be set below the requirements that
+// are always enforced for secrets.
+type PasswordPolicyParameters struct {
+	MinLength              int `json:"min_length" validate:"integer,min=12,max=256"`
+	MinLowercase           int `json:"min_lowercase" validate:"integer,min=1,max=64"`
+	MinUppercase           int `json:"min_uppercase" validate:"integer,min=1,max=64"`
+	MinSpecial             int `json:"min_special" validate:"integer,min=1,max=64"`
+	MinNumeric             int `json:"min_numeric" validate:"integer,min=1,max=64"`
+	HistoryCount           int `json:"history_count" validate:"integer,min=0,max=24"`
+	LockoutThreshold       int `json:"lockout_threshold" validate:"integer,min=0,max=100"`
+	LockoutDurationMinutes int `json:"lockout_duration_minutes" validate:"integer,min=1,max=1440"`
+}
+
+// ValidateSecret returns the complexity requirements of the policy that the given secret does not meet
+func (s PasswordPolicyParameters) ValidateSecret(secret string) utils.Errors {
+	return validation.PasswordValidator{
+		Length:  s.MinLength,
+		Lower:   s.MinLowercase,
+		Upper:   s.MinUppercase,
+		Special: s.MinSpecial,
+		Numeric: s.MinNumeric,
+	}.Validate(secret)
+}
+
+// LockoutEnabled returns true if accounts are locked after repeated failed logins
+func (s PasswordPolicyParameters) LockoutEnabled() bool {
+	return s.LockoutThreshold > 0
+}
+
+func (s PasswordPolicyParameters) LockoutDuration() time.Duration {
+	return time.Duration(s.LockoutDurationMinutes) * time.Minute
+}
+
+func GetPasswordPolicy(ctx context.Context, service ParameterService) PasswordPolicyParameters {
+	result := PasswordPolicyParameters{
+		MinLength:              DefaultPasswordMinLength,
+		MinLowercase:           DefaultPasswordMinCharacterClassCount,
+		MinUppercase:           DefaultPasswordMinCharacterClassCount,
+		MinSpecial:             DefaultPasswordMinCharacterClassCount,
+		MinNumeric:             DefaultPasswordMinCharacterClassCount,
+		LockoutThreshold:       DefaultLockoutThreshold,
+		LockoutDurationMinutes: DefaultLockoutDurationMinutes,
+	}
+
+	if cfg, err := service.GetConfigurationParameter(ctx, PasswordPolicy); err != nil {
+		slog.WarnContext(ctx, "Failed to fetch password policy configuration; returning default values")
+	} else if err := cfg.Map(&result); err != nil {
+		slog.WarnContext(ctx, fmt.Sprintf("Invalid password policy configuration supplied, %v. returning default values.", err))
+	}
+
+	return result
+}
+
+// MFAPolicy
+
+// MFAPolicyParameters defines which users must sign in with a phishing-resistant second factor. WebAuthn is the only
+// phishing-resistant factor supported for local auth, so TOTP codes are not accepted as a second factor for these users.
+type MFAPolicyParameters struct {
+	RequirePhishingResistantMFAForAdministrators bool `json:"require_phishing_resistant_mfa_for_administrators"`
+}
+
+func GetMFAPolicy(ctx context.Context, service ParameterService) MFAPolicyParameters {
+	var result MFAPolicyParameters
+
+	if cfg, err := service.GetConfigurationParameter(ctx, MFAPolicy); err != nil {
+		slog.WarnContext(ctx, "Failed to fetch MFA policy configuration; returning default values")
+	} else if err := cfg.Map(&result); err != nil {
+		slog.WarnContext(ctx, fmt.Sprintf("Invalid MFA policy configuration supplied, %v. returning default values.", err))
+	}
+
+	return result
+}
+
+// AuditLogRetention
+
+// AuditLogRetentionParameters sets how many days audit log entries are kept before the data pruning daemon deletes them.
+// A retention of 0 days keeps entries forever.
+type AuditLogRetentionParameters struct {
+	RetentionDays int `json:"retention_days" validate:"integer,min=0,max=36500"`
+}
+
+func GetAuditLogRetention(ctx context.Context, service ParameterService) AuditLogRetentionParameters {
+	var result AuditLogRetentionParameters
+
+	if cfg, err := service.GetConfigurationParameter(ctx, AuditLogRetention); err != nil {
+		slog.WarnContext(ctx, "Failed to fetch audit log retention configuration; returning default values")
+	} else if err := cfg.Map(&result); err != nil {
+		slog.WarnContext(ctx, fmt.Sprintf("Invalid audit log retention configuration supplied, %v. returning default values.", err))
+	}
+
+	return result
+}
+
+// CertificationExpiry
+
+// CertificationExpiryParameters sets how many days a manual certification of an asset group tag member lasts before the
+// member reverts to pending review. An expiry of 0 days keeps certifications forever.
+type CertificationExpiryParameters struct {
+	ExpiryDays int `json:"expiry_days" validate:"integer,min=0,max=36500"`
+}
+
+func GetCertificationExpiry(ctx context.Context, service ParameterService) CertificationExpiryParameters {
+	var result CertificationExpiryParameters
+
+	if cfg, err := service.GetConfigurationParameter(ctx, CertificationExpiry); err != nil {
+		slog.WarnContext(ctx, "Failed to fetch certification expiry configuration; returning default values")
+	} else if err := cfg.Map(&result); err != nil {
+		slog.WarnContext(ctx, fmt.Sprintf("Invalid certification expiry configuration supplied, %v. returning default values.", err))
+	}
+
+	return result
+}
+
+// SelectorMaxMembers
+
+// SelectorMaxMembersParameters sets how many members a single asset group tag selector may select before saving it
+// requires an explicit override. A limit of 0 disables the check.
+type SelectorMaxMembersParameters struct {
+	MaxMembers int `json:"max_members" validate:"integer,min=0"`
+}
+
+func GetSelectorMaxMembers(ctx context.Context, service ParameterService) SelectorMaxMembersParameters {
+	var result = SelectorMaxMembersParameters{
+		MaxMembers: DefaultSelectorMaxMembers,
+	}
+
+	if cfg, err := service.GetConfigurationParameter(ctx, SelectorMaxMembers); err != nil {
+		slog.WarnContext(ctx, "Failed to fetch selector max members configuration; returning default values")
+	} else if err := cfg.Map(&result); err != nil {
+		slog.WarnContext(ctx, fmt.Sprintf("Invalid selector max members configuration supplied, %v. returning default values.", err))
+	}
+
+	return result
+}
+
+// DataQualityStaleDays
+
+// DataQualityStaleDaysParameters sets how many days an AD domain or Azure tenant may go without being seen in a collection
+// before its data quality trend reports it as stale
+type DataQualityStaleDaysParameters struct {
+	StaleDays int `json:"stale_days" validate:"integer,min=1"`
+}
+
+func GetDataQualityStaleDays(ctx context.Context, service ParameterService) DataQualityStaleDaysParameters {
+	var result = DataQualityStaleDaysParameters{
+		StaleDays: DefaultDataQualityStaleDays,
+	}
+
+	if cfg, err := service.GetConfigurationParameter(ctx, DataQualityStaleDays); err != nil {
+		slog.WarnContext(ctx, "Failed to fetch data quality stale days configuration; returning default values")
+	} else if err := cfg.Map(&result); err != nil {
+		slog.WarnContext(ctx, fmt.Sprintf("Invalid data quality stale days configuration supplied, %v. returning default values.", err))
+	}
+
+	return result
+}
+
 // Neo4jConfigs
 
 type Neo4jParameters struct {
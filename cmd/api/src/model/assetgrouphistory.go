@@ -35,6 +35,10 @@ const (
 	AssetGroupHistoryActionCreateSelector AssetGroupHistoryAction = "CreateSelector"
 	AssetGroupHistoryActionUpdateSelector AssetGroupHistoryAction = "UpdateSelector"
 	AssetGroupHistoryActionDeleteSelector AssetGroupHistoryAction = "DeleteSelector"
+
+	AssetGroupHistoryActionCertifyNodeManual  AssetGroupHistoryAction = "CertifyNodeManual"
+	AssetGroupHistoryActionCertifyNodeRevoked AssetGroupHistoryAction = "CertifyNodeRevoked"
+	AssetGroupHistoryActionCertifyNodeExpired AssetGroupHistoryAction = "CertifyNodeExpired"
 )
 
 // AssetGroupHistory is the record of CRUD changes associated with v2 of the asset groups feature
@@ -0,0 +1,158 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/azure"
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/query"
+)
+
+const (
+	SelectorPropertyFilterMaxPredicates = 25
+	SelectorPropertyFilterWildcard      = "*"
+)
+
+var selectorPropertyFilterNameRegex = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+
+// SelectorPropertyPredicate compares a single node property against a value. Values are typed the same way as graph
+// query parameter filters, and the value "null" with eq or neq matches on whether the property is set. The ~eq
+// operator is a case-insensitive match where a leading or trailing * anchors the match to the end or start of the
+// value, and a value without wildcards matches anywhere in the property.
+type SelectorPropertyPredicate struct {
+	Property string         `json:"property"`
+	Operator FilterOperator `json:"operator"`
+	Value    string         `json:"value"`
+}
+
+func (s SelectorPropertyPredicate) Validate() error {
+	if !selectorPropertyFilterNameRegex.MatchString(s.Property) {
+		return fmt.Errorf("invalid property name %q", s.Property)
+	}
+
+	switch s.Operator {
+	case GreaterThan, GreaterThanOrEquals, LessThan, LessThanOrEquals, Equals, NotEquals:
+		return nil
+	case ApproximatelyEquals:
+		if strings.Trim(s.Value, SelectorPropertyFilterWildcard) == "" {
+			return fmt.Errorf("property %s requires a value to match", s.Property)
+		} else if strings.Contains(strings.Trim(s.Value, SelectorPropertyFilterWildcard), SelectorPropertyFilterWildcard) {
+			return fmt.Errorf("property %s only supports a wildcard at the start or end of the value", s.Property)
+		}
+		return nil
+	default:
+		return fmt.Errorf("invalid operator %q for property %s", s.Operator, s.Property)
+	}
+}
+
+func (s SelectorPropertyPredicate) Criteria() graph.Criteria {
+	var propertyRef = query.NodeProperty(s.Property)
+
+	if s.Value == NullString {
+		switch s.Operator {
+		case Equals:
+			return query.Not(query.Exists(propertyRef))
+		case NotEquals:
+			return query.Exists(propertyRef)
+		}
+	}
+
+	if s.Operator == ApproximatelyEquals {
+		var (
+			leading  = strings.HasPrefix(s.Value, SelectorPropertyFilterWildcard)
+			trailing = strings.HasSuffix(s.Value, SelectorPropertyFilterWildcard)
+			value    = strings.Trim(s.Value, SelectorPropertyFilterWildcard)
+		)
+
+		switch {
+		case leading && !trailing:
+			return query.CaseInsensitiveStringEndsWith(propertyRef, value)
+		case trailing && !leading:
+			return query.CaseInsensitiveStringStartsWith(propertyRef, value)
+		default:
+			return query.CaseInsensitiveStringContains(propertyRef, value)
+		}
+	}
+
+	return QueryParameterFilter{Name: s.Property, Operator: s.Operator, Value: s.Value}.BuildGDBNodeFilter()
+}
+
+// SelectorPropertyFilter is the value of a SelectorTypePropertyFilter seed. It selects every node of the given kind
+// that satisfies all predicates, optionally scoped to a single domain or tenant by its environment ID.
+type SelectorPropertyFilter struct {
+	Kind          string                      `json:"kind"`
+	EnvironmentId string                      `json:"environment_id,omitempty"`
+	Predicates    []SelectorPropertyPredicate `json:"predicates"`
+}
+
+// ParseSelectorPropertyFilter decodes and validates the value of a SelectorTypePropertyFilter seed
+func ParseSelectorPropertyFilter(raw string) (SelectorPropertyFilter, error) {
+	var (
+		filter  SelectorPropertyFilter
+		decoder = json.NewDecoder(strings.NewReader(raw))
+	)
+
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&filter); err != nil {
+		return filter, fmt.Errorf("property filter is not valid JSON: %w", err)
+	} else if decoder.More() {
+		return filter, errors.New("property filter must be a single JSON object")
+	}
+
+	return filter, filter.Validate()
+}
+
+func (s SelectorPropertyFilter) Validate() error {
+	if !selectorPropertyFilterNameRegex.MatchString(s.Kind) {
+		return fmt.Errorf("invalid kind %q", s.Kind)
+	} else if len(s.Predicates) > SelectorPropertyFilterMaxPredicates {
+		return fmt.Errorf("property filter supports at most %d predicates", SelectorPropertyFilterMaxPredicates)
+	}
+
+	for _, predicate := range s.Predicates {
+		if err := predicate.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s SelectorPropertyFilter) Criteria() graph.Criteria {
+	criteria := []graph.Criteria{query.Kind(query.Node(), graph.StringKind(s.Kind))}
+
+	if s.EnvironmentId != "" {
+		criteria = append(criteria, query.Or(
+			query.Equals(query.NodeProperty(ad.DomainSID.String()), s.EnvironmentId),
+			query.Equals(query.NodeProperty(azure.TenantID.String()), s.EnvironmentId),
+		))
+	}
+
+	for _, predicate := range s.Predicates {
+		criteria = append(criteria, predicate.Criteria())
+	}
+
+	return query.And(criteria...)
+}
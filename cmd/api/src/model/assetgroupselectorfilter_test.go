@@ -0,0 +1,157 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package model_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/dawgs/cypher/models/cypher"
+	"github.com/specterops/dawgs/cypher/models/cypher/format"
+	"github.com/specterops/dawgs/graph"
+	"github.com/stretchr/testify/require"
+)
+
+func formatCriteria(t *testing.T, criteria graph.Criteria) string {
+	t.Helper()
+
+	var buffer = &bytes.Buffer{}
+
+	require.NoError(t, format.NewCypherEmitter(true).WriteExpression(buffer, criteria.(cypher.Expression)))
+	return buffer.String()
+}
+
+func TestParseSelectorPropertyFilter(t *testing.T) {
+	testCases := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{
+			name:  "kind only",
+			input: `{"kind":"User"}`,
+		},
+		{
+			name:  "kind with environment and predicates",
+			input: `{"kind":"Computer","environment_id":"S-1-5-21-1","predicates":[{"property":"name","operator":"~eq","value":"*-PAW-*"},{"property":"enabled","operator":"eq","value":"true"}]}`,
+		},
+		{
+			name:    "not json",
+			input:   `MATCH (n) RETURN n`,
+			wantErr: "property filter is not valid JSON",
+		},
+		{
+			name:    "unknown field",
+			input:   `{"kind":"User","domain":"S-1-5-21-1"}`,
+			wantErr: "unknown field",
+		},
+		{
+			name:    "trailing content",
+			input:   `{"kind":"User"}{"kind":"Computer"}`,
+			wantErr: "single JSON object",
+		},
+		{
+			name:    "missing kind",
+			input:   `{"predicates":[]}`,
+			wantErr: "invalid kind",
+		},
+		{
+			name:    "invalid property name",
+			input:   `{"kind":"User","predicates":[{"property":"name) OR (1=1","operator":"eq","value":"x"}]}`,
+			wantErr: "invalid property name",
+		},
+		{
+			name:    "invalid operator",
+			input:   `{"kind":"User","predicates":[{"property":"name","operator":"like","value":"x"}]}`,
+			wantErr: "invalid operator",
+		},
+		{
+			name:    "inner wildcard",
+			input:   `{"kind":"User","predicates":[{"property":"name","operator":"~eq","value":"A*B"}]}`,
+			wantErr: "only supports a wildcard at the start or end",
+		},
+		{
+			name:    "wildcard only",
+			input:   `{"kind":"User","predicates":[{"property":"name","operator":"~eq","value":"**"}]}`,
+			wantErr: "requires a value to match",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := model.ParseSelectorPropertyFilter(testCase.input)
+			if testCase.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestSelectorPropertyFilter_Criteria(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  model.SelectorPropertyFilter
+		output string
+	}{
+		{
+			name:   "kind only",
+			input:  model.SelectorPropertyFilter{Kind: "User"},
+			output: "n:User",
+		},
+		{
+			name:   "environment scope",
+			input:  model.SelectorPropertyFilter{Kind: "User", EnvironmentId: "S-1-5-21-1"},
+			output: "n:User and (n.domainsid = $ or n.tenantid = $)",
+		},
+		{
+			name: "comparison predicates",
+			input: model.SelectorPropertyFilter{Kind: "User", Predicates: []model.SelectorPropertyPredicate{
+				{Property: "admincount", Operator: model.Equals, Value: "true"},
+				{Property: "pwdlastset", Operator: model.LessThan, Value: "1700000000"},
+				{Property: "enabled", Operator: model.NotEquals, Value: "false"},
+			}},
+			output: "n:User and n.admincount = $ and n.pwdlastset < $ and not (n.enabled = $)",
+		},
+		{
+			name: "null predicates",
+			input: model.SelectorPropertyFilter{Kind: "User", Predicates: []model.SelectorPropertyPredicate{
+				{Property: "email", Operator: model.Equals, Value: model.NullString},
+				{Property: "description", Operator: model.NotEquals, Value: model.NullString},
+			}},
+			output: "n:User and not (n.email is not $STRIPPED) and n.description is not $STRIPPED",
+		},
+		{
+			name: "approximate predicates",
+			input: model.SelectorPropertyFilter{Kind: "Computer", Predicates: []model.SelectorPropertyPredicate{
+				{Property: "name", Operator: model.ApproximatelyEquals, Value: "*-PAW-*"},
+				{Property: "name", Operator: model.ApproximatelyEquals, Value: "PAW*"},
+				{Property: "name", Operator: model.ApproximatelyEquals, Value: "*.CORP.LOCAL"},
+				{Property: "name", Operator: model.ApproximatelyEquals, Value: "PAW"},
+			}},
+			output: "n:Computer and toLower(n.name) contains $ and toLower(n.name) starts with $ and toLower(n.name) ends with $ and toLower(n.name) contains $",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.output, formatCriteria(t, testCase.input.Criteria()))
+		})
+	}
+}
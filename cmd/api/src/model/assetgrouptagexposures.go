@@ -0,0 +1,101 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package model
+
+import (
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+	"github.com/specterops/dawgs/graph"
+)
+
+// AssetGroupTagExposure is the exposure and impact of a tier measured by a single analysis run. Exposure counts the
+// distinct principals that are untiered or only in lower tiers with an attack path into the tier and Impact counts the
+// distinct objects outside the tier reachable by an attack path from its members.
+type AssetGroupTagExposure struct {
+	ID              int64     `json:"-"`
+	AssetGroupTagId int       `json:"asset_group_tag_id"`
+	AnalysisRunId   string    `json:"analysis_run_id"`
+	Exposure        int       `json:"exposure"`
+	Impact          int       `json:"impact"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+func (AssetGroupTagExposure) TableName() string {
+	return "asset_group_tag_exposures"
+}
+
+type AssetGroupTagExposures []AssetGroupTagExposure
+
+type AssetGroupTagChokePointType string
+
+const (
+	AssetGroupTagChokePointTypeEdge AssetGroupTagChokePointType = "edge"
+	AssetGroupTagChokePointTypeNode AssetGroupTagChokePointType = "node"
+)
+
+// AssetGroupTagChokePoint is an edge or an exposed principal on the attack paths into a tier, as found by the most
+// recent analysis. Principals counts the exposed principals that would be cut off from the tier if it were remediated
+// and Coverage is the fraction of all shortest attack paths into the tier that pass through it. Node choke points are
+// described by the source fields alone.
+type AssetGroupTagChokePoint struct {
+	ID              int64                       `json:"id"`
+	AssetGroupTagId int                         `json:"asset_group_tag_id"`
+	AnalysisRunId   string                      `json:"analysis_run_id"`
+	Type            AssetGroupTagChokePointType `json:"type"`
+	EdgeKind        string                      `json:"edge_kind"`
+	SourceNodeId    graph.ID                    `json:"source_node_id"`
+	SourceObjectId  string                      `json:"source_object_id"`
+	SourceName      string                      `json:"source_name"`
+	SourceKind      string                      `json:"source_kind"`
+	TargetNodeId    null.Int64                  `json:"target_node_id"`
+	TargetObjectId  string                      `json:"target_object_id"`
+	TargetName      string                      `json:"target_name"`
+	TargetKind      string                      `json:"target_kind"`
+	Principals      int                         `json:"principals"`
+	Coverage        float64                     `json:"coverage"`
+	CreatedAt       time.Time                   `json:"created_at"`
+}
+
+func (AssetGroupTagChokePoint) TableName() string {
+	return "asset_group_tag_choke_points"
+}
+
+func (s AssetGroupTagChokePoint) IsStringColumn(filter string) bool {
+	switch filter {
+	case "type", "edge_kind", "source_object_id", "source_name", "source_kind", "target_object_id", "target_name", "target_kind":
+		return true
+	default:
+		return false
+	}
+}
+
+func (s AssetGroupTagChokePoint) ValidFilters() map[string][]FilterOperator {
+	return map[string][]FilterOperator{
+		"type":             {Equals, NotEquals},
+		"edge_kind":        {Equals, NotEquals},
+		"source_object_id": {Equals, NotEquals},
+		"source_name":      {Equals, NotEquals, ApproximatelyEquals},
+		"source_kind":      {Equals, NotEquals},
+		"target_object_id": {Equals, NotEquals},
+		"target_name":      {Equals, NotEquals, ApproximatelyEquals},
+		"target_kind":      {Equals, NotEquals},
+		"principals":       {Equals, GreaterThan, GreaterThanOrEquals, LessThan, LessThanOrEquals, NotEquals},
+	}
+}
+
+type AssetGroupTagChokePoints []AssetGroupTagChokePoint
@@ -0,0 +1,74 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package model
+
+import (
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+	"github.com/specterops/dawgs/graph"
+)
+
+type AssetGroupMembershipAction string
+
+const (
+	AssetGroupMembershipActionAdded   AssetGroupMembershipAction = "added"
+	AssetGroupMembershipActionRemoved AssetGroupMembershipAction = "removed"
+)
+
+// AssetGroupTagMembershipEvent records a node being added to or removed from a tag by an analysis run. Added events
+// carry the selector that selected the node while removed events have no selector.
+type AssetGroupTagMembershipEvent struct {
+	ID              int64                      `json:"id"`
+	AssetGroupTagId int                        `json:"asset_group_tag_id"`
+	SelectorId      null.Int32                 `json:"selector_id"`
+	NodeId          graph.ID                   `json:"node_id"`
+	NodeObjectId    string                     `json:"node_object_id"`
+	NodeName        string                     `json:"node_name"`
+	NodeKind        string                     `json:"node_kind"`
+	Action          AssetGroupMembershipAction `json:"action"`
+	AnalysisRunId   string                     `json:"analysis_run_id"`
+	CreatedAt       time.Time                  `json:"created_at"`
+}
+
+func (AssetGroupTagMembershipEvent) TableName() string {
+	return "asset_group_tag_membership_events"
+}
+
+func (s AssetGroupTagMembershipEvent) IsStringColumn(filter string) bool {
+	switch filter {
+	case "node_object_id", "node_name", "node_kind", "action", "analysis_run_id":
+		return true
+	default:
+		return false
+	}
+}
+
+func (s AssetGroupTagMembershipEvent) ValidFilters() map[string][]FilterOperator {
+	return map[string][]FilterOperator{
+		"selector_id":     {Equals, NotEquals},
+		"node_id":         {Equals, NotEquals},
+		"node_object_id":  {Equals, NotEquals},
+		"node_name":       {Equals, NotEquals, ApproximatelyEquals},
+		"node_kind":       {Equals, NotEquals},
+		"action":          {Equals, NotEquals},
+		"analysis_run_id": {Equals, NotEquals},
+		"created_at":      {Equals, GreaterThan, GreaterThanOrEquals, LessThan, LessThanOrEquals, NotEquals},
+	}
+}
+
+type AssetGroupTagMembershipEvents []AssetGroupTagMembershipEvent
@@ -34,8 +34,9 @@ const (
 type SelectorType int
 
 const (
-	SelectorTypeObjectId SelectorType = 1
-	SelectorTypeCypher   SelectorType = 2
+	SelectorTypeObjectId       SelectorType = 1
+	SelectorTypeCypher         SelectorType = 2
+	SelectorTypePropertyFilter SelectorType = 3
 )
 
 type AssetGroupTagType int
@@ -55,6 +56,21 @@ const (
 	AssetGroupCertificationAuto    AssetGroupCertification = 2
 )
 
+func (s AssetGroupCertification) String() string {
+	switch s {
+	case AssetGroupCertificationRevoked:
+		return "revoked"
+	case AssetGroupCertificationNone:
+		return "pending"
+	case AssetGroupCertificationManual:
+		return "manual"
+	case AssetGroupCertificationAuto:
+		return "auto"
+	default:
+		return "unknown"
+	}
+}
+
 type AssetGroupSelectorNodeSource int
 
 const (
@@ -246,6 +262,7 @@ type AssetGroupSelectorNode struct {
 	NodeId      graph.ID                     `json:"node_id"`
 	Certified   AssetGroupCertification      `json:"certified"`
 	CertifiedBy null.String                  `json:"certified_by"`
+	CertifiedAt null.Time                    `json:"certified_at"`
 	Source      AssetGroupSelectorNodeSource `json:"source"`
 	CreatedAt   time.Time                    `json:"created_at"`
 	UpdatedAt   time.Time                    `json:"updated_at"`
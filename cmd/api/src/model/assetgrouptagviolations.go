@@ -0,0 +1,124 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package model
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+	"github.com/specterops/dawgs/graph"
+)
+
+// AssetGroupTagViolation is an attack path edge into a tier from a principal that is untiered or only in a lower tier,
+// as found by the most recent analysis
+type AssetGroupTagViolation struct {
+	ID              int64      `json:"id"`
+	AssetGroupTagId int        `json:"asset_group_tag_id"`
+	SourceTagId     null.Int32 `json:"source_tag_id"`
+	EdgeKind        string     `json:"edge_kind"`
+	SourceNodeId    graph.ID   `json:"source_node_id"`
+	SourceObjectId  string     `json:"source_object_id"`
+	SourceName      string     `json:"source_name"`
+	SourceKind      string     `json:"source_kind"`
+	TargetNodeId    graph.ID   `json:"target_node_id"`
+	TargetObjectId  string     `json:"target_object_id"`
+	TargetName      string     `json:"target_name"`
+	TargetKind      string     `json:"target_kind"`
+	Principals      int        `json:"principals"`
+	CreatedAt       time.Time  `json:"created_at"`
+}
+
+func (AssetGroupTagViolation) TableName() string {
+	return "asset_group_tag_violations"
+}
+
+func (s AssetGroupTagViolation) IsStringColumn(filter string) bool {
+	switch filter {
+	case "edge_kind", "source_object_id", "source_name", "source_kind", "target_object_id", "target_name", "target_kind":
+		return true
+	default:
+		return false
+	}
+}
+
+func (s AssetGroupTagViolation) ValidFilters() map[string][]FilterOperator {
+	return map[string][]FilterOperator{
+		"source_tag_id":    {Equals, NotEquals},
+		"edge_kind":        {Equals, NotEquals},
+		"source_object_id": {Equals, NotEquals},
+		"source_name":      {Equals, NotEquals, ApproximatelyEquals},
+		"source_kind":      {Equals, NotEquals},
+		"target_object_id": {Equals, NotEquals},
+		"target_name":      {Equals, NotEquals, ApproximatelyEquals},
+		"target_kind":      {Equals, NotEquals},
+		"principals":       {Equals, GreaterThan, GreaterThanOrEquals, LessThan, LessThanOrEquals, NotEquals},
+	}
+}
+
+type AssetGroupTagViolations []AssetGroupTagViolation
+
+var assetGroupTagViolationCSVColumns = []string{"source_tag_id", "source_object_id", "source_name", "source_kind", "edge_kind", "target_object_id", "target_name", "target_kind", "principals", "created_at"}
+
+func (s AssetGroupTagViolations) WriteCSV(writer io.Writer) error {
+	csvWriter := csv.NewWriter(writer)
+
+	if err := csvWriter.Write(assetGroupTagViolationCSVColumns); err != nil {
+		return err
+	}
+
+	for _, violation := range s {
+		var sourceTagId string
+		if violation.SourceTagId.Valid {
+			sourceTagId = strconv.Itoa(int(violation.SourceTagId.Int32))
+		}
+
+		if err := csvWriter.Write([]string{
+			sourceTagId,
+			violation.SourceObjectId,
+			violation.SourceName,
+			violation.SourceKind,
+			violation.EdgeKind,
+			violation.TargetObjectId,
+			violation.TargetName,
+			violation.TargetKind,
+			strconv.Itoa(violation.Principals),
+			violation.CreatedAt.UTC().Format(time.RFC3339),
+		}); err != nil {
+			return err
+		}
+	}
+
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+// AssetGroupTagViolationCount totals the distinct violating principals and edges into a tier from a single source tier.
+// A null SourceTagId counts untiered principals.
+type AssetGroupTagViolationCount struct {
+	AssetGroupTagId int        `json:"-"`
+	SourceTagId     null.Int32 `json:"source_tag_id"`
+	Principals      int        `json:"principals"`
+	Edges           int        `json:"edges"`
+	CreatedAt       time.Time  `json:"created_at"`
+}
+
+func (AssetGroupTagViolationCount) TableName() string {
+	return "asset_group_tag_violation_counts"
+}
@@ -17,6 +17,10 @@
 package model
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"log/slog"
 	"reflect"
@@ -47,6 +51,11 @@ const (
 	AuditLogActionCreateUser AuditLogAction = "CreateUser"
 	AuditLogActionUpdateUser AuditLogAction = "UpdateUser"
 	AuditLogActionDeleteUser AuditLogAction = "DeleteUser"
+	AuditLogActionLockUser   AuditLogAction = "LockUser"
+
+	AuditLogActionCreateRole AuditLogAction = "CreateRole"
+	AuditLogActionUpdateRole AuditLogAction = "UpdateRole"
+	AuditLogActionDeleteRole AuditLogAction = "DeleteRole"
 
 	AuditLogActionCreateAssetGroup AuditLogAction = "CreateAssetGroup"
 	AuditLogActionUpdateAssetGroup AuditLogAction = "UpdateAssetGroup"
@@ -61,16 +70,26 @@ const (
 	AuditLogActionUpdateAuthSecret AuditLogAction = "UpdateAuthSecret"
 	AuditLogActionDeleteAuthSecret AuditLogAction = "DeleteAuthSecret"
 
+	AuditLogActionCreateWebAuthnCredential AuditLogAction = "CreateWebAuthnCredential"
+	AuditLogActionUpdateWebAuthnCredential AuditLogAction = "UpdateWebAuthnCredential"
+	AuditLogActionDeleteWebAuthnCredential AuditLogAction = "DeleteWebAuthnCredential"
+
 	AuditLogActionCreateSAMLIdentityProvider AuditLogAction = "CreateSAMLIdentityProvider"
 	AuditLogActionUpdateSAMLIdentityProvider AuditLogAction = "UpdateSAMLIdentityProvider"
 
 	AuditLogActionCreateOIDCIdentityProvider AuditLogAction = "CreateOIDCIdentityProvider"
 	AuditLogActionUpdateOIDCIdentityProvider AuditLogAction = "UpdateOIDCIdentityProvider"
 
+	AuditLogActionCreateLDAPIdentityProvider AuditLogAction = "CreateLDAPIdentityProvider"
+	AuditLogActionUpdateLDAPIdentityProvider AuditLogAction = "UpdateLDAPIdentityProvider"
+
 	AuditLogActionCreateSSOIdentityProvider AuditLogAction = "CreateSSOIdentityProvider"
 	AuditLogActionUpdateSSOIdentityProvider AuditLogAction = "UpdateSSOIdentityProvider"
 	AuditLogActionDeleteSSOIdentityProvider AuditLogAction = "DeleteSSOIdentityProvider"
 
+	AuditLogActionIssueSCIMToken  AuditLogAction = "IssueSCIMToken"
+	AuditLogActionRevokeSCIMToken AuditLogAction = "RevokeSCIMToken"
+
 	AuditLogActionAcceptRisk   AuditLogAction = "AcceptRisk"
 	AuditLogActionUnacceptRisk AuditLogAction = "UnacceptRisk"
 
@@ -90,6 +109,7 @@ const (
 	AuditLogActionCreateAssetGroupTagSelector AuditLogAction = "CreateAssetGroupTagSelector"
 	AuditLogActionUpdateAssetGroupTagSelector AuditLogAction = "UpdateAssetGroupTagSelector"
 	AuditLogActionDeleteAssetGroupTagSelector AuditLogAction = "DeleteAssetGroupTagSelector"
+	AuditLogActionCertifyAssetGroupTagMembers AuditLogAction = "CertifyAssetGroupTagMembers"
 
 	AuditLogActionCreateCustomNodeKind AuditLogAction = "CreateCustomNodeKind"
 	AuditLogActionUpdateCustomNodeKind AuditLogAction = "UpdateCustomNodeKind"
@@ -100,6 +120,9 @@ const (
 	AuditLogActionCreateClient       AuditLogAction = "CreateClient"
 	AuditLogActionReplaceClientToken AuditLogAction = "ReplaceClientToken"
 
+	AuditLogActionPruneAuditLogs  AuditLogAction = "PruneAuditLogs"
+	AuditLogActionExportAuditLogs AuditLogAction = "ExportAuditLogs"
+
 	AuditLogActionImportSavedQuery   AuditLogAction = "ImportSavedQueries"
 	AuditLogActionExportSavedQuery   AuditLogAction = "ExportSavedQuery"
 	AuditLogActionExportSavedQueries AuditLogAction = "ExportSavedQueries"
@@ -118,12 +141,130 @@ type AuditLog struct {
 	SourceIpAddress string                  `json:"source_ip_address"`
 	Status          AuditLogEntryStatus     `json:"status"`
 	CommitID        uuid.UUID               `json:"commit_id" gorm:"type:text"`
+	PreviousHash    string                  `json:"previous_hash"`
+	Hash            string                  `json:"hash"`
 }
 
 func (s AuditLog) String() string {
 	return fmt.Sprintf("actor %s %s executed action %s", s.ActorID, s.ActorName, s.Action)
 }
 
+// ComputeHash returns the hex encoded HMAC-SHA256 of the entry's content and the hash of the entry before it, keyed
+// with the server's audit log hash key so that the chain cannot be recomputed by someone with only database access.
+// The fields are normalized through a JSON round trip so that the digest is the same before and after storage as jsonb.
+func (s AuditLog) ComputeHash(key []byte) (string, error) {
+	var normalizedFields any
+
+	if fieldsJSON, err := json.Marshal(s.Fields); err != nil {
+		return "", err
+	} else if err := json.Unmarshal(fieldsJSON, &normalizedFields); err != nil {
+		return "", err
+	} else if content, err := json.Marshal([]any{
+		s.ID,
+		s.CreatedAt.UTC().Format(time.RFC3339Nano),
+		s.ActorID,
+		s.ActorName,
+		s.ActorEmail,
+		s.Action,
+		normalizedFields,
+		s.RequestID,
+		s.SourceIpAddress,
+		s.Status,
+		s.CommitID.String(),
+		s.PreviousHash,
+	}); err != nil {
+		return "", err
+	} else {
+		mac := hmac.New(sha256.New, key)
+		mac.Write(content)
+
+		return hex.EncodeToString(mac.Sum(nil)), nil
+	}
+}
+
+// AuditLogChainVerification is the result of walking the audit log hash chain in ID order. Entries written before hash
+// chaining was introduced are counted as unhashed and skipped. AnchorHash is the previous hash of the first hashed
+// entry; it is empty unless older entries were pruned, in which case it must match the last_pruned_hash recorded by a
+// PruneAuditLogs entry. Keyed is false when no audit log hash key is configured, in which case the chain only detects
+// accidental changes.
+type AuditLogChainVerification struct {
+	key          []byte
+	anchorPruned bool
+
+	Verified      bool   `json:"verified"`
+	Keyed         bool   `json:"keyed"`
+	EntriesHashed int64  `json:"entries_hashed"`
+	Unhashed      int64  `json:"entries_unhashed"`
+	FirstID       int64  `json:"first_id,omitempty"`
+	LastID        int64  `json:"last_id,omitempty"`
+	AnchorHash    string `json:"anchor_hash,omitempty"`
+	LastHash      string `json:"last_hash,omitempty"`
+	BrokenAtID    int64  `json:"broken_at_id,omitempty"`
+	Reason        string `json:"reason,omitempty"`
+}
+
+// NewAuditLogChainVerification starts a verification that has seen no entries
+func NewAuditLogChainVerification(key []byte) AuditLogChainVerification {
+	return AuditLogChainVerification{key: key, Verified: true, Keyed: len(key) > 0}
+}
+
+// Add verifies the next entry of the chain. Entries must be added in ascending ID order. Returns false once the chain
+// is broken.
+func (s *AuditLogChainVerification) Add(auditLog AuditLog) bool {
+	if !s.Verified {
+		return false
+	} else if auditLog.Hash == "" {
+		if s.EntriesHashed > 0 {
+			return s.fail(auditLog.ID, "entry is missing its hash")
+		}
+
+		s.Unhashed++
+		return true
+	} else if s.EntriesHashed > 0 && auditLog.PreviousHash != s.LastHash {
+		return s.fail(auditLog.ID, "previous hash does not match the preceding entry; entries were deleted or reordered")
+	} else if hash, err := auditLog.ComputeHash(s.key); err != nil {
+		return s.fail(auditLog.ID, fmt.Sprintf("hash could not be computed: %v", err))
+	} else if hash != auditLog.Hash {
+		return s.fail(auditLog.ID, "hash does not match the entry content; the entry was altered")
+	}
+
+	if s.EntriesHashed == 0 {
+		s.FirstID = auditLog.ID
+		s.AnchorHash = auditLog.PreviousHash
+	}
+
+	if auditLog.Action == AuditLogActionPruneAuditLogs && s.AnchorHash != "" && auditLog.Fields["last_pruned_hash"] == s.AnchorHash {
+		s.anchorPruned = true
+	}
+
+	s.EntriesHashed++
+	s.LastID = auditLog.ID
+	s.LastHash = auditLog.Hash
+
+	return true
+}
+
+// Finish completes the verification once every entry has been added. A chain that starts by linking to an earlier
+// entry is only intact if that entry was removed by pruning, otherwise the head of the chain was deleted. Returns false
+// if the chain is broken.
+func (s *AuditLogChainVerification) Finish() bool {
+	if !s.Verified {
+		return false
+	} else if s.AnchorHash != "" && !s.anchorPruned {
+		return s.fail(s.FirstID, "first entry links to an entry that was not pruned; earlier entries were deleted")
+	}
+
+	return true
+}
+
+func (s *AuditLogChainVerification) fail(id int64, reason string) bool {
+	s.Verified = false
+	s.BrokenAtID = id
+	s.Reason = reason
+
+	return false
+}
+
 type AuditLogs []AuditLog
 
 func (s AuditLogs) IsSortable(column string) bool {
@@ -18,8 +18,10 @@ package model
 
 import (
 	"testing"
+	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -130,3 +132,160 @@ func TestMatches_FailureErrMsg(t *testing.T) {
 
 	assert.False(t, errMsgEntry1.Matches(errMsgEntry2), "Expected errMsgsEntry1 not to match errMsgEntry2")
 }
+
+var testAuditLogHashKey = []byte("audit log hash key")
+
+func newChainedAuditLogs(t *testing.T, count int) AuditLogs {
+	var (
+		auditLogs    = make(AuditLogs, 0, count)
+		previousHash string
+	)
+
+	for i := 1; i <= count; i++ {
+		auditLog := AuditLog{
+			ID:           int64(i),
+			CreatedAt:    time.Date(2026, 1, 1, 0, 0, i, 0, time.UTC),
+			ActorName:    "actor",
+			Action:       AuditLogActionCreateUser,
+			Fields:       types.JSONUntypedObject{"index": i},
+			Status:       AuditLogStatusSuccess,
+			PreviousHash: previousHash,
+		}
+
+		hash, err := auditLog.ComputeHash(testAuditLogHashKey)
+		assert.Nil(t, err)
+
+		auditLog.Hash = hash
+		previousHash = hash
+		auditLogs = append(auditLogs, auditLog)
+	}
+
+	return auditLogs
+}
+
+// appendPruneAuditLog chains a PruneAuditLogs entry that records the given hash as the last pruned entry
+func appendPruneAuditLog(t *testing.T, auditLogs AuditLogs, lastPrunedHash string) AuditLogs {
+	var (
+		last     = auditLogs[len(auditLogs)-1]
+		pruneLog = AuditLog{
+			ID:           last.ID + 1,
+			CreatedAt:    last.CreatedAt.Add(time.Second),
+			Action:       AuditLogActionPruneAuditLogs,
+			Fields:       types.JSONUntypedObject{"last_pruned_hash": lastPrunedHash},
+			Status:       AuditLogStatusSuccess,
+			PreviousHash: last.Hash,
+		}
+	)
+
+	hash, err := pruneLog.ComputeHash(testAuditLogHashKey)
+	assert.Nil(t, err)
+
+	pruneLog.Hash = hash
+	return append(auditLogs, pruneLog)
+}
+
+func TestAuditLog_ComputeHash(t *testing.T) {
+	auditLog := newChainedAuditLogs(t, 1)[0]
+
+	// Integers stored in jsonb are read back as float64 and must not change the hash
+	stored := auditLog
+	stored.Fields = types.JSONUntypedObject{"index": float64(1)}
+
+	hash, err := stored.ComputeHash(testAuditLogHashKey)
+	assert.Nil(t, err)
+	assert.Equal(t, auditLog.Hash, hash)
+
+	stored.ActorName = "someone else"
+	hash, err = stored.ComputeHash(testAuditLogHashKey)
+	assert.Nil(t, err)
+	assert.NotEqual(t, auditLog.Hash, hash)
+
+	// Hashes depend on the key, an altered entry can not be rehashed without it
+	hash, err = auditLog.ComputeHash([]byte("another key"))
+	assert.Nil(t, err)
+	assert.NotEqual(t, auditLog.Hash, hash)
+}
+
+func TestAuditLogChainVerification_Verified(t *testing.T) {
+	var (
+		verification = NewAuditLogChainVerification(testAuditLogHashKey)
+		auditLogs    = newChainedAuditLogs(t, 3)
+	)
+
+	auditLogs = appendPruneAuditLog(t, auditLogs, auditLogs[0].Hash)
+
+	// Entries written before hash chaining are skipped
+	assert.True(t, verification.Add(AuditLog{ID: 0}))
+
+	for _, auditLog := range auditLogs[1:] {
+		assert.True(t, verification.Add(auditLog))
+	}
+
+	assert.True(t, verification.Finish())
+	assert.True(t, verification.Verified)
+	assert.True(t, verification.Keyed)
+	assert.Equal(t, int64(3), verification.EntriesHashed)
+	assert.Equal(t, int64(1), verification.Unhashed)
+	assert.Equal(t, int64(2), verification.FirstID)
+	assert.Equal(t, int64(4), verification.LastID)
+	assert.Equal(t, auditLogs[0].Hash, verification.AnchorHash)
+	assert.Equal(t, auditLogs[3].Hash, verification.LastHash)
+}
+
+func TestAuditLogChainVerification_HeadDeleted(t *testing.T) {
+	for name, auditLogs := range map[string]AuditLogs{
+		"no prune entry":         newChainedAuditLogs(t, 3),
+		"mismatched prune entry": appendPruneAuditLog(t, newChainedAuditLogs(t, 3), "another hash"),
+	} {
+		t.Run(name, func(t *testing.T) {
+			verification := NewAuditLogChainVerification(testAuditLogHashKey)
+
+			for _, auditLog := range auditLogs[1:] {
+				assert.True(t, verification.Add(auditLog))
+			}
+
+			assert.False(t, verification.Finish())
+			assert.False(t, verification.Verified)
+			assert.Equal(t, int64(2), verification.BrokenAtID)
+			assert.Contains(t, verification.Reason, "not pruned")
+		})
+	}
+}
+
+func TestAuditLogChainVerification_Altered(t *testing.T) {
+	var (
+		verification = NewAuditLogChainVerification(testAuditLogHashKey)
+		auditLogs    = newChainedAuditLogs(t, 3)
+	)
+
+	auditLogs[1].Status = AuditLogStatusFailure
+
+	assert.True(t, verification.Add(auditLogs[0]))
+	assert.False(t, verification.Add(auditLogs[1]))
+	assert.False(t, verification.Add(auditLogs[2]))
+	assert.False(t, verification.Verified)
+	assert.Equal(t, int64(2), verification.BrokenAtID)
+	assert.Contains(t, verification.Reason, "altered")
+}
+
+func TestAuditLogChainVerification_WrongKey(t *testing.T) {
+	var (
+		verification = NewAuditLogChainVerification([]byte("another key"))
+		auditLogs    = newChainedAuditLogs(t, 1)
+	)
+
+	assert.False(t, verification.Add(auditLogs[0]))
+	assert.Equal(t, int64(1), verification.BrokenAtID)
+}
+
+func TestAuditLogChainVerification_Deleted(t *testing.T) {
+	var (
+		verification = NewAuditLogChainVerification(testAuditLogHashKey)
+		auditLogs    = newChainedAuditLogs(t, 3)
+	)
+
+	assert.True(t, verification.Add(auditLogs[0]))
+	assert.False(t, verification.Add(auditLogs[2]))
+	assert.Equal(t, int64(3), verification.BrokenAtID)
+	assert.Contains(t, verification.Reason, "deleted")
+}
@@ -18,10 +18,13 @@ package model
 
 import (
 	"fmt"
+	"net/netip"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/lib/pq"
 	"github.com/specterops/bloodhound/cmd/api/src/database/types"
 	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
 )
@@ -145,44 +148,91 @@ func (s Permissions) Has(other Permission) bool {
 }
 
 type AuthToken struct {
-	UserID     uuid.NullUUID `json:"user_id" gorm:"type:text"`
-	ClientID   uuid.NullUUID `json:"-"  gorm:"type:text"`
-	Name       null.String   `json:"name"`
-	Key        string        `json:"key,omitempty"`
-	HmacMethod string        `json:"hmac_method"`
-	LastAccess time.Time     `json:"last_access"`
+	UserID       uuid.NullUUID  `json:"user_id" gorm:"type:text"`
+	ClientID     uuid.NullUUID  `json:"-"  gorm:"type:text"`
+	Name         null.String    `json:"name"`
+	Key          string         `json:"key,omitempty"`
+	HmacMethod   string         `json:"hmac_method"`
+	LastAccess   time.Time      `json:"last_access"`
+	ExpiresAt    null.Time      `json:"expires_at"`
+	AllowedCIDRs pq.StringArray `json:"allowed_cidrs" gorm:"type:text[];column:allowed_cidrs"`
+	Permissions  Permissions    `json:"permissions" gorm:"many2many:auth_tokens_permissions"`
 
 	Unique
 }
 
 func (s AuthToken) AuditData() AuditData {
+	permissions := make([]string, len(s.Permissions))
+	for idx, permission := range s.Permissions {
+		permissions[idx] = permission.String()
+	}
+
 	return AuditData{
-		"id":          s.ID,
-		"user_id":     s.UserID,
-		"client_id":   s.ClientID,
-		"name":        s.Name,
-		"last_access": s.LastAccess,
+		"id":            s.ID,
+		"user_id":       s.UserID,
+		"client_id":     s.ClientID,
+		"name":          s.Name,
+		"last_access":   s.LastAccess,
+		"expires_at":    s.ExpiresAt,
+		"allowed_cidrs": s.AllowedCIDRs,
+		"permissions":   permissions,
 	}
 }
 
 func (s AuthToken) StripKey() AuthToken {
 	return AuthToken{
-		UserID:     s.UserID,
-		ClientID:   s.ClientID,
-		Key:        "",
-		HmacMethod: s.HmacMethod,
-		LastAccess: s.LastAccess,
-		Unique:     s.Unique,
-		Name:       s.Name,
+		UserID:       s.UserID,
+		ClientID:     s.ClientID,
+		Key:          "",
+		HmacMethod:   s.HmacMethod,
+		LastAccess:   s.LastAccess,
+		ExpiresAt:    s.ExpiresAt,
+		AllowedCIDRs: s.AllowedCIDRs,
+		Permissions:  s.Permissions,
+		Unique:       s.Unique,
+		Name:         s.Name,
 	}
 }
 
+// IsExpired returns true if the token carries an expiration time that has passed as of the given time
+func (s AuthToken) IsExpired(now time.Time) bool {
+	return s.ExpiresAt.Valid && !now.Before(s.ExpiresAt.Time)
+}
+
+// IsScoped returns true if the token is restricted to a subset of its owner's permissions
+func (s AuthToken) IsScoped() bool {
+	return len(s.Permissions) > 0
+}
+
+// AllowsAddress returns true if the given client address falls within one of the token's allowed CIDRs. Tokens without
+// CIDR restrictions allow every address.
+func (s AuthToken) AllowsAddress(address string) bool {
+	if len(s.AllowedCIDRs) == 0 {
+		return true
+	}
+
+	if addr, err := netip.ParseAddr(strings.TrimSpace(address)); err != nil {
+		return false
+	} else {
+		addr = addr.Unmap()
+
+		for _, rawCIDR := range s.AllowedCIDRs {
+			if prefix, err := netip.ParsePrefix(rawCIDR); err == nil && prefix.Contains(addr) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 type AuthTokens []AuthToken
 
 func (s AuthTokens) IsSortable(column string) bool {
 	switch column {
 	case "name",
 		"last_access",
+		"expires_at",
 		"created_at",
 		"updated_at",
 		"deleted_at":
@@ -200,6 +250,7 @@ func (s AuthTokens) ValidFilters() map[string][]FilterOperator {
 		"hmac_method": {Equals, NotEquals},
 		"id":          {Equals, NotEquals},
 		"last_access": {Equals, GreaterThan, GreaterThanOrEquals, LessThan, LessThanOrEquals, NotEquals},
+		"expires_at":  {Equals, GreaterThan, GreaterThanOrEquals, LessThan, LessThanOrEquals, NotEquals},
 		"created_at":  {Equals, GreaterThan, GreaterThanOrEquals, LessThan, LessThanOrEquals, NotEquals},
 		"updated_at":  {Equals, GreaterThan, GreaterThanOrEquals, LessThan, LessThanOrEquals, NotEquals},
 		"deleted_at":  {Equals, GreaterThan, GreaterThanOrEquals, LessThan, LessThanOrEquals, NotEquals},
@@ -261,6 +312,19 @@ type AuthSecret struct {
 	Serial
 }
 
+// AuthSecretHistory records the digest of a previous auth secret of a user so that recently used secrets can be rejected
+type AuthSecretHistory struct {
+	ID           int64 `gorm:"primaryKey"`
+	UserID       uuid.UUID
+	Digest       string
+	DigestMethod string
+	CreatedAt    time.Time
+}
+
+func (AuthSecretHistory) TableName() string {
+	return "auth_secret_history"
+}
+
 // Expired returns true if the auth secret has expired, false otherwise
 func (s AuthSecret) Expired() bool {
 	return s.ExpiresAt.Before(time.Now().UTC())
@@ -284,17 +348,32 @@ type Role struct {
 	Name        string      `json:"name"`
 	Description string      `json:"description"`
 	Permissions Permissions `json:"permissions" gorm:"many2many:roles_permissions"`
+	Custom      bool        `json:"custom"`
 
 	Serial
 }
 
 func (s Role) AuditData() AuditData {
+	permissions := make([]string, len(s.Permissions))
+
+	for idx, permission := range s.Permissions {
+		permissions[idx] = permission.URI().String()
+	}
+
 	return AuditData{
-		"role_id":   s.ID,
-		"role_name": s.Name,
+		"role_id":          s.ID,
+		"role_name":        s.Name,
+		"role_custom":      s.Custom,
+		"role_permissions": permissions,
 	}
 }
 
+// Slug returns the name of the role as it is expected in the roles claim of an SSO provider with role provisioning
+// enabled: lower cased, dashes for spaces and prefixed by `bh`, e.g. bh-power-user
+func (s Role) Slug() string {
+	return fmt.Sprintf("bh-%s", strings.ReplaceAll(strings.ToLower(s.Name), " ", "-"))
+}
+
 type Roles []Role
 
 func (s Roles) IsSortable(column string) bool {
@@ -421,6 +500,7 @@ func UserAssociations() []string {
 		"SSOProvider",
 		"SSOProvider.SAMLProvider", // Needed to populate the child provider
 		"SSOProvider.OIDCProvider", // Needed to populate the child provider
+		"SSOProvider.LDAPProvider", // Needed to populate the child provider
 		"AuthSecret",
 		"AuthTokens",
 		"Roles.Permissions",
@@ -440,6 +520,11 @@ type User struct {
 	LastLogin     time.Time    `json:"last_login"`
 	IsDisabled    bool         `json:"is_disabled"`
 
+	// FailedLoginAttempts counts consecutive failed local logins, it is reset on a successful login. Once the count
+	// reaches the password policy's lockout threshold the account is locked until LockedUntil.
+	FailedLoginAttempts int       `json:"-"`
+	LockedUntil         null.Time `json:"locked_until"`
+
 	// EULA Acceptance does not pertain to Bloodhound Community Edition; this flag is used for Bloodhound Enterprise users.
 	// This value is automatically set to true for Bloodhound Community Edition in the patchEULAAcceptance and CreateUser functions.
 	EULAAccepted bool `json:"eula_accepted"`
@@ -457,10 +542,16 @@ func (s *User) AuditData() AuditData {
 		"roles":           s.Roles.IDs(),
 		"sso_provider_id": s.SSOProviderID.ValueOrZero(),
 		"is_disabled":     s.IsDisabled,
+		"locked_until":    s.LockedUntil,
 		"eula_accepted":   s.EULAAccepted,
 	}
 }
 
+// IsLocked returns true if the user is locked out of local logins at the given time
+func (s *User) IsLocked(now time.Time) bool {
+	return s.LockedUntil.Valid && s.LockedUntil.Time.After(now)
+}
+
 func (s *User) RemoveRole(role Role) {
 	s.Roles = s.Roles.RemoveByName(role.Name)
 }
@@ -539,6 +630,7 @@ func UserSessionAssociations() []string {
 		"User.SSOProvider",
 		"User.SSOProvider.SAMLProvider", // Needed to populate the child provider
 		"User.SSOProvider.OIDCProvider", // Needed to populate the child provider
+		"User.SSOProvider.LDAPProvider", // Needed to populate the child provider
 		"User.AuthSecret",
 		"User.AuthTokens",
 		"User.Roles.Permissions",
@@ -551,6 +643,7 @@ const (
 	SessionAuthProviderSecret SessionAuthProvider = 0
 	SessionAuthProviderSAML   SessionAuthProvider = 1
 	SessionAuthProviderOIDC   SessionAuthProvider = 2
+	SessionAuthProviderLDAP   SessionAuthProvider = 3
 )
 
 func (s SessionAuthProvider) String() string {
@@ -561,6 +654,8 @@ func (s SessionAuthProvider) String() string {
 		return "SAML"
 	case SessionAuthProviderOIDC:
 		return "OIDC"
+	case SessionAuthProviderLDAP:
+		return "LDAP"
 	default:
 		return "Unknown"
 	}
@@ -579,6 +674,8 @@ type UserSession struct {
 	AuthProviderID   int32 // If SSO Session, this will be the child saml or oidc provider id
 	ExpiresAt        time.Time
 	Flags            types.JSONBBoolObject `json:"flags"`
+	SourceIPAddress  string                `gorm:"column:source_ip_address"`
+	UserAgent        string
 
 	BigSerial
 }
@@ -0,0 +1,50 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthToken_IsExpired(t *testing.T) {
+	now := time.Now()
+
+	assert.False(t, model.AuthToken{}.IsExpired(now))
+	assert.False(t, model.AuthToken{ExpiresAt: null.TimeFrom(now.Add(time.Minute))}.IsExpired(now))
+	assert.True(t, model.AuthToken{ExpiresAt: null.TimeFrom(now)}.IsExpired(now))
+	assert.True(t, model.AuthToken{ExpiresAt: null.TimeFrom(now.Add(-time.Minute))}.IsExpired(now))
+}
+
+func TestAuthToken_AllowsAddress(t *testing.T) {
+	var (
+		unrestricted = model.AuthToken{}
+		restricted   = model.AuthToken{AllowedCIDRs: []string{"10.0.0.0/8", "2001:db8::/32"}}
+	)
+
+	assert.True(t, unrestricted.AllowsAddress("203.0.113.7"))
+	assert.True(t, restricted.AllowsAddress("10.20.30.40"))
+	assert.True(t, restricted.AllowsAddress("::ffff:10.20.30.40"))
+	assert.True(t, restricted.AllowsAddress("2001:db8::1"))
+	assert.False(t, restricted.AllowsAddress("203.0.113.7"))
+	assert.False(t, restricted.AllowsAddress("not-an-ip"))
+	assert.False(t, restricted.AllowsAddress(""))
+}
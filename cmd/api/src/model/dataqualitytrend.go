@@ -0,0 +1,70 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package model
+
+import "time"
+
+type DataQualityRegressionMetric string
+
+const (
+	DataQualityRegressionSessionCompleteness    DataQualityRegressionMetric = "session_completeness"
+	DataQualityRegressionLocalGroupCompleteness DataQualityRegressionMetric = "local_group_completeness"
+	DataQualityRegressionCollectionStopped      DataQualityRegressionMetric = "collection_stopped"
+)
+
+// ADDataQualityDelta holds the change of each AD data quality stat between a run and the run before it
+type ADDataQualityDelta struct {
+	RunID                  string    `json:"run_id"`
+	CreatedAt              time.Time `json:"created_at"`
+	Users                  int       `json:"users"`
+	Groups                 int       `json:"groups"`
+	Computers              int       `json:"computers"`
+	OUs                    int       `json:"ous"`
+	Containers             int       `json:"containers"`
+	GPOs                   int       `json:"gpos"`
+	ACLs                   int       `json:"acls"`
+	Sessions               int       `json:"sessions"`
+	Relationships          int       `json:"relationships"`
+	SessionCompleteness    float64   `json:"session_completeness"`
+	LocalGroupCompleteness float64   `json:"local_group_completeness"`
+}
+
+// DataQualityRegression is a run where a data quality metric regressed. Completeness regressions hold the completeness
+// of the previous and current runs, while a stopped collection holds the hours since the domain was last ingested as of
+// each run.
+type DataQualityRegression struct {
+	RunID     string                      `json:"run_id"`
+	CreatedAt time.Time                   `json:"created_at"`
+	Metric    DataQualityRegressionMetric `json:"metric"`
+	Previous  float64                     `json:"previous"`
+	Current   float64                     `json:"current"`
+}
+
+// StaleEnvironment is a collected domain or tenant that has not been seen in a collection within the stale window
+type StaleEnvironment struct {
+	ObjectID string    `json:"object_id"`
+	Name     string    `json:"name"`
+	Kind     string    `json:"kind"`
+	LastSeen time.Time `json:"last_seen"`
+}
+
+type ADDataQualityTrend struct {
+	DomainSID         string                  `json:"domain_sid"`
+	Deltas            []ADDataQualityDelta    `json:"deltas"`
+	Regressions       []DataQualityRegression `json:"regressions"`
+	StaleEnvironments []StaleEnvironment      `json:"stale_environments"`
+}
@@ -0,0 +1,144 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package model
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+)
+
+type FindingSeverity string
+
+const (
+	FindingSeverityCritical FindingSeverity = "critical"
+	FindingSeverityHigh     FindingSeverity = "high"
+	FindingSeverityModerate FindingSeverity = "moderate"
+	FindingSeverityLow      FindingSeverity = "low"
+)
+
+// Finding is a single instance of a named finding definition within an environment. Instances are keyed by the
+// principal and, for findings that describe a relationship, the target object ID. An instance that was not found by
+// the most recent analysis is marked resolved until it is seen again.
+type Finding struct {
+	ID                int64       `json:"id"`
+	Finding           string      `json:"finding"`
+	EnvironmentId     string      `json:"environment_id"`
+	PrincipalObjectId string      `json:"principal_object_id"`
+	PrincipalName     string      `json:"principal_name"`
+	PrincipalKind     string      `json:"principal_kind"`
+	TargetObjectId    string      `json:"target_object_id"`
+	TargetName        string      `json:"target_name"`
+	TargetKind        string      `json:"target_kind"`
+	FirstSeen         time.Time   `json:"first_seen"`
+	LastSeen          time.Time   `json:"last_seen"`
+	ResolvedAt        null.Time   `json:"resolved_at"`
+	Accepted          bool        `json:"accepted"`
+	AcceptedBy        null.String `json:"accepted_by"`
+	AcceptedAt        null.Time   `json:"accepted_at"`
+}
+
+func (Finding) TableName() string {
+	return "findings"
+}
+
+func (s Finding) AuditData() AuditData {
+	return AuditData{
+		"id":                  s.ID,
+		"finding":             s.Finding,
+		"environment_id":      s.EnvironmentId,
+		"principal_object_id": s.PrincipalObjectId,
+		"target_object_id":    s.TargetObjectId,
+		"accepted":            s.Accepted,
+	}
+}
+
+func (s Finding) IsStringColumn(filter string) bool {
+	switch filter {
+	case "finding", "environment_id", "principal_object_id", "principal_name", "principal_kind", "target_object_id", "target_name", "target_kind":
+		return true
+	default:
+		return false
+	}
+}
+
+func (s Finding) ValidFilters() map[string][]FilterOperator {
+	return map[string][]FilterOperator{
+		"finding":             {Equals, NotEquals},
+		"environment_id":      {Equals, NotEquals},
+		"principal_object_id": {Equals, NotEquals},
+		"principal_name":      {Equals, NotEquals, ApproximatelyEquals},
+		"principal_kind":      {Equals, NotEquals},
+		"target_object_id":    {Equals, NotEquals},
+		"target_name":         {Equals, NotEquals, ApproximatelyEquals},
+		"target_kind":         {Equals, NotEquals},
+		"first_seen":          {Equals, GreaterThan, GreaterThanOrEquals, LessThan, LessThanOrEquals, NotEquals},
+		"last_seen":           {Equals, GreaterThan, GreaterThanOrEquals, LessThan, LessThanOrEquals, NotEquals},
+		"resolved_at":         {Equals, GreaterThan, GreaterThanOrEquals, LessThan, LessThanOrEquals, NotEquals},
+		"accepted":            {Equals, NotEquals},
+	}
+}
+
+type Findings []Finding
+
+var findingCSVColumns = []string{"finding", "environment_id", "principal_object_id", "principal_name", "principal_kind", "target_object_id", "target_name", "target_kind", "first_seen", "last_seen", "resolved_at", "accepted"}
+
+func (s Findings) WriteCSV(writer io.Writer) error {
+	csvWriter := csv.NewWriter(writer)
+
+	if err := csvWriter.Write(findingCSVColumns); err != nil {
+		return err
+	}
+
+	for _, finding := range s {
+		var resolvedAt string
+		if finding.ResolvedAt.Valid {
+			resolvedAt = finding.ResolvedAt.Time.UTC().Format(time.RFC3339)
+		}
+
+		if err := csvWriter.Write([]string{
+			finding.Finding,
+			finding.EnvironmentId,
+			finding.PrincipalObjectId,
+			finding.PrincipalName,
+			finding.PrincipalKind,
+			finding.TargetObjectId,
+			finding.TargetName,
+			finding.TargetKind,
+			finding.FirstSeen.UTC().Format(time.RFC3339),
+			finding.LastSeen.UTC().Format(time.RFC3339),
+			resolvedAt,
+			strconv.FormatBool(finding.Accepted),
+		}); err != nil {
+			return err
+		}
+	}
+
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+// FindingCount totals the instances of a finding definition by state
+type FindingCount struct {
+	Finding  string `json:"finding"`
+	Open     int    `json:"open"`
+	Accepted int    `json:"accepted"`
+	Resolved int    `json:"resolved"`
+}
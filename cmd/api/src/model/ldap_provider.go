@@ -0,0 +1,67 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package model
+
+// LDAPProvider contains the data needed to authenticate users with a simple bind against an LDAP directory
+type LDAPProvider struct {
+	// URL is the address of the directory server using either the ldap:// or ldaps:// scheme
+	URL string `json:"url"`
+	// StartTLS upgrades a plaintext ldap:// connection to TLS before any credentials are sent
+	StartTLS bool `json:"start_tls"`
+	// AllowInsecure permits a plaintext ldap:// connection without StartTLS, which sends the service account and user
+	// passwords in the clear. It must be set explicitly and is otherwise rejected.
+	AllowInsecure bool `json:"allow_insecure"`
+	// CACertificate is an optional PEM encoded certificate bundle used to verify the directory server
+	CACertificate string `json:"ca_certificate"`
+
+	// BindDN and BindPassword are the service account credentials used to search for the user's entry. An empty
+	// BindDN searches anonymously. BindPassword is stored sealed with the configured secrets encryption key.
+	BindDN       string `json:"bind_dn"`
+	BindPassword string `json:"-"`
+
+	UserBaseDN        string `json:"user_base_dn"`
+	UserFilter        string `json:"user_filter"`
+	UsernameAttribute string `json:"username_attribute"`
+	EmailAttribute    string `json:"email_attribute"`
+	// GroupAttribute names the attribute listing the user's group DNs, which are matched against the role mappings of
+	// the provider's auto provision config
+	GroupAttribute string `json:"group_attribute"`
+
+	SSOProviderID int `json:"sso_provider_id"`
+
+	Serial
+}
+
+func (LDAPProvider) TableName() string {
+	return "ldap_providers"
+}
+
+func (s LDAPProvider) AuditData() AuditData {
+	return AuditData{
+		"id":                 s.ID,
+		"url":                s.URL,
+		"start_tls":          s.StartTLS,
+		"allow_insecure":     s.AllowInsecure,
+		"bind_dn":            s.BindDN,
+		"user_base_dn":       s.UserBaseDN,
+		"user_filter":        s.UserFilter,
+		"username_attribute": s.UsernameAttribute,
+		"email_attribute":    s.EmailAttribute,
+		"group_attribute":    s.GroupAttribute,
+		"sso_provider_id":    s.SSOProviderID,
+	}
+}
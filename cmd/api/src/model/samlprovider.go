@@ -41,6 +41,7 @@ const (
 	XMLSOAPClaimsName         = "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/name"
 	XMLSOAPClaimsSurname      = "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/surname"
 	MicrosoftClaimsRole       = "http://schemas.microsoft.com/ws/2008/06/identity/claims/role"
+	MicrosoftClaimsGroups     = "http://schemas.microsoft.com/ws/2008/06/identity/claims/groups"
 )
 
 var (
@@ -189,6 +190,26 @@ func (s SAMLProvider) GetSAMLUserRolesFromAssertion(assertion *saml.Assertion) (
 	return roles
 }
 
+// GetSAMLUserGroupsFromAssertion returns the values of the group claim, MicrosoftClaimsGroups if none is configured.
+// May be empty if not present
+func (s SAMLProvider) GetSAMLUserGroupsFromAssertion(assertion *saml.Assertion, groupClaim string) (groups []string) {
+	if groupClaim == "" {
+		groupClaim = MicrosoftClaimsGroups
+	}
+
+	for _, attributeStatement := range assertion.AttributeStatements {
+		for _, attribute := range attributeStatement.Attributes {
+			if attribute.Name == groupClaim || attribute.FriendlyName == groupClaim {
+				for _, value := range attribute.Values {
+					groups = append(groups, value.Value)
+				}
+			}
+		}
+	}
+
+	return groups
+}
+
 func (s SAMLProvider) GetSAMLUserSurnameFromAssertion(assertion *saml.Assertion) (string, error) {
 	return assertionFindString(assertion, s.surnameAttributeNames()...)
 }
@@ -21,12 +21,48 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+)
+
+// SSOProviderRoleMappingPrecedence determines which role is provisioned when more than one role matches a user's claims
+type SSOProviderRoleMappingPrecedence string
+
+const (
+	// SSOProviderRoleMappingPrecedenceNone falls back to the default role when more than one role matches
+	SSOProviderRoleMappingPrecedenceNone SSOProviderRoleMappingPrecedence = ""
+	// SSOProviderRoleMappingPrecedenceHighestPrivilege provisions the matching role that ranks highest in the built-in
+	// role order, from Upload-Only and Read-Only through User and Power User to Administrator
+	SSOProviderRoleMappingPrecedenceHighestPrivilege SSOProviderRoleMappingPrecedence = "highest_privilege"
+	// SSOProviderRoleMappingPrecedenceOrdered provisions the role of the first matching mapping
+	SSOProviderRoleMappingPrecedenceOrdered SSOProviderRoleMappingPrecedence = "ordered"
 )
 
+func (s SSOProviderRoleMappingPrecedence) IsValid() bool {
+	switch s {
+	case SSOProviderRoleMappingPrecedenceNone, SSOProviderRoleMappingPrecedenceHighestPrivilege, SSOProviderRoleMappingPrecedenceOrdered:
+		return true
+	default:
+		return false
+	}
+}
+
+// SSOProviderRoleMapping maps a group or claim value asserted by the IdP to a role
+type SSOProviderRoleMapping struct {
+	ClaimValue string `json:"claim_value"`
+	RoleID     int32  `json:"role_id"`
+}
+
 type SSOProviderAutoProvisionConfig struct {
 	Enabled       bool  `json:"enabled"`
 	DefaultRoleId int32 `json:"default_role_id"`
 	RoleProvision bool  `json:"role_provision"`
+
+	// GroupClaim names the OIDC claim or SAML attribute holding the user's groups. When empty, the groups claim and the
+	// Microsoft groups claim are used for OIDC and SAML respectively.
+	GroupClaim            string                           `json:"group_claim,omitempty"`
+	RoleMappings          []SSOProviderRoleMapping         `json:"role_mappings,omitempty"`
+	RoleMappingPrecedence SSOProviderRoleMappingPrecedence `json:"role_mapping_precedence,omitempty"`
 }
 
 type SSOProviderConfig struct {
@@ -41,12 +77,22 @@ type SSOProvider struct {
 
 	OIDCProvider *OIDCProvider `json:"oidc_provider,omitempty" gorm:"foreignKey:SSOProviderID"`
 	SAMLProvider *SAMLProvider `json:"saml_provider,omitempty" gorm:"foreignKey:SSOProviderID"`
+	LDAPProvider *LDAPProvider `json:"ldap_provider,omitempty" gorm:"foreignKey:SSOProviderID"`
 
 	Config SSOProviderConfig `json:"config" gorm:"type:jsonb column:config"`
 
+	// SCIMTokenDigest is the digest of the bearer token the provider's IdP uses for SCIM provisioning. The token itself is
+	// only ever shown once when it is issued.
+	SCIMTokenDigest null.String `json:"-" gorm:"column:scim_token_digest"`
+
 	Serial
 }
 
+// SCIMEnabled returns true if a SCIM provisioning token has been issued for the provider
+func (s SSOProvider) SCIMEnabled() bool {
+	return s.SCIMTokenDigest.Valid && s.SCIMTokenDigest.String != ""
+}
+
 // Implement the sql.Scanner interface so that GORM can scan the jsonb column from the database into a golang struct
 func (cfg *SSOProviderConfig) Scan(value interface{}) error {
 	// Handle null values from the database
@@ -80,6 +126,8 @@ func (s SSOProvider) AuditData() AuditData {
 		details = s.SAMLProvider
 	case SessionAuthProviderOIDC:
 		details = s.OIDCProvider
+	case SessionAuthProviderLDAP:
+		details = s.LDAPProvider
 	}
 
 	return AuditData{
@@ -0,0 +1,68 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package model
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/lib/pq"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+)
+
+// WebAuthnCredential is a WebAuthn / FIDO2 public key credential registered by a user as a second authentication factor
+type WebAuthnCredential struct {
+	UserID       uuid.UUID      `json:"user_id" gorm:"type:text"`
+	Name         string         `json:"name"`
+	CredentialID []byte         `json:"credential_id"`
+	PublicKey    []byte         `json:"-"`
+	SignCount    int64          `json:"-"`
+	AAGUID       uuid.UUID      `json:"aaguid" gorm:"column:aaguid;type:text"`
+	Transports   pq.StringArray `json:"transports" gorm:"type:text[]"`
+	LastUsedAt   null.Time      `json:"last_used_at"`
+
+	Serial
+}
+
+func (WebAuthnCredential) TableName() string {
+	return "webauthn_credentials"
+}
+
+type WebAuthnCredentials []WebAuthnCredential
+
+func (s WebAuthnCredential) AuditData() AuditData {
+	return AuditData{
+		"id":      s.ID,
+		"user_id": s.UserID,
+		"name":    s.Name,
+		"aaguid":  s.AAGUID,
+	}
+}
+
+// WebAuthnChallenge is an outstanding challenge issued to a user for a WebAuthn registration or authentication
+// ceremony. Challenges are single use and are deleted once consumed.
+type WebAuthnChallenge struct {
+	Challenge string    `gorm:"primaryKey"`
+	UserID    uuid.UUID `gorm:"type:text"`
+	Ceremony  string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+func (WebAuthnChallenge) TableName() string {
+	return "webauthn_challenges"
+}
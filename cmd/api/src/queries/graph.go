@@ -147,6 +147,7 @@ type Graph interface {
 	GetFilteredAndSortedNodes(sortItems query.SortItems, filterCriteria graph.Criteria) ([]*graph.Node, error)
 	FetchNodesByObjectIDs(ctx context.Context, objectIDs ...string) (graph.NodeSet, error)
 	FetchNodesByObjectIDsAndKinds(ctx context.Context, kinds graph.Kinds, objectIDs ...string) (graph.NodeSet, error)
+	FetchNodesByNamesOrObjectIDs(ctx context.Context, values ...string) (graph.NodeSet, error)
 	ValidateOUs(ctx context.Context, ous []string) ([]string, error)
 	BatchNodeUpdate(ctx context.Context, nodeUpdate graph.NodeUpdate) error
 	RawCypherQuery(ctx context.Context, pQuery PreparedQuery, includeProperties bool) (model.UnifiedGraph, error)
@@ -798,6 +799,38 @@ func (s *GraphQuery) FetchNodesByObjectIDsAndKinds(ctx context.Context, kinds gr
 	})
 }
 
+// FetchNodesByNamesOrObjectIDs fetches the AD and Azure entities whose name or object ID exactly matches one of the
+// given values in a single read transaction. Values are upper cased before matching.
+func (s *GraphQuery) FetchNodesByNamesOrObjectIDs(ctx context.Context, values ...string) (graph.NodeSet, error) {
+	var (
+		nodes       graph.NodeSet
+		upperValues = make([]string, len(values))
+	)
+
+	for idx, value := range values {
+		upperValues[idx] = strings.ToUpper(value)
+	}
+
+	return nodes, s.Graph.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		if fetchedNodes, err := ops.FetchNodeSet(tx.Nodes().Filterf(
+			func() graph.Criteria {
+				return query.And(
+					query.KindIn(query.Node(), ad.Entity, azure.Entity),
+					query.Or(
+						query.In(query.NodeProperty(common.Name.String()), upperValues),
+						query.In(query.NodeProperty(common.ObjectID.String()), upperValues),
+					),
+				)
+			}),
+		); err != nil {
+			return err
+		} else {
+			nodes = fetchedNodes
+			return nil
+		}
+	})
+}
+
 func (s *GraphQuery) ValidateOUs(ctx context.Context, ous []string) ([]string, error) {
 	var validated = make([]string, 0)
 
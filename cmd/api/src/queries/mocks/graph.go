@@ -125,6 +125,26 @@ func (mr *MockGraphMockRecorder) FetchNodeByGraphId(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchNodeByGraphId", reflect.TypeOf((*MockGraph)(nil).FetchNodeByGraphId), ctx, id)
 }
 
+// FetchNodesByNamesOrObjectIDs mocks base method.
+func (m *MockGraph) FetchNodesByNamesOrObjectIDs(ctx context.Context, values ...string) (graph.NodeSet, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range values {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FetchNodesByNamesOrObjectIDs", varargs...)
+	ret0, _ := ret[0].(graph.NodeSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchNodesByNamesOrObjectIDs indicates an expected call of FetchNodesByNamesOrObjectIDs.
+func (mr *MockGraphMockRecorder) FetchNodesByNamesOrObjectIDs(ctx any, values ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, values...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchNodesByNamesOrObjectIDs", reflect.TypeOf((*MockGraph)(nil).FetchNodesByNamesOrObjectIDs), varargs...)
+}
+
 // FetchNodesByObjectIDs mocks base method.
 func (m *MockGraph) FetchNodesByObjectIDs(ctx context.Context, objectIDs ...string) (graph.NodeSet, error) {
 	m.ctrl.T.Helper()
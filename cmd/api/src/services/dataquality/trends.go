@@ -0,0 +1,163 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dataquality
+
+import (
+	"context"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/nan"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/azure"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/ops"
+	"github.com/specterops/dawgs/query"
+)
+
+// DefaultRegressionThreshold is the drop in session or local group completeness between two runs that is flagged as a
+// regression when no threshold is given. Completeness is a ratio, so this is a drop of ten percentage points.
+const DefaultRegressionThreshold = 0.1
+
+// ADDataQualityTrend computes the deltas between consecutive data quality runs of a domain and flags the runs where
+// completeness dropped by more than the given threshold or where the domain stopped being collected. Objects from
+// earlier collections remain in the graph after collection stops, so a stopped collection is found from the time the
+// domain was last ingested not advancing between runs rather than from its object counts. Only the first run of each
+// stretch without a new collection is flagged.
+func ADDataQualityTrend(domainSID string, stats model.ADDataQualityStats, regressionThreshold float64) model.ADDataQualityTrend {
+	var (
+		trend = model.ADDataQualityTrend{
+			DomainSID:   domainSID,
+			Deltas:      []model.ADDataQualityDelta{},
+			Regressions: []model.DataQualityRegression{},
+		}
+		collecting = true
+	)
+
+	runs := slices.Clone(stats)
+	slices.SortStableFunc(runs, func(a, b model.ADDataQualityStat) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+
+	for idx := 1; idx < len(runs); idx++ {
+		previous, current := runs[idx-1], runs[idx]
+
+		trend.Deltas = append(trend.Deltas, model.ADDataQualityDelta{
+			RunID:                  current.RunID,
+			CreatedAt:              current.CreatedAt,
+			Users:                  current.Users - previous.Users,
+			Groups:                 current.Groups - previous.Groups,
+			Computers:              current.Computers - previous.Computers,
+			OUs:                    current.OUs - previous.OUs,
+			Containers:             current.Containers - previous.Containers,
+			GPOs:                   current.GPOs - previous.GPOs,
+			ACLs:                   current.ACLs - previous.ACLs,
+			Sessions:               current.Sessions - previous.Sessions,
+			Relationships:          current.Relationships - previous.Relationships,
+			SessionCompleteness:    completeness(current.SessionCompleteness) - completeness(previous.SessionCompleteness),
+			LocalGroupCompleteness: completeness(current.LocalGroupCompleteness) - completeness(previous.LocalGroupCompleteness),
+		})
+
+		if previous.LastCollected.Valid && current.LastCollected.Valid {
+			if current.LastCollected.Time.After(previous.LastCollected.Time) {
+				collecting = true
+			} else if collecting {
+				collecting = false
+				trend.Regressions = append(trend.Regressions, regression(current, model.DataQualityRegressionCollectionStopped, collectionAge(previous), collectionAge(current)))
+				continue
+			}
+		}
+
+		if previousValue, currentValue := completeness(previous.SessionCompleteness), completeness(current.SessionCompleteness); previousValue-currentValue > regressionThreshold {
+			trend.Regressions = append(trend.Regressions, regression(current, model.DataQualityRegressionSessionCompleteness, previousValue, currentValue))
+		}
+
+		if previousValue, currentValue := completeness(previous.LocalGroupCompleteness), completeness(current.LocalGroupCompleteness); previousValue-currentValue > regressionThreshold {
+			trend.Regressions = append(trend.Regressions, regression(current, model.DataQualityRegressionLocalGroupCompleteness, previousValue, currentValue))
+		}
+	}
+
+	return trend
+}
+
+// FetchStaleEnvironments returns the collected AD domain or Azure tenant with the given object id if it was last seen
+// before the given cutoff
+func FetchStaleEnvironments(ctx context.Context, graphDB graph.Database, environmentID string, cutoff time.Time) ([]model.StaleEnvironment, error) {
+	staleEnvironments := []model.StaleEnvironment{}
+
+	return staleEnvironments, graphDB.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		if environments, err := ops.FetchNodes(tx.Nodes().Filterf(func() graph.Criteria {
+			return query.And(
+				query.KindIn(query.Node(), ad.Domain, azure.Tenant),
+				query.Equals(query.NodeProperty(common.Collected.String()), true),
+				query.Equals(query.NodeProperty(common.ObjectID.String()), environmentID),
+			)
+		})); err != nil {
+			return err
+		} else {
+			for _, environment := range environments {
+				if lastSeen, err := environment.Properties.Get(common.LastSeen.String()).Time(); err != nil || !lastSeen.Before(cutoff) {
+					continue
+				} else {
+					objectID, _ := environment.Properties.GetOrDefault(common.ObjectID.String(), "").String()
+					name, _ := environment.Properties.GetOrDefault(common.Name.String(), "").String()
+					kind := ad.Domain
+
+					if environment.Kinds.ContainsOneOf(azure.Tenant) {
+						kind = azure.Tenant
+					}
+
+					staleEnvironments = append(staleEnvironments, model.StaleEnvironment{
+						ObjectID: objectID,
+						Name:     name,
+						Kind:     kind.String(),
+						LastSeen: lastSeen,
+					})
+				}
+			}
+
+			return nil
+		}
+	})
+}
+
+func regression(run model.ADDataQualityStat, metric model.DataQualityRegressionMetric, previous, current float64) model.DataQualityRegression {
+	return model.DataQualityRegression{
+		RunID:     run.RunID,
+		CreatedAt: run.CreatedAt,
+		Metric:    metric,
+		Previous:  previous,
+		Current:   current,
+	}
+}
+
+// completeness treats a NaN completeness, which is stored when there was nothing to measure, as zero
+func completeness(value nan.Float64) float64 {
+	if math.IsNaN(float64(value)) {
+		return 0
+	}
+
+	return float64(value)
+}
+
+// collectionAge is the number of hours between the last ingest of the domain and the run
+func collectionAge(run model.ADDataQualityStat) float64 {
+	return run.CreatedAt.Sub(run.LastCollected.Time).Hours()
+}
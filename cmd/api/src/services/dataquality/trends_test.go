@@ -0,0 +1,108 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dataquality_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/nan"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/services/dataquality"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newADDataQualityStat(runID string, createdAt, lastCollected time.Time, users int, sessionCompleteness, localGroupCompleteness float64) model.ADDataQualityStat {
+	stat := model.ADDataQualityStat{
+		Users:                  users,
+		Computers:              users,
+		SessionCompleteness:    nan.Float64(sessionCompleteness),
+		LocalGroupCompleteness: nan.Float64(localGroupCompleteness),
+		LastCollected:          null.TimeFrom(lastCollected),
+		RunID:                  runID,
+	}
+	stat.CreatedAt = createdAt
+
+	return stat
+}
+
+func TestADDataQualityTrend(t *testing.T) {
+	var (
+		now   = time.Now()
+		stats = model.ADDataQualityStats{
+			// Out of order to mirror the default created_at desc ordering of the stats
+			newADDataQualityStat("3", now.Add(-time.Hour), now.Add(-2*time.Hour), 12, 0.5, 0),
+			newADDataQualityStat("1", now.Add(-3*time.Hour), now.Add(-3*time.Hour), 10, 0.8, 0.5),
+			newADDataQualityStat("2", now.Add(-2*time.Hour), now.Add(-2*time.Hour), 12, 0.5, math.NaN()),
+		}
+		trend = dataquality.ADDataQualityTrend("S-1-5-21-1", stats, dataquality.DefaultRegressionThreshold)
+	)
+
+	assert.Equal(t, "S-1-5-21-1", trend.DomainSID)
+	require.Len(t, trend.Deltas, 2)
+	assert.Equal(t, "2", trend.Deltas[0].RunID)
+	assert.Equal(t, 2, trend.Deltas[0].Users)
+	assert.InDelta(t, -0.3, trend.Deltas[0].SessionCompleteness, 0.0001)
+	assert.InDelta(t, -0.5, trend.Deltas[0].LocalGroupCompleteness, 0.0001)
+	assert.Equal(t, 0, trend.Deltas[1].Users)
+
+	require.Len(t, trend.Regressions, 3)
+	assert.Equal(t, model.DataQualityRegressionSessionCompleteness, trend.Regressions[0].Metric)
+	assert.Equal(t, model.DataQualityRegressionLocalGroupCompleteness, trend.Regressions[1].Metric)
+	assert.Equal(t, model.DataQualityRegressionCollectionStopped, trend.Regressions[2].Metric)
+	assert.Equal(t, "3", trend.Regressions[2].RunID)
+	assert.Equal(t, float64(0), trend.Regressions[2].Previous)
+	assert.Equal(t, float64(1), trend.Regressions[2].Current)
+}
+
+func TestADDataQualityTrend_CollectionStoppedOnce(t *testing.T) {
+	var (
+		now   = time.Now()
+		stats = model.ADDataQualityStats{
+			newADDataQualityStat("1", now.Add(-5*time.Hour), now.Add(-5*time.Hour), 10, 0.8, 0.8),
+			newADDataQualityStat("2", now.Add(-4*time.Hour), now.Add(-5*time.Hour), 10, 0.8, 0.8),
+			newADDataQualityStat("3", now.Add(-3*time.Hour), now.Add(-5*time.Hour), 10, 0.8, 0.8),
+			newADDataQualityStat("4", now.Add(-2*time.Hour), now.Add(-2*time.Hour), 10, 0.8, 0.8),
+			newADDataQualityStat("5", now.Add(-time.Hour), now.Add(-2*time.Hour), 10, 0.8, 0.8),
+		}
+		trend = dataquality.ADDataQualityTrend("S-1-5-21-1", stats, dataquality.DefaultRegressionThreshold)
+	)
+
+	// Object counts never drop, so only the ingest times reveal the two stretches without a new collection
+	require.Len(t, trend.Regressions, 2)
+	assert.Equal(t, model.DataQualityRegressionCollectionStopped, trend.Regressions[0].Metric)
+	assert.Equal(t, "2", trend.Regressions[0].RunID)
+	assert.Equal(t, model.DataQualityRegressionCollectionStopped, trend.Regressions[1].Metric)
+	assert.Equal(t, "5", trend.Regressions[1].RunID)
+}
+
+func TestADDataQualityTrend_BelowThreshold(t *testing.T) {
+	var (
+		now   = time.Now()
+		stats = model.ADDataQualityStats{
+			newADDataQualityStat("1", now.Add(-2*time.Hour), now.Add(-2*time.Hour), 10, 0.8, 0.8),
+			newADDataQualityStat("2", now.Add(-time.Hour), now.Add(-time.Hour), 10, 0.75, 0.9),
+		}
+		trend = dataquality.ADDataQualityTrend("S-1-5-21-1", stats, dataquality.DefaultRegressionThreshold)
+	)
+
+	assert.Len(t, trend.Deltas, 1)
+	assert.Empty(t, trend.Regressions)
+}
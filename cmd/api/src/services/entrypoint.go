@@ -44,10 +44,19 @@ import (
 
 // ConnectPostgres initializes a connection to PG, and returns errors if any
 func ConnectPostgres(cfg config.Configuration) (*database.BloodhoundDB, error) {
-	if db, err := database.OpenDatabase(cfg.Database.PostgreSQLConnectionString()); err != nil {
+	if auditLogHashKey, err := cfg.Crypto.AuditLog.HashKeyBytes(); err != nil {
+		return nil, fmt.Errorf("error decoding audit log hash key: %w", err)
+	} else if db, err := database.OpenDatabase(cfg.Database.PostgreSQLConnectionString()); err != nil {
 		return nil, fmt.Errorf("error while attempting to create database connection: %w", err)
 	} else {
-		return database.NewBloodhoundDB(db, auth.NewIdentityResolver()), nil
+		if len(auditLogHashKey) == 0 {
+			slog.Warn("No audit log hash key is configured; set crypto.audit_log.hash_key so that audit log entries cannot be altered and rehashed by someone with database access")
+		}
+
+		bhdb := database.NewBloodhoundDB(db, auth.NewIdentityResolver())
+		bhdb.SetAuditLogHashKey(auditLogHashKey)
+
+		return bhdb, nil
 	}
 }
 
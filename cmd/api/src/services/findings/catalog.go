@@ -0,0 +1,200 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package findings
+
+import (
+	"slices"
+
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/packages/go/analysis/tiering"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/ops"
+	"github.com/specterops/dawgs/query"
+)
+
+const (
+	FindingKerberoastableTierZeroUsers = "KerberoastableTierZeroUsers"
+	FindingNonTierZeroDCSync           = "NonTierZeroDCSync"
+	FindingESC1DomainUsers             = "ESC1DomainUsers"
+	FindingDomainUsersLocalAdmin       = "DomainUsersLocalAdmin"
+)
+
+// Result is a single principal matched by a finding definition along with the target of the matched relationship, if
+// the finding describes one
+type Result struct {
+	Principal *graph.Node
+	Target    *graph.Node
+}
+
+// Check is a Go implementation of a finding definition
+type Check func(tx graph.Transaction, tieringEnabled bool) ([]Result, error)
+
+// Definition is a named finding evaluated after every analysis. A definition is expressed either as a Cypher query or as
+// a Go check. Cypher queries must return paths: the root of each path is the principal and the terminal of each path
+// with at least one edge is the target.
+type Definition struct {
+	Name        string                `json:"name"`
+	Title       string                `json:"title"`
+	Description string                `json:"description"`
+	Severity    model.FindingSeverity `json:"severity"`
+	Cypher      string                `json:"cypher,omitempty"`
+	Check       Check                 `json:"-"`
+}
+
+// Evaluate runs the definition against the graph
+func (s Definition) Evaluate(tx graph.Transaction, tieringEnabled bool) ([]Result, error) {
+	if s.Check != nil {
+		return s.Check(tx, tieringEnabled)
+	}
+
+	pathSet, err := ops.FetchPathSetByQuery(tx, s.Cypher)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]Result, 0, len(pathSet))
+	for _, path := range pathSet {
+		result := Result{Principal: path.Root()}
+		if len(path.Edges) > 0 {
+			result.Target = path.Terminal()
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+var catalog = []Definition{
+	{
+		Name:        FindingKerberoastableTierZeroUsers,
+		Title:       "Kerberoastable Tier Zero Users",
+		Description: "Enabled Tier Zero users with a service principal name. Any domain user can request a service ticket for these users and attempt to crack their password offline.",
+		Severity:    model.FindingSeverityCritical,
+		Check:       checkKerberoastableTierZeroUsers,
+	},
+	{
+		Name:        FindingNonTierZeroDCSync,
+		Title:       "Non Tier Zero Principals with DCSync",
+		Description: "Principals outside of Tier Zero that can replicate secrets from a domain, including the password hashes of every Tier Zero user.",
+		Severity:    model.FindingSeverityCritical,
+		Check:       checkNonTierZeroDCSync,
+	},
+	{
+		Name:        FindingESC1DomainUsers,
+		Title:       "ESC1 Exploitable by Domain Users",
+		Description: "Domains where a broad built-in group such as Domain Users, Domain Computers, Authenticated Users or Everyone can perform ESC1. Any member can enroll in a vulnerable certificate template and request a certificate that authenticates as any user in the domain.",
+		Severity:    model.FindingSeverityCritical,
+		Check:       checkESC1DomainUsers,
+	},
+	{
+		Name:        FindingDomainUsersLocalAdmin,
+		Title:       "Domain Users with Local Admin Rights",
+		Description: "Computers where every domain user is a local administrator.",
+		Severity:    model.FindingSeverityHigh,
+		Cypher:      "MATCH p = (g:Group)-[:AdminTo]->(:Computer) WHERE g.objectid ENDS WITH '-513' RETURN p",
+	},
+}
+
+// Catalog returns every finding definition
+func Catalog() []Definition {
+	return slices.Clone(catalog)
+}
+
+// GetDefinition returns the finding definition with the given name
+func GetDefinition(name string) (Definition, bool) {
+	for _, definition := range catalog {
+		if definition.Name == name {
+			return definition, true
+		}
+	}
+
+	return Definition{}, false
+}
+
+func checkKerberoastableTierZeroUsers(tx graph.Transaction, tieringEnabled bool) ([]Result, error) {
+	var results []Result
+
+	if nodes, err := ops.FetchNodes(tx.Nodes().Filter(query.And(
+		query.Kind(query.Node(), ad.User),
+		query.Equals(query.NodeProperty(ad.HasSPN.String()), true),
+		query.Equals(query.NodeProperty(common.Enabled.String()), true),
+		tiering.SearchTierNodes(tieringEnabled),
+	))); err != nil {
+		return nil, err
+	} else {
+		for _, node := range nodes {
+			results = append(results, Result{Principal: node})
+		}
+	}
+
+	return results, nil
+}
+
+func checkNonTierZeroDCSync(tx graph.Transaction, _ bool) ([]Result, error) {
+	var results []Result
+
+	if pathSet, err := ops.FetchPathSet(tx.Relationships().Filter(query.And(
+		query.Kind(query.Relationship(), ad.DCSync),
+		query.Kind(query.End(), ad.Domain),
+	))); err != nil {
+		return nil, err
+	} else {
+		for _, path := range pathSet {
+			// Tier zero is checked here rather than in the query so principals without system tags are not excluded
+			if start := path.Root(); !tiering.IsTierZero(start) {
+				results = append(results, Result{Principal: start, Target: path.Terminal()})
+			}
+		}
+	}
+
+	return results, nil
+}
+
+// broadGroupObjectIdSuffixes matches the Domain Users, Domain Computers, Authenticated Users and Everyone groups of
+// every domain
+var broadGroupObjectIdSuffixes = []string{"-513", "-515", "-S-1-5-11", "-S-1-1-0"}
+
+func checkESC1DomainUsers(tx graph.Transaction, _ bool) ([]Result, error) {
+	var (
+		results       []Result
+		groupCriteria = make([]graph.Criteria, len(broadGroupObjectIdSuffixes))
+	)
+
+	for idx, suffix := range broadGroupObjectIdSuffixes {
+		groupCriteria[idx] = query.StringEndsWith(query.StartProperty(common.ObjectID.String()), suffix)
+	}
+
+	// ADCSESC1 edges are created by post-processing only when a published template is vulnerable and the principal can
+	// enroll through both the template and its enterprise CA
+	if pathSet, err := ops.FetchPathSet(tx.Relationships().Filter(query.And(
+		query.Kind(query.Start(), ad.Group),
+		query.Or(groupCriteria...),
+		query.Kind(query.Relationship(), ad.ADCSESC1),
+		query.Kind(query.End(), ad.Domain),
+	))); err != nil {
+		return nil, err
+	} else {
+		for _, path := range pathSet {
+			results = append(results, Result{Principal: path.Root(), Target: path.Terminal()})
+		}
+	}
+
+	return results, nil
+}
@@ -0,0 +1,131 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package findings
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/packages/go/analysis"
+	"github.com/specterops/bloodhound/packages/go/bhlog/measure"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/azure"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/graph"
+)
+
+type FindingData interface {
+	UpsertFindings(ctx context.Context, findings model.Findings) error
+	ResolveFindings(ctx context.Context, finding string, seenBefore time.Time) (int64, error)
+}
+
+// SaveFindings evaluates every finding definition in the catalog, records the instances found and resolves the
+// instances that are no longer found. A definition that fails to evaluate leaves its stored instances untouched.
+func SaveFindings(ctx context.Context, db FindingData, graphDB graph.Database, tieringEnabled bool) error {
+	slog.InfoContext(ctx, "Started Findings Analysis")
+	defer measure.ContextMeasure(ctx, slog.LevelInfo, "Finished Findings Analysis")()
+
+	var errs []error
+
+	for _, definition := range catalog {
+		var (
+			results []Result
+			seenAt  = time.Now().UTC()
+		)
+
+		if err := graphDB.ReadTransaction(ctx, func(tx graph.Transaction) error {
+			var err error
+			results, err = definition.Evaluate(tx, tieringEnabled)
+			return err
+		}); err != nil {
+			errs = append(errs, fmt.Errorf("could not evaluate finding %s: %w", definition.Name, err))
+		} else if err := db.UpsertFindings(ctx, NewFindings(definition, results, seenAt)); err != nil {
+			errs = append(errs, fmt.Errorf("could not save finding %s: %w", definition.Name, err))
+		} else if resolved, err := db.ResolveFindings(ctx, definition.Name, seenAt); err != nil {
+			errs = append(errs, fmt.Errorf("could not resolve finding %s: %w", definition.Name, err))
+		} else {
+			slog.InfoContext(ctx, fmt.Sprintf("Finding %s: %d found, %d resolved", definition.Name, len(results), resolved))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// NewFindings maps the results of a finding definition to finding instances seen at the given time. Results are
+// deduplicated by environment, principal and target and results without a principal object ID are dropped.
+func NewFindings(definition Definition, results []Result, seenAt time.Time) model.Findings {
+	var (
+		findings = make(model.Findings, 0, len(results))
+		seen     = map[[3]string]struct{}{}
+	)
+
+	for _, result := range results {
+		if result.Principal == nil {
+			continue
+		}
+
+		finding := model.Finding{
+			Finding:       definition.Name,
+			EnvironmentId: environmentId(result.Principal),
+			PrincipalKind: analysis.GetNodeKindDisplayLabel(result.Principal),
+			FirstSeen:     seenAt,
+			LastSeen:      seenAt,
+		}
+		finding.PrincipalObjectId, finding.PrincipalName = objectIdAndName(result.Principal)
+
+		if finding.PrincipalObjectId == "" {
+			continue
+		}
+
+		if result.Target != nil {
+			finding.TargetObjectId, finding.TargetName = objectIdAndName(result.Target)
+			finding.TargetKind = analysis.GetNodeKindDisplayLabel(result.Target)
+
+			if finding.EnvironmentId == "" {
+				finding.EnvironmentId = environmentId(result.Target)
+			}
+		}
+
+		key := [3]string{finding.EnvironmentId, finding.PrincipalObjectId, finding.TargetObjectId}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+
+		seen[key] = struct{}{}
+		findings = append(findings, finding)
+	}
+
+	return findings
+}
+
+func environmentId(node *graph.Node) string {
+	environmentId, _ := node.Properties.GetWithFallback(ad.DomainSID.String(), "", azure.TenantID.String()).String()
+	return environmentId
+}
+
+func objectIdAndName(node *graph.Node) (string, string) {
+	var (
+		objectId, _ = node.Properties.GetOrDefault(common.ObjectID.String(), "").String()
+		name, _     = node.Properties.GetWithFallback(common.Name.String(), "", common.DisplayName.String(), common.ObjectID.String()).String()
+	)
+
+	return objectId, name
+}
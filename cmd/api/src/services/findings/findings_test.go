@@ -0,0 +1,105 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package findings_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/services/findings"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/azure"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/graph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCatalog(t *testing.T) {
+	names := map[string]struct{}{}
+
+	for _, definition := range findings.Catalog() {
+		require.NotEmpty(t, definition.Name)
+		assert.NotEmpty(t, definition.Title, definition.Name)
+		assert.NotEmpty(t, definition.Severity, definition.Name)
+		assert.True(t, (definition.Cypher == "") != (definition.Check == nil), "%s must define exactly one of a Cypher query or a Go check", definition.Name)
+
+		_, duplicate := names[definition.Name]
+		assert.False(t, duplicate, "duplicate finding %s", definition.Name)
+		names[definition.Name] = struct{}{}
+
+		found, ok := findings.GetDefinition(definition.Name)
+		require.True(t, ok)
+		assert.Equal(t, definition.Name, found.Name)
+	}
+
+	_, ok := findings.GetDefinition("NotAFinding")
+	assert.False(t, ok)
+}
+
+func TestNewFindings(t *testing.T) {
+	var (
+		seenAt     = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+		definition = findings.Definition{Name: findings.FindingNonTierZeroDCSync}
+		helpdesk   = graph.NewNode(1, graph.AsProperties(map[string]any{
+			common.ObjectID.String(): "S-1-5-21-1-1105",
+			common.Name.String():     "HELPDESK@CORP.LOCAL",
+			ad.DomainSID.String():    "S-1-5-21-1",
+		}), ad.Entity, ad.Group)
+		domain = graph.NewNode(2, graph.AsProperties(map[string]any{
+			common.ObjectID.String(): "S-1-5-21-1",
+			common.Name.String():     "CORP.LOCAL",
+			ad.DomainSID.String():    "S-1-5-21-1",
+		}), ad.Entity, ad.Domain)
+		azureUser = graph.NewNode(3, graph.AsProperties(map[string]any{
+			common.ObjectID.String(): "AZ-USER",
+			azure.TenantID.String():  "TENANT-1",
+		}), azure.Entity, azure.User)
+		missingObjectId = graph.NewNode(4, graph.NewProperties(), ad.Entity, ad.User)
+		noEnvironment   = graph.NewNode(5, graph.AsProperties(map[string]any{common.ObjectID.String(): "S-1-5-21-1-1106"}), ad.Entity, ad.User)
+	)
+
+	result := findings.NewFindings(definition, []findings.Result{
+		{Principal: helpdesk, Target: domain},
+		{Principal: helpdesk, Target: domain},
+		{Principal: azureUser},
+		{Principal: missingObjectId},
+		{Principal: noEnvironment, Target: domain},
+		{Target: domain},
+	}, seenAt)
+
+	require.Len(t, result, 3)
+
+	assert.Equal(t, findings.FindingNonTierZeroDCSync, result[0].Finding)
+	assert.Equal(t, "S-1-5-21-1", result[0].EnvironmentId)
+	assert.Equal(t, "S-1-5-21-1-1105", result[0].PrincipalObjectId)
+	assert.Equal(t, "HELPDESK@CORP.LOCAL", result[0].PrincipalName)
+	assert.Equal(t, "Group", result[0].PrincipalKind)
+	assert.Equal(t, "S-1-5-21-1", result[0].TargetObjectId)
+	assert.Equal(t, "CORP.LOCAL", result[0].TargetName)
+	assert.Equal(t, "Domain", result[0].TargetKind)
+	assert.Equal(t, seenAt, result[0].FirstSeen)
+	assert.Equal(t, seenAt, result[0].LastSeen)
+
+	assert.Equal(t, "TENANT-1", result[1].EnvironmentId)
+	assert.Equal(t, "AZ-USER", result[1].PrincipalName)
+	assert.Empty(t, result[1].TargetObjectId)
+
+	// The environment falls back to the target when the principal has none
+	assert.Equal(t, "S-1-5-21-1", result[2].EnvironmentId)
+	assert.Equal(t, "S-1-5-21-1-1106", result[2].PrincipalObjectId)
+}
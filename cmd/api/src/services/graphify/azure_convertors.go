@@ -142,10 +142,26 @@ func getKindConverter(kind enums.Kind) func(json.RawMessage, *ConvertedAzureData
 		return convertAzureAutomationAccount
 	case enums.KindAZAutomationAccountRoleAssignment:
 		return convertAzureAutomationAccountRoleAssignment
+	case enums.KindAZStorageAccount:
+		return convertAzureStorageAccount
+	case enums.KindAZStorageAccountRoleAssignment:
+		return convertAzureStorageAccountRoleAssignment
+	case enums.KindAZStorageContainer:
+		return convertAzureStorageContainer
 	case enums.KindAZRoleManagementPolicyAssignment:
 		return convertAzureRoleManagementPolicyAssignment
 	case enums.KindAZRoleEligibilityScheduleInstance:
 		return convertAzureRoleEligibilityScheduleInstance
+	case ein.KindAZConditionalAccessPolicy:
+		return convertAzureConditionalAccessPolicy
+	case ein.KindAZIntuneManagedDevice:
+		return convertAzureIntuneManagedDevice
+	case ein.KindAZIntuneScript:
+		return convertAzureIntuneScript
+	case ein.KindAZIntuneRoleAssignment:
+		return convertAzureIntuneRoleAssignment
+	case ein.KindAZStorageFileShare:
+		return convertAzureStorageFileShare
 	default:
 		// TODO: we should probably have a hook or something to log the unknown type
 		return func(rm json.RawMessage, cd *ConvertedAzureData, now time.Time) {}
@@ -717,6 +733,49 @@ func convertAzureAutomationAccountRoleAssignment(raw json.RawMessage, converted
 	}
 }
 
+func convertAzureStorageAccount(raw json.RawMessage, converted *ConvertedAzureData, ingestTime time.Time) {
+	var data models.StorageAccount
+	if err := json.Unmarshal(raw, &data); err != nil {
+		slog.Error(fmt.Sprintf(SerialError, "azure storage account", err))
+	} else {
+		node, relationship := ein.ConvertAzureStorageAccount(data, ingestTime)
+		converted.NodeProps = append(converted.NodeProps, node)
+		converted.RelProps = append(converted.RelProps, relationship)
+	}
+}
+
+func convertAzureStorageAccountRoleAssignment(raw json.RawMessage, converted *ConvertedAzureData, ingestTime time.Time) {
+	var data models.AzureRoleAssignments
+
+	if err := json.Unmarshal(raw, &data); err != nil {
+		slog.Error(fmt.Sprintf(SerialError, "azure storage account role assignments", err))
+	} else {
+		converted.RelProps = append(converted.RelProps, ein.ConvertAzureStorageAccountRoleAssignment(data)...)
+	}
+}
+
+func convertAzureStorageContainer(raw json.RawMessage, converted *ConvertedAzureData, ingestTime time.Time) {
+	var data models.StorageContainer
+	if err := json.Unmarshal(raw, &data); err != nil {
+		slog.Error(fmt.Sprintf(SerialError, "azure storage container", err))
+	} else {
+		node, relationship := ein.ConvertAzureStorageContainer(data, ingestTime)
+		converted.NodeProps = append(converted.NodeProps, node)
+		converted.RelProps = append(converted.RelProps, relationship)
+	}
+}
+
+func convertAzureStorageFileShare(raw json.RawMessage, converted *ConvertedAzureData, ingestTime time.Time) {
+	var data ein.StorageFileShare
+	if err := json.Unmarshal(raw, &data); err != nil {
+		slog.Error(fmt.Sprintf(SerialError, "azure storage file share", err))
+	} else {
+		node, relationship := ein.ConvertAzureStorageFileShare(data, ingestTime)
+		converted.NodeProps = append(converted.NodeProps, node)
+		converted.RelProps = append(converted.RelProps, relationship)
+	}
+}
+
 // convertAzureRoleManagementPolicyAssignment implements function signature required in getKindConverter
 func convertAzureRoleManagementPolicyAssignment(raw json.RawMessage, converted *ConvertedAzureData, ingestTime time.Time) {
 	var data models.RoleManagementPolicyAssignment
@@ -740,3 +799,49 @@ func convertAzureRoleEligibilityScheduleInstance(raw json.RawMessage, converted
 		converted.RelProps = append(converted.RelProps, relProps...)
 	}
 }
+
+func convertAzureConditionalAccessPolicy(raw json.RawMessage, converted *ConvertedAzureData, ingestTime time.Time) {
+	var data ein.ConditionalAccessPolicy
+
+	if err := json.Unmarshal(raw, &data); err != nil {
+		slog.Error(fmt.Sprintf(SerialError, "azure conditional access policy", err))
+	} else {
+		node, relationships := ein.ConvertAzureConditionalAccessPolicy(data, ingestTime)
+		converted.NodeProps = append(converted.NodeProps, node)
+		converted.RelProps = append(converted.RelProps, relationships...)
+	}
+}
+
+func convertAzureIntuneManagedDevice(raw json.RawMessage, converted *ConvertedAzureData, ingestTime time.Time) {
+	var data ein.IntuneManagedDevice
+
+	if err := json.Unmarshal(raw, &data); err != nil {
+		slog.Error(fmt.Sprintf(SerialError, "azure intune managed device", err))
+	} else if node := ein.ConvertAzureIntuneManagedDevice(data, ingestTime); node.IsValid() {
+		converted.NodeProps = append(converted.NodeProps, node)
+	}
+}
+
+func convertAzureIntuneScript(raw json.RawMessage, converted *ConvertedAzureData, ingestTime time.Time) {
+	var data ein.IntuneScript
+
+	if err := json.Unmarshal(raw, &data); err != nil {
+		slog.Error(fmt.Sprintf(SerialError, "azure intune script", err))
+	} else {
+		node, relationships := ein.ConvertAzureIntuneScript(data, ingestTime)
+		converted.NodeProps = append(converted.NodeProps, node)
+		converted.RelProps = append(converted.RelProps, relationships...)
+	}
+}
+
+func convertAzureIntuneRoleAssignment(raw json.RawMessage, converted *ConvertedAzureData, ingestTime time.Time) {
+	var data ein.IntuneRoleAssignment
+
+	if err := json.Unmarshal(raw, &data); err != nil {
+		slog.Error(fmt.Sprintf(SerialError, "azure intune role assignment", err))
+	} else {
+		node, relationships := ein.ConvertAzureIntuneRoleAssignment(data, ingestTime)
+		converted.NodeProps = append(converted.NodeProps, node)
+		converted.RelProps = append(converted.RelProps, relationships...)
+	}
+}
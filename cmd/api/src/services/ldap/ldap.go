@@ -0,0 +1,273 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ldap
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	goldap "github.com/go-ldap/ldap/v3"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -copyright_file=../../../../../LICENSE.header -destination=./mocks/ldap.go -package=mocks . Service
+
+const (
+	DefaultTimeout        = 10 * time.Second
+	DefaultEmailAttribute = "mail"
+	DefaultGroupAttribute = "memberOf"
+
+	firstNameAttribute = "givenName"
+	lastNameAttribute  = "sn"
+)
+
+var (
+	ErrInvalidCredentials   = errors.New("invalid ldap credentials")
+	ErrUserNotFound         = errors.New("ldap user not found")
+	ErrAmbiguousUser        = errors.New("ldap user search matched more than one entry")
+	ErrInvalidURL           = errors.New("ldap url must use the ldap or ldaps scheme")
+	ErrInsecureConnection   = errors.New("ldap url must use the ldaps scheme or start tls unless insecure connections are explicitly allowed")
+	ErrInvalidFilter        = errors.New("invalid ldap filter")
+	ErrInvalidCACertificate = errors.New("ldap ca certificate is not valid pem")
+)
+
+// User is the directory entry of a user that successfully authenticated
+type User struct {
+	DN        string
+	Username  string
+	Email     string
+	FirstName string
+	LastName  string
+	Groups    []string
+}
+
+// Service authenticates users against an LDAP directory using the configuration of an LDAP SSO provider
+type Service interface {
+	Authenticate(ctx context.Context, provider model.LDAPProvider, username, password string) (User, error)
+}
+
+// Client implements Service on top of the go-ldap client
+type Client struct {
+	Timeout time.Duration
+}
+
+// Authenticate searches for the user's entry, optionally binding as the provider's service account first, and then
+// binds as the user's entry with the given password. Group membership is read from the provider's group attribute,
+// such as the memberOf attribute maintained by Active Directory and the OpenLDAP memberof overlay.
+func (s *Client) Authenticate(ctx context.Context, provider model.LDAPProvider, username, password string) (User, error) {
+	// A simple bind with an empty password is an unauthenticated bind (RFC 4513 section 5.1.2) and always succeeds
+	if username == "" || password == "" {
+		return User{}, ErrInvalidCredentials
+	}
+
+	userFilter := UserSearchFilter(provider, username)
+	if err := ValidateFilter(userFilter); err != nil {
+		return User{}, err
+	}
+
+	connection, err := s.dial(ctx, provider)
+	if err != nil {
+		return User{}, err
+	}
+	defer connection.Close()
+
+	if provider.BindDN != "" {
+		if err := connection.Bind(provider.BindDN, provider.BindPassword); err != nil {
+			return User{}, fmt.Errorf("service account bind: %w", err)
+		}
+	}
+
+	var (
+		emailAttribute = valueOrDefault(provider.EmailAttribute, DefaultEmailAttribute)
+		groupAttribute = valueOrDefault(provider.GroupAttribute, DefaultGroupAttribute)
+		attributes     = []string{provider.UsernameAttribute, emailAttribute, groupAttribute, firstNameAttribute, lastNameAttribute}
+
+		// Limit the search to two entries; finding more than one entry for a username is a configuration error
+		searchRequest = goldap.NewSearchRequest(provider.UserBaseDN, goldap.ScopeWholeSubtree, goldap.NeverDerefAliases, 2, int(s.timeout().Seconds()), false, userFilter, attributes, nil)
+	)
+
+	if result, err := connection.Search(searchRequest); result != nil && len(result.Entries) > 1 {
+		return User{}, ErrAmbiguousUser
+	} else if err != nil {
+		return User{}, fmt.Errorf("user search: %w", err)
+	} else if len(result.Entries) == 0 {
+		return User{}, ErrUserNotFound
+	} else if err := connection.Bind(result.Entries[0].DN, password); err != nil {
+		if goldap.IsErrorWithCode(err, goldap.LDAPResultInvalidCredentials) {
+			return User{}, ErrInvalidCredentials
+		}
+
+		return User{}, fmt.Errorf("user bind: %w", err)
+	} else {
+		entry := result.Entries[0]
+
+		return User{
+			DN:        entry.DN,
+			Username:  valueOrDefault(entry.GetEqualFoldAttributeValue(provider.UsernameAttribute), username),
+			Email:     entry.GetEqualFoldAttributeValue(emailAttribute),
+			FirstName: entry.GetEqualFoldAttributeValue(firstNameAttribute),
+			LastName:  entry.GetEqualFoldAttributeValue(lastNameAttribute),
+			Groups:    entry.GetEqualFoldAttributeValues(groupAttribute),
+		}, nil
+	}
+}
+
+// UserSearchFilter returns the filter used to find the entry of the given username
+func UserSearchFilter(provider model.LDAPProvider, username string) string {
+	usernameFilter := fmt.Sprintf("(%s=%s)", provider.UsernameAttribute, goldap.EscapeFilter(username))
+
+	if userFilter := strings.TrimSpace(provider.UserFilter); userFilter == "" {
+		return usernameFilter
+	} else {
+		return fmt.Sprintf("(&%s%s)", userFilter, usernameFilter)
+	}
+}
+
+// ValidateFilter returns ErrInvalidFilter if the given RFC 4515 search filter cannot be compiled
+func ValidateFilter(filter string) error {
+	if _, err := goldap.CompileFilter(filter); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidFilter, err)
+	}
+
+	return nil
+}
+
+// ParseURL validates the URL of a directory server and returns its dial address and whether TLS is used from the start
+func ParseURL(rawURL string) (string, bool, error) {
+	if parsed, err := url.Parse(rawURL); err != nil {
+		return "", false, fmt.Errorf("%w: %v", ErrInvalidURL, err)
+	} else if parsed.Hostname() == "" {
+		return "", false, ErrInvalidURL
+	} else {
+		switch strings.ToLower(parsed.Scheme) {
+		case "ldap":
+			return net.JoinHostPort(parsed.Hostname(), valueOrDefault(parsed.Port(), goldap.DefaultLdapPort)), false, nil
+		case "ldaps":
+			return net.JoinHostPort(parsed.Hostname(), valueOrDefault(parsed.Port(), goldap.DefaultLdapsPort)), true, nil
+		default:
+			return "", false, ErrInvalidURL
+		}
+	}
+}
+
+// ValidateTransport returns ErrInsecureConnection if credentials would be sent to the provider's directory server over a
+// plaintext connection without the provider explicitly allowing it
+func ValidateTransport(provider model.LDAPProvider) error {
+	if _, implicitTLS, err := ParseURL(provider.URL); err != nil {
+		return err
+	} else if !implicitTLS && !provider.StartTLS && !provider.AllowInsecure {
+		return ErrInsecureConnection
+	}
+
+	return nil
+}
+
+// TLSConfig returns the TLS configuration used to connect to the provider's directory server
+func TLSConfig(provider model.LDAPProvider) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if parsed, err := url.Parse(provider.URL); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidURL, err)
+	} else {
+		tlsConfig.ServerName = parsed.Hostname()
+	}
+
+	if provider.CACertificate != "" {
+		tlsConfig.RootCAs = x509.NewCertPool()
+
+		if !tlsConfig.RootCAs.AppendCertsFromPEM([]byte(provider.CACertificate)) {
+			return nil, ErrInvalidCACertificate
+		}
+	}
+
+	return tlsConfig, nil
+}
+
+// dial connects to the provider's directory server. The connection is established by this function rather than
+// goldap.DialURL so the dial honours the request context and the whole exchange is bound by the same deadline.
+func (s *Client) dial(ctx context.Context, provider model.LDAPProvider) (*goldap.Conn, error) {
+	var dialer net.Dialer
+
+	if address, implicitTLS, err := ParseURL(provider.URL); err != nil {
+		return nil, err
+	} else if err := ValidateTransport(provider); err != nil {
+		return nil, err
+	} else if tlsConfig, err := TLSConfig(provider); err != nil {
+		return nil, err
+	} else {
+		ctx, cancel := context.WithTimeout(ctx, s.timeout())
+		defer cancel()
+
+		netConn, err := dialer.DialContext(ctx, "tcp", address)
+		if err != nil {
+			return nil, err
+		}
+
+		if deadline, ok := ctx.Deadline(); ok {
+			_ = netConn.SetDeadline(deadline)
+		}
+
+		if implicitTLS {
+			tlsConn := tls.Client(netConn, tlsConfig)
+
+			if err := tlsConn.HandshakeContext(ctx); err != nil {
+				netConn.Close()
+				return nil, fmt.Errorf("tls handshake: %w", err)
+			}
+
+			netConn = tlsConn
+		}
+
+		connection := goldap.NewConn(netConn, implicitTLS)
+		connection.Start()
+		connection.SetTimeout(s.timeout())
+
+		if provider.StartTLS && !implicitTLS {
+			if err := connection.StartTLS(tlsConfig); err != nil {
+				connection.Close()
+				return nil, fmt.Errorf("start tls: %w", err)
+			}
+		}
+
+		return connection, nil
+	}
+}
+
+func (s *Client) timeout() time.Duration {
+	if s.Timeout > 0 {
+		return s.Timeout
+	}
+
+	return DefaultTimeout
+}
+
+func valueOrDefault(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+
+	return value
+}
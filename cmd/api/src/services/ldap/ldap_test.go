@@ -0,0 +1,615 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ldap
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	goldap "github.com/go-ldap/ldap/v3"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testBaseDN          = "dc=example,dc=org"
+	testServiceDN       = "cn=bloodhound,ou=services,dc=example,dc=org"
+	testServicePassword = "service-password"
+	testAdminsGroupDN   = "cn=bloodhound-admins,ou=groups,dc=example,dc=org"
+	testUsersGroupDN    = "cn=bloodhound-users,ou=groups,dc=example,dc=org"
+
+	startTLSOID = "1.3.6.1.4.1.1466.20037"
+)
+
+type testEntry struct {
+	DN         string
+	Attributes map[string][]string
+}
+
+func (s testEntry) Values(attribute string) []string {
+	for name, values := range s.Attributes {
+		if strings.EqualFold(name, attribute) {
+			return values
+		}
+	}
+
+	return nil
+}
+
+// testDirectory is an in-process stand-in for an OpenLDAP server with the memberof overlay enabled and anonymous
+// searches disabled. It implements bind, search with filter evaluation, StartTLS and unbind.
+type testDirectory struct {
+	entries   []testEntry
+	passwords map[string]string
+	tlsConfig *tls.Config
+}
+
+func newTestDirectory() *testDirectory {
+	return &testDirectory{
+		entries: []testEntry{
+			{
+				DN: "uid=alice,ou=people,dc=example,dc=org",
+				Attributes: map[string][]string{
+					"objectClass": {"inetOrgPerson"},
+					"uid":         {"alice"},
+					"mail":        {"alice@example.org"},
+					"givenName":   {"Alice"},
+					"sn":          {"Liddell"},
+					"memberOf":    {testAdminsGroupDN, testUsersGroupDN},
+				},
+			},
+			{
+				DN: "uid=bob,ou=people,dc=example,dc=org",
+				Attributes: map[string][]string{
+					"objectClass": {"inetOrgPerson"},
+					"uid":         {"bob"},
+					"givenName":   {"Bob"},
+					"sn":          {"Builder"},
+				},
+			},
+			{
+				DN: "uid=carol,ou=people,dc=example,dc=org",
+				Attributes: map[string][]string{
+					"objectClass": {"inetOrgPerson"},
+					"uid":         {"carol"},
+					"mail":        {"carol@example.org"},
+				},
+			},
+			{
+				DN: "uid=carol,ou=contractors,dc=example,dc=org",
+				Attributes: map[string][]string{
+					"objectClass": {"inetOrgPerson"},
+					"uid":         {"carol"},
+				},
+			},
+			{
+				DN: "cn=printer,ou=devices,dc=example,dc=org",
+				Attributes: map[string][]string{
+					"objectClass": {"device"},
+					"uid":         {"printer"},
+				},
+			},
+		},
+		passwords: map[string]string{
+			testServiceDN:                                testServicePassword,
+			"uid=alice,ou=people,dc=example,dc=org":      "alice-password",
+			"uid=bob,ou=people,dc=example,dc=org":        "bob-password",
+			"uid=carol,ou=people,dc=example,dc=org":      "carol-password",
+			"uid=carol,ou=contractors,dc=example,dc=org": "carol-password",
+			"cn=printer,ou=devices,dc=example,dc=org":    "printer-password",
+		},
+	}
+}
+
+// serve accepts connections on the given listener until the test completes
+func (s *testDirectory) serve(t *testing.T, listener net.Listener) {
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			if netConn, err := listener.Accept(); err != nil {
+				return
+			} else {
+				go s.handle(netConn)
+			}
+		}
+	}()
+}
+
+// packetString returns the content of a primitive element regardless of its class
+func packetString(packet *ber.Packet) string {
+	return packet.Data.String()
+}
+
+func testResult(tag ber.Tag, code uint16, message string) *ber.Packet {
+	result := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "")
+	result.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, int64(code), ""))
+	result.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", ""))
+	result.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, message, ""))
+
+	return result
+}
+
+func (s *testDirectory) handle(netConn net.Conn) {
+	var boundDN string
+
+	defer func() { netConn.Close() }()
+
+	write := func(messageID int64, protocolOp *ber.Packet) error {
+		message := ber.NewSequence("")
+		message.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, messageID, ""))
+		message.AppendChild(protocolOp)
+
+		_, err := netConn.Write(message.Bytes())
+		return err
+	}
+
+	for {
+		message, err := ber.ReadPacket(netConn)
+		if err != nil || len(message.Children) < 2 {
+			return
+		}
+
+		messageID, _ := message.Children[0].Value.(int64)
+		protocolOp := message.Children[1]
+
+		switch protocolOp.Tag {
+		case goldap.ApplicationBindRequest:
+			dn, password := packetString(protocolOp.Children[1]), packetString(protocolOp.Children[2])
+
+			if password == "" {
+				boundDN = ""
+				err = write(messageID, testResult(goldap.ApplicationBindResponse, goldap.LDAPResultSuccess, ""))
+			} else if expected, ok := s.passwords[dn]; ok && expected == password {
+				boundDN = dn
+				err = write(messageID, testResult(goldap.ApplicationBindResponse, goldap.LDAPResultSuccess, ""))
+			} else {
+				boundDN = ""
+				err = write(messageID, testResult(goldap.ApplicationBindResponse, goldap.LDAPResultInvalidCredentials, "invalid credentials"))
+			}
+
+		case goldap.ApplicationExtendedRequest:
+			if s.tlsConfig == nil || len(protocolOp.Children) == 0 || packetString(protocolOp.Children[0]) != startTLSOID {
+				err = write(messageID, testResult(goldap.ApplicationExtendedResponse, goldap.LDAPResultProtocolError, "unsupported extended operation"))
+			} else if err = write(messageID, testResult(goldap.ApplicationExtendedResponse, goldap.LDAPResultSuccess, "")); err == nil {
+				netConn = tls.Server(netConn, s.tlsConfig)
+			}
+
+		case goldap.ApplicationSearchRequest:
+			if boundDN == "" {
+				err = write(messageID, testResult(goldap.ApplicationSearchResultDone, goldap.LDAPResultInsufficientAccessRights, "anonymous search is not permitted"))
+			} else {
+				err = s.search(messageID, protocolOp, write)
+			}
+
+		case goldap.ApplicationUnbindRequest:
+			return
+		}
+
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (s *testDirectory) search(messageID int64, protocolOp *ber.Packet, write func(int64, *ber.Packet) error) error {
+	var (
+		baseDN       = strings.ToLower(packetString(protocolOp.Children[0]))
+		sizeLimit, _ = protocolOp.Children[3].Value.(int64)
+		attributes   = map[string]bool{}
+		numMatched   int64
+	)
+
+	for _, attribute := range protocolOp.Children[7].Children {
+		attributes[strings.ToLower(packetString(attribute))] = true
+	}
+
+	for _, entry := range s.entries {
+		if !strings.HasSuffix(strings.ToLower(entry.DN), baseDN) || !matchTestFilter(protocolOp.Children[6], entry) {
+			continue
+		}
+
+		if numMatched++; sizeLimit > 0 && numMatched > sizeLimit {
+			return write(messageID, testResult(goldap.ApplicationSearchResultDone, goldap.LDAPResultSizeLimitExceeded, "size limit exceeded"))
+		}
+
+		encodedAttributes := ber.NewSequence("")
+		for name, values := range entry.Attributes {
+			if !attributes[strings.ToLower(name)] {
+				continue
+			}
+
+			encodedValues := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "")
+			for _, value := range values {
+				encodedValues.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, value, ""))
+			}
+
+			encodedAttribute := ber.NewSequence("")
+			encodedAttribute.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, ""))
+			encodedAttribute.AppendChild(encodedValues)
+			encodedAttributes.AppendChild(encodedAttribute)
+		}
+
+		searchEntry := ber.Encode(ber.ClassApplication, ber.TypeConstructed, goldap.ApplicationSearchResultEntry, nil, "")
+		searchEntry.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, entry.DN, ""))
+		searchEntry.AppendChild(encodedAttributes)
+
+		if err := write(messageID, searchEntry); err != nil {
+			return err
+		}
+	}
+
+	return write(messageID, testResult(goldap.ApplicationSearchResultDone, goldap.LDAPResultSuccess, ""))
+}
+
+// matchTestFilter evaluates the filter types used by the client's search filters against an entry
+func matchTestFilter(filter *ber.Packet, entry testEntry) bool {
+	switch filter.Tag {
+	case goldap.FilterAnd, goldap.FilterOr:
+		for _, child := range filter.Children {
+			if matched := matchTestFilter(child, entry); matched != (filter.Tag == goldap.FilterAnd) {
+				return matched
+			}
+		}
+
+		return filter.Tag == goldap.FilterAnd
+
+	case goldap.FilterNot:
+		return !matchTestFilter(filter.Children[0], entry)
+
+	case goldap.FilterPresent:
+		return len(entry.Values(packetString(filter))) > 0
+
+	case goldap.FilterEqualityMatch:
+		for _, value := range entry.Values(packetString(filter.Children[0])) {
+			if strings.EqualFold(value, packetString(filter.Children[1])) {
+				return true
+			}
+		}
+
+		return false
+
+	case goldap.FilterSubstrings:
+		for _, value := range entry.Values(packetString(filter.Children[0])) {
+			remainder, matched := strings.ToLower(value), true
+
+			for _, substring := range filter.Children[1].Children {
+				needle := strings.ToLower(packetString(substring))
+
+				switch substring.Tag {
+				case goldap.FilterSubstringsInitial:
+					matched = matched && strings.HasPrefix(remainder, needle)
+					remainder = strings.TrimPrefix(remainder, needle)
+				case goldap.FilterSubstringsAny:
+					if idx := strings.Index(remainder, needle); idx < 0 {
+						matched = false
+					} else {
+						remainder = remainder[idx+len(needle):]
+					}
+				case goldap.FilterSubstringsFinal:
+					matched = matched && strings.HasSuffix(remainder, needle)
+				}
+			}
+
+			if matched {
+				return true
+			}
+		}
+
+		return false
+
+	default:
+		return false
+	}
+}
+
+// newTestCertificate returns a server certificate for 127.0.0.1 and its PEM encoding for use as a CA certificate
+func newTestCertificate(t *testing.T) (tls.Certificate, string) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ldap.example.org"},
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	certificate, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	require.Nil(t, err)
+
+	return tls.Certificate{Certificate: [][]byte{certificate}, PrivateKey: privateKey}, string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate}))
+}
+
+func testProvider(url string) model.LDAPProvider {
+	return model.LDAPProvider{
+		URL:               url,
+		BindDN:            testServiceDN,
+		BindPassword:      testServicePassword,
+		UserBaseDN:        testBaseDN,
+		UserFilter:        "(objectClass=inetOrgPerson)",
+		UsernameAttribute: "uid",
+	}
+}
+
+func TestClient_Authenticate(t *testing.T) {
+	var (
+		directory = newTestDirectory()
+		client    = &Client{Timeout: 5 * time.Second}
+		ctx       = context.Background()
+	)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	directory.serve(t, listener)
+
+	provider := testProvider("ldap://" + listener.Addr().String())
+	provider.AllowInsecure = true
+
+	t.Run("plaintext connection without opt-in", func(t *testing.T) {
+		insecure := provider
+		insecure.AllowInsecure = false
+
+		_, err := client.Authenticate(ctx, insecure, "alice", "alice-password")
+		require.ErrorIs(t, err, ErrInsecureConnection)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		user, err := client.Authenticate(ctx, provider, "alice", "alice-password")
+		require.Nil(t, err)
+		require.Equal(t, User{
+			DN:        "uid=alice,ou=people,dc=example,dc=org",
+			Username:  "alice",
+			Email:     "alice@example.org",
+			FirstName: "Alice",
+			LastName:  "Liddell",
+			Groups:    []string{testAdminsGroupDN, testUsersGroupDN},
+		}, user)
+	})
+
+	t.Run("success without optional attributes", func(t *testing.T) {
+		user, err := client.Authenticate(ctx, provider, "bob", "bob-password")
+		require.Nil(t, err)
+		require.Equal(t, "bob", user.Username)
+		require.Empty(t, user.Email)
+		require.Empty(t, user.Groups)
+	})
+
+	t.Run("wrong password", func(t *testing.T) {
+		_, err := client.Authenticate(ctx, provider, "alice", "bob-password")
+		require.ErrorIs(t, err, ErrInvalidCredentials)
+	})
+
+	t.Run("empty password is never sent as an unauthenticated bind", func(t *testing.T) {
+		_, err := client.Authenticate(ctx, provider, "alice", "")
+		require.ErrorIs(t, err, ErrInvalidCredentials)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		_, err := client.Authenticate(ctx, provider, "mallory", "mallory-password")
+		require.ErrorIs(t, err, ErrUserNotFound)
+	})
+
+	t.Run("user filter excludes entry", func(t *testing.T) {
+		_, err := client.Authenticate(ctx, provider, "printer", "printer-password")
+		require.ErrorIs(t, err, ErrUserNotFound)
+	})
+
+	t.Run("username is escaped", func(t *testing.T) {
+		_, err := client.Authenticate(ctx, provider, "a*", "alice-password")
+		require.ErrorIs(t, err, ErrUserNotFound)
+
+		_, err = client.Authenticate(ctx, provider, "*)(uid=alice", "alice-password")
+		require.ErrorIs(t, err, ErrUserNotFound)
+	})
+
+	t.Run("ambiguous user", func(t *testing.T) {
+		_, err := client.Authenticate(ctx, provider, "carol", "carol-password")
+		require.ErrorIs(t, err, ErrAmbiguousUser)
+	})
+
+	t.Run("narrowed user base", func(t *testing.T) {
+		narrowed := provider
+		narrowed.UserBaseDN = "ou=people," + testBaseDN
+
+		user, err := client.Authenticate(ctx, narrowed, "carol", "carol-password")
+		require.Nil(t, err)
+		require.Equal(t, "uid=carol,ou=people,dc=example,dc=org", user.DN)
+	})
+
+	t.Run("wrong service account password", func(t *testing.T) {
+		misconfigured := provider
+		misconfigured.BindPassword = "wrong"
+
+		_, err := client.Authenticate(ctx, misconfigured, "alice", "alice-password")
+		require.ErrorContains(t, err, "service account bind")
+		require.NotErrorIs(t, err, ErrInvalidCredentials)
+	})
+
+	t.Run("anonymous search rejected", func(t *testing.T) {
+		anonymous := provider
+		anonymous.BindDN = ""
+
+		_, err := client.Authenticate(ctx, anonymous, "alice", "alice-password")
+		require.True(t, goldap.IsErrorWithCode(err, goldap.LDAPResultInsufficientAccessRights), err)
+	})
+
+	t.Run("start tls unsupported", func(t *testing.T) {
+		startTLS := provider
+		startTLS.StartTLS = true
+
+		_, err := client.Authenticate(ctx, startTLS, "alice", "alice-password")
+		require.ErrorContains(t, err, "start tls")
+	})
+}
+
+func TestClient_Authenticate_TLS(t *testing.T) {
+	var (
+		directory       = newTestDirectory()
+		client          = &Client{Timeout: 5 * time.Second}
+		ctx             = context.Background()
+		certificate, ca = newTestCertificate(t)
+		_, unrelatedCA  = newTestCertificate(t)
+		serverTLSConfig = &tls.Config{Certificates: []tls.Certificate{certificate}}
+	)
+
+	directory.tlsConfig = serverTLSConfig
+
+	plaintextListener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	directory.serve(t, plaintextListener)
+
+	tlsListener, err := tls.Listen("tcp", "127.0.0.1:0", serverTLSConfig)
+	require.Nil(t, err)
+	directory.serve(t, tlsListener)
+
+	t.Run("ldaps", func(t *testing.T) {
+		provider := testProvider("ldaps://" + tlsListener.Addr().String())
+		provider.CACertificate = ca
+
+		user, err := client.Authenticate(ctx, provider, "alice", "alice-password")
+		require.Nil(t, err)
+		require.Equal(t, "alice@example.org", user.Email)
+	})
+
+	t.Run("start tls", func(t *testing.T) {
+		provider := testProvider("ldap://" + plaintextListener.Addr().String())
+		provider.StartTLS = true
+		provider.CACertificate = ca
+
+		user, err := client.Authenticate(ctx, provider, "alice", "alice-password")
+		require.Nil(t, err)
+		require.Equal(t, "alice@example.org", user.Email)
+	})
+
+	t.Run("untrusted certificate", func(t *testing.T) {
+		provider := testProvider("ldaps://" + tlsListener.Addr().String())
+		provider.CACertificate = unrelatedCA
+
+		_, err := client.Authenticate(ctx, provider, "alice", "alice-password")
+		require.ErrorContains(t, err, "tls handshake")
+	})
+
+	t.Run("invalid ca certificate", func(t *testing.T) {
+		provider := testProvider("ldaps://" + tlsListener.Addr().String())
+		provider.CACertificate = "not a certificate"
+
+		_, err := client.Authenticate(ctx, provider, "alice", "alice-password")
+		require.ErrorIs(t, err, ErrInvalidCACertificate)
+	})
+}
+
+func TestValidateTransport(t *testing.T) {
+	provider := testProvider("ldap://dc01.example.org")
+	require.ErrorIs(t, ValidateTransport(provider), ErrInsecureConnection)
+
+	provider.StartTLS = true
+	require.Nil(t, ValidateTransport(provider))
+
+	provider.StartTLS, provider.AllowInsecure = false, true
+	require.Nil(t, ValidateTransport(provider))
+
+	require.Nil(t, ValidateTransport(testProvider("ldaps://dc01.example.org")))
+}
+
+func TestParseURL(t *testing.T) {
+	address, implicitTLS, err := ParseURL("ldap://dc01.example.org")
+	require.Nil(t, err)
+	require.Equal(t, "dc01.example.org:389", address)
+	require.False(t, implicitTLS)
+
+	address, implicitTLS, err = ParseURL("LDAPS://dc01.example.org")
+	require.Nil(t, err)
+	require.Equal(t, "dc01.example.org:636", address)
+	require.True(t, implicitTLS)
+
+	address, _, err = ParseURL("ldaps://10.0.0.1:3269")
+	require.Nil(t, err)
+	require.Equal(t, "10.0.0.1:3269", address)
+
+	_, _, err = ParseURL("https://dc01.example.org")
+	require.ErrorIs(t, err, ErrInvalidURL)
+
+	_, _, err = ParseURL("dc01.example.org")
+	require.ErrorIs(t, err, ErrInvalidURL)
+}
+
+func TestValidateFilter(t *testing.T) {
+	entry := testEntry{
+		DN: "uid=alice,ou=people,dc=example,dc=org",
+		Attributes: map[string][]string{
+			"objectClass": {"top", "inetOrgPerson"},
+			"uid":         {"alice"},
+			"cn":          {"Alice (Admin) Liddell"},
+		},
+	}
+
+	for filter, expected := range map[string]bool{
+		"(uid=alice)":               true,
+		"(UID=ALICE)":               true,
+		"(uid=bob)":                 false,
+		"(mail=*)":                  false,
+		"(uid=*)":                   true,
+		"(uid=al*)":                 true,
+		"(uid=*ic*)":                true,
+		"(uid=*ce)":                 true,
+		"(uid=a*z)":                 false,
+		"(cn=Alice \\28Admin\\29*)": true,
+		"(&(objectClass=inetOrgPerson)(uid=alice))":      true,
+		"(&(objectClass=inetOrgPerson)(uid=bob))":        false,
+		"(|(uid=bob)(uid=alice))":                        true,
+		"(!(uid=alice))":                                 false,
+		"(&(objectClass=top)(!(uid=bob)))":               true,
+		"(userAccountControl:1.2.840.113556.1.4.803:=2)": false,
+	} {
+		require.Nil(t, ValidateFilter(filter), filter)
+
+		compiled, err := goldap.CompileFilter(filter)
+		require.Nil(t, err, filter)
+		require.Equal(t, expected, matchTestFilter(compiled, entry), filter)
+	}
+
+	for _, filter := range []string{"", "uid=alice", "(uid=alice", "(uid=alice))", "(uid=\\2)", "(!(uid=alice)(uid=bob))"} {
+		require.ErrorIs(t, ValidateFilter(filter), ErrInvalidFilter, filter)
+	}
+}
+
+func TestUserSearchFilter(t *testing.T) {
+	provider := testProvider("ldap://127.0.0.1")
+	require.Equal(t, "(&(objectClass=inetOrgPerson)(uid=alice))", UserSearchFilter(provider, "alice"))
+	require.Equal(t, "(&(objectClass=inetOrgPerson)(uid=\\2a\\29\\28uid=\\2a\\5c\\00))", UserSearchFilter(provider, "*)(uid=*\\\x00"))
+
+	provider.UserFilter = ""
+	require.Equal(t, "(uid=alice)", UserSearchFilter(provider, "alice"))
+}
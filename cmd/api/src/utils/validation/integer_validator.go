@@ -0,0 +1,83 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	"github.com/specterops/bloodhound/cmd/api/src/utils"
+)
+
+const (
+	ErrorInteger    = "invalid integer provided %v"
+	ErrorIntegerMin = "must be >= %d"
+	ErrorIntegerMax = "must be <= %d"
+)
+
+type IntegerValidator struct {
+	min, max       int
+	hasMin, hasMax bool
+}
+
+func NewIntegerValidator(params map[string]string) Validator {
+	validator := IntegerValidator{}
+
+	if rawMin, ok := params["min"]; ok {
+		if value, err := strconv.Atoi(rawMin); err != nil {
+			slog.Warn(fmt.Sprintf("NewIntegerValidator invalid min limit provided %s", rawMin))
+		} else {
+			validator.min, validator.hasMin = value, true
+		}
+	}
+
+	if rawMax, ok := params["max"]; ok {
+		if value, err := strconv.Atoi(rawMax); err != nil {
+			slog.Warn(fmt.Sprintf("NewIntegerValidator invalid max limit provided %s", rawMax))
+		} else {
+			validator.max, validator.hasMax = value, true
+		}
+	}
+
+	return validator
+}
+
+func (s IntegerValidator) Validate(value any) utils.Errors {
+	var (
+		i    int
+		ok   bool
+		errs = utils.Errors{}
+	)
+	if i, ok = value.(int); !ok {
+		return append(errs, fmt.Errorf(ErrorInteger, value))
+	}
+
+	if s.hasMin && i < s.min {
+		errs = append(errs, fmt.Errorf(ErrorIntegerMin, s.min))
+	}
+
+	if s.hasMax && i > s.max {
+		errs = append(errs, fmt.Errorf(ErrorIntegerMax, s.max))
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/specterops/bloodhound/cmd/api/src/utils"
+	"github.com/specterops/bloodhound/cmd/api/src/utils/validation"
+)
+
+func TestIntegerValidator(t *testing.T) {
+
+	type Attempts struct {
+		Count int `validate:"integer,min=0,max=10"`
+	}
+
+	minErr := fmt.Errorf("Count: "+validation.ErrorIntegerMin, 0)
+	maxErr := fmt.Errorf("Count: "+validation.ErrorIntegerMax, 10)
+
+	var cases = []struct {
+		Input  Attempts
+		Errors utils.Errors
+	}{
+		{Attempts{-1}, utils.Errors{minErr}},
+		{Attempts{0}, nil},
+		{Attempts{10}, nil},
+		{Attempts{11}, utils.Errors{maxErr}},
+	}
+
+	for _, tc := range cases {
+		if errs := validation.Validate(tc.Input); errs != nil {
+			if tc.Errors == nil {
+				t.Errorf("For input: %v, expected errs to be nil: errs = %v\n", tc.Input, errs)
+			} else if errs.Error() != tc.Errors.Error() {
+				t.Errorf("For input: %v, got %v, want %v\n", tc.Input, errs, tc.Errors)
+			}
+		} else if tc.Errors != nil {
+			t.Errorf("For input: %v, expected errs to not be nil\n", tc.Input)
+		}
+	}
+}
@@ -50,6 +50,7 @@ func init() {
 		"password": NewPasswordValidator,
 		"required": NewRequiredValidator,
 		"duration": NewDurationValidator,
+		"integer":  NewIntegerValidator,
 		"url":      NewUrlValidator,
 		"rrule":    NewRRuleValidator,
 	}}
@@ -1655,6 +1655,21 @@ CanApplyGPO: types.#Kind & {
 	schema: "active_directory"
 }
 
+SyncedToEntraGroup: types.#Kind & {
+	symbol: "SyncedToEntraGroup"
+	schema: "active_directory"
+}
+
+SyncedToEntraDevice: types.#Kind & {
+	symbol: "SyncedToEntraDevice"
+	schema: "active_directory"
+}
+
+EntraSyncAccountFor: types.#Kind & {
+	symbol: "EntraSyncAccountFor"
+	schema: "active_directory"
+}
+
 // Relationship Kinds
 RelationshipKinds: [
 	Owns,
@@ -1742,6 +1757,9 @@ RelationshipKinds: [
 	GPOAppliesTo,
 	CanApplyGPO,
 	HasTrustKeys,
+	SyncedToEntraGroup,
+	SyncedToEntraDevice,
+	EntraSyncAccountFor,
 ]
 
 // ACL Relationships
@@ -1820,6 +1838,8 @@ SharedRelationshipKinds: [
 	ADCSESC10b,
 	ADCSESC13,
 	SyncedToEntraUser,
+	SyncedToEntraGroup,
+	SyncedToEntraDevice,
 	CoerceAndRelayNTLMToSMB,
 	CoerceAndRelayNTLMToADCS,
 	WriteOwnerLimitedRights,
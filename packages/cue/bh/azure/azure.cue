@@ -310,6 +310,188 @@ EndUserAssignmentRequiresTicketInformation: types.#StringEnum & {
 	representation: "enduserassignmentrequiresticketinformation"
 }
 
+CAPolicyState: types.#StringEnum & {
+	symbol:         "CAPolicyState"
+	schema:         "azure"
+	name:           "CA Policy State"
+	representation: "capolicystate"
+}
+
+CAPolicyIncludeUsers: types.#StringEnum & {
+	symbol:         "CAPolicyIncludeUsers"
+	schema:         "azure"
+	name:           "CA Policy Include Users"
+	representation: "includeusers"
+}
+
+CAPolicyExcludeUsers: types.#StringEnum & {
+	symbol:         "CAPolicyExcludeUsers"
+	schema:         "azure"
+	name:           "CA Policy Exclude Users"
+	representation: "excludeusers"
+}
+
+CAPolicyIncludeGroups: types.#StringEnum & {
+	symbol:         "CAPolicyIncludeGroups"
+	schema:         "azure"
+	name:           "CA Policy Include Groups"
+	representation: "includegroups"
+}
+
+CAPolicyExcludeGroups: types.#StringEnum & {
+	symbol:         "CAPolicyExcludeGroups"
+	schema:         "azure"
+	name:           "CA Policy Exclude Groups"
+	representation: "excludegroups"
+}
+
+CAPolicyIncludeRoles: types.#StringEnum & {
+	symbol:         "CAPolicyIncludeRoles"
+	schema:         "azure"
+	name:           "CA Policy Include Roles"
+	representation: "includeroles"
+}
+
+CAPolicyExcludeRoles: types.#StringEnum & {
+	symbol:         "CAPolicyExcludeRoles"
+	schema:         "azure"
+	name:           "CA Policy Exclude Roles"
+	representation: "excluderoles"
+}
+
+CAPolicyIncludeApplications: types.#StringEnum & {
+	symbol:         "CAPolicyIncludeApplications"
+	schema:         "azure"
+	name:           "CA Policy Include Applications"
+	representation: "includeapplications"
+}
+
+CAPolicyExcludeApplications: types.#StringEnum & {
+	symbol:         "CAPolicyExcludeApplications"
+	schema:         "azure"
+	name:           "CA Policy Exclude Applications"
+	representation: "excludeapplications"
+}
+
+CAPolicyGrantControls: types.#StringEnum & {
+	symbol:         "CAPolicyGrantControls"
+	schema:         "azure"
+	name:           "CA Policy Grant Controls"
+	representation: "grantcontrols"
+}
+
+CAPolicyGrantOperator: types.#StringEnum & {
+	symbol:         "CAPolicyGrantOperator"
+	schema:         "azure"
+	name:           "CA Policy Grant Operator"
+	representation: "grantoperator"
+}
+
+CAPBlocked: types.#StringEnum & {
+	symbol:         "CAPBlocked"
+	schema:         "azure"
+	name:           "Blocked By Conditional Access"
+	representation: "capblocked"
+}
+
+CAPBlockedBy: types.#StringEnum & {
+	symbol:         "CAPBlockedBy"
+	schema:         "azure"
+	name:           "Conditional Access Policies Blocking"
+	representation: "capblockedby"
+}
+
+CAPMFARequired: types.#StringEnum & {
+	symbol:         "CAPMFARequired"
+	schema:         "azure"
+	name:           "MFA Required By Conditional Access"
+	representation: "capmfarequired"
+}
+
+CAPMFARequiredBy: types.#StringEnum & {
+	symbol:         "CAPMFARequiredBy"
+	schema:         "azure"
+	name:           "Conditional Access Policies Requiring MFA"
+	representation: "capmfarequiredby"
+}
+
+IntuneDeviceID: types.#StringEnum & {
+	symbol:         "IntuneDeviceID"
+	schema:         "azure"
+	name:           "Intune Device ID"
+	representation: "intunedeviceid"
+}
+
+IntuneComplianceState: types.#StringEnum & {
+	symbol:         "IntuneComplianceState"
+	schema:         "azure"
+	name:           "Intune Compliance State"
+	representation: "compliancestate"
+}
+
+IntuneManagementAgent: types.#StringEnum & {
+	symbol:         "IntuneManagementAgent"
+	schema:         "azure"
+	name:           "Intune Management Agent"
+	representation: "managementagent"
+}
+
+IntuneScopeTagIDs: types.#StringEnum & {
+	symbol:         "IntuneScopeTagIDs"
+	schema:         "azure"
+	name:           "Intune Scope Tag IDs"
+	representation: "scopetagids"
+}
+
+IntuneRoleDefinitionName: types.#StringEnum & {
+	symbol:         "IntuneRoleDefinitionName"
+	schema:         "azure"
+	name:           "Intune Role Definition Name"
+	representation: "intuneroledefinitionname"
+}
+
+IntuneScopeType: types.#StringEnum & {
+	symbol:         "IntuneScopeType"
+	schema:         "azure"
+	name:           "Intune Scope Type"
+	representation: "intunescopetype"
+}
+
+IntuneScopeGroups: types.#StringEnum & {
+	symbol:         "IntuneScopeGroups"
+	schema:         "azure"
+	name:           "Intune Scope Groups"
+	representation: "intunescopegroups"
+}
+
+IntuneCanRunScripts: types.#StringEnum & {
+	symbol:         "IntuneCanRunScripts"
+	schema:         "azure"
+	name:           "Can Run Intune Scripts"
+	representation: "canrunscripts"
+}
+
+IntuneRunAsAccount: types.#StringEnum & {
+	symbol:         "IntuneRunAsAccount"
+	schema:         "azure"
+	name:           "Intune Script Run As Account"
+	representation: "runasaccount"
+}
+
+StoragePublicAccess: types.#StringEnum & {
+	symbol:         "StoragePublicAccess"
+	schema:         "azure"
+	name:           "Public Access"
+	representation: "publicaccess"
+}
+
+TenantDomains: types.#StringEnum & {
+	symbol:         "TenantDomains"
+	schema:         "azure"
+	name:           "Tenant Domains"
+	representation: "domains"
+}
+
 
 Properties: [
 	AppOwnerOrganizationID,
@@ -351,7 +533,33 @@ Properties: [
 	EndUserAssignmentGroupApprovers,
 	EndUserAssignmentRequiresMFA,
 	EndUserAssignmentRequiresJustification,
-	EndUserAssignmentRequiresTicketInformation
+	EndUserAssignmentRequiresTicketInformation,
+	CAPolicyState,
+	CAPolicyIncludeUsers,
+	CAPolicyExcludeUsers,
+	CAPolicyIncludeGroups,
+	CAPolicyExcludeGroups,
+	CAPolicyIncludeRoles,
+	CAPolicyExcludeRoles,
+	CAPolicyIncludeApplications,
+	CAPolicyExcludeApplications,
+	CAPolicyGrantControls,
+	CAPolicyGrantOperator,
+	CAPBlocked,
+	CAPBlockedBy,
+	CAPMFARequired,
+	CAPMFARequiredBy,
+	IntuneDeviceID,
+	IntuneComplianceState,
+	IntuneManagementAgent,
+	IntuneScopeTagIDs,
+	IntuneRoleDefinitionName,
+	IntuneScopeType,
+	IntuneScopeGroups,
+	IntuneCanRunScripts,
+	IntuneRunAsAccount,
+	StoragePublicAccess,
+	TenantDomains
 ]
 
 // Kinds
@@ -475,6 +683,42 @@ AutomationAccount: types.#Kind & {
 	representation: "AZAutomationAccount"
 }
 
+ConditionalAccessPolicy: types.#Kind & {
+	symbol:         "ConditionalAccessPolicy"
+	schema:         "azure"
+	representation: "AZConditionalAccessPolicy"
+}
+
+IntuneRoleAssignment: types.#Kind & {
+	symbol:         "IntuneRoleAssignment"
+	schema:         "azure"
+	representation: "AZIntuneRoleAssignment"
+}
+
+IntuneScript: types.#Kind & {
+	symbol:         "IntuneScript"
+	schema:         "azure"
+	representation: "AZIntuneScript"
+}
+
+StorageAccount: types.#Kind & {
+	symbol:         "StorageAccount"
+	schema:         "azure"
+	representation: "AZStorageAccount"
+}
+
+StorageContainer: types.#Kind & {
+	symbol:         "StorageContainer"
+	schema:         "azure"
+	representation: "AZStorageContainer"
+}
+
+StorageFileShare: types.#Kind & {
+	symbol:         "StorageFileShare"
+	schema:         "azure"
+	representation: "AZStorageFileShare"
+}
+
 NodeKinds: [
 	Entity,
 	VMScaleSet,
@@ -496,6 +740,12 @@ NodeKinds: [
 	WebApp,
 	LogicApp,
 	AutomationAccount,
+	ConditionalAccessPolicy,
+	IntuneRoleAssignment,
+	IntuneScript,
+	StorageAccount,
+	StorageContainer,
+	StorageFileShare,
 ]
 
 AvereContributor: types.#Kind & {
@@ -804,6 +1054,96 @@ AZRoleApprover: types.#Kind & {
 	representation:	"AZRoleApprover"
 }
 
+CAPolicyTargets: types.#Kind & {
+	symbol:			"CAPolicyTargets"
+	schema:			"azure"
+	representation:	"AZCAPolicyTargets"
+}
+
+CAPolicyExcludes: types.#Kind & {
+	symbol:			"CAPolicyExcludes"
+	schema:			"azure"
+	representation:	"AZCAPolicyExcludes"
+}
+
+HasIntuneRole: types.#Kind & {
+	symbol:			"HasIntuneRole"
+	schema:			"azure"
+	representation:	"AZHasIntuneRole"
+}
+
+IntuneScriptTargets: types.#Kind & {
+	symbol:			"IntuneScriptTargets"
+	schema:			"azure"
+	representation:	"AZIntuneScriptTargets"
+}
+
+StorageAccountContributor: types.#Kind & {
+	symbol:			"StorageAccountContributor"
+	schema:			"azure"
+	representation:	"AZStorageAccountContributor"
+}
+
+StorageBlobDataOwner: types.#Kind & {
+	symbol:			"StorageBlobDataOwner"
+	schema:			"azure"
+	representation:	"AZStorageBlobDataOwner"
+}
+
+StorageBlobDataContributor: types.#Kind & {
+	symbol:			"StorageBlobDataContributor"
+	schema:			"azure"
+	representation:	"AZStorageBlobDataContributor"
+}
+
+GetStorageKeys: types.#Kind & {
+	symbol:			"GetStorageKeys"
+	schema:			"azure"
+	representation:	"AZGetStorageKeys"
+}
+
+AccessBlobData: types.#Kind & {
+	symbol:			"AccessBlobData"
+	schema:			"azure"
+	representation:	"AZAccessBlobData"
+}
+
+StorageFileSMBContributor: types.#Kind & {
+	symbol:			"StorageFileSMBContributor"
+	schema:			"azure"
+	representation:	"AZStorageFileSMBContributor"
+}
+
+StorageFileSMBElevatedContributor: types.#Kind & {
+	symbol:			"StorageFileSMBElevatedContributor"
+	schema:			"azure"
+	representation:	"AZStorageFileSMBElevatedContributor"
+}
+
+StorageFilePrivilegedContributor: types.#Kind & {
+	symbol:			"StorageFilePrivilegedContributor"
+	schema:			"azure"
+	representation:	"AZStorageFilePrivilegedContributor"
+}
+
+AccessFileData: types.#Kind & {
+	symbol:			"AccessFileData"
+	schema:			"azure"
+	representation:	"AZAccessFileData"
+}
+
+SyncedToADGroup: types.#Kind & {
+	symbol:			"SyncedToADGroup"
+	schema:			"azure"
+	representation:	"SyncedToADGroup"
+}
+
+SyncedToADComputer: types.#Kind & {
+	symbol:			"SyncedToADComputer"
+	schema:			"azure"
+	representation:	"SyncedToADComputer"
+}
+
 RelationshipKinds: [
 	AvereContributor,
 	Contains,
@@ -854,6 +1194,21 @@ RelationshipKinds: [
 	SyncedToADUser,
 	AZRoleEligible,
 	AZRoleApprover,
+	CAPolicyTargets,
+	CAPolicyExcludes,
+	HasIntuneRole,
+	IntuneScriptTargets,
+	StorageAccountContributor,
+	StorageBlobDataOwner,
+	StorageBlobDataContributor,
+	GetStorageKeys,
+	AccessBlobData,
+	StorageFileSMBContributor,
+	StorageFileSMBElevatedContributor,
+	StorageFilePrivilegedContributor,
+	AccessFileData,
+	SyncedToADGroup,
+	SyncedToADComputer,
 ]
 
 AppRoleTransitRelationshipKinds: [
@@ -903,6 +1258,7 @@ ControlRelationshipKinds: [
 	AZMGAddSecret,
 	AZMGGrantAppRoles,
 	AZMGGrantRole,
+	StorageAccountContributor,
 ]
 
 ExecutionPrivilegeKinds: [
@@ -955,7 +1311,18 @@ InboundOutboundRelationshipKinds: [
 	AZMGGrantRole,
 	SyncedToADUser,
 	AZRoleEligible,
-	AZRoleApprover
+	AZRoleApprover,
+	StorageAccountContributor,
+	StorageBlobDataOwner,
+	StorageBlobDataContributor,
+	GetStorageKeys,
+	AccessBlobData,
+	StorageFileSMBContributor,
+	StorageFileSMBElevatedContributor,
+	StorageFilePrivilegedContributor,
+	AccessFileData,
+	SyncedToADGroup,
+	SyncedToADComputer
 ]
 
 PathfindingRelationships: list.Concat([InboundOutboundRelationshipKinds, [Contains]])
@@ -59,6 +59,9 @@ func PostProcessedRelationships() []graph.Kind {
 		ad.ADCSESC13,
 		ad.EnrollOnBehalfOf,
 		ad.SyncedToEntraUser,
+		ad.SyncedToEntraGroup,
+		ad.SyncedToEntraDevice,
+		ad.EntraSyncAccountFor,
 		ad.Owns,
 		ad.WriteOwner,
 		ad.ExtendedByPolicy,
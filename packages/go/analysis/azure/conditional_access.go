@@ -0,0 +1,363 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package azure
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/specterops/bloodhound/packages/go/bhlog/measure"
+	"github.com/specterops/bloodhound/packages/go/graphschema/azure"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/ops"
+	"github.com/specterops/dawgs/query"
+)
+
+// ConditionalAccessEvaluatedRelationships returns the post-processed relationship kinds that are annotated with the
+// Conditional Access policies that would block them or require MFA for them
+func ConditionalAccessEvaluatedRelationships() []graph.Kind {
+	return []graph.Kind{
+		azure.AddSecret,
+		azure.ExecuteCommand,
+		azure.ResetPassword,
+		azure.AddMembers,
+		azure.GlobalAdmin,
+		azure.PrivilegedRoleAdmin,
+		azure.PrivilegedAuthAdmin,
+	}
+}
+
+// ConditionalAccessResourceApps returns the resource application IDs an attacker must authenticate to in order to
+// abuse the given relationship kind. A policy only restricts the relationship when it covers every one of them.
+func ConditionalAccessResourceApps(kind graph.Kind) []string {
+	switch {
+	case kind.Is(azure.ExecuteCommand):
+		return []string{azure.IntuneAppID}
+	default:
+		return []string{azure.MSGraphAppUniversalID}
+	}
+}
+
+// ConditionalAccessPolicy is the evaluated form of an AZConditionalAccessPolicy node
+type ConditionalAccessPolicy struct {
+	ObjectID            string
+	IncludeUsers        []string
+	ExcludeUsers        []string
+	IncludeGroups       []string
+	ExcludeGroups       []string
+	IncludeRoles        []string
+	ExcludeRoles        []string
+	IncludeApplications []string
+	ExcludeApplications []string
+	GrantControls       []string
+	GrantOperator       string
+}
+
+func NewConditionalAccessPolicy(node *graph.Node) (ConditionalAccessPolicy, error) {
+	var (
+		policy = ConditionalAccessPolicy{}
+		err    error
+	)
+
+	if policy.ObjectID, err = node.Properties.Get(common.ObjectID.String()).String(); err != nil {
+		return policy, fmt.Errorf("conditional access policy node %d is missing property %s: %w", node.ID, common.ObjectID, err)
+	}
+
+	for property, target := range map[azure.Property]*[]string{
+		azure.CAPolicyIncludeUsers:        &policy.IncludeUsers,
+		azure.CAPolicyExcludeUsers:        &policy.ExcludeUsers,
+		azure.CAPolicyIncludeGroups:       &policy.IncludeGroups,
+		azure.CAPolicyExcludeGroups:       &policy.ExcludeGroups,
+		azure.CAPolicyIncludeRoles:        &policy.IncludeRoles,
+		azure.CAPolicyExcludeRoles:        &policy.ExcludeRoles,
+		azure.CAPolicyIncludeApplications: &policy.IncludeApplications,
+		azure.CAPolicyExcludeApplications: &policy.ExcludeApplications,
+		azure.CAPolicyGrantControls:       &policy.GrantControls,
+	} {
+		if values, err := node.Properties.GetOrDefault(property.String(), []any{}).StringSlice(); err != nil {
+			return policy, fmt.Errorf("conditional access policy node %d property %s is not a string slice: %w", node.ID, property, err)
+		} else {
+			*target = values
+		}
+	}
+
+	policy.GrantOperator, _ = node.Properties.GetOrDefault(azure.CAPolicyGrantOperator.String(), "").String()
+	return policy, nil
+}
+
+// Blocks returns true if the policy blocks access outright
+func (s ConditionalAccessPolicy) Blocks() bool {
+	return slices.Contains(s.GrantControls, azure.ConditionalAccessControlBlock)
+}
+
+// RequiresMFA returns true if every way of satisfying the policy's grant controls involves multifactor authentication
+func (s ConditionalAccessPolicy) RequiresMFA() bool {
+	var requiresMFA = false
+
+	for _, control := range s.GrantControls {
+		switch control {
+		case azure.ConditionalAccessControlMFA, azure.ConditionalAccessControlAuthenticationStrength:
+			requiresMFA = true
+		default:
+			// Any other control satisfies an OR policy on its own, e.g. a compliant or hybrid joined device
+			if strings.EqualFold(s.GrantOperator, azure.ConditionalAccessOperatorOR) {
+				return false
+			}
+		}
+	}
+
+	return requiresMFA
+}
+
+// AppliesToApplications returns true if the policy covers every one of the given resource application IDs
+func (s ConditionalAccessPolicy) AppliesToApplications(appIDs ...string) bool {
+	for _, appID := range appIDs {
+		if containsFold(s.ExcludeApplications, appID) {
+			return false
+		} else if !containsFold(s.IncludeApplications, azure.ConditionalAccessAll) && !containsFold(s.IncludeApplications, appID) {
+			return false
+		}
+	}
+
+	return len(appIDs) > 0
+}
+
+// AppliesToRole returns true if the policy targets every principal that holds the given role. Exclusions of
+// individual users or groups can not be resolved against a role and are therefore treated as not applying.
+func (s ConditionalAccessPolicy) AppliesToRole(roleTemplateID string) bool {
+	if containsFold(s.ExcludeRoles, roleTemplateID) || len(s.ExcludeUsers) > 0 || len(s.ExcludeGroups) > 0 {
+		return false
+	}
+
+	return containsFold(s.IncludeUsers, azure.ConditionalAccessAll) || containsFold(s.IncludeRoles, roleTemplateID)
+}
+
+// AppliesToUser returns true if the policy targets the given user after its exclusions have been applied
+func (s ConditionalAccessPolicy) AppliesToUser(userID string, groupIDs []string, roleTemplateIDs []string) bool {
+	if containsFold(s.ExcludeUsers, userID) || containsAnyFold(s.ExcludeGroups, groupIDs) || containsAnyFold(s.ExcludeRoles, roleTemplateIDs) {
+		return false
+	}
+
+	return containsFold(s.IncludeUsers, azure.ConditionalAccessAll) ||
+		containsFold(s.IncludeUsers, userID) ||
+		containsAnyFold(s.IncludeGroups, groupIDs) ||
+		containsAnyFold(s.IncludeRoles, roleTemplateIDs)
+}
+
+func containsFold(values []string, value string) bool {
+	return slices.ContainsFunc(values, func(next string) bool {
+		return strings.EqualFold(next, value)
+	})
+}
+
+func containsAnyFold(values []string, candidates []string) bool {
+	return slices.ContainsFunc(candidates, func(candidate string) bool {
+		return containsFold(values, candidate)
+	})
+}
+
+// FetchTenantConditionalAccessPolicies returns the enabled Conditional Access policies contained by the given tenant.
+// Policies in report-only mode are not enforced and are skipped.
+func FetchTenantConditionalAccessPolicies(tx graph.Transaction, tenant *graph.Node) ([]ConditionalAccessPolicy, error) {
+	if policyNodes, err := EndNodes(tx, tenant, azure.Contains, azure.ConditionalAccessPolicy); err != nil {
+		return nil, err
+	} else {
+		policies := make([]ConditionalAccessPolicy, 0, policyNodes.Len())
+
+		for _, policyNode := range policyNodes {
+			if state, _ := policyNode.Properties.GetOrDefault(azure.CAPolicyState.String(), "").String(); state != azure.ConditionalAccessStateEnabled {
+				continue
+			} else if policy, err := NewConditionalAccessPolicy(policyNode); err != nil {
+				return nil, err
+			} else {
+				policies = append(policies, policy)
+			}
+		}
+
+		return policies, nil
+	}
+}
+
+type conditionalAccessOutcome struct {
+	BlockedBy     []string
+	MFARequiredBy []string
+}
+
+type conditionalAccessCandidate struct {
+	Relationship *graph.Relationship
+	StartNode    *graph.Node
+}
+
+type conditionalAccessMembership struct {
+	GroupIDs        []string
+	RoleTemplateIDs []string
+}
+
+func evaluateConditionalAccessPolicies(policies []ConditionalAccessPolicy, appIDs []string, appliesTo func(policy ConditionalAccessPolicy) bool) conditionalAccessOutcome {
+	outcome := conditionalAccessOutcome{
+		BlockedBy:     []string{},
+		MFARequiredBy: []string{},
+	}
+
+	for _, policy := range policies {
+		if !policy.AppliesToApplications(appIDs...) || !appliesTo(policy) {
+			continue
+		}
+
+		if policy.Blocks() {
+			outcome.BlockedBy = append(outcome.BlockedBy, policy.ObjectID)
+		} else if policy.RequiresMFA() {
+			outcome.MFARequiredBy = append(outcome.MFARequiredBy, policy.ObjectID)
+		}
+	}
+
+	return outcome
+}
+
+// fetchUserConditionalAccessMembership returns the object IDs of the groups and the template IDs of the roles
+// that Conditional Access will consider when evaluating a policy for the given user
+func fetchUserConditionalAccessMembership(tx graph.Transaction, user *graph.Node) (conditionalAccessMembership, error) {
+	var membership conditionalAccessMembership
+
+	if groups, err := FetchEntityGroupMembership(tx, user, 0, 0); err != nil {
+		return membership, err
+	} else if roles, err := FetchEntityRoles(tx, user, 0, 0); err != nil {
+		return membership, err
+	} else {
+		for _, group := range groups {
+			if objectID, err := group.Properties.Get(common.ObjectID.String()).String(); err == nil {
+				membership.GroupIDs = append(membership.GroupIDs, objectID)
+			}
+		}
+
+		for _, role := range roles {
+			if roleTemplateID, err := role.Properties.Get(azure.RoleTemplateID.String()).String(); err == nil {
+				membership.RoleTemplateIDs = append(membership.RoleTemplateIDs, roleTemplateID)
+			}
+		}
+
+		return membership, nil
+	}
+}
+
+// ApplyConditionalAccessPolicies annotates post-processed Azure relationships with the enabled Conditional Access
+// policies that would block them or require MFA for them. Only relationships that originate from a role or a user are
+// evaluated as service principals are not subject to user-targeted Conditional Access policies.
+func ApplyConditionalAccessPolicies(ctx context.Context, db graph.Database) error {
+	defer measure.ContextMeasure(ctx, slog.LevelInfo, "Azure Conditional Access Post Processing")()
+
+	if tenants, err := FetchTenants(ctx, db); err != nil {
+		return err
+	} else {
+		for _, tenant := range tenants {
+			if err := applyTenantConditionalAccessPolicies(ctx, db, tenant); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func applyTenantConditionalAccessPolicies(ctx context.Context, db graph.Database, tenant *graph.Node) error {
+	return db.WriteTransaction(ctx, func(tx graph.Transaction) error {
+		if tenantID, err := tenant.Properties.Get(common.ObjectID.String()).String(); err != nil {
+			return fmt.Errorf("tenant node %d is missing property %s: %w", tenant.ID, common.ObjectID, err)
+		} else if policies, err := FetchTenantConditionalAccessPolicies(tx, tenant); err != nil {
+			return err
+		} else if len(policies) == 0 {
+			return nil
+		} else {
+			var (
+				candidates     []conditionalAccessCandidate
+				userMembership = map[graph.ID]conditionalAccessMembership{}
+				updates        []*graph.Relationship
+			)
+
+			// Collect the candidate relationships first so that membership lookups are not issued while the cursor is open
+			if err := ops.ForEachStartNode(tx.Relationships().Filterf(func() graph.Criteria {
+				return query.And(
+					query.KindIn(query.Start(), azure.Role, azure.User),
+					query.KindIn(query.Relationship(), ConditionalAccessEvaluatedRelationships()...),
+					query.Equals(query.StartProperty(azure.TenantID.String()), strings.ToUpper(tenantID)),
+				)
+			}), func(relationship *graph.Relationship, startNode *graph.Node) error {
+				candidates = append(candidates, conditionalAccessCandidate{
+					Relationship: relationship,
+					StartNode:    startNode,
+				})
+
+				return nil
+			}); err != nil {
+				return err
+			}
+
+			for _, candidate := range candidates {
+				var (
+					relationship = candidate.Relationship
+					startNode    = candidate.StartNode
+					appIDs       = ConditionalAccessResourceApps(relationship.Kind)
+					outcome      conditionalAccessOutcome
+				)
+
+				if startNode.Kinds.ContainsOneOf(azure.Role) {
+					roleTemplateID, _ := startNode.Properties.GetOrDefault(azure.RoleTemplateID.String(), "").String()
+					outcome = evaluateConditionalAccessPolicies(policies, appIDs, func(policy ConditionalAccessPolicy) bool {
+						return policy.AppliesToRole(roleTemplateID)
+					})
+				} else {
+					membership, cached := userMembership[startNode.ID]
+
+					if !cached {
+						if fetchedMembership, err := fetchUserConditionalAccessMembership(tx, startNode); err != nil {
+							return err
+						} else {
+							membership = fetchedMembership
+							userMembership[startNode.ID] = membership
+						}
+					}
+
+					userID, _ := startNode.Properties.GetOrDefault(common.ObjectID.String(), "").String()
+					outcome = evaluateConditionalAccessPolicies(policies, appIDs, func(policy ConditionalAccessPolicy) bool {
+						return policy.AppliesToUser(userID, membership.GroupIDs, membership.RoleTemplateIDs)
+					})
+				}
+
+				if len(outcome.BlockedBy) > 0 || len(outcome.MFARequiredBy) > 0 {
+					relationship.Properties.Set(azure.CAPBlocked.String(), len(outcome.BlockedBy) > 0)
+					relationship.Properties.Set(azure.CAPBlockedBy.String(), outcome.BlockedBy)
+					relationship.Properties.Set(azure.CAPMFARequired.String(), len(outcome.MFARequiredBy) > 0)
+					relationship.Properties.Set(azure.CAPMFARequiredBy.String(), outcome.MFARequiredBy)
+					updates = append(updates, relationship)
+				}
+			}
+
+			for _, relationship := range updates {
+				if err := tx.UpdateRelationship(relationship); err != nil {
+					return err
+				}
+			}
+
+			slog.DebugContext(ctx, fmt.Sprintf("Applied %d conditional access policies to %d relationships in tenant %d", len(policies), len(updates), tenant.ID))
+			return nil
+		}
+	})
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package azure_test
+
+import (
+	"testing"
+
+	"github.com/specterops/bloodhound/packages/go/analysis/azure"
+	azschema "github.com/specterops/bloodhound/packages/go/graphschema/azure"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/graph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewConditionalAccessPolicy(t *testing.T) {
+	var (
+		properties = graph.NewProperties().
+				Set(common.ObjectID.String(), "POLICY-1234").
+				Set(azschema.CAPolicyIncludeUsers.String(), []any{"ALL"}).
+				Set(azschema.CAPolicyGrantControls.String(), []any{"block"}).
+				Set(azschema.CAPolicyGrantOperator.String(), "OR")
+		node = graph.NewNode(1, properties, azschema.ConditionalAccessPolicy)
+	)
+
+	policy, err := azure.NewConditionalAccessPolicy(node)
+	require.Nil(t, err)
+	assert.Equal(t, "POLICY-1234", policy.ObjectID)
+	assert.Equal(t, []string{"ALL"}, policy.IncludeUsers)
+	assert.Empty(t, policy.ExcludeUsers)
+	assert.True(t, policy.Blocks())
+	assert.False(t, policy.RequiresMFA())
+
+	_, err = azure.NewConditionalAccessPolicy(graph.NewNode(2, graph.NewProperties(), azschema.ConditionalAccessPolicy))
+	assert.NotNil(t, err)
+}
+
+func TestConditionalAccessPolicy_RequiresMFA(t *testing.T) {
+	assert.True(t, azure.ConditionalAccessPolicy{GrantControls: []string{"mfa"}, GrantOperator: "OR"}.RequiresMFA())
+	assert.True(t, azure.ConditionalAccessPolicy{GrantControls: []string{"mfa", "compliantDevice"}, GrantOperator: "AND"}.RequiresMFA())
+	assert.True(t, azure.ConditionalAccessPolicy{GrantControls: []string{azschema.ConditionalAccessControlAuthenticationStrength}}.RequiresMFA())
+
+	// A compliant device satisfies the policy without MFA when either control is sufficient
+	assert.False(t, azure.ConditionalAccessPolicy{GrantControls: []string{"mfa", "compliantDevice"}, GrantOperator: "OR"}.RequiresMFA())
+	assert.False(t, azure.ConditionalAccessPolicy{GrantControls: []string{"compliantDevice"}, GrantOperator: "AND"}.RequiresMFA())
+}
+
+func TestConditionalAccessPolicy_AppliesToApplications(t *testing.T) {
+	allApps := azure.ConditionalAccessPolicy{IncludeApplications: []string{"ALL"}}
+	assert.True(t, allApps.AppliesToApplications(azschema.MSGraphAppUniversalID))
+	assert.False(t, allApps.AppliesToApplications())
+
+	allAppsExcludingIntune := azure.ConditionalAccessPolicy{
+		IncludeApplications: []string{"ALL"},
+		ExcludeApplications: []string{"0000000A-0000-0000-C000-000000000000"},
+	}
+	assert.True(t, allAppsExcludingIntune.AppliesToApplications(azschema.MSGraphAppUniversalID))
+	assert.False(t, allAppsExcludingIntune.AppliesToApplications(azschema.IntuneAppID))
+
+	graphOnly := azure.ConditionalAccessPolicy{IncludeApplications: []string{azschema.MSGraphAppUniversalID}}
+	assert.True(t, graphOnly.AppliesToApplications(azschema.MSGraphAppUniversalID))
+	assert.False(t, graphOnly.AppliesToApplications(azschema.MSGraphAppUniversalID, azschema.IntuneAppID))
+}
+
+func TestConditionalAccessPolicy_AppliesToRole(t *testing.T) {
+	roleTemplateID := azschema.CompanyAdministratorRole
+
+	assert.True(t, azure.ConditionalAccessPolicy{IncludeUsers: []string{"ALL"}}.AppliesToRole(roleTemplateID))
+	assert.True(t, azure.ConditionalAccessPolicy{IncludeRoles: []string{roleTemplateID}}.AppliesToRole(roleTemplateID))
+	assert.False(t, azure.ConditionalAccessPolicy{IncludeRoles: []string{azschema.GlobalReaderRole}}.AppliesToRole(roleTemplateID))
+	assert.False(t, azure.ConditionalAccessPolicy{IncludeUsers: []string{"ALL"}, ExcludeRoles: []string{roleTemplateID}}.AppliesToRole(roleTemplateID))
+
+	// Individual exclusions can not be resolved against every holder of the role
+	assert.False(t, azure.ConditionalAccessPolicy{IncludeUsers: []string{"ALL"}, ExcludeUsers: []string{"USER-1234"}}.AppliesToRole(roleTemplateID))
+}
+
+func TestConditionalAccessPolicy_AppliesToUser(t *testing.T) {
+	var (
+		userID          = "USER-1234"
+		groupIDs        = []string{"GROUP-1234"}
+		roleTemplateIDs = []string{azschema.CompanyAdministratorRole}
+	)
+
+	assert.True(t, azure.ConditionalAccessPolicy{IncludeUsers: []string{"ALL"}}.AppliesToUser(userID, groupIDs, roleTemplateIDs))
+	assert.True(t, azure.ConditionalAccessPolicy{IncludeUsers: []string{"user-1234"}}.AppliesToUser(userID, nil, nil))
+	assert.True(t, azure.ConditionalAccessPolicy{IncludeGroups: groupIDs}.AppliesToUser(userID, groupIDs, nil))
+	assert.True(t, azure.ConditionalAccessPolicy{IncludeRoles: roleTemplateIDs}.AppliesToUser(userID, nil, roleTemplateIDs))
+	assert.False(t, azure.ConditionalAccessPolicy{IncludeGroups: groupIDs}.AppliesToUser(userID, nil, roleTemplateIDs))
+	assert.False(t, azure.ConditionalAccessPolicy{IncludeUsers: []string{"ALL"}, ExcludeGroups: groupIDs}.AppliesToUser(userID, groupIDs, roleTemplateIDs))
+	assert.False(t, azure.ConditionalAccessPolicy{IncludeUsers: []string{"ALL"}, ExcludeUsers: []string{userID}}.AppliesToUser(userID, groupIDs, roleTemplateIDs))
+}
@@ -0,0 +1,221 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package azure
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/specterops/bloodhound/packages/go/analysis"
+	"github.com/specterops/bloodhound/packages/go/graphschema/azure"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/ops"
+	"github.com/specterops/dawgs/query"
+	"github.com/specterops/dawgs/util/channels"
+)
+
+// IntuneRoleAssignment is the evaluated form of an AZIntuneRoleAssignment node
+type IntuneRoleAssignment struct {
+	ScopeType     string
+	ScopeGroups   []string
+	ScopeTagIDs   []string
+	CanRunScripts bool
+}
+
+func NewIntuneRoleAssignment(node *graph.Node) (IntuneRoleAssignment, error) {
+	var (
+		assignment = IntuneRoleAssignment{}
+		err        error
+	)
+
+	if assignment.ScopeGroups, err = node.Properties.GetOrDefault(azure.IntuneScopeGroups.String(), []any{}).StringSlice(); err != nil {
+		return assignment, fmt.Errorf("intune role assignment node %d property %s is not a string slice: %w", node.ID, azure.IntuneScopeGroups, err)
+	} else if assignment.ScopeTagIDs, err = IntuneScopeTagIDs(node); err != nil {
+		return assignment, err
+	}
+
+	assignment.ScopeType, _ = node.Properties.GetOrDefault(azure.IntuneScopeType.String(), azure.IntuneScopeTypeResourceScope).String()
+	assignment.CanRunScripts, _ = node.Properties.GetOrDefault(azure.IntuneCanRunScripts.String(), false).Bool()
+
+	return assignment, nil
+}
+
+// AppliesToDevice returns true if a device with the given group memberships and scope tags is managed by the assignment
+func (s IntuneRoleAssignment) AppliesToDevice(deviceGroupIDs []string, deviceScopeTagIDs []string) bool {
+	// Scope tags limit the objects an Intune administrator can see regardless of the assignment's scope groups
+	if !containsAnyFold(s.ScopeTagIDs, deviceScopeTagIDs) {
+		return false
+	}
+
+	switch s.ScopeType {
+	case azure.IntuneScopeTypeAllDevices, azure.IntuneScopeTypeAllDevicesAndLicensedUsers:
+		return true
+	default:
+		return containsAnyFold(s.ScopeGroups, deviceGroupIDs)
+	}
+}
+
+// IntuneScopeTagIDs returns the Intune scope tags of the given node, defaulting to the built-in Default scope tag
+func IntuneScopeTagIDs(node *graph.Node) ([]string, error) {
+	if scopeTagIDs, err := node.Properties.GetOrDefault(azure.IntuneScopeTagIDs.String(), []any{}).StringSlice(); err != nil {
+		return nil, fmt.Errorf("node %d property %s is not a string slice: %w", node.ID, azure.IntuneScopeTagIDs, err)
+	} else if len(scopeTagIDs) == 0 {
+		return []string{azure.IntuneDefaultScopeTagID}, nil
+	} else {
+		return scopeTagIDs, nil
+	}
+}
+
+// IsIntuneManagedDevice returns true if Intune management data was ingested for the given device
+func IsIntuneManagedDevice(node *graph.Node) bool {
+	return node.Properties.Exists(azure.IntuneDeviceID.String())
+}
+
+// FetchIntuneRoleAssignmentMembers returns the groups that are members of the given Intune role assignment
+func FetchIntuneRoleAssignmentMembers(tx graph.Transaction, roleAssignment *graph.Node) (graph.NodeSet, error) {
+	return ops.FetchStartNodes(tx.Relationships().Filterf(func() graph.Criteria {
+		return query.And(
+			query.Kind(query.Start(), azure.Group),
+			query.Kind(query.Relationship(), azure.HasIntuneRole),
+			query.InIDs(query.EndID(), roleAssignment.ID),
+		)
+	}))
+}
+
+// fetchIntuneManagedWindowsDevices returns the Intune managed Windows devices of the given tenant along with the object
+// IDs of the groups each device is a member of
+func fetchIntuneManagedWindowsDevices(tx graph.Transaction, tenant *graph.Node) (graph.NodeSet, map[graph.ID][]string, error) {
+	var (
+		managedDevices = graph.NewNodeSet()
+		deviceGroupIDs = map[graph.ID][]string{}
+	)
+
+	if tenantDevices, err := EndNodes(tx, tenant, azure.Contains, azure.Device); err != nil {
+		return nil, nil, err
+	} else {
+		for _, tenantDevice := range tenantDevices {
+			if !IsIntuneManagedDevice(tenantDevice) {
+				continue
+			} else if isWindowsDevice, err := IsWindowsDevice(tenantDevice); err != nil {
+				return nil, nil, err
+			} else if !isWindowsDevice {
+				continue
+			} else if groups, err := FetchEntityGroupMembership(tx, tenantDevice, 0, 0); err != nil {
+				return nil, nil, err
+			} else {
+				groupIDs := make([]string, 0, groups.Len())
+
+				for _, group := range groups {
+					if objectID, err := group.Properties.Get(common.ObjectID.String()).String(); err == nil {
+						groupIDs = append(groupIDs, objectID)
+					}
+				}
+
+				managedDevices.Add(tenantDevice)
+				deviceGroupIDs[tenantDevice.ID] = groupIDs
+			}
+		}
+
+		return managedDevices, deviceGroupIDs, nil
+	}
+}
+
+// fetchIntuneScriptExecutionJobs returns an AZExecuteCommand job from every member group of an Intune role assignment
+// that can deploy platform scripts to each Intune managed Windows device within the scope of that assignment
+func fetchIntuneScriptExecutionJobs(tx graph.Transaction, tenant *graph.Node) ([]analysis.CreatePostRelationshipJob, error) {
+	var jobs []analysis.CreatePostRelationshipJob
+
+	if roleAssignmentNodes, err := EndNodes(tx, tenant, azure.Contains, azure.IntuneRoleAssignment); err != nil {
+		return nil, err
+	} else if roleAssignmentNodes.Len() == 0 {
+		return nil, nil
+	} else if managedDevices, deviceGroupIDs, err := fetchIntuneManagedWindowsDevices(tx, tenant); err != nil {
+		return nil, err
+	} else if managedDevices.Len() == 0 {
+		return nil, nil
+	} else {
+		for _, roleAssignmentNode := range roleAssignmentNodes {
+			if roleAssignment, err := NewIntuneRoleAssignment(roleAssignmentNode); err != nil {
+				return nil, err
+			} else if !roleAssignment.CanRunScripts {
+				continue
+			} else if members, err := FetchIntuneRoleAssignmentMembers(tx, roleAssignmentNode); err != nil {
+				return nil, err
+			} else {
+				for _, managedDevice := range managedDevices {
+					if deviceScopeTagIDs, err := IntuneScopeTagIDs(managedDevice); err != nil {
+						return nil, err
+					} else if !roleAssignment.AppliesToDevice(deviceGroupIDs[managedDevice.ID], deviceScopeTagIDs) {
+						continue
+					}
+
+					for _, member := range members {
+						jobs = append(jobs, analysis.CreatePostRelationshipJob{
+							FromID: member.ID,
+							ToID:   managedDevice.ID,
+							Kind:   azure.ExecuteCommand,
+						})
+					}
+				}
+			}
+		}
+
+		return jobs, nil
+	}
+}
+
+// IntuneScriptExecution creates AZExecuteCommand edges from the member groups of Intune RBAC role assignments that
+// allow deploying platform scripts to the Intune managed Windows devices within the scope of each assignment
+func IntuneScriptExecution(ctx context.Context, db graph.Database) (*analysis.AtomicPostProcessingStats, error) {
+	if tenants, err := FetchTenants(ctx, db); err != nil {
+		return &analysis.AtomicPostProcessingStats{}, err
+	} else {
+		operation := analysis.NewPostRelationshipOperation(ctx, db, "AZExecuteCommand Intune RBAC Post Processing")
+
+		if err := db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+			for _, tenant := range tenants {
+				if jobs, err := fetchIntuneScriptExecutionJobs(tx, tenant); err != nil {
+					return err
+				} else if len(jobs) == 0 {
+					continue
+				} else if err := operation.Operation.SubmitReader(func(ctx context.Context, _ graph.Transaction, outC chan<- analysis.CreatePostRelationshipJob) error {
+					for _, job := range jobs {
+						if !channels.Submit(ctx, outC, job) {
+							return nil
+						}
+					}
+
+					return nil
+				}); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}); err != nil {
+			if err := operation.Done(); err != nil {
+				slog.ErrorContext(ctx, fmt.Sprintf("Error caught during azure Intune ExecuteCommand teardown: %v", err))
+			}
+
+			return &operation.Stats, err
+		}
+
+		return &operation.Stats, operation.Done()
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package azure_test
+
+import (
+	"testing"
+
+	"github.com/specterops/bloodhound/packages/go/analysis/azure"
+	azschema "github.com/specterops/bloodhound/packages/go/graphschema/azure"
+	"github.com/specterops/dawgs/graph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewIntuneRoleAssignment(t *testing.T) {
+	properties := graph.NewProperties().
+		Set(azschema.IntuneScopeType.String(), azschema.IntuneScopeTypeAllDevices).
+		Set(azschema.IntuneCanRunScripts.String(), true)
+
+	assignment, err := azure.NewIntuneRoleAssignment(graph.NewNode(1, properties, azschema.IntuneRoleAssignment))
+	require.Nil(t, err)
+	assert.Equal(t, azschema.IntuneScopeTypeAllDevices, assignment.ScopeType)
+	assert.True(t, assignment.CanRunScripts)
+	assert.Empty(t, assignment.ScopeGroups)
+	assert.Equal(t, []string{azschema.IntuneDefaultScopeTagID}, assignment.ScopeTagIDs)
+}
+
+func TestIntuneRoleAssignment_AppliesToDevice(t *testing.T) {
+	var (
+		defaultTags = []string{azschema.IntuneDefaultScopeTagID}
+		scoped      = azure.IntuneRoleAssignment{
+			ScopeType:   azschema.IntuneScopeTypeResourceScope,
+			ScopeGroups: []string{"GROUP-1234"},
+			ScopeTagIDs: defaultTags,
+		}
+		allDevices = azure.IntuneRoleAssignment{
+			ScopeType:   azschema.IntuneScopeTypeAllDevices,
+			ScopeTagIDs: []string{"1"},
+		}
+	)
+
+	assert.True(t, scoped.AppliesToDevice([]string{"group-1234"}, defaultTags))
+	assert.False(t, scoped.AppliesToDevice([]string{"GROUP-5678"}, defaultTags))
+	assert.False(t, scoped.AppliesToDevice([]string{"GROUP-1234"}, []string{"1"}))
+
+	assert.True(t, allDevices.AppliesToDevice(nil, []string{"1", "2"}))
+	assert.False(t, allDevices.AppliesToDevice(nil, defaultTags))
+}
+
+func TestIsIntuneManagedDevice(t *testing.T) {
+	assert.False(t, azure.IsIntuneManagedDevice(graph.NewNode(1, graph.NewProperties(), azschema.Device)))
+	assert.True(t, azure.IsIntuneManagedDevice(graph.NewNode(2, graph.NewProperties().Set(azschema.IntuneDeviceID.String(), "MANAGED-1234"), azschema.Device)))
+}
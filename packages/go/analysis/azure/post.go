@@ -133,6 +133,11 @@ func PostProcessedRelationships() []graph.Kind {
 		azure.AZMGGrantRole,
 		azure.SyncedToADUser,
 		azure.AZRoleApprover,
+		azure.GetStorageKeys,
+		azure.AccessBlobData,
+		azure.AccessFileData,
+		azure.SyncedToADGroup,
+		azure.SyncedToADComputer,
 	}
 }
 
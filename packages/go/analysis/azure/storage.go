@@ -0,0 +1,163 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package azure
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/specterops/bloodhound/packages/go/analysis"
+	"github.com/specterops/bloodhound/packages/go/graphschema/azure"
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/ops"
+	"github.com/specterops/dawgs/query"
+	"github.com/specterops/dawgs/util/channels"
+)
+
+// StorageKeyAccessRelationships returns the role relationship kinds that grant the listKeys action on a storage account.
+// Storage account keys grant full access to the data plane of the account.
+func StorageKeyAccessRelationships() []graph.Kind {
+	return []graph.Kind{
+		azure.Owner,
+		azure.Contributor,
+		azure.StorageAccountContributor,
+	}
+}
+
+// StorageBlobDataRelationships returns the role relationship kinds that grant read and write access to blob data
+func StorageBlobDataRelationships() []graph.Kind {
+	return []graph.Kind{
+		azure.StorageBlobDataOwner,
+		azure.StorageBlobDataContributor,
+	}
+}
+
+// StorageFileDataRelationships returns the role relationship kinds that grant read and write access to file share data
+func StorageFileDataRelationships() []graph.Kind {
+	return []graph.Kind{
+		azure.StorageFileSMBContributor,
+		azure.StorageFileSMBElevatedContributor,
+		azure.StorageFilePrivilegedContributor,
+	}
+}
+
+func fetchStorageAccountRoleRelationships(tx graph.Transaction, storageAccount *graph.Node) ([]*graph.Relationship, error) {
+	roleKinds := append(StorageKeyAccessRelationships(), StorageBlobDataRelationships()...)
+	roleKinds = append(roleKinds, StorageFileDataRelationships()...)
+
+	return ops.FetchRelationships(tx.Relationships().Filterf(func() graph.Criteria {
+		return query.And(
+			query.InIDs(query.EndID(), storageAccount.ID),
+			query.KindIn(query.Relationship(), roleKinds...),
+		)
+	}))
+}
+
+// fetchStorageAccessJobs returns the AZGetStorageKeys, AZAccessBlobData and AZAccessFileData jobs for the principals
+// holding a data plane capable role on the given storage account
+func fetchStorageAccessJobs(tx graph.Transaction, storageAccount *graph.Node) ([]analysis.CreatePostRelationshipJob, error) {
+	var jobs []analysis.CreatePostRelationshipJob
+
+	if roleRelationships, err := fetchStorageAccountRoleRelationships(tx, storageAccount); err != nil {
+		return nil, err
+	} else if len(roleRelationships) == 0 {
+		return nil, nil
+	} else if containers, err := EndNodes(tx, storageAccount, azure.Contains, azure.StorageContainer); err != nil {
+		return nil, err
+	} else if fileShares, err := EndNodes(tx, storageAccount, azure.Contains, azure.StorageFileShare); err != nil {
+		return nil, err
+	} else {
+		for _, roleRelationship := range roleRelationships {
+			canListKeys := roleRelationship.Kind.Is(StorageKeyAccessRelationships()...)
+
+			if canListKeys {
+				jobs = append(jobs, analysis.CreatePostRelationshipJob{
+					FromID: roleRelationship.StartID,
+					ToID:   storageAccount.ID,
+					Kind:   azure.GetStorageKeys,
+				})
+			}
+
+			if canListKeys || roleRelationship.Kind.Is(StorageBlobDataRelationships()...) {
+				for _, container := range containers {
+					jobs = append(jobs, analysis.CreatePostRelationshipJob{
+						FromID: roleRelationship.StartID,
+						ToID:   container.ID,
+						Kind:   azure.AccessBlobData,
+					})
+				}
+			}
+
+			if canListKeys || roleRelationship.Kind.Is(StorageFileDataRelationships()...) {
+				for _, fileShare := range fileShares {
+					jobs = append(jobs, analysis.CreatePostRelationshipJob{
+						FromID: roleRelationship.StartID,
+						ToID:   fileShare.ID,
+						Kind:   azure.AccessFileData,
+					})
+				}
+			}
+		}
+
+		return jobs, nil
+	}
+}
+
+// StorageAccess creates AZGetStorageKeys edges from principals that can list the keys of a storage account,
+// AZAccessBlobData edges to the containers of the storage account from principals that hold a blob data role or can
+// list the account keys and AZAccessFileData edges to the file shares of the storage account from principals that hold
+// a file data role or can list the account keys
+func StorageAccess(ctx context.Context, db graph.Database) (*analysis.AtomicPostProcessingStats, error) {
+	operation := analysis.NewPostRelationshipOperation(ctx, db, "Azure Storage Access Post Processing")
+
+	if err := db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		if storageAccounts, err := ops.FetchNodes(tx.Nodes().Filterf(func() graph.Criteria {
+			return query.Kind(query.Node(), azure.StorageAccount)
+		})); err != nil {
+			return err
+		} else {
+			for _, storageAccount := range storageAccounts {
+				if jobs, err := fetchStorageAccessJobs(tx, storageAccount); err != nil {
+					return err
+				} else if len(jobs) == 0 {
+					continue
+				} else if err := operation.Operation.SubmitReader(func(ctx context.Context, _ graph.Transaction, outC chan<- analysis.CreatePostRelationshipJob) error {
+					for _, job := range jobs {
+						if !channels.Submit(ctx, outC, job) {
+							return nil
+						}
+					}
+
+					return nil
+				}); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}
+	}); err != nil {
+		if err := operation.Done(); err != nil {
+			slog.ErrorContext(ctx, fmt.Sprintf("Error caught during azure StorageAccess teardown: %v", err))
+		}
+
+		return &operation.Stats, err
+	}
+
+	return &operation.Stats, operation.Done()
+}
@@ -0,0 +1,243 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hybrid
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/specterops/bloodhound/packages/go/analysis"
+	"github.com/specterops/bloodhound/packages/go/analysis/azure"
+	adSchema "github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	azureSchema "github.com/specterops/bloodhound/packages/go/graphschema/azure"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/ops"
+	"github.com/specterops/dawgs/query"
+	"github.com/specterops/dawgs/util/channels"
+)
+
+// PostHybridExecuteCommand extends AZExecuteCommand edges that end at hybrid joined Entra devices to the AD computers
+// those devices were joined from, as a script deployed through Intune runs on the on-prem computer as SYSTEM
+func PostHybridExecuteCommand(ctx context.Context, db graph.Database) (*analysis.AtomicPostProcessingStats, error) {
+	tenants, err := azure.FetchTenants(ctx, db)
+	if err != nil {
+		return &analysis.AtomicPostProcessingStats{}, fmt.Errorf("fetching Entra tenants: %w", err)
+	}
+
+	operation := analysis.NewPostRelationshipOperation(ctx, db, "Hybrid AZExecuteCommand Post Processing")
+
+	err = db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		if computerIndex, err := fetchADComputerIndex(tx); err != nil {
+			return err
+		} else if computerIndex.Len() == 0 {
+			return nil
+		} else {
+			for _, tenant := range tenants {
+				if deviceToComputer, err := fetchHybridJoinedDevices(tx, tenant, computerIndex); err != nil {
+					return err
+				} else if len(deviceToComputer) == 0 {
+					continue
+				} else if executeCommandRels, err := fetchExecuteCommandRelationships(tx, deviceToComputer); err != nil {
+					return err
+				} else if err := operation.Operation.SubmitReader(func(ctx context.Context, tx graph.Transaction, outC chan<- analysis.CreatePostRelationshipJob) error {
+					for _, executeCommandRel := range executeCommandRels {
+						nextJob := analysis.CreatePostRelationshipJob{
+							FromID: executeCommandRel.StartID,
+							ToID:   deviceToComputer[executeCommandRel.EndID],
+							Kind:   azureSchema.ExecuteCommand,
+						}
+
+						if !channels.Submit(ctx, outC, nextJob) {
+							return nil
+						}
+					}
+
+					return nil
+				}); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}
+	})
+
+	if opErr := operation.Done(); opErr != nil || err != nil {
+		return &operation.Stats, fmt.Errorf("marking operation as done: %w; transaction error (if any): %v", opErr, err)
+	}
+
+	return &operation.Stats, nil
+}
+
+// PostHybridDevices correlates hybrid joined Entra devices with the AD computers they were joined from. Both objects
+// represent the same machine, so SyncedToEntraDevice and SyncedToADComputer edges are created in both directions.
+func PostHybridDevices(ctx context.Context, db graph.Database) (*analysis.AtomicPostProcessingStats, error) {
+	tenants, err := azure.FetchTenants(ctx, db)
+	if err != nil {
+		return &analysis.AtomicPostProcessingStats{}, fmt.Errorf("fetching Entra tenants: %w", err)
+	}
+
+	operation := analysis.NewPostRelationshipOperation(ctx, db, "Hybrid Device Post Processing")
+
+	err = db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		if computerIndex, err := fetchADComputerIndex(tx); err != nil {
+			return err
+		} else if computerIndex.Len() == 0 {
+			return nil
+		} else {
+			for _, tenant := range tenants {
+				if deviceToComputer, err := fetchHybridJoinedDevices(tx, tenant, computerIndex); err != nil {
+					return err
+				} else if len(deviceToComputer) == 0 {
+					continue
+				} else if err := operation.Operation.SubmitReader(func(ctx context.Context, tx graph.Transaction, outC chan<- analysis.CreatePostRelationshipJob) error {
+					for deviceID, computerID := range deviceToComputer {
+						if !channels.Submit(ctx, outC, analysis.CreatePostRelationshipJob{
+							FromID: computerID,
+							ToID:   deviceID,
+							Kind:   adSchema.SyncedToEntraDevice,
+						}) {
+							return nil
+						} else if !channels.Submit(ctx, outC, analysis.CreatePostRelationshipJob{
+							FromID: deviceID,
+							ToID:   computerID,
+							Kind:   azureSchema.SyncedToADComputer,
+						}) {
+							return nil
+						}
+					}
+
+					return nil
+				}); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}
+	})
+
+	if opErr := operation.Done(); opErr != nil || err != nil {
+		return &operation.Stats, fmt.Errorf("marking operation as done: %w; transaction error (if any): %v", opErr, err)
+	}
+
+	return &operation.Stats, nil
+}
+
+// ADComputerIndex correlates hybrid joined Entra devices with AD computers. A hybrid joined device keeps the objectGUID
+// of the computer it was joined from as its deviceId, so devices are matched on that stable identifier first. The
+// upper cased samaccountname without the trailing "$" is only used as a fallback for computers collected without an
+// objectGUID, and names that are shared by more than one such computer, e.g. across forests, are never matched.
+type ADComputerIndex struct {
+	byObjectGUID map[string]graph.ID
+	byName       map[string]graph.ID
+}
+
+func NewADComputerIndex(computers []*graph.Node) ADComputerIndex {
+	index := ADComputerIndex{
+		byObjectGUID: map[string]graph.ID{},
+		byName:       map[string]graph.ID{},
+	}
+
+	for _, computer := range computers {
+		if objectGUID, _ := computer.Properties.GetOrDefault(adSchema.ObjectGUID.String(), "").String(); objectGUID != "" {
+			index.byObjectGUID[strings.ToUpper(objectGUID)] = computer.ID
+		} else if samAccountName, err := computer.Properties.Get(adSchema.SamAccountName.String()).String(); err == nil {
+			hostname := strings.ToUpper(strings.TrimSuffix(samAccountName, "$"))
+
+			if _, seen := index.byName[hostname]; seen {
+				index.byName[hostname] = 0
+			} else {
+				index.byName[hostname] = computer.ID
+			}
+		}
+	}
+
+	return index
+}
+
+func (s ADComputerIndex) Len() int {
+	return len(s.byObjectGUID) + len(s.byName)
+}
+
+// Correlate returns the id of the AD computer the given hybrid joined device was joined from
+func (s ADComputerIndex) Correlate(device *graph.Node) (graph.ID, bool) {
+	if deviceID, _ := device.Properties.GetOrDefault(azureSchema.DeviceID.String(), "").String(); deviceID != "" {
+		if computerID, found := s.byObjectGUID[strings.ToUpper(deviceID)]; found {
+			return computerID, true
+		}
+	}
+
+	if displayName, err := device.Properties.Get(common.DisplayName.String()).String(); err != nil {
+		return 0, false
+	} else if computerID, found := s.byName[strings.ToUpper(strings.TrimSuffix(displayName, "$"))]; !found || computerID == 0 {
+		return 0, false
+	} else {
+		return computerID, true
+	}
+}
+
+func fetchADComputerIndex(tx graph.Transaction) (ADComputerIndex, error) {
+	if computers, err := ops.FetchNodes(tx.Nodes().Filterf(func() graph.Criteria {
+		return query.Kind(query.Node(), adSchema.Computer)
+	})); err != nil {
+		return ADComputerIndex{}, err
+	} else {
+		return NewADComputerIndex(computers), nil
+	}
+}
+
+// fetchHybridJoinedDevices maps the node ids of the hybrid joined devices in the given tenant to the node ids of the AD
+// computers they correlate with
+func fetchHybridJoinedDevices(tx graph.Transaction, tenant *graph.Node, computerIndex ADComputerIndex) (map[graph.ID]graph.ID, error) {
+	deviceToComputer := map[graph.ID]graph.ID{}
+
+	if tenantDevices, err := azure.EndNodes(tx, tenant, azureSchema.Contains, azureSchema.Device); err != nil {
+		return nil, err
+	} else {
+		for _, tenantDevice := range tenantDevices {
+			if trustType, _ := tenantDevice.Properties.GetOrDefault(azureSchema.TrustType.String(), "").String(); trustType != string(enums.TrustTypeServerAD) {
+				continue
+			} else if computerID, found := computerIndex.Correlate(tenantDevice); !found {
+				slog.Debug(fmt.Sprintf("Unable to correlate hybrid joined device %d with a single AD computer", tenantDevice.ID))
+			} else {
+				deviceToComputer[tenantDevice.ID] = computerID
+			}
+		}
+
+		return deviceToComputer, nil
+	}
+}
+
+func fetchExecuteCommandRelationships(tx graph.Transaction, deviceToComputer map[graph.ID]graph.ID) ([]*graph.Relationship, error) {
+	deviceIDs := make([]graph.ID, 0, len(deviceToComputer))
+
+	for deviceID := range deviceToComputer {
+		deviceIDs = append(deviceIDs, deviceID)
+	}
+
+	return ops.FetchRelationships(tx.Relationships().Filterf(func() graph.Criteria {
+		return query.And(
+			query.Kind(query.Relationship(), azureSchema.ExecuteCommand),
+			query.InIDs(query.EndID(), deviceIDs...),
+		)
+	}))
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hybrid_test
+
+import (
+	"testing"
+
+	"github.com/specterops/bloodhound/packages/go/analysis/hybrid"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/azure"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/graph"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestADComputerIndex_Correlate(t *testing.T) {
+	var (
+		newComputer = func(id graph.ID, samAccountName, objectGUID string) *graph.Node {
+			properties := graph.NewProperties().Set(ad.SamAccountName.String(), samAccountName)
+			if objectGUID != "" {
+				properties.Set(ad.ObjectGUID.String(), objectGUID)
+			}
+			return graph.NewNode(id, properties, ad.Entity, ad.Computer)
+		}
+		newDevice = func(displayName, deviceID string) *graph.Node {
+			return graph.NewNode(100, graph.NewProperties().
+				Set(common.DisplayName.String(), displayName).
+				Set(azure.DeviceID.String(), deviceID), azure.Entity, azure.Device)
+		}
+
+		index = hybrid.NewADComputerIndex([]*graph.Node{
+			newComputer(1, "WS01$", "1f0e3dad-9a4b-4c6d-8e7f-0a1b2c3d4e5f"),
+			newComputer(2, "WS02$", "2a1b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"),
+			newComputer(3, "LEGACY$", ""),
+			newComputer(4, "SHARED$", ""),
+			newComputer(5, "SHARED$", ""),
+		})
+	)
+
+	t.Run("matches on the objectGUID regardless of name", func(t *testing.T) {
+		computerID, found := index.Correlate(newDevice("RENAMED", "1F0E3DAD-9A4B-4C6D-8E7F-0A1B2C3D4E5F"))
+		assert.True(t, found)
+		assert.Equal(t, graph.ID(1), computerID)
+	})
+
+	t.Run("does not match a name shared with a computer that has an objectGUID", func(t *testing.T) {
+		_, found := index.Correlate(newDevice("WS02", "9e8d7c6b-5a4f-4e3d-8c2b-1a0f9e8d7c6b"))
+		assert.False(t, found)
+	})
+
+	t.Run("falls back to the name without the trailing dollar sign", func(t *testing.T) {
+		computerID, found := index.Correlate(newDevice("legacy", ""))
+		assert.True(t, found)
+		assert.Equal(t, graph.ID(3), computerID)
+	})
+
+	t.Run("does not match ambiguous names", func(t *testing.T) {
+		_, found := index.Correlate(newDevice("SHARED", ""))
+		assert.False(t, found)
+	})
+}
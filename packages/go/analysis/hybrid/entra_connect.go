@@ -0,0 +1,134 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hybrid
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+
+	"github.com/specterops/bloodhound/packages/go/analysis"
+	"github.com/specterops/bloodhound/packages/go/analysis/azure"
+	adSchema "github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	azureSchema "github.com/specterops/bloodhound/packages/go/graphschema/azure"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/ops"
+	"github.com/specterops/dawgs/query"
+	"github.com/specterops/dawgs/util/channels"
+)
+
+// entraConnectDescriptionMarker is part of the description Entra Connect writes to the AD DS connector account it creates
+const entraConnectDescriptionMarker = "synchronize to tenant"
+
+var entraConnectTenantPattern = regexp.MustCompile(`(?i)synchronize to tenant (\S+?)\.?(?:\s|$)`)
+
+// EntraConnectTenantDomain parses the domain of the tenant an Entra Connect AD DS connector account synchronizes to
+// from the account description, e.g. "... running on computer AADC01 configured to synchronize to tenant
+// contoso.onmicrosoft.com. This account must have directory replication permissions ...".
+func EntraConnectTenantDomain(description string) (string, bool) {
+	if match := entraConnectTenantPattern.FindStringSubmatch(description); match == nil {
+		return "", false
+	} else {
+		return strings.ToUpper(match[1]), true
+	}
+}
+
+// PostEntraConnectSyncAccounts creates EntraSyncAccountFor edges from the AD DS connector accounts created by Entra
+// Connect to the tenant they synchronize to. The connector account holds directory replication rights in AD and its
+// credential is stored on the sync server alongside the credential of the Entra sync account.
+//
+// The edge is not traversable. Controlling the connector account grants nothing in the tenant by itself; the Entra sync
+// account credential is only reachable by compromising the sync server, which is not collected. The tenant is also
+// resolved from the free text description of the account, so the edge marks the relationship for review rather than
+// asserting an attack path.
+func PostEntraConnectSyncAccounts(ctx context.Context, db graph.Database) (*analysis.AtomicPostProcessingStats, error) {
+	tenants, err := azure.FetchTenants(ctx, db)
+	if err != nil {
+		return &analysis.AtomicPostProcessingStats{}, fmt.Errorf("fetching Entra tenants: %w", err)
+	}
+
+	tenantIndex := map[string]graph.ID{}
+
+	for _, tenant := range tenants {
+		if domains, err := tenant.Properties.GetOrDefault(azureSchema.TenantDomains.String(), []any{}).StringSlice(); err != nil {
+			return &analysis.AtomicPostProcessingStats{}, fmt.Errorf("reading domains of tenant %d: %w", tenant.ID, err)
+		} else {
+			for _, domain := range domains {
+				tenantIndex[strings.ToUpper(domain)] = tenant.ID
+			}
+		}
+	}
+
+	if len(tenantIndex) == 0 {
+		return &analysis.AtomicPostProcessingStats{}, nil
+	}
+
+	operation := analysis.NewPostRelationshipOperation(ctx, db, "Entra Connect Sync Account Post Processing")
+
+	err = db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		syncAccountToTenant := map[graph.ID]graph.ID{}
+
+		if syncAccounts, err := fetchEntraConnectSyncAccounts(tx); err != nil {
+			return err
+		} else {
+			for _, syncAccount := range syncAccounts {
+				if description, err := syncAccount.Properties.Get(common.Description.String()).String(); err != nil {
+					continue
+				} else if tenantDomain, found := EntraConnectTenantDomain(description); !found {
+					continue
+				} else if tenantID, found := tenantIndex[tenantDomain]; !found {
+					slog.DebugContext(ctx, fmt.Sprintf("Unable to find tenant %s for Entra Connect sync account %d", tenantDomain, syncAccount.ID))
+				} else {
+					syncAccountToTenant[syncAccount.ID] = tenantID
+				}
+			}
+		}
+
+		return operation.Operation.SubmitReader(func(ctx context.Context, tx graph.Transaction, outC chan<- analysis.CreatePostRelationshipJob) error {
+			for syncAccountID, tenantID := range syncAccountToTenant {
+				if !channels.Submit(ctx, outC, analysis.CreatePostRelationshipJob{
+					FromID: syncAccountID,
+					ToID:   tenantID,
+					Kind:   adSchema.EntraSyncAccountFor,
+				}) {
+					return nil
+				}
+			}
+
+			return nil
+		})
+	})
+
+	if opErr := operation.Done(); opErr != nil || err != nil {
+		return &operation.Stats, fmt.Errorf("marking operation as done: %w; transaction error (if any): %v", opErr, err)
+	}
+
+	return &operation.Stats, nil
+}
+
+// fetchEntraConnectSyncAccounts fetches the AD users whose description marks them as an Entra Connect connector account
+func fetchEntraConnectSyncAccounts(tx graph.Transaction) ([]*graph.Node, error) {
+	return ops.FetchNodes(tx.Nodes().Filterf(func() graph.Criteria {
+		return query.And(
+			query.Kind(query.Node(), adSchema.User),
+			query.CaseInsensitiveStringContains(query.NodeProperty(common.Description.String()), entraConnectDescriptionMarker),
+		)
+	}))
+}
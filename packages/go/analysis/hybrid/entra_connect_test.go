@@ -0,0 +1,43 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hybrid_test
+
+import (
+	"testing"
+
+	"github.com/specterops/bloodhound/packages/go/analysis/hybrid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntraConnectTenantDomain(t *testing.T) {
+	t.Run("Entra Connect description", func(t *testing.T) {
+		domain, found := hybrid.EntraConnectTenantDomain("Account created by Microsoft Azure Active Directory Connect with installation identifier 0d8a3f5c9e2b4a6d8f1e3c5b7a9d2e4f running on computer AADC01 configured to synchronize to tenant contoso.onmicrosoft.com. This account must have directory replication permissions in the local Active Directory and write permission on certain attributes to enable Hybrid Deployment.")
+		assert.True(t, found)
+		assert.Equal(t, "CONTOSO.ONMICROSOFT.COM", domain)
+	})
+
+	t.Run("Tenant at the end of the description", func(t *testing.T) {
+		domain, found := hybrid.EntraConnectTenantDomain("configured to Synchronize to tenant fabrikam.com")
+		assert.True(t, found)
+		assert.Equal(t, "FABRIKAM.COM", domain)
+	})
+
+	t.Run("Unrelated description", func(t *testing.T) {
+		_, found := hybrid.EntraConnectTenantDomain("Built-in account for administering the computer/domain")
+		assert.False(t, found)
+	})
+}
@@ -0,0 +1,136 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hybrid
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/specterops/bloodhound/packages/go/analysis"
+	"github.com/specterops/bloodhound/packages/go/analysis/azure"
+	adSchema "github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	azureSchema "github.com/specterops/bloodhound/packages/go/graphschema/azure"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/ops"
+	"github.com/specterops/dawgs/query"
+	"github.com/specterops/dawgs/util/channels"
+)
+
+// PostHybridGroups correlates Entra groups with the AD groups that share their on-prem security identifier. Groups that
+// are synchronized from AD receive a SyncedToEntraGroup edge from the AD group, as control of the AD group membership
+// is control of the Entra group membership. Entra groups that carry an on-prem security identifier without being
+// synchronized from AD are written back to AD and receive a SyncedToADGroup edge to the AD group instead.
+func PostHybridGroups(ctx context.Context, db graph.Database) (*analysis.AtomicPostProcessingStats, error) {
+	tenants, err := azure.FetchTenants(ctx, db)
+	if err != nil {
+		return &analysis.AtomicPostProcessingStats{}, fmt.Errorf("fetching Entra tenants: %w", err)
+	}
+
+	operation := analysis.NewPostRelationshipOperation(ctx, db, "Hybrid Group Post Processing")
+
+	err = db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		var (
+			syncedGroups      = map[graph.ID]string{}
+			writtenBackGroups = map[graph.ID]string{}
+			onPremIDs         = make([]string, 0, 1024)
+		)
+
+		for _, tenant := range tenants {
+			if tenantGroups, err := azure.EndNodes(tx, tenant, azureSchema.Contains, azureSchema.Group); err != nil {
+				return err
+			} else {
+				for _, tenantGroup := range tenantGroups {
+					if onPremID, _ := tenantGroup.Properties.GetOrDefault(azureSchema.OnPremID.String(), "").String(); onPremID == "" {
+						continue
+					} else if onPremSyncEnabled, _ := tenantGroup.Properties.GetOrDefault(azureSchema.OnPremSyncEnabled.String(), false).Bool(); onPremSyncEnabled {
+						syncedGroups[tenantGroup.ID] = strings.ToUpper(onPremID)
+						onPremIDs = append(onPremIDs, strings.ToUpper(onPremID))
+					} else {
+						writtenBackGroups[tenantGroup.ID] = strings.ToUpper(onPremID)
+						onPremIDs = append(onPremIDs, strings.ToUpper(onPremID))
+					}
+				}
+			}
+		}
+
+		if len(onPremIDs) == 0 {
+			return nil
+		} else if adGroupIndex, err := fetchADGroupIndex(tx, onPremIDs); err != nil {
+			return err
+		} else {
+			return operation.Operation.SubmitReader(func(ctx context.Context, tx graph.Transaction, outC chan<- analysis.CreatePostRelationshipJob) error {
+				for entraGroup, onPremID := range syncedGroups {
+					if adGroup, found := adGroupIndex[onPremID]; !found {
+						continue
+					} else if !channels.Submit(ctx, outC, analysis.CreatePostRelationshipJob{
+						FromID: adGroup,
+						ToID:   entraGroup,
+						Kind:   adSchema.SyncedToEntraGroup,
+					}) {
+						return nil
+					}
+				}
+
+				for entraGroup, onPremID := range writtenBackGroups {
+					if adGroup, found := adGroupIndex[onPremID]; !found {
+						continue
+					} else if !channels.Submit(ctx, outC, analysis.CreatePostRelationshipJob{
+						FromID: entraGroup,
+						ToID:   adGroup,
+						Kind:   azureSchema.SyncedToADGroup,
+					}) {
+						return nil
+					}
+				}
+
+				return nil
+			})
+		}
+	})
+
+	if opErr := operation.Done(); opErr != nil || err != nil {
+		return &operation.Stats, fmt.Errorf("marking operation as done: %w; transaction error (if any): %v", opErr, err)
+	}
+
+	return &operation.Stats, nil
+}
+
+// fetchADGroupIndex indexes the node ids of the AD groups with the given object ids by their object id
+func fetchADGroupIndex(tx graph.Transaction, objectIDs []string) (map[string]graph.ID, error) {
+	adGroupIndex := make(map[string]graph.ID, len(objectIDs))
+
+	if adGroups, err := ops.FetchNodes(tx.Nodes().Filterf(func() graph.Criteria {
+		return query.And(
+			query.Kind(query.Node(), adSchema.Group),
+			query.In(query.NodeProperty(common.ObjectID.String()), objectIDs),
+		)
+	})); err != nil {
+		return nil, err
+	} else {
+		for _, adGroup := range adGroups {
+			if objectID, err := adGroup.Properties.Get(common.ObjectID.String()).String(); err != nil {
+				continue
+			} else {
+				adGroupIndex[strings.ToUpper(objectID)] = adGroup.ID
+			}
+		}
+
+		return adGroupIndex, nil
+	}
+}
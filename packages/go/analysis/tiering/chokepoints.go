@@ -0,0 +1,396 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tiering
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/ops"
+	"github.com/specterops/dawgs/query"
+)
+
+// TierEdgeChokePoint is an attack path edge that every attack path into a tier from Principals exposed principals
+// crosses. Coverage is the fraction of all shortest attack paths into the tier that cross the edge.
+type TierEdgeChokePoint struct {
+	Start        *graph.Node
+	Relationship *graph.Relationship
+	End          *graph.Node
+	Principals   int
+	Coverage     float64
+}
+
+// TierNodeChokePoint is an exposed principal, such as a group, that every attack path into a tier from Principals other
+// exposed principals passes through. Coverage is the fraction of all shortest attack paths into the tier that pass
+// through the node without starting at it.
+type TierNodeChokePoint struct {
+	Node       *graph.Node
+	Principals int
+	Coverage   float64
+}
+
+type attackEdge struct {
+	id    graph.ID
+	start int
+	end   int
+}
+
+// attackGraph holds every attack path into a tier. Principals are indexed from 1 in order of their distance from the
+// tier and index 0 stands in for every member of the tier.
+type attackGraph struct {
+	nodeIds  []graph.ID
+	depths   []int
+	edges    []attackEdge
+	outbound [][]int
+	inbound  [][]int
+}
+
+func (s *attackGraph) principals() int {
+	return len(s.nodeIds) - 1
+}
+
+// fetchAttackGraph walks attack paths backwards from the members of a tier, following only principals accepted by
+// isExposed
+func fetchAttackGraph(tx graph.Transaction, pathfindKinds []graph.Kind, members []graph.ID, isExposed func(nodeId graph.ID) bool) (*attackGraph, error) {
+	var (
+		attack = &attackGraph{
+			nodeIds:  []graph.ID{0},
+			depths:   []int{0},
+			outbound: [][]int{nil},
+			inbound:  [][]int{nil},
+		}
+		indexes = make(map[graph.ID]int, len(members))
+	)
+
+	for _, member := range members {
+		indexes[member] = 0
+	}
+
+	// Paths are walked one hop at a time so principals are discovered in order of their distance from the tier
+	if err := walkInbound(tx, pathfindKinds, members, func(triple graph.RelationshipTripleResult) bool {
+		start, seen := indexes[triple.StartID]
+		if triple.StartID == triple.EndID || (seen && start == 0) || (!seen && !isExposed(triple.StartID)) {
+			return false
+		}
+
+		end := indexes[triple.EndID]
+		if !seen {
+			start = len(attack.nodeIds)
+			indexes[triple.StartID] = start
+
+			attack.nodeIds = append(attack.nodeIds, triple.StartID)
+			attack.depths = append(attack.depths, attack.depths[end]+1)
+			attack.outbound = append(attack.outbound, nil)
+			attack.inbound = append(attack.inbound, nil)
+		}
+
+		edgeIndex := len(attack.edges)
+		attack.edges = append(attack.edges, attackEdge{id: triple.ID, start: start, end: end})
+		attack.outbound[start] = append(attack.outbound[start], edgeIndex)
+		attack.inbound[end] = append(attack.inbound[end], edgeIndex)
+
+		return !seen
+	}); err != nil {
+		return nil, err
+	}
+
+	return attack, nil
+}
+
+// cutOff counts the principals cut off from the tier by removing each principal and each edge. A principal is cut off
+// by an element when the element dominates it, meaning every attack path from the principal into the tier passes
+// through it, so the counts are the sizes of the dominator subtrees of the attack graph rooted at the tier. Edges are
+// given their own vertex, numbered after the principals, so that they can dominate principals too.
+func (s *attackGraph) cutOff() ([]int, []int) {
+	var (
+		numPrincipals = len(s.nodeIds)
+		numVertices   = numPrincipals + len(s.edges)
+		// Successors walk away from the tier: from a principal, or the tier, to the edges ending at it and from an
+		// edge to the principal it starts at
+		successors = func(vertex int) []int {
+			if vertex < numPrincipals {
+				vertices := make([]int, len(s.inbound[vertex]))
+				for idx, edgeIndex := range s.inbound[vertex] {
+					vertices[idx] = numPrincipals + edgeIndex
+				}
+				return vertices
+			}
+			return []int{s.edges[vertex-numPrincipals].start}
+		}
+		predecessors = func(vertex int) []int {
+			if vertex < numPrincipals {
+				vertices := make([]int, len(s.outbound[vertex]))
+				for idx, edgeIndex := range s.outbound[vertex] {
+					vertices[idx] = numPrincipals + edgeIndex
+				}
+				return vertices
+			}
+			return []int{s.edges[vertex-numPrincipals].end}
+		}
+		postorder     = make([]int, 0, numVertices)
+		postorderRank = make([]int, numVertices)
+		visited       = make([]bool, numVertices)
+	)
+
+	// Iterative depth first search from the tier to number the vertices in postorder
+	type frame struct {
+		vertex int
+		next   []int
+	}
+
+	visited[0] = true
+	for stack := []frame{{vertex: 0, next: successors(0)}}; len(stack) > 0; {
+		top := &stack[len(stack)-1]
+
+		if len(top.next) == 0 {
+			postorderRank[top.vertex] = len(postorder)
+			postorder = append(postorder, top.vertex)
+			stack = stack[:len(stack)-1]
+			continue
+		}
+
+		vertex := top.next[0]
+		top.next = top.next[1:]
+
+		if !visited[vertex] {
+			visited[vertex] = true
+			stack = append(stack, frame{vertex: vertex, next: successors(vertex)})
+		}
+	}
+
+	// Cooper, Harvey and Kennedy's iterative dominator algorithm over the vertices in reverse postorder
+	dominators := make([]int, numVertices)
+	for idx := range dominators {
+		dominators[idx] = -1
+	}
+	dominators[0] = 0
+
+	intersect := func(a, b int) int {
+		for a != b {
+			for postorderRank[a] < postorderRank[b] {
+				a = dominators[a]
+			}
+			for postorderRank[b] < postorderRank[a] {
+				b = dominators[b]
+			}
+		}
+		return a
+	}
+
+	for changed := true; changed; {
+		changed = false
+
+		for idx := len(postorder) - 2; idx >= 0; idx-- {
+			var (
+				vertex    = postorder[idx]
+				dominator = -1
+			)
+
+			for _, predecessor := range predecessors(vertex) {
+				if dominators[predecessor] == -1 {
+					continue
+				} else if dominator == -1 {
+					dominator = predecessor
+				} else {
+					dominator = intersect(predecessor, dominator)
+				}
+			}
+
+			if dominators[vertex] != dominator {
+				dominators[vertex] = dominator
+				changed = true
+			}
+		}
+	}
+
+	// Every vertex follows its dominator in reverse postorder, so walking the postorder totals each dominator subtree
+	subtreePrincipals := make([]int, numVertices)
+	for _, vertex := range postorder {
+		if vertex != 0 {
+			if vertex < numPrincipals {
+				subtreePrincipals[vertex]++
+			}
+			subtreePrincipals[dominators[vertex]] += subtreePrincipals[vertex]
+		}
+	}
+
+	var (
+		principalCutOff = make([]int, numPrincipals)
+		edgeCutOff      = make([]int, len(s.edges))
+	)
+
+	for principal := 1; principal < numPrincipals; principal++ {
+		principalCutOff[principal] = subtreePrincipals[principal] - 1
+	}
+
+	for edgeIndex := range s.edges {
+		edgeCutOff[edgeIndex] = subtreePrincipals[numPrincipals+edgeIndex]
+	}
+
+	return principalCutOff, edgeCutOff
+}
+
+// coverage measures the fraction of all shortest attack paths into the tier that pass through each principal, without
+// starting at it, and that cross each edge. Principals are indexed in order of their distance from the tier so paths
+// are counted in a single pass in each direction.
+func (s *attackGraph) coverage() ([]float64, []float64) {
+	var (
+		numPrincipals = len(s.nodeIds)
+		// toTier counts the shortest paths from each principal into the tier and fromPrincipals counts the
+		// shortest paths from any principal that arrive at each principal, including the empty path
+		toTier         = make([]float64, numPrincipals)
+		fromPrincipals = make([]float64, numPrincipals)
+		isShortest     = func(edge attackEdge) bool {
+			return s.depths[edge.start] == s.depths[edge.end]+1
+		}
+		totalPaths        float64
+		principalCoverage = make([]float64, numPrincipals)
+		edgeCoverage      = make([]float64, len(s.edges))
+	)
+
+	toTier[0] = 1
+	for principal := 1; principal < numPrincipals; principal++ {
+		for _, edgeIndex := range s.outbound[principal] {
+			if edge := s.edges[edgeIndex]; isShortest(edge) {
+				toTier[principal] += toTier[edge.end]
+			}
+		}
+		totalPaths += toTier[principal]
+	}
+
+	for principal := numPrincipals - 1; principal > 0; principal-- {
+		fromPrincipals[principal]++
+
+		for _, edgeIndex := range s.outbound[principal] {
+			if edge := s.edges[edgeIndex]; isShortest(edge) && edge.end != 0 {
+				fromPrincipals[edge.end] += fromPrincipals[principal]
+			}
+		}
+	}
+
+	if totalPaths == 0 || math.IsInf(totalPaths, 0) {
+		return principalCoverage, edgeCoverage
+	}
+
+	for principal := 1; principal < numPrincipals; principal++ {
+		principalCoverage[principal] = (fromPrincipals[principal] - 1) * toTier[principal] / totalPaths
+	}
+
+	for edgeIndex, edge := range s.edges {
+		if isShortest(edge) {
+			edgeCoverage[edgeIndex] = fromPrincipals[edge.start] * toTier[edge.end] / totalPaths
+		}
+	}
+
+	return principalCoverage, edgeCoverage
+}
+
+// rankChokePoints returns the indexes of the elements that cut off at least one principal, ordered by the most
+// principals cut off and then by coverage, keeping at most limit of them when limit is greater than 0
+func rankChokePoints(cutOff []int, coverage []float64, limit int) []int {
+	var ranked []int
+
+	for idx, principals := range cutOff {
+		if principals > 0 {
+			ranked = append(ranked, idx)
+		}
+	}
+
+	slices.SortFunc(ranked, func(a, b int) int {
+		if byPrincipals := cmp.Compare(cutOff[b], cutOff[a]); byPrincipals != 0 {
+			return byPrincipals
+		} else if byCoverage := cmp.Compare(coverage[b], coverage[a]); byCoverage != 0 {
+			return byCoverage
+		}
+		return cmp.Compare(a, b)
+	})
+
+	if limit > 0 && len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+
+	return ranked
+}
+
+// chokePoints ranks the edges and principals of the attack graph that cut off the most principals from the tier,
+// keeping at most limit of each when limit is greater than 0
+func (s *attackGraph) chokePoints(tx graph.Transaction, limit int) ([]TierEdgeChokePoint, []TierNodeChokePoint, error) {
+	var (
+		principalCutOff, edgeCutOff     = s.cutOff()
+		principalCoverage, edgeCoverage = s.coverage()
+		rankedEdges                     = rankChokePoints(edgeCutOff, edgeCoverage, limit)
+		rankedPrincipals                = rankChokePoints(principalCutOff, principalCoverage, limit)
+		edges                           []TierEdgeChokePoint
+		nodes                           []TierNodeChokePoint
+	)
+
+	if len(rankedEdges) > 0 {
+		relationshipIds := make([]graph.ID, len(rankedEdges))
+		for idx, edgeIndex := range rankedEdges {
+			relationshipIds[idx] = s.edges[edgeIndex].id
+		}
+
+		paths, err := ops.FetchPathSet(tx.Relationships().Filter(query.InIDs(query.RelationshipID(), relationshipIds...)))
+		if err != nil {
+			return nil, nil, fmt.Errorf("fetching choke point edges: %w", err)
+		}
+
+		edgePaths := make(map[graph.ID]graph.Path, len(paths))
+		for _, path := range paths {
+			edgePaths[path.Edges[0].ID] = path
+		}
+
+		for _, edgeIndex := range rankedEdges {
+			if path, ok := edgePaths[s.edges[edgeIndex].id]; ok {
+				edges = append(edges, TierEdgeChokePoint{
+					Start:        path.Root(),
+					Relationship: path.Edges[0],
+					End:          path.Terminal(),
+					Principals:   edgeCutOff[edgeIndex],
+					Coverage:     edgeCoverage[edgeIndex],
+				})
+			}
+		}
+	}
+
+	if len(rankedPrincipals) > 0 {
+		nodeIds := make([]graph.ID, len(rankedPrincipals))
+		for idx, principal := range rankedPrincipals {
+			nodeIds[idx] = s.nodeIds[principal]
+		}
+
+		principals, err := ops.FetchNodeSet(tx.Nodes().Filter(query.InIDs(query.NodeID(), nodeIds...)))
+		if err != nil {
+			return nil, nil, fmt.Errorf("fetching choke point nodes: %w", err)
+		}
+
+		for _, principal := range rankedPrincipals {
+			if node := principals.Get(s.nodeIds[principal]); node != nil {
+				nodes = append(nodes, TierNodeChokePoint{
+					Node:       node,
+					Principals: principalCutOff[principal],
+					Coverage:   principalCoverage[principal],
+				})
+			}
+		}
+	}
+
+	return edges, nodes, nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build integration
+// +build integration
+
+package tiering_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/specterops/bloodhound/cmd/api/src/test/integration"
+	"github.com/specterops/bloodhound/packages/go/analysis/tiering"
+	schema "github.com/specterops/bloodhound/packages/go/graphschema"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/graph"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindTierExposure_ChokePoints(t *testing.T) {
+	var (
+		testContext = integration.NewGraphTestContext(t, schema.DefaultGraphSchema())
+		tierKinds   = []graph.Kind{tiering.KindTagTierZero}
+		domainSid   = "S-1-5-21-1"
+
+		newNode = func(name string, kinds ...graph.Kind) *graph.Node {
+			return testContext.NewNode(graph.AsProperties(graph.PropertyMap{
+				common.Name:     name,
+				common.ObjectID: name,
+				ad.DomainSID:    domainSid,
+			}), append([]graph.Kind{ad.Entity}, kinds...)...)
+		}
+
+		domainController = newNode("DC", ad.Computer, tiering.KindTagTierZero)
+		serverAdmin      = newNode("SERVER ADMIN", ad.User)
+		backupAdmin      = newNode("BACKUP ADMIN", ad.User)
+		helpdesk         = newNode("HELPDESK", ad.Group)
+		helpdeskUserOne  = newNode("HELPDESK USER ONE", ad.User)
+		helpdeskUserTwo  = newNode("HELPDESK USER TWO", ad.User)
+		vendor           = newNode("VENDOR", ad.User)
+
+		serverAdminEdge = testContext.NewRelationship(serverAdmin, domainController, ad.AdminTo)
+	)
+
+	// The helpdesk reaches tier zero through two admins so neither admin edge alone cuts it off, but every helpdesk
+	// user only reaches tier zero through the helpdesk group
+	testContext.NewRelationship(backupAdmin, domainController, ad.GenericWrite)
+	testContext.NewRelationship(helpdesk, serverAdmin, ad.ForceChangePassword)
+	testContext.NewRelationship(helpdesk, backupAdmin, ad.ForceChangePassword)
+	testContext.NewRelationship(helpdeskUserOne, helpdesk, ad.MemberOf)
+	testContext.NewRelationship(helpdeskUserTwo, helpdesk, ad.MemberOf)
+	testContext.NewRelationship(vendor, serverAdmin, ad.GenericAll)
+
+	results, err := tiering.FindTierExposure(context.Background(), testContext.Graph.Database, tierKinds, 0)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+
+	tierZero := results[0]
+	require.Equal(t, 6, tierZero.Exposure)
+
+	require.NotEmpty(t, tierZero.EdgeChokePoints)
+	require.Equal(t, serverAdminEdge.ID, tierZero.EdgeChokePoints[0].Relationship.ID)
+	require.Equal(t, serverAdmin.ID, tierZero.EdgeChokePoints[0].Start.ID)
+	require.Equal(t, domainController.ID, tierZero.EdgeChokePoints[0].End.ID)
+	require.Equal(t, 2, tierZero.EdgeChokePoints[0].Principals)
+
+	require.Len(t, tierZero.NodeChokePoints, 2)
+	require.Equal(t, helpdesk.ID, tierZero.NodeChokePoints[0].Node.ID)
+	require.Equal(t, 2, tierZero.NodeChokePoints[0].Principals)
+	require.Equal(t, serverAdmin.ID, tierZero.NodeChokePoints[1].Node.ID)
+	require.Equal(t, 1, tierZero.NodeChokePoints[1].Principals)
+
+	limited, err := tiering.FindTierExposure(context.Background(), testContext.Graph.Database, tierKinds, 1)
+	require.NoError(t, err)
+	require.Len(t, limited[0].EdgeChokePoints, 1)
+	require.Len(t, limited[0].NodeChokePoints, 1)
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tiering
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/query"
+)
+
+// TierExposure measures the attack paths into and out of a single tier. Exposure counts the distinct principals that
+// are untiered or only in lower tiers with an attack path into the tier and Impact counts the distinct objects outside
+// the tier that are reachable by an attack path from its members. EdgeChokePoints and NodeChokePoints are the edges and
+// exposed principals that cut off the most exposed principals from the tier, ordered by the most principals first and
+// then by coverage.
+type TierExposure struct {
+	Tier            int
+	Exposure        int
+	Impact          int
+	EdgeChokePoints []TierEdgeChokePoint
+	NodeChokePoints []TierNodeChokePoint
+}
+
+// FindTierExposure measures the inbound exposure and outbound impact of each tier. Tiers are given by their tag kind
+// from highest to lowest with the same membership rules as FindTierViolations. At most chokePointLimit edge and
+// chokePointLimit node choke points are returned for each tier; a limit of 0 or less returns every choke point.
+func FindTierExposure(ctx context.Context, db graph.Database, tierKinds []graph.Kind, chokePointLimit int) ([]TierExposure, error) {
+	var (
+		results       = make([]TierExposure, len(tierKinds))
+		pathfindKinds = tierPathfindingRelationships()
+	)
+
+	return results, db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		tiers, err := fetchNodeTiers(tx, tierKinds)
+		if err != nil {
+			return err
+		}
+
+		for tier, tierKind := range tierKinds {
+			var (
+				result   = TierExposure{Tier: tier}
+				members  = tiers.members(tier)
+				impacted = map[graph.ID]struct{}{}
+			)
+
+			attack, err := fetchAttackGraph(tx, pathfindKinds, members, func(nodeId graph.ID) bool {
+				return tiers.isBelow(nodeId, tier)
+			})
+			if err != nil {
+				return fmt.Errorf("fetching inbound attack paths of tier %s: %w", tierKind, err)
+			}
+
+			for frontier := members; len(frontier) > 0; {
+				var next []graph.ID
+
+				if err := tx.Relationships().Filter(query.And(
+					query.InIDs(query.StartID(), frontier...),
+					query.KindIn(query.Relationship(), pathfindKinds...),
+				)).FetchTriples(func(cursor graph.Cursor[graph.RelationshipTripleResult]) error {
+					for triple := range cursor.Chan() {
+						if _, seen := impacted[triple.EndID]; seen || tiers.tierOf(triple.EndID) == tier {
+							continue
+						}
+
+						impacted[triple.EndID] = struct{}{}
+						next = append(next, triple.EndID)
+					}
+
+					return cursor.Error()
+				}); err != nil {
+					return fmt.Errorf("fetching outbound attack paths of tier %s: %w", tierKind, err)
+				}
+
+				frontier = next
+			}
+
+			result.Exposure = attack.principals()
+			result.Impact = len(impacted)
+
+			if result.EdgeChokePoints, result.NodeChokePoints, err = attack.chokePoints(tx, chokePointLimit); err != nil {
+				return fmt.Errorf("fetching choke points of tier %s: %w", tierKind, err)
+			}
+
+			results[tier] = result
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,104 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build integration
+// +build integration
+
+package tiering_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/specterops/bloodhound/cmd/api/src/test/integration"
+	"github.com/specterops/bloodhound/packages/go/analysis/tiering"
+	schema "github.com/specterops/bloodhound/packages/go/graphschema"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/graph"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindTierExposure(t *testing.T) {
+	var (
+		testContext = integration.NewGraphTestContext(t, schema.DefaultGraphSchema())
+		tierOneKind = graph.StringKind("Tag_Tier_One")
+		tierKinds   = []graph.Kind{tiering.KindTagTierZero, tierOneKind}
+		domainSid   = "S-1-5-21-1"
+
+		newNode = func(name string, kinds ...graph.Kind) *graph.Node {
+			return testContext.NewNode(graph.AsProperties(graph.PropertyMap{
+				common.Name:     name,
+				common.ObjectID: name,
+				ad.DomainSID:    domainSid,
+			}), append([]graph.Kind{ad.Entity}, kinds...)...)
+		}
+
+		domainController = newNode("DC", ad.Computer, tiering.KindTagTierZero)
+		server           = newNode("SERVER", ad.Computer, tierOneKind)
+		serverAdmin      = newNode("SERVER ADMIN", ad.User, tierOneKind)
+		helpdesk         = newNode("HELPDESK", ad.Group)
+		helpdeskUser     = newNode("HELPDESK USER", ad.User)
+		serviceAccount   = newNode("SERVICE ACCOUNT", ad.User)
+		sessionUser      = newNode("SESSION USER", ad.User)
+
+		serverAdminEdge    = testContext.NewRelationship(serverAdmin, domainController, ad.AdminTo)
+		serviceAccountEdge = testContext.NewRelationship(serviceAccount, domainController, ad.GenericWrite)
+		helpdeskEdge       = testContext.NewRelationship(helpdesk, serverAdmin, ad.GenericAll)
+		helpdeskUserEdge   = testContext.NewRelationship(helpdeskUser, helpdesk, ad.MemberOf)
+	)
+
+	testContext.NewRelationship(domainController, server, ad.GenericAll)
+	testContext.NewRelationship(server, sessionUser, ad.HasSession)
+
+	results, err := tiering.FindTierExposure(context.Background(), testContext.Graph.Database, tierKinds, 0)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	// Every principal reaching tier zero through the server admin is cut off by the server admin's edge
+	tierZero := results[0]
+	require.Equal(t, 4, tierZero.Exposure)
+	require.Equal(t, 2, tierZero.Impact)
+	require.Len(t, tierZero.EdgeChokePoints, 4)
+	require.Equal(t, serverAdminEdge.ID, tierZero.EdgeChokePoints[0].Relationship.ID)
+	require.Equal(t, serverAdmin.ID, tierZero.EdgeChokePoints[0].Start.ID)
+	require.Equal(t, domainController.ID, tierZero.EdgeChokePoints[0].End.ID)
+	require.Equal(t, 3, tierZero.EdgeChokePoints[0].Principals)
+	require.Equal(t, helpdeskEdge.ID, tierZero.EdgeChokePoints[1].Relationship.ID)
+	require.Equal(t, 2, tierZero.EdgeChokePoints[1].Principals)
+	require.Contains(t, []graph.ID{serviceAccountEdge.ID, helpdeskUserEdge.ID}, tierZero.EdgeChokePoints[2].Relationship.ID)
+	require.Equal(t, 1, tierZero.EdgeChokePoints[2].Principals)
+	require.Len(t, tierZero.NodeChokePoints, 2)
+	require.Equal(t, serverAdmin.ID, tierZero.NodeChokePoints[0].Node.ID)
+	require.Equal(t, 2, tierZero.NodeChokePoints[0].Principals)
+	require.Equal(t, helpdesk.ID, tierZero.NodeChokePoints[1].Node.ID)
+	require.Equal(t, 1, tierZero.NodeChokePoints[1].Principals)
+
+	// Tier zero controlling tier one is not exposure but tier one reaching tier zero is impact
+	tierOne := results[1]
+	require.Equal(t, 2, tierOne.Exposure)
+	require.Equal(t, 2, tierOne.Impact)
+	require.Len(t, tierOne.EdgeChokePoints, 2)
+	require.Equal(t, helpdeskEdge.ID, tierOne.EdgeChokePoints[0].Relationship.ID)
+	require.Equal(t, 2, tierOne.EdgeChokePoints[0].Principals)
+	require.Equal(t, helpdeskUserEdge.ID, tierOne.EdgeChokePoints[1].Relationship.ID)
+	require.Equal(t, 1, tierOne.EdgeChokePoints[1].Principals)
+
+	limited, err := tiering.FindTierExposure(context.Background(), testContext.Graph.Database, tierKinds, 1)
+	require.NoError(t, err)
+	require.Len(t, limited[0].EdgeChokePoints, 1)
+	require.Equal(t, serverAdminEdge.ID, limited[0].EdgeChokePoints[0].Relationship.ID)
+}
@@ -0,0 +1,256 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tiering
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/azure"
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/ops"
+	"github.com/specterops/dawgs/query"
+)
+
+// Untiered is the tier index of a principal that is not a member of any tier
+const Untiered = -1
+
+// TierViolation is an attack path edge into a tier from a principal that is either untiered or only a member of lower
+// tiers. Principals counts the start node along with every principal outside the tier or below it with an attack path
+// of any length to the start node, such as the transitive members of a group or the principals controlling them.
+type TierViolation struct {
+	SourceTier   int
+	Start        *graph.Node
+	Relationship *graph.Relationship
+	End          *graph.Node
+	Principals   int
+}
+
+// TierViolationCount totals the violating principals and edges into a tier from a single source tier
+type TierViolationCount struct {
+	SourceTier int
+	Principals int
+	Edges      int
+}
+
+// TierViolations are the violations into a single tier along with their counts by source tier
+type TierViolations struct {
+	Tier       int
+	Violations []TierViolation
+	Counts     []TierViolationCount
+}
+
+func tierPathfindingRelationships() []graph.Kind {
+	return append(ad.PathfindingRelationships(), azure.PathfindingRelationships()...)
+}
+
+// FindTierViolations finds the attack path edges crossing into each tier from principals outside of it or in a lower
+// tier. Tiers are given by their tag kind from highest to lowest, so a principal is a member of the first tier whose
+// kind it carries and a tier is only violated by principals in tiers after it. Edges ending at a member of a higher
+// tier are reported against that tier only.
+func FindTierViolations(ctx context.Context, db graph.Database, tierKinds []graph.Kind) ([]TierViolations, error) {
+	var (
+		results       = make([]TierViolations, len(tierKinds))
+		pathfindKinds = tierPathfindingRelationships()
+	)
+
+	return results, db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		tiers, err := fetchNodeTiers(tx, tierKinds)
+		if err != nil {
+			return err
+		}
+
+		var (
+			tierOf  = tiers.tierOf
+			isBelow = tiers.isBelow
+		)
+
+		for tier, tierKind := range tierKinds {
+			var (
+				result     = TierViolations{Tier: tier}
+				principals = map[int]map[graph.ID]struct{}{}
+				edges      = map[int]int{}
+				// inboundPrincipals caches the principals with an attack path to each start node, which depend on the tier
+				inboundPrincipals = map[graph.ID][]graph.ID{}
+			)
+
+			paths, err := ops.FetchPathSet(tx.Relationships().Filter(query.And(
+				query.Kind(query.End(), tierKind),
+				query.KindIn(query.Relationship(), pathfindKinds...),
+			)))
+			if err != nil {
+				return fmt.Errorf("fetching inbound edges of tier %s: %w", tierKind, err)
+			}
+
+			for _, path := range paths {
+				var (
+					start        = path.Root()
+					end          = path.Terminal()
+					relationship = path.Edges[0]
+					sourceTier   = tierOf(start.ID)
+				)
+
+				if tierOf(end.ID) != tier || !isBelow(start.ID, tier) {
+					continue
+				}
+
+				controllers, walked := inboundPrincipals[start.ID]
+				if !walked {
+					if controllers, err = fetchInboundPrincipals(tx, pathfindKinds, tiers, tier, start.ID); err != nil {
+						return fmt.Errorf("fetching attack paths into %d: %w", start.ID, err)
+					}
+
+					inboundPrincipals[start.ID] = controllers
+				}
+
+				violation := TierViolation{
+					SourceTier:   sourceTier,
+					Start:        start,
+					Relationship: relationship,
+					End:          end,
+					Principals:   1 + len(controllers),
+				}
+
+				addPrincipal(principals, sourceTier, start.ID)
+				edges[sourceTier]++
+
+				for _, controller := range controllers {
+					addPrincipal(principals, tierOf(controller), controller)
+				}
+
+				result.Violations = append(result.Violations, violation)
+			}
+
+			for sourceTier := Untiered; sourceTier < len(tierKinds); sourceTier++ {
+				if sourcePrincipals, ok := principals[sourceTier]; ok {
+					result.Counts = append(result.Counts, TierViolationCount{
+						SourceTier: sourceTier,
+						Principals: len(sourcePrincipals),
+						Edges:      edges[sourceTier],
+					})
+				}
+			}
+
+			results[tier] = result
+		}
+
+		return nil
+	})
+}
+
+// fetchInboundPrincipals returns the principals, other than the root, with an attack path to the root that only passes
+// through principals that are untiered or below the given tier. Paths through a member of the tier or a higher tier
+// are violations of that tier instead.
+func fetchInboundPrincipals(tx graph.Transaction, pathfindKinds []graph.Kind, tiers nodeTiers, tier int, root graph.ID) ([]graph.ID, error) {
+	var (
+		principals []graph.ID
+		seen       = map[graph.ID]struct{}{root: {}}
+	)
+
+	return principals, walkInbound(tx, pathfindKinds, []graph.ID{root}, func(triple graph.RelationshipTripleResult) bool {
+		if _, visited := seen[triple.StartID]; visited || !tiers.isBelow(triple.StartID, tier) {
+			return false
+		}
+
+		seen[triple.StartID] = struct{}{}
+		principals = append(principals, triple.StartID)
+
+		return true
+	})
+}
+
+// walkInbound walks attack paths backwards from the given nodes one hop at a time. Every attack path edge ending at a
+// node of the current frontier is passed to visit and its start node joins the next frontier when visit returns true.
+func walkInbound(tx graph.Transaction, pathfindKinds []graph.Kind, roots []graph.ID, visit func(triple graph.RelationshipTripleResult) bool) error {
+	for frontier := roots; len(frontier) > 0; {
+		var next []graph.ID
+
+		if err := tx.Relationships().Filter(query.And(
+			query.InIDs(query.EndID(), frontier...),
+			query.KindIn(query.Relationship(), pathfindKinds...),
+		)).FetchTriples(func(cursor graph.Cursor[graph.RelationshipTripleResult]) error {
+			for triple := range cursor.Chan() {
+				if visit(triple) {
+					next = append(next, triple.StartID)
+				}
+			}
+
+			return cursor.Error()
+		}); err != nil {
+			return err
+		}
+
+		frontier = next
+	}
+
+	return nil
+}
+
+// nodeTiers maps each tiered node to the index of the highest tier it is a member of
+type nodeTiers map[graph.ID]int
+
+func fetchNodeTiers(tx graph.Transaction, tierKinds []graph.Kind) (nodeTiers, error) {
+	tiers := nodeTiers{}
+
+	for tier, tierKind := range tierKinds {
+		if nodeIds, err := ops.FetchNodeIDs(tx.Nodes().Filter(query.Kind(query.Node(), tierKind))); err != nil {
+			return nil, fmt.Errorf("fetching members of tier %s: %w", tierKind, err)
+		} else {
+			for _, nodeId := range nodeIds {
+				if _, seen := tiers[nodeId]; !seen {
+					tiers[nodeId] = tier
+				}
+			}
+		}
+	}
+
+	return tiers, nil
+}
+
+func (s nodeTiers) tierOf(nodeId graph.ID) int {
+	if tier, ok := s[nodeId]; ok {
+		return tier
+	}
+	return Untiered
+}
+
+// isBelow reports whether the node is untiered or only a member of tiers lower than the given tier
+func (s nodeTiers) isBelow(nodeId graph.ID, tier int) bool {
+	nodeTier := s.tierOf(nodeId)
+	return nodeTier == Untiered || nodeTier > tier
+}
+
+// members returns the nodes whose highest tier is the given tier
+func (s nodeTiers) members(tier int) []graph.ID {
+	var members []graph.ID
+
+	for nodeId, nodeTier := range s {
+		if nodeTier == tier {
+			members = append(members, nodeId)
+		}
+	}
+
+	return members
+}
+
+func addPrincipal(principals map[int]map[graph.ID]struct{}, tier int, nodeId graph.ID) {
+	if _, ok := principals[tier]; !ok {
+		principals[tier] = map[graph.ID]struct{}{}
+	}
+	principals[tier][nodeId] = struct{}{}
+}
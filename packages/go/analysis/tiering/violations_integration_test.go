@@ -0,0 +1,149 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build integration
+// +build integration
+
+package tiering_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/specterops/bloodhound/cmd/api/src/test/integration"
+	"github.com/specterops/bloodhound/packages/go/analysis/tiering"
+	schema "github.com/specterops/bloodhound/packages/go/graphschema"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/graph"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindTierViolations(t *testing.T) {
+	var (
+		testContext = integration.NewGraphTestContext(t, schema.DefaultGraphSchema())
+		tierOneKind = graph.StringKind("Tag_Tier_One")
+		tierKinds   = []graph.Kind{tiering.KindTagTierZero, tierOneKind}
+		domainSid   = "S-1-5-21-1"
+
+		newNode = func(name string, kinds ...graph.Kind) *graph.Node {
+			return testContext.NewNode(graph.AsProperties(graph.PropertyMap{
+				common.Name:     name,
+				common.ObjectID: name,
+				ad.DomainSID:    domainSid,
+			}), append([]graph.Kind{ad.Entity}, kinds...)...)
+		}
+
+		domainAdmin      = newNode("DOMAIN ADMIN", ad.User, tiering.KindTagTierZero)
+		domainController = newNode("DC", ad.Computer, tiering.KindTagTierZero)
+		server           = newNode("SERVER", ad.Computer, tierOneKind)
+		serverAdmin      = newNode("SERVER ADMIN", ad.User, tierOneKind)
+		helpdesk         = newNode("HELPDESK", ad.Group)
+		helpdeskUser     = newNode("HELPDESK USER", ad.User)
+		nestedGroup      = newNode("NESTED", ad.Group)
+		nestedUser       = newNode("NESTED USER", ad.User)
+	)
+
+	// Tier zero controlling tier one is expected and is not a violation
+	testContext.NewRelationship(domainAdmin, server, ad.GenericAll)
+
+	// Tier one controlling tier zero is a violation of tier zero
+	testContext.NewRelationship(serverAdmin, domainController, ad.AdminTo)
+
+	// An untiered group controlling tier one is a violation of tier one by every untiered member of the group. The tier
+	// one server admin is also a member but is not below tier one so is not counted.
+	testContext.NewRelationship(helpdesk, server, ad.GenericAll)
+	testContext.NewRelationship(helpdeskUser, helpdesk, ad.MemberOf)
+	testContext.NewRelationship(serverAdmin, helpdesk, ad.MemberOf)
+	testContext.NewRelationship(nestedGroup, helpdesk, ad.MemberOf)
+	testContext.NewRelationship(nestedUser, nestedGroup, ad.MemberOf)
+
+	results, err := tiering.FindTierViolations(context.Background(), testContext.Graph.Database, tierKinds)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	tierZero := results[0]
+	require.Len(t, tierZero.Violations, 1)
+	require.Equal(t, serverAdmin.ID, tierZero.Violations[0].Start.ID)
+	require.Equal(t, domainController.ID, tierZero.Violations[0].End.ID)
+	require.Equal(t, ad.AdminTo, tierZero.Violations[0].Relationship.Kind)
+	require.Equal(t, 1, tierZero.Violations[0].SourceTier)
+	require.Equal(t, 1, tierZero.Violations[0].Principals)
+	require.Equal(t, []tiering.TierViolationCount{{SourceTier: 1, Principals: 1, Edges: 1}}, tierZero.Counts)
+
+	tierOne := results[1]
+	require.Len(t, tierOne.Violations, 1)
+	require.Equal(t, helpdesk.ID, tierOne.Violations[0].Start.ID)
+	require.Equal(t, server.ID, tierOne.Violations[0].End.ID)
+	require.Equal(t, tiering.Untiered, tierOne.Violations[0].SourceTier)
+	require.Equal(t, 4, tierOne.Violations[0].Principals)
+	require.Equal(t, []tiering.TierViolationCount{{SourceTier: tiering.Untiered, Principals: 4, Edges: 1}}, tierOne.Counts)
+}
+
+func TestFindTierViolations_MultiHop(t *testing.T) {
+	var (
+		testContext = integration.NewGraphTestContext(t, schema.DefaultGraphSchema())
+		tierOneKind = graph.StringKind("Tag_Tier_One")
+		tierKinds   = []graph.Kind{tiering.KindTagTierZero, tierOneKind}
+		domainSid   = "S-1-5-21-1"
+
+		newNode = func(name string, kinds ...graph.Kind) *graph.Node {
+			return testContext.NewNode(graph.AsProperties(graph.PropertyMap{
+				common.Name:     name,
+				common.ObjectID: name,
+				ad.DomainSID:    domainSid,
+			}), append([]graph.Kind{ad.Entity}, kinds...)...)
+		}
+
+		domainController = newNode("DC", ad.Computer, tiering.KindTagTierZero)
+		server           = newNode("SERVER", ad.Computer, tierOneKind)
+		operator         = newNode("OPERATOR", ad.User)
+		workstation      = newNode("WORKSTATION", ad.Computer)
+		itGroup          = newNode("IT", ad.Group)
+		itUser           = newNode("IT USER", ad.User)
+		contractor       = newNode("CONTRACTOR", ad.User)
+	)
+
+	// The operator controls tier zero directly and is reached over several hops of control that are not group
+	// membership: the operator has a session on a workstation the IT group administers, and a contractor can reset
+	// the password of an IT user
+	testContext.NewRelationship(operator, domainController, ad.GenericAll)
+	testContext.NewRelationship(workstation, operator, ad.HasSession)
+	testContext.NewRelationship(itGroup, workstation, ad.AdminTo)
+	testContext.NewRelationship(itUser, itGroup, ad.MemberOf)
+	testContext.NewRelationship(contractor, itUser, ad.ForceChangePassword)
+
+	// A tier one principal with a path to the operator is counted against tier zero from tier one, even without an
+	// edge of its own into tier zero
+	testContext.NewRelationship(server, operator, ad.GenericWrite)
+
+	results, err := tiering.FindTierViolations(context.Background(), testContext.Graph.Database, tierKinds)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	tierZero := results[0]
+	require.Len(t, tierZero.Violations, 1)
+	require.Equal(t, operator.ID, tierZero.Violations[0].Start.ID)
+	require.Equal(t, domainController.ID, tierZero.Violations[0].End.ID)
+	require.Equal(t, tiering.Untiered, tierZero.Violations[0].SourceTier)
+	require.Equal(t, 6, tierZero.Violations[0].Principals)
+	require.Equal(t, []tiering.TierViolationCount{
+		{SourceTier: tiering.Untiered, Principals: 5, Edges: 1},
+		{SourceTier: 1, Principals: 1, Edges: 0},
+	}, tierZero.Counts)
+
+	require.Empty(t, results[1].Violations)
+}
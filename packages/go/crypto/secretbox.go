@@ -0,0 +1,70 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package crypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+const SecretBoxKeyByteLength = 32
+
+var ErrSecretBoxKeyLength = fmt.Errorf("secret box key must be %d bytes", SecretBoxKeyByteLength)
+
+// SecretBox encrypts secrets that must be recovered later, such as the password of a directory service account, with
+// AES-256-GCM. Sealed secrets are the base64 encoding of the random nonce followed by the ciphertext.
+type SecretBox struct {
+	aead cipher.AEAD
+}
+
+func NewSecretBox(key []byte) (SecretBox, error) {
+	if len(key) != SecretBoxKeyByteLength {
+		return SecretBox{}, ErrSecretBoxKeyLength
+	} else if block, err := aes.NewCipher(key); err != nil {
+		return SecretBox{}, err
+	} else if aead, err := cipher.NewGCM(block); err != nil {
+		return SecretBox{}, err
+	} else {
+		return SecretBox{aead: aead}, nil
+	}
+}
+
+func (s SecretBox) Seal(secret string) (string, error) {
+	nonce := make([]byte, s.aead.NonceSize(), s.aead.NonceSize()+len(secret)+s.aead.Overhead())
+
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(s.aead.Seal(nonce, nonce, []byte(secret), nil)), nil
+}
+
+func (s SecretBox) Open(sealedSecret string) (string, error) {
+	if sealed, err := base64.StdEncoding.DecodeString(sealedSecret); err != nil {
+		return "", err
+	} else if len(sealed) < s.aead.NonceSize() {
+		return "", errors.New("sealed secret is too short")
+	} else if secret, err := s.aead.Open(nil, sealed[:s.aead.NonceSize()], sealed[s.aead.NonceSize():], nil); err != nil {
+		return "", err
+	} else {
+		return string(secret), nil
+	}
+}
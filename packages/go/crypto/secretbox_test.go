@@ -0,0 +1,56 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package crypto
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestSecretBox(t *testing.T) {
+	secretBox, err := NewSecretBox(bytes.Repeat([]byte{1}, SecretBoxKeyByteLength))
+	if err != nil {
+		t.Fatalf("Unexpected error while creating secret box: %v", err)
+	}
+
+	sealed, err := secretBox.Seal("This is a test.")
+	if err != nil {
+		t.Fatalf("Unexpected error while sealing secret: %v", err)
+	} else if sealed == "This is a test." {
+		t.Fatal("Expected sealed secret to differ from the secret")
+	}
+
+	if secret, err := secretBox.Open(sealed); err != nil {
+		t.Fatalf("Unexpected error while opening secret: %v", err)
+	} else if secret != "This is a test." {
+		t.Fatalf("Expected opened secret to be %q but got: %q", "This is a test.", secret)
+	}
+
+	otherSecretBox, err := NewSecretBox(bytes.Repeat([]byte{2}, SecretBoxKeyByteLength))
+	if err != nil {
+		t.Fatalf("Unexpected error while creating secret box: %v", err)
+	} else if _, err := otherSecretBox.Open(sealed); err == nil {
+		t.Fatal("Expected opening a secret sealed with another key to fail")
+	}
+}
+
+func TestNewSecretBox_InvalidKeyLength(t *testing.T) {
+	if _, err := NewSecretBox([]byte("short")); !errors.Is(err, ErrSecretBoxKeyLength) {
+		t.Fatalf("Expected ErrSecretBoxKeyLength but got: %v", err)
+	}
+}
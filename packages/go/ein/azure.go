@@ -1204,9 +1204,26 @@ func ConvertAzureTenantToNode(data models.Tenant, ingestTime time.Time) Ingestib
 		node.PropertyMap["collected"] = true
 	}
 
+	if domains := tenantDomains(data); len(domains) > 0 {
+		node.PropertyMap[azure.TenantDomains.String()] = domains
+	}
+
 	return node
 }
 
+// tenantDomains returns the upper cased verified domains of the tenant, including its default domain
+func tenantDomains(data models.Tenant) []string {
+	domains := make([]string, 0, len(data.Domains)+1)
+
+	for _, domain := range append([]string{data.DefaultDomain}, data.Domains...) {
+		if domain = strings.ToUpper(domain); domain != "" && !slices.Contains(domains, domain) {
+			domains = append(domains, domain)
+		}
+	}
+
+	return domains
+}
+
 // ConvertAzureUser returns the basic node, the on prem node and then the ingestible contains relationship
 func ConvertAzureUser(data models.User, ingestTime time.Time) (IngestibleNode, IngestibleNode, IngestibleRelationship) {
 	onPremNode := IngestibleNode{}
@@ -1949,6 +1966,414 @@ func ConvertAzureRoleManagementPolicyAssignment(policyAssignment models.RoleMana
 	return targetAZRole, rels
 }
 
+func ConvertAzureStorageAccount(account models.StorageAccount, ingestTime time.Time) (IngestibleNode, IngestibleRelationship) {
+	return IngestibleNode{
+			ObjectID: strings.ToUpper(account.Id),
+			PropertyMap: map[string]any{
+				common.Name.String():          strings.ToUpper(account.Name),
+				azure.TenantID.String():       strings.ToUpper(account.TenantId),
+				common.LastCollected.String(): ingestTime,
+			},
+			Labels: []graph.Kind{azure.StorageAccount},
+		},
+		NewIngestibleRelationship(
+			IngestibleEndpoint{
+				Value: strings.ToUpper(account.ResourceGroupId),
+				Kind:  azure.ResourceGroup,
+			},
+			IngestibleEndpoint{
+				Kind:  azure.StorageAccount,
+				Value: strings.ToUpper(account.Id),
+			},
+			IngestibleRel{
+				RelProps: map[string]any{},
+				RelType:  azure.Contains,
+			},
+		)
+}
+
+func ConvertAzureStorageContainer(container models.StorageContainer, ingestTime time.Time) (IngestibleNode, IngestibleRelationship) {
+	return IngestibleNode{
+			ObjectID: strings.ToUpper(container.Id),
+			PropertyMap: map[string]any{
+				common.Name.String():               strings.ToUpper(container.Name),
+				azure.StoragePublicAccess.String(): string(container.Properties.PublicAccess),
+				azure.TenantID.String():            strings.ToUpper(container.TenantId),
+				common.LastCollected.String():      ingestTime,
+			},
+			Labels: []graph.Kind{azure.StorageContainer},
+		},
+		NewIngestibleRelationship(
+			IngestibleEndpoint{
+				Value: strings.ToUpper(container.StorageAccountId),
+				Kind:  azure.StorageAccount,
+			},
+			IngestibleEndpoint{
+				Kind:  azure.StorageContainer,
+				Value: strings.ToUpper(container.Id),
+			},
+			IngestibleRel{
+				RelProps: map[string]any{},
+				RelType:  azure.Contains,
+			},
+		)
+}
+
+func ConvertAzureStorageFileShare(share StorageFileShare, ingestTime time.Time) (IngestibleNode, IngestibleRelationship) {
+	return IngestibleNode{
+			ObjectID: strings.ToUpper(share.Id),
+			PropertyMap: map[string]any{
+				common.Name.String():          strings.ToUpper(share.Name),
+				azure.TenantID.String():       strings.ToUpper(share.TenantId),
+				common.LastCollected.String(): ingestTime,
+			},
+			Labels: []graph.Kind{azure.StorageFileShare},
+		},
+		NewIngestibleRelationship(
+			IngestibleEndpoint{
+				Value: strings.ToUpper(share.StorageAccountId),
+				Kind:  azure.StorageAccount,
+			},
+			IngestibleEndpoint{
+				Kind:  azure.StorageFileShare,
+				Value: strings.ToUpper(share.Id),
+			},
+			IngestibleRel{
+				RelProps: map[string]any{},
+				RelType:  azure.Contains,
+			},
+		)
+}
+
+func ConvertAzureStorageAccountRoleAssignment(roleAssignments models.AzureRoleAssignments) []IngestibleRelationship {
+	relationships := make([]IngestibleRelationship, 0)
+	for _, raw := range roleAssignments.RoleAssignments {
+		if strings.EqualFold(raw.Assignee.Properties.Scope, raw.ObjectId) {
+			if slices.Contains([]string{
+				constants.OwnerRoleID,
+				constants.UserAccessAdminRoleID,
+				constants.ContributorRoleID,
+				azure.StorageAccountContributorRole,
+				azure.StorageBlobDataOwnerRole,
+				azure.StorageBlobDataContributorRole,
+				azure.StorageFileSMBContributorRole,
+				azure.StorageFileSMBElevatedContributorRole,
+				azure.StorageFilePrivilegedContributorRole,
+			}, strings.ToLower(raw.RoleDefinitionId)) {
+				relationships = append(relationships, NewIngestibleRelationship(
+					IngestibleEndpoint{
+						Value: strings.ToUpper(raw.Assignee.GetPrincipalId()),
+						Kind:  azure.Entity,
+					},
+					IngestibleEndpoint{
+						Kind:  azure.StorageAccount,
+						Value: strings.ToUpper(roleAssignments.ObjectId),
+					},
+					IngestibleRel{
+						RelProps: map[string]any{},
+						RelType:  KindFromRoleId(strings.ToLower(raw.RoleDefinitionId)),
+					},
+				))
+			}
+		}
+	}
+
+	return relationships
+}
+
+// ConvertAzureConditionalAccessPolicy returns the Conditional Access policy node, the tenant contains relationship and
+// relationships from the policy to every user, group and role it explicitly targets or excludes. Well known values such
+// as "All" or "GuestsOrExternalUsers" are only retained on the node's properties.
+func ConvertAzureConditionalAccessPolicy(data ConditionalAccessPolicy, ingestTime time.Time) (IngestibleNode, []IngestibleRelationship) {
+	var (
+		tenantID      = strings.ToUpper(data.TenantId)
+		policyID      = strings.ToUpper(data.Id)
+		users         = data.Conditions.Users
+		grantControls = make([]string, 0)
+		grantOperator string
+		relationships = []IngestibleRelationship{
+			NewIngestibleRelationship(
+				IngestibleEndpoint{
+					Value: tenantID,
+					Kind:  azure.Tenant,
+				},
+				IngestibleEndpoint{
+					Value: policyID,
+					Kind:  azure.ConditionalAccessPolicy,
+				},
+				IngestibleRel{
+					RelProps: map[string]any{},
+					RelType:  azure.Contains,
+				},
+			),
+		}
+	)
+
+	if data.GrantControls != nil {
+		grantOperator = strings.ToUpper(data.GrantControls.Operator)
+		grantControls = append(grantControls, data.GrantControls.BuiltInControls...)
+
+		if data.GrantControls.AuthenticationStrength != nil {
+			grantControls = append(grantControls, azure.ConditionalAccessControlAuthenticationStrength)
+		}
+	}
+
+	node := IngestibleNode{
+		ObjectID: policyID,
+		PropertyMap: map[string]any{
+			common.Name.String():                       strings.ToUpper(fmt.Sprintf("%s@%s", data.DisplayName, data.TenantName)),
+			common.DisplayName.String():                data.DisplayName,
+			common.WhenCreated.String():                ParseISO8601(data.CreatedDateTime),
+			azure.CAPolicyState.String():               data.State,
+			azure.CAPolicyIncludeUsers.String():        upperAll(users.IncludeUsers),
+			azure.CAPolicyExcludeUsers.String():        upperAll(users.ExcludeUsers),
+			azure.CAPolicyIncludeGroups.String():       upperAll(users.IncludeGroups),
+			azure.CAPolicyExcludeGroups.String():       upperAll(users.ExcludeGroups),
+			azure.CAPolicyIncludeRoles.String():        upperAll(users.IncludeRoles),
+			azure.CAPolicyExcludeRoles.String():        upperAll(users.ExcludeRoles),
+			azure.CAPolicyIncludeApplications.String(): upperAll(data.Conditions.Applications.IncludeApplications),
+			azure.CAPolicyExcludeApplications.String(): upperAll(data.Conditions.Applications.ExcludeApplications),
+			azure.CAPolicyGrantControls.String():       grantControls,
+			azure.CAPolicyGrantOperator.String():       grantOperator,
+			azure.TenantID.String():                    tenantID,
+			common.LastCollected.String():              ingestTime,
+		},
+		Labels: []graph.Kind{azure.ConditionalAccessPolicy},
+	}
+
+	relationships = append(relationships, conditionalAccessTargetRels(policyID, azure.CAPolicyTargets, azure.User, users.IncludeUsers)...)
+	relationships = append(relationships, conditionalAccessTargetRels(policyID, azure.CAPolicyExcludes, azure.User, users.ExcludeUsers)...)
+	relationships = append(relationships, conditionalAccessTargetRels(policyID, azure.CAPolicyTargets, azure.Group, users.IncludeGroups)...)
+	relationships = append(relationships, conditionalAccessTargetRels(policyID, azure.CAPolicyExcludes, azure.Group, users.ExcludeGroups)...)
+	relationships = append(relationships, conditionalAccessTargetRels(policyID, azure.CAPolicyTargets, azure.Role, roleObjectIDs(users.IncludeRoles, tenantID))...)
+	relationships = append(relationships, conditionalAccessTargetRels(policyID, azure.CAPolicyExcludes, azure.Role, roleObjectIDs(users.ExcludeRoles, tenantID))...)
+
+	return node, relationships
+}
+
+func conditionalAccessTargetRels(policyID string, relType graph.Kind, targetKind graph.Kind, targetIDs []string) []IngestibleRelationship {
+	relationships := make([]IngestibleRelationship, 0, len(targetIDs))
+
+	for _, targetID := range targetIDs {
+		switch targetID {
+		case azure.ConditionalAccessAll, azure.ConditionalAccessNone, azure.ConditionalAccessGuestsOrExternalUsers:
+			continue
+		}
+
+		relationships = append(relationships, NewIngestibleRelationship(
+			IngestibleEndpoint{
+				Value: policyID,
+				Kind:  azure.ConditionalAccessPolicy,
+			},
+			IngestibleEndpoint{
+				Value: strings.ToUpper(targetID),
+				Kind:  targetKind,
+			},
+			IngestibleRel{
+				RelProps: map[string]any{},
+				RelType:  relType,
+			},
+		))
+	}
+
+	return relationships
+}
+
+// roleObjectIDs converts role template IDs into the objectid format used by AZRole nodes (TEMPLATEID@TENANTID)
+func roleObjectIDs(roleTemplateIDs []string, tenantID string) []string {
+	objectIDs := make([]string, 0, len(roleTemplateIDs))
+
+	for _, roleTemplateID := range roleTemplateIDs {
+		if roleTemplateID == azure.ConditionalAccessAll || roleTemplateID == azure.ConditionalAccessNone {
+			continue
+		}
+
+		objectIDs = append(objectIDs, strings.ToUpper(fmt.Sprintf("%s@%s", roleTemplateID, tenantID)))
+	}
+
+	return objectIDs
+}
+
+func upperAll(values []string) []string {
+	result := make([]string, len(values))
+
+	for idx, value := range values {
+		result[idx] = strings.ToUpper(value)
+	}
+
+	return result
+}
+
+// ConvertAzureIntuneManagedDevice returns the Intune management properties of a managed device, keyed by the object ID of
+// the Entra device it is enrolled as
+func ConvertAzureIntuneManagedDevice(data IntuneManagedDevice, ingestTime time.Time) IngestibleNode {
+	return IngestibleNode{
+		ObjectID: strings.ToUpper(data.DeviceObjectId),
+		PropertyMap: map[string]any{
+			azure.IntuneDeviceID.String():        strings.ToUpper(data.Id),
+			azure.IntuneComplianceState.String(): data.ComplianceState,
+			azure.IntuneManagementAgent.String(): data.ManagementAgent,
+			azure.IntuneScopeTagIDs.String():     intuneScopeTags(data.RoleScopeTagIds),
+			azure.TenantID.String():              strings.ToUpper(data.TenantId),
+			common.LastCollected.String():        ingestTime,
+		},
+		Labels: []graph.Kind{azure.Device},
+	}
+}
+
+// ConvertAzureIntuneScript returns the Intune script node, the tenant contains relationship and a relationship to each
+// group the script is assigned to
+func ConvertAzureIntuneScript(data IntuneScript, ingestTime time.Time) (IngestibleNode, []IngestibleRelationship) {
+	var (
+		tenantID      = strings.ToUpper(data.TenantId)
+		scriptID      = strings.ToUpper(data.Id)
+		relationships = []IngestibleRelationship{
+			NewIngestibleRelationship(
+				IngestibleEndpoint{
+					Value: tenantID,
+					Kind:  azure.Tenant,
+				},
+				IngestibleEndpoint{
+					Value: scriptID,
+					Kind:  azure.IntuneScript,
+				},
+				IngestibleRel{
+					RelProps: map[string]any{},
+					RelType:  azure.Contains,
+				},
+			),
+		}
+	)
+
+	node := IngestibleNode{
+		ObjectID: scriptID,
+		PropertyMap: map[string]any{
+			common.Name.String():              strings.ToUpper(fmt.Sprintf("%s@%s", data.DisplayName, data.TenantName)),
+			common.DisplayName.String():       data.DisplayName,
+			common.Description.String():       data.Description,
+			common.WhenCreated.String():       ParseISO8601(data.CreatedDateTime),
+			azure.IntuneRunAsAccount.String(): data.RunAsAccount,
+			azure.IntuneScopeTagIDs.String():  intuneScopeTags(data.RoleScopeTagIds),
+			azure.TenantID.String():           tenantID,
+			common.LastCollected.String():     ingestTime,
+		},
+		Labels: []graph.Kind{azure.IntuneScript},
+	}
+
+	for _, assignment := range data.Assignments {
+		if assignment.TargetGroupId == "" {
+			continue
+		}
+
+		relationships = append(relationships, NewIngestibleRelationship(
+			IngestibleEndpoint{
+				Value: scriptID,
+				Kind:  azure.IntuneScript,
+			},
+			IngestibleEndpoint{
+				Value: strings.ToUpper(assignment.TargetGroupId),
+				Kind:  azure.Group,
+			},
+			IngestibleRel{
+				RelProps: map[string]any{},
+				RelType:  azure.IntuneScriptTargets,
+			},
+		))
+	}
+
+	return node, relationships
+}
+
+// ConvertAzureIntuneRoleAssignment returns the Intune RBAC role assignment node, the tenant contains relationship and a
+// relationship from each member group of the assignment
+func ConvertAzureIntuneRoleAssignment(data IntuneRoleAssignment, ingestTime time.Time) (IngestibleNode, []IngestibleRelationship) {
+	var (
+		tenantID      = strings.ToUpper(data.TenantId)
+		assignmentID  = strings.ToUpper(data.Id)
+		relationships = []IngestibleRelationship{
+			NewIngestibleRelationship(
+				IngestibleEndpoint{
+					Value: tenantID,
+					Kind:  azure.Tenant,
+				},
+				IngestibleEndpoint{
+					Value: assignmentID,
+					Kind:  azure.IntuneRoleAssignment,
+				},
+				IngestibleRel{
+					RelProps: map[string]any{},
+					RelType:  azure.Contains,
+				},
+			),
+		}
+	)
+
+	node := IngestibleNode{
+		ObjectID: assignmentID,
+		PropertyMap: map[string]any{
+			common.Name.String():                    strings.ToUpper(fmt.Sprintf("%s@%s", data.DisplayName, data.TenantName)),
+			common.DisplayName.String():             data.DisplayName,
+			azure.RoleDefinitionId.String():         strings.ToUpper(data.RoleDefinitionId),
+			azure.IntuneRoleDefinitionName.String(): data.RoleDefinitionName,
+			azure.IntuneScopeType.String():          data.ScopeType,
+			azure.IntuneScopeGroups.String():        upperAll(data.ScopeMembers),
+			azure.IntuneScopeTagIDs.String():        intuneScopeTags(data.RoleScopeTagIds),
+			azure.IntuneCanRunScripts.String():      CanRunIntuneScripts(data.AllowedResourceActions),
+			azure.TenantID.String():                 tenantID,
+			common.LastCollected.String():           ingestTime,
+		},
+		Labels: []graph.Kind{azure.IntuneRoleAssignment},
+	}
+
+	for _, member := range data.Members {
+		relationships = append(relationships, NewIngestibleRelationship(
+			IngestibleEndpoint{
+				Value: strings.ToUpper(member),
+				Kind:  azure.Group,
+			},
+			IngestibleEndpoint{
+				Value: assignmentID,
+				Kind:  azure.IntuneRoleAssignment,
+			},
+			IngestibleRel{
+				RelProps: map[string]any{},
+				RelType:  azure.HasIntuneRole,
+			},
+		))
+	}
+
+	return node, relationships
+}
+
+// CanRunIntuneScripts returns true if the given Intune resource actions allow deploying a platform script to devices
+func CanRunIntuneScripts(allowedResourceActions []string) bool {
+	var (
+		canAssign bool
+		canModify bool
+	)
+
+	for _, action := range allowedResourceActions {
+		switch {
+		case strings.EqualFold(action, azure.IntuneActionDeviceConfigurationsAssign):
+			canAssign = true
+		case strings.EqualFold(action, azure.IntuneActionDeviceConfigurationsCreate), strings.EqualFold(action, azure.IntuneActionDeviceConfigurationsUpdate):
+			canModify = true
+		}
+	}
+
+	return canAssign && canModify
+}
+
+// intuneScopeTags returns the given scope tag IDs, defaulting to the built-in Default scope tag that Intune applies to
+// objects without an explicit scope tag
+func intuneScopeTags(roleScopeTagIds []string) []string {
+	if len(roleScopeTagIds) == 0 {
+		return []string{azure.IntuneDefaultScopeTagID}
+	}
+
+	return roleScopeTagIds
+}
+
 func CanAddSecret(roleDefinitionId string) bool {
 	return roleDefinitionId == azure.ApplicationAdministratorRole || roleDefinitionId == azure.CloudApplicationAdministratorRole
 }
@@ -1991,6 +2416,18 @@ func KindFromRoleId(roleId string) graph.Kind {
 		return azure.VMContributor
 	case azure.AKSContributorRole:
 		return azure.AKSContributor
+	case azure.StorageAccountContributorRole:
+		return azure.StorageAccountContributor
+	case azure.StorageBlobDataOwnerRole:
+		return azure.StorageBlobDataOwner
+	case azure.StorageBlobDataContributorRole:
+		return azure.StorageBlobDataContributor
+	case azure.StorageFileSMBContributorRole:
+		return azure.StorageFileSMBContributor
+	case azure.StorageFileSMBElevatedContributorRole:
+		return azure.StorageFileSMBElevatedContributor
+	case azure.StorageFilePrivilegedContributorRole:
+		return azure.StorageFilePrivilegedContributor
 	default:
 		return graph.StringKind("")
 	}
@@ -0,0 +1,135 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ein
+
+import "github.com/bloodhoundad/azurehound/v2/enums"
+
+// Azure data kinds that are emitted in the AzureHound ingest format but are not yet part of the AzureHound enums
+// package. The models below mirror the shape of the corresponding Microsoft Graph resources.
+const (
+	KindAZConditionalAccessPolicy enums.Kind = "AZConditionalAccessPolicy"
+	KindAZIntuneManagedDevice     enums.Kind = "AZIntuneManagedDevice"
+	KindAZIntuneScript            enums.Kind = "AZIntuneScript"
+	KindAZIntuneRoleAssignment    enums.Kind = "AZIntuneRoleAssignment"
+	KindAZStorageFileShare        enums.Kind = "AZStorageFileShare"
+)
+
+type ConditionalAccessUsers struct {
+	IncludeUsers  []string `json:"includeUsers"`
+	ExcludeUsers  []string `json:"excludeUsers"`
+	IncludeGroups []string `json:"includeGroups"`
+	ExcludeGroups []string `json:"excludeGroups"`
+	IncludeRoles  []string `json:"includeRoles"`
+	ExcludeRoles  []string `json:"excludeRoles"`
+}
+
+type ConditionalAccessApplications struct {
+	IncludeApplications []string `json:"includeApplications"`
+	ExcludeApplications []string `json:"excludeApplications"`
+}
+
+type ConditionalAccessConditions struct {
+	Users        ConditionalAccessUsers        `json:"users"`
+	Applications ConditionalAccessApplications `json:"applications"`
+}
+
+type ConditionalAccessAuthenticationStrength struct {
+	Id          string `json:"id"`
+	DisplayName string `json:"displayName"`
+}
+
+type ConditionalAccessGrantControls struct {
+	Operator               string                                   `json:"operator"`
+	BuiltInControls        []string                                 `json:"builtInControls"`
+	AuthenticationStrength *ConditionalAccessAuthenticationStrength `json:"authenticationStrength,omitempty"`
+}
+
+type ConditionalAccessPolicy struct {
+	Id               string                          `json:"id"`
+	DisplayName      string                          `json:"displayName"`
+	State            string                          `json:"state"`
+	CreatedDateTime  string                          `json:"createdDateTime"`
+	ModifiedDateTime string                          `json:"modifiedDateTime"`
+	Conditions       ConditionalAccessConditions     `json:"conditions"`
+	GrantControls    *ConditionalAccessGrantControls `json:"grantControls,omitempty"`
+	TenantId         string                          `json:"tenantId"`
+	TenantName       string                          `json:"tenantName"`
+}
+
+// IntuneManagedDevice is an Intune managedDevice. Intune only references the Entra device by its device ID so the
+// collector resolves the object ID of the matching Entra device into DeviceObjectId.
+type IntuneManagedDevice struct {
+	Id               string   `json:"id"`
+	DeviceName       string   `json:"deviceName"`
+	DeviceObjectId   string   `json:"deviceObjectId"`
+	AzureADDeviceId  string   `json:"azureADDeviceId"`
+	OperatingSystem  string   `json:"operatingSystem"`
+	ComplianceState  string   `json:"complianceState"`
+	ManagementAgent  string   `json:"managementAgent"`
+	RoleScopeTagIds  []string `json:"roleScopeTagIds"`
+	LastSyncDateTime string   `json:"lastSyncDateTime"`
+	EnrolledDateTime string   `json:"enrolledDateTime"`
+	TenantId         string   `json:"tenantId"`
+}
+
+type IntuneScriptAssignment struct {
+	Id            string `json:"id"`
+	TargetGroupId string `json:"targetGroupId"`
+}
+
+// IntuneScript is an Intune deviceManagementScript (a PowerShell platform script) along with its group assignments
+type IntuneScript struct {
+	Id              string                   `json:"id"`
+	DisplayName     string                   `json:"displayName"`
+	Description     string                   `json:"description"`
+	FileName        string                   `json:"fileName"`
+	RunAsAccount    string                   `json:"runAsAccount"`
+	RoleScopeTagIds []string                 `json:"roleScopeTagIds"`
+	CreatedDateTime string                   `json:"createdDateTime"`
+	Assignments     []IntuneScriptAssignment `json:"assignments"`
+	TenantId        string                   `json:"tenantId"`
+	TenantName      string                   `json:"tenantName"`
+}
+
+// IntuneRoleAssignment is an Intune RBAC deviceAndAppManagementRoleAssignment. The allowed resource actions of the
+// assigned role definition are included so that the effective permissions can be evaluated without the definition.
+type IntuneRoleAssignment struct {
+	Id                     string   `json:"id"`
+	DisplayName            string   `json:"displayName"`
+	RoleDefinitionId       string   `json:"roleDefinitionId"`
+	RoleDefinitionName     string   `json:"roleDefinitionName"`
+	AllowedResourceActions []string `json:"allowedResourceActions"`
+	Members                []string `json:"members"`
+	ScopeMembers           []string `json:"scopeMembers"`
+	ScopeType              string   `json:"scopeType"`
+	RoleScopeTagIds        []string `json:"roleScopeTagIds"`
+	TenantId               string   `json:"tenantId"`
+	TenantName             string   `json:"tenantName"`
+}
+
+// StorageFileShare is an Azure Files share of a storage account. It mirrors the AzureHound StorageContainer model so
+// that both kinds of storage account children are ingested the same way.
+type StorageFileShare struct {
+	Id                string `json:"id"`
+	Name              string `json:"name"`
+	Type              string `json:"type"`
+	SubscriptionId    string `json:"subscriptionId"`
+	ResourceGroupId   string `json:"resourceGroupId"`
+	ResourceGroupName string `json:"resourceGroupName"`
+	StorageAccountId  string `json:"storageAccountId"`
+	TenantId          string `json:"tenantId"`
+}
@@ -20,10 +20,15 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/bloodhoundad/azurehound/v2/enums"
 	"github.com/bloodhoundad/azurehound/v2/models"
+	azureModels "github.com/bloodhoundad/azurehound/v2/models/azure"
 	"github.com/specterops/bloodhound/packages/go/ein"
 	"github.com/specterops/bloodhound/packages/go/graphschema/azure"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/graph"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -129,3 +134,254 @@ func Test_ConvertAzureRoleManagementPolicyAssignment(t *testing.T) {
 		assert.Equal(t, azure.AZRoleApprover, rels[3].RelType)
 	})
 }
+
+func TestConvertAzureConditionalAccessPolicy(t *testing.T) {
+	var (
+		ingestTime = time.Now().UTC()
+		policy     = ein.ConditionalAccessPolicy{
+			Id:          "policy-1234",
+			DisplayName: "Require MFA for admins",
+			State:       "enabled",
+			Conditions: ein.ConditionalAccessConditions{
+				Users: ein.ConditionalAccessUsers{
+					IncludeUsers:  []string{"All"},
+					ExcludeUsers:  []string{"user-1234", "GuestsOrExternalUsers"},
+					IncludeGroups: []string{"group-1234"},
+					IncludeRoles:  []string{azure.CompanyAdministratorRole},
+				},
+				Applications: ein.ConditionalAccessApplications{
+					IncludeApplications: []string{"All"},
+				},
+			},
+			GrantControls: &ein.ConditionalAccessGrantControls{
+				Operator:        "OR",
+				BuiltInControls: []string{"mfa"},
+				AuthenticationStrength: &ein.ConditionalAccessAuthenticationStrength{
+					Id: "strength-1234",
+				},
+			},
+			TenantId:   "tenant-1234",
+			TenantName: "Contoso",
+		}
+	)
+
+	node, rels := ein.ConvertAzureConditionalAccessPolicy(policy, ingestTime)
+
+	assert.Equal(t, "POLICY-1234", node.ObjectID)
+	assert.Equal(t, azure.ConditionalAccessPolicy, node.Labels[0])
+	assert.Equal(t, "REQUIRE MFA FOR ADMINS@CONTOSO", node.PropertyMap[common.Name.String()])
+	assert.Equal(t, "enabled", node.PropertyMap[azure.CAPolicyState.String()])
+	assert.Equal(t, []string{"ALL"}, node.PropertyMap[azure.CAPolicyIncludeUsers.String()])
+	assert.Equal(t, []string{"USER-1234", "GUESTSOREXTERNALUSERS"}, node.PropertyMap[azure.CAPolicyExcludeUsers.String()])
+	assert.Equal(t, []string{"mfa", azure.ConditionalAccessControlAuthenticationStrength}, node.PropertyMap[azure.CAPolicyGrantControls.String()])
+	assert.Equal(t, "OR", node.PropertyMap[azure.CAPolicyGrantOperator.String()])
+	assert.Equal(t, "TENANT-1234", node.PropertyMap[azure.TenantID.String()])
+
+	// Well known values such as "All" must not produce relationships
+	require.Len(t, rels, 4)
+
+	assert.Equal(t, "TENANT-1234", rels[0].Source.Value)
+	assert.Equal(t, azure.Contains, rels[0].RelType)
+	assert.Equal(t, "POLICY-1234", rels[0].Target.Value)
+
+	assert.Equal(t, "USER-1234", rels[1].Target.Value)
+	assert.Equal(t, azure.User, rels[1].Target.Kind)
+	assert.Equal(t, azure.CAPolicyExcludes, rels[1].RelType)
+
+	assert.Equal(t, "GROUP-1234", rels[2].Target.Value)
+	assert.Equal(t, azure.Group, rels[2].Target.Kind)
+	assert.Equal(t, azure.CAPolicyTargets, rels[2].RelType)
+
+	assert.Equal(t, strings.ToUpper(azure.CompanyAdministratorRole+"@tenant-1234"), rels[3].Target.Value)
+	assert.Equal(t, azure.Role, rels[3].Target.Kind)
+	assert.Equal(t, azure.CAPolicyTargets, rels[3].RelType)
+}
+
+func TestConvertAzureIntuneRoleAssignment(t *testing.T) {
+	var (
+		ingestTime = time.Now().UTC()
+		assignment = ein.IntuneRoleAssignment{
+			Id:                 "assignment-1234",
+			DisplayName:        "Workstation Admins",
+			RoleDefinitionId:   "definition-1234",
+			RoleDefinitionName: "Policy and Profile manager",
+			AllowedResourceActions: []string{
+				azure.IntuneActionDeviceConfigurationsCreate,
+				azure.IntuneActionDeviceConfigurationsAssign,
+			},
+			Members:      []string{"group-1234"},
+			ScopeMembers: []string{"scope-group-1234"},
+			ScopeType:    azure.IntuneScopeTypeResourceScope,
+			TenantId:     "tenant-1234",
+			TenantName:   "Contoso",
+		}
+	)
+
+	node, rels := ein.ConvertAzureIntuneRoleAssignment(assignment, ingestTime)
+
+	assert.Equal(t, "ASSIGNMENT-1234", node.ObjectID)
+	assert.Equal(t, azure.IntuneRoleAssignment, node.Labels[0])
+	assert.Equal(t, true, node.PropertyMap[azure.IntuneCanRunScripts.String()])
+	assert.Equal(t, []string{"SCOPE-GROUP-1234"}, node.PropertyMap[azure.IntuneScopeGroups.String()])
+	assert.Equal(t, []string{azure.IntuneDefaultScopeTagID}, node.PropertyMap[azure.IntuneScopeTagIDs.String()])
+
+	require.Len(t, rels, 2)
+	assert.Equal(t, azure.Contains, rels[0].RelType)
+	assert.Equal(t, "GROUP-1234", rels[1].Source.Value)
+	assert.Equal(t, azure.Group, rels[1].Source.Kind)
+	assert.Equal(t, "ASSIGNMENT-1234", rels[1].Target.Value)
+	assert.Equal(t, azure.HasIntuneRole, rels[1].RelType)
+}
+
+func TestConvertAzureIntuneScript(t *testing.T) {
+	script := ein.IntuneScript{
+		Id:              "script-1234",
+		DisplayName:     "Install agent",
+		RunAsAccount:    "system",
+		RoleScopeTagIds: []string{"1"},
+		Assignments: []ein.IntuneScriptAssignment{
+			{Id: "assignment-1", TargetGroupId: "group-1234"},
+			{Id: "assignment-2"},
+		},
+		TenantId:   "tenant-1234",
+		TenantName: "Contoso",
+	}
+
+	node, rels := ein.ConvertAzureIntuneScript(script, time.Now().UTC())
+
+	assert.Equal(t, "SCRIPT-1234", node.ObjectID)
+	assert.Equal(t, "system", node.PropertyMap[azure.IntuneRunAsAccount.String()])
+	assert.Equal(t, []string{"1"}, node.PropertyMap[azure.IntuneScopeTagIDs.String()])
+
+	// Assignments without a target group, e.g. all devices, are not represented as relationships
+	require.Len(t, rels, 2)
+	assert.Equal(t, "GROUP-1234", rels[1].Target.Value)
+	assert.Equal(t, azure.IntuneScriptTargets, rels[1].RelType)
+}
+
+func TestConvertAzureIntuneManagedDevice(t *testing.T) {
+	device := ein.IntuneManagedDevice{
+		Id:              "managed-1234",
+		DeviceObjectId:  "device-1234",
+		ComplianceState: "compliant",
+		ManagementAgent: "mdm",
+		TenantId:        "tenant-1234",
+	}
+
+	node := ein.ConvertAzureIntuneManagedDevice(device, time.Now().UTC())
+
+	assert.Equal(t, "DEVICE-1234", node.ObjectID)
+	assert.Equal(t, azure.Device, node.Labels[0])
+	assert.Equal(t, "MANAGED-1234", node.PropertyMap[azure.IntuneDeviceID.String()])
+	assert.Equal(t, "compliant", node.PropertyMap[azure.IntuneComplianceState.String()])
+
+	device.DeviceObjectId = ""
+	assert.False(t, ein.ConvertAzureIntuneManagedDevice(device, time.Now().UTC()).IsValid())
+}
+
+func TestCanRunIntuneScripts(t *testing.T) {
+	assert.True(t, ein.CanRunIntuneScripts([]string{azure.IntuneActionDeviceConfigurationsUpdate, azure.IntuneActionDeviceConfigurationsAssign}))
+	assert.False(t, ein.CanRunIntuneScripts([]string{azure.IntuneActionDeviceConfigurationsAssign}))
+	assert.False(t, ein.CanRunIntuneScripts([]string{azure.IntuneActionDeviceConfigurationsCreate}))
+	assert.False(t, ein.CanRunIntuneScripts(nil))
+}
+
+func TestConvertAzureStorageAccountRoleAssignment(t *testing.T) {
+	const storageAccountID = "/subscriptions/sub/resourcegroups/rg/providers/microsoft.storage/storageaccounts/account"
+
+	newAssignment := func(principalID, roleDefinitionID, scope string) models.AzureRoleAssignment {
+		return models.AzureRoleAssignment{
+			Assignee: azureModels.RoleAssignment{
+				Properties: azureModels.RoleAssignmentPropertiesWithScope{
+					PrincipalId: principalID,
+					Scope:       scope,
+				},
+			},
+			ObjectId:         storageAccountID,
+			RoleDefinitionId: roleDefinitionID,
+		}
+	}
+
+	rels := ein.ConvertAzureStorageAccountRoleAssignment(models.AzureRoleAssignments{
+		ObjectId: storageAccountID,
+		RoleAssignments: []models.AzureRoleAssignment{
+			newAssignment("principal-1", azure.StorageAccountContributorRole, storageAccountID),
+			newAssignment("principal-2", strings.ToUpper(azure.StorageBlobDataOwnerRole), storageAccountID),
+			// Inherited assignments are collected at their own scope
+			newAssignment("principal-3", azure.StorageBlobDataContributorRole, "/subscriptions/sub"),
+			// Roles without data plane access are ignored
+			newAssignment("principal-4", "2a2b9908-6ea1-4ae2-8e65-a410df84e7d1", storageAccountID),
+			newAssignment("principal-5", azure.StorageFileSMBElevatedContributorRole, storageAccountID),
+		},
+	})
+
+	require.Len(t, rels, 3)
+	assert.Equal(t, "PRINCIPAL-1", rels[0].Source.Value)
+	assert.Equal(t, strings.ToUpper(storageAccountID), rels[0].Target.Value)
+	assert.Equal(t, azure.StorageAccount, rels[0].Target.Kind)
+	assert.Equal(t, azure.StorageAccountContributor, rels[0].RelType)
+	assert.Equal(t, "PRINCIPAL-2", rels[1].Source.Value)
+	assert.Equal(t, azure.StorageBlobDataOwner, rels[1].RelType)
+	assert.Equal(t, "PRINCIPAL-5", rels[2].Source.Value)
+	assert.Equal(t, azure.StorageFileSMBElevatedContributor, rels[2].RelType)
+}
+
+func TestConvertAzureStorageContainer(t *testing.T) {
+	container := models.StorageContainer{
+		StorageContainer: azureModels.StorageContainer{
+			Entity: azureModels.Entity{Id: "container-1234"},
+			Name:   "backups",
+			Properties: azureModels.StorageContainerProperties{
+				PublicAccess: enums.ContainerPublicAccess,
+			},
+		},
+		StorageAccountId: "account-1234",
+		TenantId:         "tenant-1234",
+	}
+
+	node, rel := ein.ConvertAzureStorageContainer(container, time.Now().UTC())
+
+	assert.Equal(t, "CONTAINER-1234", node.ObjectID)
+	assert.Equal(t, "BACKUPS", node.PropertyMap[common.Name.String()])
+	assert.Equal(t, "Container", node.PropertyMap[azure.StoragePublicAccess.String()])
+	assert.Equal(t, "ACCOUNT-1234", rel.Source.Value)
+	assert.Equal(t, azure.StorageAccount, rel.Source.Kind)
+	assert.Equal(t, azure.Contains, rel.RelType)
+}
+
+func TestConvertAzureStorageFileShare(t *testing.T) {
+	share := ein.StorageFileShare{
+		Id:               "share-1234",
+		Name:             "profiles",
+		StorageAccountId: "account-1234",
+		TenantId:         "tenant-1234",
+	}
+
+	node, rel := ein.ConvertAzureStorageFileShare(share, time.Now().UTC())
+
+	assert.Equal(t, "SHARE-1234", node.ObjectID)
+	assert.Equal(t, "PROFILES", node.PropertyMap[common.Name.String()])
+	assert.Equal(t, []graph.Kind{azure.StorageFileShare}, node.Labels)
+	assert.Equal(t, "ACCOUNT-1234", rel.Source.Value)
+	assert.Equal(t, azure.StorageAccount, rel.Source.Kind)
+	assert.Equal(t, azure.StorageFileShare, rel.Target.Kind)
+	assert.Equal(t, azure.Contains, rel.RelType)
+}
+
+func TestConvertAzureTenantToNode(t *testing.T) {
+	tenant := models.Tenant{
+		Tenant: azureModels.Tenant{
+			DefaultDomain: "contoso.onmicrosoft.com",
+			DisplayName:   "Contoso",
+			Domains:       []string{"contoso.com", "Contoso.onmicrosoft.com"},
+			TenantId:      "6c12b0b0-b2cc-4a73-8252-0b94bfca2145",
+		},
+	}
+
+	node := ein.ConvertAzureTenantToNode(tenant, time.Now().UTC())
+	assert.Equal(t, []string{"CONTOSO.ONMICROSOFT.COM", "CONTOSO.COM"}, node.PropertyMap[azure.TenantDomains.String()])
+
+	tenant.DefaultDomain, tenant.Domains = "", nil
+	node = ein.ConvertAzureTenantToNode(tenant, time.Now().UTC())
+	assert.NotContains(t, node.PropertyMap, azure.TenantDomains.String())
+}
@@ -45,6 +45,12 @@ var (
 	WebApp                               = graph.StringKind("AZWebApp")
 	LogicApp                             = graph.StringKind("AZLogicApp")
 	AutomationAccount                    = graph.StringKind("AZAutomationAccount")
+	ConditionalAccessPolicy              = graph.StringKind("AZConditionalAccessPolicy")
+	IntuneRoleAssignment                 = graph.StringKind("AZIntuneRoleAssignment")
+	IntuneScript                         = graph.StringKind("AZIntuneScript")
+	StorageAccount                       = graph.StringKind("AZStorageAccount")
+	StorageContainer                     = graph.StringKind("AZStorageContainer")
+	StorageFileShare                     = graph.StringKind("AZStorageFileShare")
 	AvereContributor                     = graph.StringKind("AZAvereContributor")
 	Contains                             = graph.StringKind("AZContains")
 	Contributor                          = graph.StringKind("AZContributor")
@@ -94,6 +100,21 @@ var (
 	SyncedToADUser                       = graph.StringKind("SyncedToADUser")
 	AZRoleEligible                       = graph.StringKind("AZRoleEligible")
 	AZRoleApprover                       = graph.StringKind("AZRoleApprover")
+	CAPolicyTargets                      = graph.StringKind("AZCAPolicyTargets")
+	CAPolicyExcludes                     = graph.StringKind("AZCAPolicyExcludes")
+	HasIntuneRole                        = graph.StringKind("AZHasIntuneRole")
+	IntuneScriptTargets                  = graph.StringKind("AZIntuneScriptTargets")
+	StorageAccountContributor            = graph.StringKind("AZStorageAccountContributor")
+	StorageBlobDataOwner                 = graph.StringKind("AZStorageBlobDataOwner")
+	StorageBlobDataContributor           = graph.StringKind("AZStorageBlobDataContributor")
+	GetStorageKeys                       = graph.StringKind("AZGetStorageKeys")
+	AccessBlobData                       = graph.StringKind("AZAccessBlobData")
+	StorageFileSMBContributor            = graph.StringKind("AZStorageFileSMBContributor")
+	StorageFileSMBElevatedContributor    = graph.StringKind("AZStorageFileSMBElevatedContributor")
+	StorageFilePrivilegedContributor     = graph.StringKind("AZStorageFilePrivilegedContributor")
+	AccessFileData                       = graph.StringKind("AZAccessFileData")
+	SyncedToADGroup                      = graph.StringKind("SyncedToADGroup")
+	SyncedToADComputer                   = graph.StringKind("SyncedToADComputer")
 )
 
 type Property string
@@ -139,10 +160,36 @@ const (
 	EndUserAssignmentRequiresMFA                      Property = "enduserassignmentrequiresmfa"
 	EndUserAssignmentRequiresJustification            Property = "enduserassignmentrequiresjustification"
 	EndUserAssignmentRequiresTicketInformation        Property = "enduserassignmentrequiresticketinformation"
+	CAPolicyState                                     Property = "capolicystate"
+	CAPolicyIncludeUsers                              Property = "includeusers"
+	CAPolicyExcludeUsers                              Property = "excludeusers"
+	CAPolicyIncludeGroups                             Property = "includegroups"
+	CAPolicyExcludeGroups                             Property = "excludegroups"
+	CAPolicyIncludeRoles                              Property = "includeroles"
+	CAPolicyExcludeRoles                              Property = "excluderoles"
+	CAPolicyIncludeApplications                       Property = "includeapplications"
+	CAPolicyExcludeApplications                       Property = "excludeapplications"
+	CAPolicyGrantControls                             Property = "grantcontrols"
+	CAPolicyGrantOperator                             Property = "grantoperator"
+	CAPBlocked                                        Property = "capblocked"
+	CAPBlockedBy                                      Property = "capblockedby"
+	CAPMFARequired                                    Property = "capmfarequired"
+	CAPMFARequiredBy                                  Property = "capmfarequiredby"
+	IntuneDeviceID                                    Property = "intunedeviceid"
+	IntuneComplianceState                             Property = "compliancestate"
+	IntuneManagementAgent                             Property = "managementagent"
+	IntuneScopeTagIDs                                 Property = "scopetagids"
+	IntuneRoleDefinitionName                          Property = "intuneroledefinitionname"
+	IntuneScopeType                                   Property = "intunescopetype"
+	IntuneScopeGroups                                 Property = "intunescopegroups"
+	IntuneCanRunScripts                               Property = "canrunscripts"
+	IntuneRunAsAccount                                Property = "runasaccount"
+	StoragePublicAccess                               Property = "publicaccess"
+	TenantDomains                                     Property = "domains"
 )
 
 func AllProperties() []Property {
-	return []Property{AppOwnerOrganizationID, AppDescription, AppDisplayName, ServicePrincipalType, UserType, TenantID, ServicePrincipalID, ServicePrincipalNames, OperatingSystemVersion, TrustType, IsBuiltIn, AppID, AppRoleID, DeviceID, NodeResourceGroupID, OnPremID, OnPremSyncEnabled, SecurityEnabled, SecurityIdentifier, EnableRBACAuthorization, Scope, Offer, MFAEnabled, License, Licenses, LoginURL, MFAEnforced, UserPrincipalName, IsAssignableToRole, PublisherDomain, SignInAudience, RoleTemplateID, RoleDefinitionId, EndUserAssignmentRequiresApproval, EndUserAssignmentRequiresCAPAuthenticationContext, EndUserAssignmentUserApprovers, EndUserAssignmentGroupApprovers, EndUserAssignmentRequiresMFA, EndUserAssignmentRequiresJustification, EndUserAssignmentRequiresTicketInformation}
+	return []Property{AppOwnerOrganizationID, AppDescription, AppDisplayName, ServicePrincipalType, UserType, TenantID, ServicePrincipalID, ServicePrincipalNames, OperatingSystemVersion, TrustType, IsBuiltIn, AppID, AppRoleID, DeviceID, NodeResourceGroupID, OnPremID, OnPremSyncEnabled, SecurityEnabled, SecurityIdentifier, EnableRBACAuthorization, Scope, Offer, MFAEnabled, License, Licenses, LoginURL, MFAEnforced, UserPrincipalName, IsAssignableToRole, PublisherDomain, SignInAudience, RoleTemplateID, RoleDefinitionId, EndUserAssignmentRequiresApproval, EndUserAssignmentRequiresCAPAuthenticationContext, EndUserAssignmentUserApprovers, EndUserAssignmentGroupApprovers, EndUserAssignmentRequiresMFA, EndUserAssignmentRequiresJustification, EndUserAssignmentRequiresTicketInformation, CAPolicyState, CAPolicyIncludeUsers, CAPolicyExcludeUsers, CAPolicyIncludeGroups, CAPolicyExcludeGroups, CAPolicyIncludeRoles, CAPolicyExcludeRoles, CAPolicyIncludeApplications, CAPolicyExcludeApplications, CAPolicyGrantControls, CAPolicyGrantOperator, CAPBlocked, CAPBlockedBy, CAPMFARequired, CAPMFARequiredBy, IntuneDeviceID, IntuneComplianceState, IntuneManagementAgent, IntuneScopeTagIDs, IntuneRoleDefinitionName, IntuneScopeType, IntuneScopeGroups, IntuneCanRunScripts, IntuneRunAsAccount, StoragePublicAccess, TenantDomains}
 }
 func ParseProperty(source string) (Property, error) {
 	switch source {
@@ -226,6 +273,58 @@ func ParseProperty(source string) (Property, error) {
 		return EndUserAssignmentRequiresJustification, nil
 	case "enduserassignmentrequiresticketinformation":
 		return EndUserAssignmentRequiresTicketInformation, nil
+	case "capolicystate":
+		return CAPolicyState, nil
+	case "includeusers":
+		return CAPolicyIncludeUsers, nil
+	case "excludeusers":
+		return CAPolicyExcludeUsers, nil
+	case "includegroups":
+		return CAPolicyIncludeGroups, nil
+	case "excludegroups":
+		return CAPolicyExcludeGroups, nil
+	case "includeroles":
+		return CAPolicyIncludeRoles, nil
+	case "excluderoles":
+		return CAPolicyExcludeRoles, nil
+	case "includeapplications":
+		return CAPolicyIncludeApplications, nil
+	case "excludeapplications":
+		return CAPolicyExcludeApplications, nil
+	case "grantcontrols":
+		return CAPolicyGrantControls, nil
+	case "grantoperator":
+		return CAPolicyGrantOperator, nil
+	case "capblocked":
+		return CAPBlocked, nil
+	case "capblockedby":
+		return CAPBlockedBy, nil
+	case "capmfarequired":
+		return CAPMFARequired, nil
+	case "capmfarequiredby":
+		return CAPMFARequiredBy, nil
+	case "intunedeviceid":
+		return IntuneDeviceID, nil
+	case "compliancestate":
+		return IntuneComplianceState, nil
+	case "managementagent":
+		return IntuneManagementAgent, nil
+	case "scopetagids":
+		return IntuneScopeTagIDs, nil
+	case "intuneroledefinitionname":
+		return IntuneRoleDefinitionName, nil
+	case "intunescopetype":
+		return IntuneScopeType, nil
+	case "intunescopegroups":
+		return IntuneScopeGroups, nil
+	case "canrunscripts":
+		return IntuneCanRunScripts, nil
+	case "runasaccount":
+		return IntuneRunAsAccount, nil
+	case "publicaccess":
+		return StoragePublicAccess, nil
+	case "domains":
+		return TenantDomains, nil
 	default:
 		return "", errors.New("Invalid enumeration value: " + source)
 	}
@@ -312,6 +411,58 @@ func (s Property) String() string {
 		return string(EndUserAssignmentRequiresJustification)
 	case EndUserAssignmentRequiresTicketInformation:
 		return string(EndUserAssignmentRequiresTicketInformation)
+	case CAPolicyState:
+		return string(CAPolicyState)
+	case CAPolicyIncludeUsers:
+		return string(CAPolicyIncludeUsers)
+	case CAPolicyExcludeUsers:
+		return string(CAPolicyExcludeUsers)
+	case CAPolicyIncludeGroups:
+		return string(CAPolicyIncludeGroups)
+	case CAPolicyExcludeGroups:
+		return string(CAPolicyExcludeGroups)
+	case CAPolicyIncludeRoles:
+		return string(CAPolicyIncludeRoles)
+	case CAPolicyExcludeRoles:
+		return string(CAPolicyExcludeRoles)
+	case CAPolicyIncludeApplications:
+		return string(CAPolicyIncludeApplications)
+	case CAPolicyExcludeApplications:
+		return string(CAPolicyExcludeApplications)
+	case CAPolicyGrantControls:
+		return string(CAPolicyGrantControls)
+	case CAPolicyGrantOperator:
+		return string(CAPolicyGrantOperator)
+	case CAPBlocked:
+		return string(CAPBlocked)
+	case CAPBlockedBy:
+		return string(CAPBlockedBy)
+	case CAPMFARequired:
+		return string(CAPMFARequired)
+	case CAPMFARequiredBy:
+		return string(CAPMFARequiredBy)
+	case IntuneDeviceID:
+		return string(IntuneDeviceID)
+	case IntuneComplianceState:
+		return string(IntuneComplianceState)
+	case IntuneManagementAgent:
+		return string(IntuneManagementAgent)
+	case IntuneScopeTagIDs:
+		return string(IntuneScopeTagIDs)
+	case IntuneRoleDefinitionName:
+		return string(IntuneRoleDefinitionName)
+	case IntuneScopeType:
+		return string(IntuneScopeType)
+	case IntuneScopeGroups:
+		return string(IntuneScopeGroups)
+	case IntuneCanRunScripts:
+		return string(IntuneCanRunScripts)
+	case IntuneRunAsAccount:
+		return string(IntuneRunAsAccount)
+	case StoragePublicAccess:
+		return string(StoragePublicAccess)
+	case TenantDomains:
+		return string(TenantDomains)
 	default:
 		return "Invalid enumeration case: " + string(s)
 	}
@@ -398,6 +549,58 @@ func (s Property) Name() string {
 		return "End User Assignment Requires Justification"
 	case EndUserAssignmentRequiresTicketInformation:
 		return "End User Assignment Requires Ticket Information"
+	case CAPolicyState:
+		return "CA Policy State"
+	case CAPolicyIncludeUsers:
+		return "CA Policy Include Users"
+	case CAPolicyExcludeUsers:
+		return "CA Policy Exclude Users"
+	case CAPolicyIncludeGroups:
+		return "CA Policy Include Groups"
+	case CAPolicyExcludeGroups:
+		return "CA Policy Exclude Groups"
+	case CAPolicyIncludeRoles:
+		return "CA Policy Include Roles"
+	case CAPolicyExcludeRoles:
+		return "CA Policy Exclude Roles"
+	case CAPolicyIncludeApplications:
+		return "CA Policy Include Applications"
+	case CAPolicyExcludeApplications:
+		return "CA Policy Exclude Applications"
+	case CAPolicyGrantControls:
+		return "CA Policy Grant Controls"
+	case CAPolicyGrantOperator:
+		return "CA Policy Grant Operator"
+	case CAPBlocked:
+		return "Blocked By Conditional Access"
+	case CAPBlockedBy:
+		return "Conditional Access Policies Blocking"
+	case CAPMFARequired:
+		return "MFA Required By Conditional Access"
+	case CAPMFARequiredBy:
+		return "Conditional Access Policies Requiring MFA"
+	case IntuneDeviceID:
+		return "Intune Device ID"
+	case IntuneComplianceState:
+		return "Intune Compliance State"
+	case IntuneManagementAgent:
+		return "Intune Management Agent"
+	case IntuneScopeTagIDs:
+		return "Intune Scope Tag IDs"
+	case IntuneRoleDefinitionName:
+		return "Intune Role Definition Name"
+	case IntuneScopeType:
+		return "Intune Scope Type"
+	case IntuneScopeGroups:
+		return "Intune Scope Groups"
+	case IntuneCanRunScripts:
+		return "Can Run Intune Scripts"
+	case IntuneRunAsAccount:
+		return "Intune Script Run As Account"
+	case StoragePublicAccess:
+		return "Public Access"
+	case TenantDomains:
+		return "Tenant Domains"
 	default:
 		return "Invalid enumeration case: " + string(s)
 	}
@@ -411,7 +614,7 @@ func (s Property) Is(others ...graph.Kind) bool {
 	return false
 }
 func Relationships() []graph.Kind {
-	return []graph.Kind{AvereContributor, Contains, Contributor, GetCertificates, GetKeys, GetSecrets, HasRole, MemberOf, Owner, RunsAs, VMContributor, AutomationContributor, KeyVaultContributor, VMAdminLogin, AddMembers, AddSecret, ExecuteCommand, GlobalAdmin, PrivilegedAuthAdmin, Grant, GrantSelf, PrivilegedRoleAdmin, ResetPassword, UserAccessAdministrator, Owns, ScopedTo, CloudAppAdmin, AppAdmin, AddOwner, ManagedIdentity, ApplicationReadWriteAll, AppRoleAssignmentReadWriteAll, DirectoryReadWriteAll, GroupReadWriteAll, GroupMemberReadWriteAll, RoleManagementReadWriteDirectory, ServicePrincipalEndpointReadWriteAll, AKSContributor, NodeResourceGroup, WebsiteContributor, LogicAppContributor, AZMGAddMember, AZMGAddOwner, AZMGAddSecret, AZMGGrantAppRoles, AZMGGrantRole, SyncedToADUser, AZRoleEligible, AZRoleApprover}
+	return []graph.Kind{AvereContributor, Contains, Contributor, GetCertificates, GetKeys, GetSecrets, HasRole, MemberOf, Owner, RunsAs, VMContributor, AutomationContributor, KeyVaultContributor, VMAdminLogin, AddMembers, AddSecret, ExecuteCommand, GlobalAdmin, PrivilegedAuthAdmin, Grant, GrantSelf, PrivilegedRoleAdmin, ResetPassword, UserAccessAdministrator, Owns, ScopedTo, CloudAppAdmin, AppAdmin, AddOwner, ManagedIdentity, ApplicationReadWriteAll, AppRoleAssignmentReadWriteAll, DirectoryReadWriteAll, GroupReadWriteAll, GroupMemberReadWriteAll, RoleManagementReadWriteDirectory, ServicePrincipalEndpointReadWriteAll, AKSContributor, NodeResourceGroup, WebsiteContributor, LogicAppContributor, AZMGAddMember, AZMGAddOwner, AZMGAddSecret, AZMGGrantAppRoles, AZMGGrantRole, SyncedToADUser, AZRoleEligible, AZRoleApprover, CAPolicyTargets, CAPolicyExcludes, HasIntuneRole, IntuneScriptTargets, StorageAccountContributor, StorageBlobDataOwner, StorageBlobDataContributor, GetStorageKeys, AccessBlobData, StorageFileSMBContributor, StorageFileSMBElevatedContributor, StorageFilePrivilegedContributor, AccessFileData, SyncedToADGroup, SyncedToADComputer}
 }
 func AppRoleTransitRelationshipKinds() []graph.Kind {
 	return []graph.Kind{AZMGAddMember, AZMGAddOwner, AZMGAddSecret, AZMGGrantAppRoles, AZMGGrantRole}
@@ -420,14 +623,14 @@ func AbusableAppRoleRelationshipKinds() []graph.Kind {
 	return []graph.Kind{ApplicationReadWriteAll, AppRoleAssignmentReadWriteAll, DirectoryReadWriteAll, GroupReadWriteAll, GroupMemberReadWriteAll, RoleManagementReadWriteDirectory, ServicePrincipalEndpointReadWriteAll}
 }
 func ControlRelationships() []graph.Kind {
-	return []graph.Kind{AvereContributor, Contributor, Owner, VMContributor, AutomationContributor, KeyVaultContributor, AddMembers, AddSecret, ExecuteCommand, GlobalAdmin, Grant, GrantSelf, PrivilegedRoleAdmin, ResetPassword, UserAccessAdministrator, Owns, CloudAppAdmin, AppAdmin, AddOwner, ManagedIdentity, AKSContributor, WebsiteContributor, LogicAppContributor, AZMGAddMember, AZMGAddOwner, AZMGAddSecret, AZMGGrantAppRoles, AZMGGrantRole}
+	return []graph.Kind{AvereContributor, Contributor, Owner, VMContributor, AutomationContributor, KeyVaultContributor, AddMembers, AddSecret, ExecuteCommand, GlobalAdmin, Grant, GrantSelf, PrivilegedRoleAdmin, ResetPassword, UserAccessAdministrator, Owns, CloudAppAdmin, AppAdmin, AddOwner, ManagedIdentity, AKSContributor, WebsiteContributor, LogicAppContributor, AZMGAddMember, AZMGAddOwner, AZMGAddSecret, AZMGGrantAppRoles, AZMGGrantRole, StorageAccountContributor}
 }
 func ExecutionPrivileges() []graph.Kind {
 	return []graph.Kind{VMAdminLogin, VMContributor, AvereContributor, WebsiteContributor, Contributor, ExecuteCommand}
 }
 func PathfindingRelationships() []graph.Kind {
-	return []graph.Kind{AvereContributor, Contributor, GetCertificates, GetKeys, GetSecrets, HasRole, MemberOf, Owner, RunsAs, VMContributor, AutomationContributor, KeyVaultContributor, VMAdminLogin, AddMembers, AddSecret, ExecuteCommand, GlobalAdmin, PrivilegedAuthAdmin, Grant, GrantSelf, PrivilegedRoleAdmin, ResetPassword, UserAccessAdministrator, Owns, CloudAppAdmin, AppAdmin, AddOwner, ManagedIdentity, AKSContributor, NodeResourceGroup, WebsiteContributor, LogicAppContributor, AZMGAddMember, AZMGAddOwner, AZMGAddSecret, AZMGGrantAppRoles, AZMGGrantRole, SyncedToADUser, AZRoleEligible, AZRoleApprover, Contains}
+	return []graph.Kind{AvereContributor, Contributor, GetCertificates, GetKeys, GetSecrets, HasRole, MemberOf, Owner, RunsAs, VMContributor, AutomationContributor, KeyVaultContributor, VMAdminLogin, AddMembers, AddSecret, ExecuteCommand, GlobalAdmin, PrivilegedAuthAdmin, Grant, GrantSelf, PrivilegedRoleAdmin, ResetPassword, UserAccessAdministrator, Owns, CloudAppAdmin, AppAdmin, AddOwner, ManagedIdentity, AKSContributor, NodeResourceGroup, WebsiteContributor, LogicAppContributor, AZMGAddMember, AZMGAddOwner, AZMGAddSecret, AZMGGrantAppRoles, AZMGGrantRole, SyncedToADUser, AZRoleEligible, AZRoleApprover, StorageAccountContributor, StorageBlobDataOwner, StorageBlobDataContributor, GetStorageKeys, AccessBlobData, StorageFileSMBContributor, StorageFileSMBElevatedContributor, StorageFilePrivilegedContributor, AccessFileData, SyncedToADGroup, SyncedToADComputer, Contains}
 }
 func NodeKinds() []graph.Kind {
-	return []graph.Kind{Entity, VMScaleSet, App, Role, Device, FunctionApp, Group, KeyVault, ManagementGroup, ResourceGroup, ServicePrincipal, Subscription, Tenant, User, VM, ManagedCluster, ContainerRegistry, WebApp, LogicApp, AutomationAccount}
+	return []graph.Kind{Entity, VMScaleSet, App, Role, Device, FunctionApp, Group, KeyVault, ManagementGroup, ResourceGroup, ServicePrincipal, Subscription, Tenant, User, VM, ManagedCluster, ContainerRegistry, WebApp, LogicApp, AutomationAccount, ConditionalAccessPolicy, IntuneRoleAssignment, IntuneScript, StorageAccount, StorageContainer, StorageFileShare}
 }
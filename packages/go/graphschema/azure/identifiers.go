@@ -27,6 +27,22 @@ const (
 	GroupMemberReadWriteAllID              = "dbaae8cf-10b5-4b86-a4a1-f871c94c6695"
 	RoleManagementReadWriteDirectoryID     = "9e3f62cf-ca93-4989-b6ce-bf83c28f9fe8"
 	ServicePrincipalEndpointReadWriteAllID = "89c8469c-83ad-45f7-8ff2-6e3d4285709e"
+	IntuneAppID                            = "0000000a-0000-0000-c000-000000000000"
+)
+
+// Well known values used by Conditional Access policy conditions and grant controls
+const (
+	ConditionalAccessAll                   = "All"
+	ConditionalAccessNone                  = "None"
+	ConditionalAccessGuestsOrExternalUsers = "GuestsOrExternalUsers"
+	ConditionalAccessStateEnabled          = "enabled"
+	ConditionalAccessControlBlock          = "block"
+	ConditionalAccessControlMFA            = "mfa"
+	ConditionalAccessOperatorOR            = "OR"
+
+	// ConditionalAccessControlAuthenticationStrength is recorded as a grant control when a policy requires an
+	// authentication strength, which always implies multifactor authentication
+	ConditionalAccessControlAuthenticationStrength = "authenticationStrength"
 )
 
 var (
@@ -50,3 +66,16 @@ var (
 		ServicePrincipalEndpointReadWriteAllID: ServicePrincipalEndpointReadWriteAll,
 	}
 )
+
+// Well known values used by Intune RBAC role assignments and managed devices
+const (
+	IntuneDefaultScopeTagID                   = "0"
+	IntuneScopeTypeResourceScope              = "resourceScope"
+	IntuneScopeTypeAllDevices                 = "allDevices"
+	IntuneScopeTypeAllDevicesAndLicensedUsers = "allDevicesAndLicensedUsers"
+
+	// Deploying a platform script to a device requires creating or updating a device configuration and assigning it
+	IntuneActionDeviceConfigurationsCreate = "Microsoft.Intune_DeviceConfigurations_Create"
+	IntuneActionDeviceConfigurationsUpdate = "Microsoft.Intune_DeviceConfigurations_Update"
+	IntuneActionDeviceConfigurationsAssign = "Microsoft.Intune_DeviceConfigurations_Assign"
+)
@@ -87,5 +87,11 @@ const (
 	UserAccessAdminRole                         = "18d7d88d-d35e-4fb5-a5c3-7773c20a72d9"
 	ContributorRole                             = "b24988ac-6180-42a0-ab88-20f7382dd24c"
 	AKSContributorRole                          = "ed7f3fbd-7b88-4dd4-9017-9adb7ce333f8"
+	StorageAccountContributorRole               = "17d1049b-9a84-46fb-8f53-869881c3d3ab"
+	StorageBlobDataOwnerRole                    = "b7e6dc6d-f1e8-4753-8033-0f276bb0955b"
+	StorageBlobDataContributorRole              = "ba92f5b4-2d11-453d-a403-e96b0029c9fe"
+	StorageFileSMBContributorRole               = "0c867c2a-1d8c-454a-a3db-ab2ea1bdc8bb"
+	StorageFileSMBElevatedContributorRole       = "a7264617-510b-434b-a828-9731dc254ea7"
+	StorageFilePrivilegedContributorRole        = "69566ab7-960f-475b-8e7c-b3118f30c6bd"
 	UsageSummaryReportsReaderRole               = "75934031-6c7e-415a-99d7-48dbd49e875e"
 )
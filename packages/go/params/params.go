@@ -26,9 +26,10 @@ import (
 
 // Query parameters
 var (
-	StartNode         = newParam("start_node", nil)
-	EndNode           = newParam("end_node", nil)
-	RelationshipKinds = newParam("relationship_kinds", containsPredicate)
+	StartNode                = newParam("start_node", nil)
+	EndNode                  = newParam("end_node", nil)
+	RelationshipKinds        = newParam("relationship_kinds", containsPredicate)
+	ExcludeConditionalAccess = newParam("exclude_conditional_access", conditionalAccessPredicate)
 )
 
 // param is an immutable path or query parameter
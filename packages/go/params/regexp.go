@@ -19,5 +19,6 @@ package params
 import "regexp"
 
 var (
-	containsPredicate = regexp.MustCompile(`^(in|nin):(\w+)(,\s*\w+)*$`)
+	containsPredicate          = regexp.MustCompile(`^(in|nin):(\w+)(,\s*\w+)*$`)
+	conditionalAccessPredicate = regexp.MustCompile(`^(block|mfa)(,\s*(block|mfa))*$`)
 )
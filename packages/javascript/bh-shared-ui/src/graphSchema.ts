@@ -155,6 +155,9 @@ export enum ActiveDirectoryRelationshipKind {
     GPOAppliesTo = 'GPOAppliesTo',
     CanApplyGPO = 'CanApplyGPO',
     HasTrustKeys = 'HasTrustKeys',
+    SyncedToEntraGroup = 'SyncedToEntraGroup',
+    SyncedToEntraDevice = 'SyncedToEntraDevice',
+    EntraSyncAccountFor = 'EntraSyncAccountFor',
 }
 export function ActiveDirectoryRelationshipKindToDisplay(value: ActiveDirectoryRelationshipKind): string | undefined {
     switch (value) {
@@ -328,6 +331,12 @@ export function ActiveDirectoryRelationshipKindToDisplay(value: ActiveDirectoryR
             return 'CanApplyGPO';
         case ActiveDirectoryRelationshipKind.HasTrustKeys:
             return 'HasTrustKeys';
+        case ActiveDirectoryRelationshipKind.SyncedToEntraGroup:
+            return 'SyncedToEntraGroup';
+        case ActiveDirectoryRelationshipKind.SyncedToEntraDevice:
+            return 'SyncedToEntraDevice';
+        case ActiveDirectoryRelationshipKind.EntraSyncAccountFor:
+            return 'EntraSyncAccountFor';
         default:
             return undefined;
     }
@@ -803,6 +812,8 @@ export function ActiveDirectoryPathfindingEdges(): ActiveDirectoryRelationshipKi
         ActiveDirectoryRelationshipKind.ADCSESC10b,
         ActiveDirectoryRelationshipKind.ADCSESC13,
         ActiveDirectoryRelationshipKind.SyncedToEntraUser,
+        ActiveDirectoryRelationshipKind.SyncedToEntraGroup,
+        ActiveDirectoryRelationshipKind.SyncedToEntraDevice,
         ActiveDirectoryRelationshipKind.CoerceAndRelayNTLMToSMB,
         ActiveDirectoryRelationshipKind.CoerceAndRelayNTLMToADCS,
         ActiveDirectoryRelationshipKind.WriteOwnerLimitedRights,
@@ -842,6 +853,12 @@ export enum AzureNodeKind {
     WebApp = 'AZWebApp',
     LogicApp = 'AZLogicApp',
     AutomationAccount = 'AZAutomationAccount',
+    ConditionalAccessPolicy = 'AZConditionalAccessPolicy',
+    IntuneRoleAssignment = 'AZIntuneRoleAssignment',
+    IntuneScript = 'AZIntuneScript',
+    StorageAccount = 'AZStorageAccount',
+    StorageContainer = 'AZStorageContainer',
+    StorageFileShare = 'AZStorageFileShare',
 }
 export function AzureNodeKindToDisplay(value: AzureNodeKind): string | undefined {
     switch (value) {
@@ -885,6 +902,18 @@ export function AzureNodeKindToDisplay(value: AzureNodeKind): string | undefined
             return 'LogicApp';
         case AzureNodeKind.AutomationAccount:
             return 'AutomationAccount';
+        case AzureNodeKind.ConditionalAccessPolicy:
+            return 'ConditionalAccessPolicy';
+        case AzureNodeKind.IntuneRoleAssignment:
+            return 'IntuneRoleAssignment';
+        case AzureNodeKind.IntuneScript:
+            return 'IntuneScript';
+        case AzureNodeKind.StorageAccount:
+            return 'StorageAccount';
+        case AzureNodeKind.StorageContainer:
+            return 'StorageContainer';
+        case AzureNodeKind.StorageFileShare:
+            return 'StorageFileShare';
         default:
             return undefined;
     }
@@ -939,6 +968,21 @@ export enum AzureRelationshipKind {
     SyncedToADUser = 'SyncedToADUser',
     AZRoleEligible = 'AZRoleEligible',
     AZRoleApprover = 'AZRoleApprover',
+    CAPolicyTargets = 'AZCAPolicyTargets',
+    CAPolicyExcludes = 'AZCAPolicyExcludes',
+    HasIntuneRole = 'AZHasIntuneRole',
+    IntuneScriptTargets = 'AZIntuneScriptTargets',
+    StorageAccountContributor = 'AZStorageAccountContributor',
+    StorageBlobDataOwner = 'AZStorageBlobDataOwner',
+    StorageBlobDataContributor = 'AZStorageBlobDataContributor',
+    GetStorageKeys = 'AZGetStorageKeys',
+    AccessBlobData = 'AZAccessBlobData',
+    StorageFileSMBContributor = 'AZStorageFileSMBContributor',
+    StorageFileSMBElevatedContributor = 'AZStorageFileSMBElevatedContributor',
+    StorageFilePrivilegedContributor = 'AZStorageFilePrivilegedContributor',
+    AccessFileData = 'AZAccessFileData',
+    SyncedToADGroup = 'SyncedToADGroup',
+    SyncedToADComputer = 'SyncedToADComputer',
 }
 export function AzureRelationshipKindToDisplay(value: AzureRelationshipKind): string | undefined {
     switch (value) {
@@ -1040,6 +1084,36 @@ export function AzureRelationshipKindToDisplay(value: AzureRelationshipKind): st
             return 'AZRoleEligible';
         case AzureRelationshipKind.AZRoleApprover:
             return 'AZRoleApprover';
+        case AzureRelationshipKind.CAPolicyTargets:
+            return 'CAPolicyTargets';
+        case AzureRelationshipKind.CAPolicyExcludes:
+            return 'CAPolicyExcludes';
+        case AzureRelationshipKind.HasIntuneRole:
+            return 'HasIntuneRole';
+        case AzureRelationshipKind.IntuneScriptTargets:
+            return 'IntuneScriptTargets';
+        case AzureRelationshipKind.StorageAccountContributor:
+            return 'StorageAccountContributor';
+        case AzureRelationshipKind.StorageBlobDataOwner:
+            return 'StorageBlobDataOwner';
+        case AzureRelationshipKind.StorageBlobDataContributor:
+            return 'StorageBlobDataContributor';
+        case AzureRelationshipKind.GetStorageKeys:
+            return 'GetStorageKeys';
+        case AzureRelationshipKind.AccessBlobData:
+            return 'AccessBlobData';
+        case AzureRelationshipKind.StorageFileSMBContributor:
+            return 'StorageFileSMBContributor';
+        case AzureRelationshipKind.StorageFileSMBElevatedContributor:
+            return 'StorageFileSMBElevatedContributor';
+        case AzureRelationshipKind.StorageFilePrivilegedContributor:
+            return 'StorageFilePrivilegedContributor';
+        case AzureRelationshipKind.AccessFileData:
+            return 'AccessFileData';
+        case AzureRelationshipKind.SyncedToADGroup:
+            return 'SyncedToADGroup';
+        case AzureRelationshipKind.SyncedToADComputer:
+            return 'SyncedToADComputer';
         default:
             return undefined;
     }
@@ -1086,6 +1160,32 @@ export enum AzureKindProperties {
     EndUserAssignmentRequiresMFA = 'enduserassignmentrequiresmfa',
     EndUserAssignmentRequiresJustification = 'enduserassignmentrequiresjustification',
     EndUserAssignmentRequiresTicketInformation = 'enduserassignmentrequiresticketinformation',
+    CAPolicyState = 'capolicystate',
+    CAPolicyIncludeUsers = 'includeusers',
+    CAPolicyExcludeUsers = 'excludeusers',
+    CAPolicyIncludeGroups = 'includegroups',
+    CAPolicyExcludeGroups = 'excludegroups',
+    CAPolicyIncludeRoles = 'includeroles',
+    CAPolicyExcludeRoles = 'excluderoles',
+    CAPolicyIncludeApplications = 'includeapplications',
+    CAPolicyExcludeApplications = 'excludeapplications',
+    CAPolicyGrantControls = 'grantcontrols',
+    CAPolicyGrantOperator = 'grantoperator',
+    CAPBlocked = 'capblocked',
+    CAPBlockedBy = 'capblockedby',
+    CAPMFARequired = 'capmfarequired',
+    CAPMFARequiredBy = 'capmfarequiredby',
+    IntuneDeviceID = 'intunedeviceid',
+    IntuneComplianceState = 'compliancestate',
+    IntuneManagementAgent = 'managementagent',
+    IntuneScopeTagIDs = 'scopetagids',
+    IntuneRoleDefinitionName = 'intuneroledefinitionname',
+    IntuneScopeType = 'intunescopetype',
+    IntuneScopeGroups = 'intunescopegroups',
+    IntuneCanRunScripts = 'canrunscripts',
+    IntuneRunAsAccount = 'runasaccount',
+    StoragePublicAccess = 'publicaccess',
+    TenantDomains = 'domains',
 }
 export function AzureKindPropertiesToDisplay(value: AzureKindProperties): string | undefined {
     switch (value) {
@@ -1169,6 +1269,58 @@ export function AzureKindPropertiesToDisplay(value: AzureKindProperties): string
             return 'End User Assignment Requires Justification';
         case AzureKindProperties.EndUserAssignmentRequiresTicketInformation:
             return 'End User Assignment Requires Ticket Information';
+        case AzureKindProperties.CAPolicyState:
+            return 'CA Policy State';
+        case AzureKindProperties.CAPolicyIncludeUsers:
+            return 'CA Policy Include Users';
+        case AzureKindProperties.CAPolicyExcludeUsers:
+            return 'CA Policy Exclude Users';
+        case AzureKindProperties.CAPolicyIncludeGroups:
+            return 'CA Policy Include Groups';
+        case AzureKindProperties.CAPolicyExcludeGroups:
+            return 'CA Policy Exclude Groups';
+        case AzureKindProperties.CAPolicyIncludeRoles:
+            return 'CA Policy Include Roles';
+        case AzureKindProperties.CAPolicyExcludeRoles:
+            return 'CA Policy Exclude Roles';
+        case AzureKindProperties.CAPolicyIncludeApplications:
+            return 'CA Policy Include Applications';
+        case AzureKindProperties.CAPolicyExcludeApplications:
+            return 'CA Policy Exclude Applications';
+        case AzureKindProperties.CAPolicyGrantControls:
+            return 'CA Policy Grant Controls';
+        case AzureKindProperties.CAPolicyGrantOperator:
+            return 'CA Policy Grant Operator';
+        case AzureKindProperties.CAPBlocked:
+            return 'Blocked By Conditional Access';
+        case AzureKindProperties.CAPBlockedBy:
+            return 'Conditional Access Policies Blocking';
+        case AzureKindProperties.CAPMFARequired:
+            return 'MFA Required By Conditional Access';
+        case AzureKindProperties.CAPMFARequiredBy:
+            return 'Conditional Access Policies Requiring MFA';
+        case AzureKindProperties.IntuneDeviceID:
+            return 'Intune Device ID';
+        case AzureKindProperties.IntuneComplianceState:
+            return 'Intune Compliance State';
+        case AzureKindProperties.IntuneManagementAgent:
+            return 'Intune Management Agent';
+        case AzureKindProperties.IntuneScopeTagIDs:
+            return 'Intune Scope Tag IDs';
+        case AzureKindProperties.IntuneRoleDefinitionName:
+            return 'Intune Role Definition Name';
+        case AzureKindProperties.IntuneScopeType:
+            return 'Intune Scope Type';
+        case AzureKindProperties.IntuneScopeGroups:
+            return 'Intune Scope Groups';
+        case AzureKindProperties.IntuneCanRunScripts:
+            return 'Can Run Intune Scripts';
+        case AzureKindProperties.IntuneRunAsAccount:
+            return 'Intune Script Run As Account';
+        case AzureKindProperties.StoragePublicAccess:
+            return 'Public Access';
+        case AzureKindProperties.TenantDomains:
+            return 'Tenant Domains';
         default:
             return undefined;
     }
@@ -1215,6 +1367,17 @@ export function AzurePathfindingEdges(): AzureRelationshipKind[] {
         AzureRelationshipKind.SyncedToADUser,
         AzureRelationshipKind.AZRoleEligible,
         AzureRelationshipKind.AZRoleApprover,
+        AzureRelationshipKind.StorageAccountContributor,
+        AzureRelationshipKind.StorageBlobDataOwner,
+        AzureRelationshipKind.StorageBlobDataContributor,
+        AzureRelationshipKind.GetStorageKeys,
+        AzureRelationshipKind.AccessBlobData,
+        AzureRelationshipKind.StorageFileSMBContributor,
+        AzureRelationshipKind.StorageFileSMBElevatedContributor,
+        AzureRelationshipKind.StorageFilePrivilegedContributor,
+        AzureRelationshipKind.AccessFileData,
+        AzureRelationshipKind.SyncedToADGroup,
+        AzureRelationshipKind.SyncedToADComputer,
         AzureRelationshipKind.Contains,
     ];
 }